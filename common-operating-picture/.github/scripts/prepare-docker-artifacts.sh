@@ -50,6 +50,8 @@ mkdir -p "$COMPOSE_DIR"
 cp "$APP_ROOT/docker-compose.cop.yaml" "$ZIP_DIR/docker-compose.cop.yaml"
 cp "$APP_ROOT/compose/docker-compose.cop-web-server.yaml" "$COMPOSE_DIR/docker-compose.cop-web-server.yaml"
 cp "$APP_ROOT/compose/docker-compose.cop-db.yaml" "$COMPOSE_DIR/docker-compose.cop-db.yaml"
+yq -i '(.services.cop-db-migrate.image) = "ghcr.io/virtru-corp/dsp-cop/cop-web-server:'"$RELEASE_TAG"'"' "$COMPOSE_DIR/docker-compose.cop-db.yaml"
+yq -i 'del(.services.cop-db-migrate.build)' "$COMPOSE_DIR/docker-compose.cop-db.yaml"
 yq -i '(.services.cop-web-server.image) = "ghcr.io/virtru-corp/dsp-cop/cop-web-server:'"$RELEASE_TAG"'"' "$COMPOSE_DIR/docker-compose.cop-web-server.yaml"
 yq -i 'del(.services.cop-web-server.build)' "$COMPOSE_DIR/docker-compose.cop-web-server.yaml"
 
@@ -65,7 +67,6 @@ yq -i '(.service.public_static_host) = "https://local-dsp.virtru.com:5001"' "$DO
 echo "gathering mock database assets"
 DB_DIR="$ZIP_DIR/db"
 mkdir -p "$DB_DIR"
-cp "$APP_ROOT/db/schema.sql" "$DB_DIR/schema.sql"
 cp "$APP_ROOT/db/seed.sql" "$DB_DIR/seed.sql"
 
 # prepare the README
@@ -0,0 +1,237 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/audit"
+	"github.com/virtru-corp/dsp-cop/pkg/dspClient"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// attachmentBlobKeyPrefix is the blob store key prefix of the payloads of attachments
+const attachmentBlobKeyPrefix = "attachments"
+
+// Parent types of attachments, the tables of their parent
+const (
+	attachmentParentTdfObject = "tdf_object"
+	attachmentParentTdfNote   = "tdf_note"
+)
+
+func (s *TdfObjectServer) CreateTdfAttachment(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.CreateTdfAttachmentRequest],
+) (*connect.Response[tdf_objectv1.CreateTdfAttachmentResponse], error) {
+	// a token is checked with the platform before its user is recorded in the audit log
+	if _, err := s.changedBy(req.Header().Get("Authorization")); err != nil {
+		return nil, err
+	}
+
+	parentId, err := uuid.Parse(req.Msg.ParentId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid parent_id format: %w", err))
+	}
+
+	// check the parent for a clear error, the tdf_attachments trigger enforces it
+	switch req.Msg.ParentType {
+	case attachmentParentTdfObject:
+		_, err = s.DBQueries.GetTdfObject(ctx, parentId)
+	case attachmentParentTdfNote:
+		_, err = s.DBQueries.GetNoteByID(ctx, parentId)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("parent_type must be %s or %s", attachmentParentTdfObject, attachmentParentTdfNote))
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("%s %s not found", req.Msg.ParentType, parentId))
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting %s: %w", req.Msg.ParentType, err))
+	}
+
+	var search []byte
+	if req.Msg.Search != "" {
+		search = []byte(req.Msg.Search)
+	}
+
+	tdfBlob, tdfUri, size := req.Msg.TdfBlob, req.Msg.TdfUri, req.Msg.Size
+	if len(tdfBlob) > 0 {
+		size = int64(len(tdfBlob))
+	}
+	if tdfUri == "" {
+		uri, err := s.Blobs.Offload(ctx, attachmentBlobKeyPrefix, tdfBlob)
+		if err != nil {
+			slog.ErrorContext(ctx, "error storing blob", slog.String("parent_id", req.Msg.ParentId), slog.String("error", err.Error()))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error storing blob: %w", err))
+		}
+		if uri != "" {
+			tdfBlob, tdfUri = nil, uri
+		}
+	}
+
+	id, err := s.DBQueries.CreateTdfAttachment(ctx, db.CreateTdfAttachmentParams{
+		ParentID:   parentId,
+		ParentType: req.Msg.ParentType,
+		Name:       req.Msg.Name,
+		MimeType:   req.Msg.MimeType,
+		Size:       size,
+		Search:     search,
+		TdfBlob:    tdfBlob,
+		TdfUri:     pgtype.Text{String: tdfUri, Valid: tdfUri != ""},
+	})
+	if err != nil {
+		if req.Msg.TdfUri == "" {
+			s.Blobs.Discard(ctx, tdfUri)
+		}
+		return nil, db.StatusifyError(err, db.ErrCreateFailure, slog.String("parent_id", req.Msg.ParentId))
+	}
+	s.audit(ctx, req.Header().Get("Authorization"), req.Spec().Procedure, audit.ActionCreate, audit.DecisionAllowed,
+		auditObject{id: id.String(), search: req.Msg.Search})
+
+	res := connect.NewResponse(&tdf_objectv1.CreateTdfAttachmentResponse{
+		Id: id.String(),
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+func (s *TdfObjectServer) ListTdfAttachments(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.ListTdfAttachmentsRequest],
+) (*connect.Response[tdf_objectv1.ListTdfAttachmentsResponse], error) {
+	token := req.Header().Get("Authorization")
+	entitlements, err := s.getEntitlements(token)
+	if err != nil {
+		return nil, err
+	}
+
+	parentId, err := uuid.Parse(req.Msg.ParentId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid parent_id format: %w", err))
+	}
+
+	attachments, err := s.DBQueries.ListTdfAttachments(ctx, parentId)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]*tdf_objectv1.TdfAttachment, 0, len(attachments))
+	var allowed, filtered []auditObject
+	for _, a := range attachments {
+		audited := auditObject{id: a.ID.String(), search: string(a.Search)}
+		if !attachmentVisible(a.Search, entitlements) {
+			filtered = append(filtered, audited)
+			continue
+		}
+		allowed = append(allowed, audited)
+		visible = append(visible, prepAttachmentForResponse(db.TdfAttachment{
+			ID:         a.ID,
+			Ts:         a.Ts,
+			ParentID:   a.ParentID,
+			ParentType: a.ParentType,
+			Name:       a.Name,
+			MimeType:   a.MimeType,
+			Size:       a.Size,
+			Search:     a.Search,
+		}))
+	}
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionQuery, audit.DecisionAllowed, allowed...)
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionQuery, audit.DecisionFiltered, filtered...)
+
+	res := connect.NewResponse(&tdf_objectv1.ListTdfAttachmentsResponse{
+		TdfAttachments: visible,
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+func (s *TdfObjectServer) GetTdfAttachment(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.GetTdfAttachmentRequest],
+) (*connect.Response[tdf_objectv1.GetTdfAttachmentResponse], error) {
+	token := req.Header().Get("Authorization")
+	entitlements, err := s.getEntitlements(token)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err))
+	}
+
+	a, err := s.DBQueries.GetTdfAttachment(ctx, id)
+	if err == nil {
+		s.audit(ctx, token, req.Spec().Procedure, audit.ActionView, auditDecision(attachmentVisible(a.Search, entitlements)),
+			auditObject{id: a.ID.String(), search: string(a.Search)})
+	}
+	// attachments the caller can not see are not found alike
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && !attachmentVisible(a.Search, entitlements)) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("attachment %s not found", req.Msg.Id))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := prepAttachmentForResponse(db.TdfAttachment{
+		ID:         a.ID,
+		Ts:         a.Ts,
+		ParentID:   a.ParentID,
+		ParentType: a.ParentType,
+		Name:       a.Name,
+		MimeType:   a.MimeType,
+		Size:       a.Size,
+		Search:     a.Search,
+		TdfBlob:    a.TdfBlob,
+		TdfUri:     a.TdfUri,
+	})
+	if attachment.TdfUri != "" {
+		url, err := s.Blobs.DownloadURL(ctx, attachment.TdfUri)
+		if err != nil {
+			slog.ErrorContext(ctx, "error signing blob URL", slog.String("id", attachment.Id), slog.String("error", err.Error()))
+		} else {
+			attachment.TdfUri = url
+		}
+	}
+
+	res := connect.NewResponse(&tdf_objectv1.GetTdfAttachmentResponse{
+		TdfAttachment: attachment,
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+// attachmentVisible reports whether the search attributes of an attachment are visible to the entitlements, as for
+// the notes of QueryTdfNotes. Attachments without search attributes are visible to all.
+func attachmentVisible(search []byte, entitlements dspClient.Entitlements) bool {
+	if len(search) == 0 {
+		return true
+	}
+	_, canSee := searchVisibility(string(search), entitlements)
+	return canSee
+}
+
+func prepAttachmentForResponse(in db.TdfAttachment) *tdf_objectv1.TdfAttachment {
+	return &tdf_objectv1.TdfAttachment{
+		Id:         in.ID.String(),
+		Ts:         timestamppb.New(in.Ts.Time),
+		ParentId:   in.ParentID.String(),
+		ParentType: in.ParentType,
+		Name:       in.Name,
+		MimeType:   in.MimeType,
+		Size:       in.Size,
+		Search:     string(in.Search),
+		TdfBlob:    in.TdfBlob,
+		TdfUri:     in.TdfUri.String,
+	}
+}
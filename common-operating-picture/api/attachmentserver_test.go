@@ -0,0 +1,34 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/virtru-corp/dsp-cop/pkg/dspClient"
+)
+
+var Test_attachmentVisibleTests = []struct {
+	name   string
+	search string
+	want   bool
+}{
+	{"no search", "", true},
+	{"no attributes", `{}`, true},
+	{"entitled classification", `{"attrClassification": "https://demo.com/attr/classification/value/secret"}`, true},
+	{"classification above entitlements", `{"attrClassification": "https://demo.com/attr/classification/value/topsecret"}`, false},
+	{"entitled rel to", `{"attrRelTo": ["https://demo.com/attr/relto/value/usa", "https://demo.com/attr/relto/value/gbr"]}`, true},
+	{"invalid search", `not json`, false},
+}
+
+func Test_attachmentVisible(t *testing.T) {
+	entitlements := dspClient.Entitlements{
+		"https://demo.com/attr/classification/value/secret": true,
+		"https://demo.com/attr/relto/value/usa":             true,
+	}
+	for _, tt := range Test_attachmentVisibleTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := attachmentVisible([]byte(tt.search), entitlements); got != tt.want {
+				t.Errorf("attachmentVisible() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
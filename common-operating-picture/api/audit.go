@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/pkg/audit"
+	"github.com/virtru-corp/dsp-cop/pkg/dspClient"
+	"github.com/virtru-corp/dsp-cop/pkg/util"
+)
+
+// auditObject is an object of an access, with the search attributes holding its classification
+type auditObject struct {
+	id      string
+	srcType string
+	search  string
+}
+
+func tdfObjectAudit(t *tdf_objectv1.TdfObject) auditObject {
+	return auditObject{id: t.Id, srcType: t.SrcType, search: t.Search}
+}
+
+// audit records an access of the user of a token to objects, one record per src_type and classification. An allowed
+// access without objects, a query matching none, is recorded as well. Callers check the token with getEntitlements or
+// changedBy first, so the user is never taken from a token the platform did not accept.
+func (s *TdfObjectServer) audit(ctx context.Context, token, procedure, action, decision string, objs ...auditObject) {
+	if s.Audit == nil {
+		return
+	}
+	record := audit.Record{
+		Subject:   tokenUser(token),
+		Action:    action,
+		Procedure: procedure,
+		Decision:  decision,
+	}
+	if len(objs) == 0 {
+		if decision == audit.DecisionAllowed {
+			s.Audit.Log(ctx, record)
+		}
+		return
+	}
+
+	var groups []*audit.Record
+	index := make(map[string]*audit.Record)
+	for _, o := range objs {
+		classification := searchClassification(o.search)
+		key := o.srcType + "\x00" + strings.Join(classification, "\x00")
+		g, ok := index[key]
+		if !ok {
+			r := record
+			r.SrcType = o.srcType
+			r.Classification = classification
+			g = &r
+			index[key] = g
+			groups = append(groups, g)
+		}
+		g.ObjectIDs = append(g.ObjectIDs, o.id)
+	}
+	for _, g := range groups {
+		s.Audit.Log(ctx, *g)
+	}
+}
+
+// auditFilter filters tdf_objects with filterTdfObjects and records the ones returned to the user of the token as
+// allowed, the ones withheld as filtered
+func (s *TdfObjectServer) auditFilter(
+	ctx context.Context,
+	token, procedure, action string,
+	tdfObjects []*tdf_objectv1.TdfObject,
+	entitlements dspClient.Entitlements,
+) []*tdf_objectv1.TdfObject {
+	if s.Audit == nil {
+		return filterTdfObjects(tdfObjects, entitlements)
+	}
+
+	// the search attributes of the objects returned are pruned, the classification is kept
+	objs := make([]auditObject, len(tdfObjects))
+	for i, t := range tdfObjects {
+		objs[i] = tdfObjectAudit(t)
+	}
+	visible := filterTdfObjects(tdfObjects, entitlements)
+
+	returned := make(map[*tdf_objectv1.TdfObject]bool, len(visible))
+	for _, t := range visible {
+		returned[t] = true
+	}
+	allowed := make([]auditObject, 0, len(visible))
+	var filtered []auditObject
+	for i, t := range tdfObjects {
+		if returned[t] {
+			allowed = append(allowed, objs[i])
+		} else {
+			filtered = append(filtered, objs[i])
+		}
+	}
+	s.audit(ctx, token, procedure, action, audit.DecisionAllowed, allowed...)
+	s.audit(ctx, token, procedure, action, audit.DecisionFiltered, filtered...)
+
+	return visible
+}
+
+// auditDecision returns the decision of an access the entitlements allowed or not
+func auditDecision(allowed bool) string {
+	if allowed {
+		return audit.DecisionAllowed
+	}
+	return audit.DecisionFiltered
+}
+
+// searchClassification returns the attrClassification of search attributes
+func searchClassification(search string) []string {
+	if search == "" {
+		return nil
+	}
+	var searchAttributes util.TDFObjectSearchAttributes
+	if err := json.Unmarshal([]byte(search), &searchAttributes); err != nil {
+		return nil
+	}
+	return searchAttributes.Classification
+}
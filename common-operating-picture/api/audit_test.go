@@ -0,0 +1,83 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/pkg/audit"
+	"github.com/virtru-corp/dsp-cop/pkg/dspClient"
+)
+
+func Test_auditFilter(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := audit.NewFile(path, 1<<20, 0)
+	if err != nil {
+		t.Fatalf("NewFile() failed: %v", err)
+	}
+	logger, err := audit.NewLogger(ctx, sink)
+	if err != nil {
+		t.Fatalf("NewLogger() failed: %v", err)
+	}
+	s := &TdfObjectServer{Audit: logger}
+
+	secret := `{"attrClassification": "https://demo.com/attr/classification/value/secret"}`
+	topSecret := `{"attrClassification": "https://demo.com/attr/classification/value/topsecret"}`
+	tdfObjects := []*tdf_objectv1.TdfObject{
+		{Id: "a", SrcType: "vehicle", Search: secret},
+		{Id: "b", SrcType: "vehicle", Search: topSecret},
+		{Id: "c", SrcType: "vessel", Search: secret},
+		{Id: "d", SrcType: "vehicle", Search: secret},
+	}
+	entitlements := dspClient.Entitlements{"https://demo.com/attr/classification/value/secret": true}
+
+	visible := s.auditFilter(ctx, "", "/tdf_object.v1.TdfObjectService/QueryTdfObjects", audit.ActionQuery, tdfObjects, entitlements)
+	if len(visible) != 3 {
+		t.Errorf("auditFilter() returned %d tdf_objects; want 3", len(visible))
+	}
+	logger.Close()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open() failed: %v", err)
+	}
+	defer f.Close()
+	var records []audit.Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r audit.Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			t.Fatalf("Unmarshal() failed: %v", err)
+		}
+		records = append(records, r)
+	}
+
+	// one record per src_type and classification, the allowed ones first
+	want := []struct {
+		srcType  string
+		ids      []string
+		decision string
+	}{
+		{"vehicle", []string{"a", "d"}, audit.DecisionAllowed},
+		{"vessel", []string{"c"}, audit.DecisionAllowed},
+		{"vehicle", []string{"b"}, audit.DecisionFiltered},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("auditFilter() recorded %d records; want %d", len(records), len(want))
+	}
+	for i, w := range want {
+		r := records[i]
+		if r.SrcType != w.srcType || !slices.Equal(r.ObjectIDs, w.ids) || r.Decision != w.decision || r.Subject != anonymousUser {
+			t.Errorf("record %d = %+v; want %s %v %s of %s", i, r, w.srcType, w.ids, w.decision, anonymousUser)
+		}
+	}
+	if got := records[2].Classification; !slices.Equal(got, []string{"https://demo.com/attr/classification/value/topsecret"}) {
+		t.Errorf("classification of the filtered record = %v; want the topsecret classification", got)
+	}
+}
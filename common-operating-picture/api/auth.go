@@ -0,0 +1,64 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+)
+
+// anonymousUser is the user of changes without a token, as the _created_by default of the tables
+const anonymousUser = "anonymous"
+
+// changedBy returns the user of a change of a tdf_object made with the token, anonymous without a token. The token
+// is checked with the platform by getting its entitlements before its claims are trusted.
+func (s *TdfObjectServer) changedBy(token string) (string, error) {
+	if token == "" {
+		return anonymousUser, nil
+	}
+	if _, err := s.getEntitlements(token); err != nil {
+		return "", err
+	}
+	return tokenUser(token), nil
+}
+
+// tokenClaims are the claims of an access token identifying its user
+type tokenClaims struct {
+	PreferredUsername string `json:"preferred_username"`
+	Subject           string `json:"sub"`
+	RealmAccess       struct {
+		Roles []string `json:"roles"`
+	} `json:"realm_access"`
+}
+
+// parseTokenClaims returns the claims of an access token, false when it is not a JWT. The signature is not verified
+// here, which is up to the platform.
+func parseTokenClaims(token string) (tokenClaims, bool) {
+	var claims tokenClaims
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return claims, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return claims, false
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return claims, false
+	}
+	return claims, true
+}
+
+// tokenUser returns the preferred_username claim of an access token, or else its subject
+func tokenUser(token string) string {
+	claims, ok := parseTokenClaims(token)
+	if !ok {
+		return anonymousUser
+	}
+	switch {
+	case claims.PreferredUsername != "":
+		return claims.PreferredUsername
+	case claims.Subject != "":
+		return claims.Subject
+	}
+	return anonymousUser
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+// testToken returns an unsigned access token with the claims
+func testToken(claims string) string {
+	return "eyJhbGciOiJub25lIn0." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + "."
+}
+
+var Test_tokenUserTests = []struct {
+	name  string
+	token string
+	want  string
+}{
+	{"preferred_username", testToken(`{"sub":"0b6c","preferred_username":"alice"}`), "alice"},
+	{"subject", testToken(`{"sub":"0b6c"}`), "0b6c"},
+	{"no user claims", testToken(`{"azp":"dsp-cop"}`), anonymousUser},
+	{"empty", "", anonymousUser},
+	{"opaque", "0b6c9d7e", anonymousUser},
+	{"invalid payload", "eyJhbGciOiJub25lIn0.!!!.", anonymousUser},
+	{"invalid json", testToken(`{"sub":`), anonymousUser},
+}
+
+func Test_tokenUser(t *testing.T) {
+	for _, tt := range Test_tokenUserTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tokenUser(tt.token); got != tt.want {
+				t.Errorf("tokenUser() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
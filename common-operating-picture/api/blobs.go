@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/pkg/blobstore"
+)
+
+// BlobsPath is the path of the download URLs of the filesystem blob store
+const BlobsPath = "/blobs/"
+
+// signTdfUris replaces the tdf_uris of the blob store with download URLs valid for blob_store.url_ttl, tdf_uris set by
+// clients are left alone
+func (s *TdfObjectServer) signTdfUris(ctx context.Context, tdfObjects []*tdf_objectv1.TdfObject) {
+	for _, t := range tdfObjects {
+		if t.TdfUri == "" {
+			continue
+		}
+		url, err := s.Blobs.DownloadURL(ctx, t.TdfUri)
+		if err != nil {
+			slog.ErrorContext(ctx, "error signing blob URL", slog.String("id", t.Id), slog.String("error", err.Error()))
+			continue
+		}
+		t.TdfUri = url
+	}
+}
+
+// blobStore returns the store of the blob store, nil without a blob store
+func (s *TdfObjectServer) blobStore() blobstore.Store {
+	if s.Blobs == nil {
+		return nil
+	}
+	return s.Blobs.Store
+}
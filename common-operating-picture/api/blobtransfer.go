@@ -0,0 +1,374 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1/tdf_objectv1connect"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/audit"
+	"github.com/virtru-corp/dsp-cop/pkg/blobstore"
+	"github.com/virtru-corp/dsp-cop/pkg/uploads"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// defaultChunkSize is the chunk size of a DownloadTdfBlobRequest without one
+	defaultChunkSize = 1 << 20
+	// maxChunkSize is the largest chunk size of a DownloadTdfBlobRequest, below the 4 MiB message limit of gRPC clients
+	maxChunkSize = 3 << 20
+)
+
+// UploadTdfBlob receives a TDF blob in chunks into a partial upload, which a later call resumes from its offset after
+// a failure. Once every byte is received and the sha256 checked, the blob goes to the blob store and is attached to
+// the tdf_object of the upload.
+func (s *TdfObjectServer) UploadTdfBlob(
+	ctx context.Context,
+	stream *connect.ClientStream[tdf_objectv1.UploadTdfBlobRequest],
+) (*connect.Response[tdf_objectv1.UploadTdfBlobResponse], error) {
+	if s.Uploads == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("uploads require a blob_store backend"))
+	}
+
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("empty upload stream"))
+	}
+	msg := stream.Msg()
+	u, err := s.startUpload(ctx, msg.Start)
+	if err != nil {
+		return nil, err
+	}
+	defer u.Close()
+
+	// the first message may carry a chunk as well
+	for {
+		if len(msg.Data) > 0 {
+			if err := u.Write(msg.Offset, msg.Data); err != nil {
+				return nil, uploadError(err)
+			}
+		}
+		if !stream.Receive() {
+			break
+		}
+		msg = stream.Msg()
+		if msg.Start != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start is only allowed in the first message"))
+		}
+	}
+	// the chunks received so far are kept for the upload to be resumed
+	if err := stream.Err(); err != nil {
+		return nil, err
+	}
+
+	res := &tdf_objectv1.UploadTdfBlobResponse{UploadId: u.ID, Offset: u.Offset()}
+	if u.Complete() {
+		id, err := s.attachUpload(ctx, u, stream.RequestHeader().Get("Authorization"))
+		if err != nil {
+			return nil, err
+		}
+		res.TdfObjectId = id
+		if err := s.Uploads.Remove(u.ID); err != nil {
+			slog.ErrorContext(ctx, "error removing upload", slog.String("upload_id", u.ID), slog.String("error", err.Error()))
+		}
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// startUpload returns the upload of the start of an upload stream, resumed or created for its tdf_object
+func (s *TdfObjectServer) startUpload(ctx context.Context, start *tdf_objectv1.UploadTdfBlobStart) (*uploads.Upload, error) {
+	if start == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("the first message must have start"))
+	}
+	if start.UploadId != "" {
+		u, err := s.Uploads.Open(start.UploadId)
+		if err != nil {
+			return nil, uploadError(err)
+		}
+		return u, nil
+	}
+
+	target := &tdf_objectv1.UploadTdfBlobStart{TdfObjectId: start.TdfObjectId, TdfObject: start.TdfObject}
+	switch {
+	case start.TdfObjectId != "" && start.TdfObject != nil:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("only one of tdf_object_id and tdf_object is allowed"))
+	case start.TdfObjectId != "":
+		id, err := uuid.Parse(start.TdfObjectId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid tdf_object_id: %w", err))
+		}
+		if _, err := s.DBQueries.GetTdfObject(ctx, id); errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_object %s not found", id))
+		} else if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting tdf_object: %w", err))
+		}
+	case start.TdfObject != nil:
+		// fail early rather than once every chunk is received
+		if _, err := newTdfObjectParams(ctx, s.DBQueries, nil, start.TdfObject); errors.Is(err, errInvalidGeoJSON) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		} else if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("one of tdf_object_id and tdf_object is required"))
+	}
+
+	encoded, err := protojson.Marshal(target)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error encoding upload target: %w", err))
+	}
+	u, err := s.Uploads.Create(uploads.State{Size: start.Size, SHA256: start.Sha256, Target: encoded})
+	if err != nil {
+		return nil, uploadError(err)
+	}
+	return u, nil
+}
+
+// attachUpload checks the sha256 of a complete upload and attaches its blob to its tdf_object, returning the id of
+// the tdf_object. An upload failing the check is discarded. The replacement of the blob of an existing tdf_object is
+// recorded as a revision made by the user of the token.
+func (s *TdfObjectServer) attachUpload(ctx context.Context, u *uploads.Upload, token string) (string, error) {
+	if err := u.Verify(); errors.Is(err, uploads.ErrChecksum) {
+		if err := s.Uploads.Remove(u.ID); err != nil {
+			slog.ErrorContext(ctx, "error removing upload", slog.String("upload_id", u.ID), slog.String("error", err.Error()))
+		}
+		return "", connect.NewError(connect.CodeDataLoss, fmt.Errorf("%w, the upload is discarded", err))
+	} else if err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+
+	var target tdf_objectv1.UploadTdfBlobStart
+	if err := protojson.Unmarshal(u.Target, &target); err != nil {
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("invalid upload target: %w", err))
+	}
+	// the token is checked with the platform before its user is recorded in the revision and the audit log
+	changedBy, err := s.changedBy(token)
+	if err != nil {
+		return "", err
+	}
+
+	if target.TdfObjectId == "" {
+		params, err := newTdfObjectParams(ctx, s.DBQueries, nil, target.TdfObject)
+		if err != nil {
+			return "", err
+		}
+		params.TdfBlob, params.TdfUri.String, err = s.storeUpload(ctx, u, params.SrcType)
+		if err != nil {
+			return "", err
+		}
+		params.TdfUri.Valid = params.TdfUri.String != ""
+
+		var newId uuid.UUID
+		var respErr *connect.Error
+		s.DBQueries.CreateTdfObjects(ctx, []db.CreateTdfObjectsParams{params}).QueryRow(func(i int, id uuid.UUID, err error) {
+			if err != nil {
+				slog.ErrorContext(ctx, "Error inserting record", slog.String("error", err.Error()))
+				respErr = db.StatusifyError(err, db.ErrCreateFailure, slog.String("src_type", params.SrcType))
+				s.Blobs.Discard(ctx, params.TdfUri.String)
+			}
+			newId = id
+		})
+		if respErr != nil {
+			return "", respErr
+		}
+		s.audit(ctx, token, tdf_objectv1connect.TdfObjectServiceUploadTdfBlobProcedure, audit.ActionCreate, audit.DecisionAllowed,
+			auditObject{id: newId.String(), srcType: params.SrcType, search: string(params.Search)})
+		return newId.String(), nil
+	}
+
+	id, err := uuid.Parse(target.TdfObjectId)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("invalid upload target: %w", err))
+	}
+	row, err := s.DBQueries.GetTdfObject(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_object %s not found", id))
+	} else if err != nil {
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("error getting tdf_object: %w", err))
+	}
+	blob, uri, err := s.storeUpload(ctx, u, row.SrcType)
+	if err != nil {
+		return "", err
+	}
+	if blob == nil {
+		// the payload of either column is replaced, the other one is emptied
+		blob = []byte{}
+	}
+	err = s.changeTdfObject(ctx, changedBy, revisionOperationUpdate, func(q *db.Queries) error {
+		_, err := q.UpdateTdfObject(ctx, db.UpdateTdfObjectParams{
+			ID:      id,
+			TdfBlob: blob,
+			TdfUri:  pgtype.Text{String: uri, Valid: true},
+		})
+		return err
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Error updating record", slog.String("id", id.String()), slog.String("error", err.Error()))
+		s.Blobs.Discard(ctx, uri)
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("database update failed: %w", err))
+	}
+	s.audit(ctx, token, tdf_objectv1connect.TdfObjectServiceUploadTdfBlobProcedure, audit.ActionUpdate, audit.DecisionAllowed,
+		auditObject{id: id.String(), srcType: row.SrcType, search: string(row.Search)})
+	return id.String(), nil
+}
+
+// storeUpload returns the inline payload of an upload up to the blob_store threshold, or the tdf_uri it is stored at
+// in the blob store
+func (s *TdfObjectServer) storeUpload(ctx context.Context, u *uploads.Upload, srcType string) ([]byte, string, error) {
+	r, err := u.Reader()
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodeInternal, err)
+	}
+	defer r.Close()
+
+	if u.Size <= int64(s.Blobs.Threshold) {
+		blob, err := io.ReadAll(r)
+		if err != nil {
+			return nil, "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read upload: %w", err))
+		}
+		return blob, "", nil
+	}
+	uri, err := s.Blobs.Put(ctx, blobstore.Key(srcType), r, u.Size)
+	if err != nil {
+		slog.ErrorContext(ctx, "error storing blob", slog.String("upload_id", u.ID), slog.String("error", err.Error()))
+		return nil, "", connect.NewError(connect.CodeInternal, fmt.Errorf("error storing blob: %w", err))
+	}
+	return nil, uri, nil
+}
+
+// uploadError returns the status of an error of an upload
+func uploadError(err error) error {
+	switch {
+	case errors.Is(err, uploads.ErrInvalid), errors.Is(err, uploads.ErrSize):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, uploads.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, uploads.ErrBusy):
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, uploads.ErrOffset):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// DownloadTdfBlob sends the TDF blob of a tdf_object the caller can see in chunks from an offset. The first message
+// has the size of the blob and the last one the sha256 of the whole blob, so a resumed download is checked as well.
+func (s *TdfObjectServer) DownloadTdfBlob(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.DownloadTdfBlobRequest],
+	stream *connect.ServerStream[tdf_objectv1.DownloadTdfBlobResponse],
+) error {
+	token := req.Header().Get("Authorization")
+	entitlements, err := s.getEntitlements(token)
+	if err != nil {
+		return err
+	}
+
+	chunkSize := int(req.Msg.ChunkSize)
+	if chunkSize == 0 {
+		chunkSize = defaultChunkSize
+	} else if chunkSize < 0 || chunkSize > maxChunkSize {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("chunk_size must be between 1 and %d", maxChunkSize))
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid id: %w", err))
+	}
+	row, err := s.DBQueries.GetTdfObject(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_object %s not found", id))
+	} else if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("error getting tdf_object: %w", err))
+	}
+	// tdf_objects the caller can not see are not found alike
+	canSee := true
+	if len(row.Search) > 0 {
+		_, canSee = searchVisibility(string(row.Search), entitlements)
+	}
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionDownload, auditDecision(canSee),
+		auditObject{id: id.String(), srcType: row.SrcType, search: string(row.Search)})
+	if !canSee {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_object %s not found", id))
+	}
+
+	r, size, err := s.openTdfBlob(ctx, row)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	offset := req.Msg.Offset
+	if offset < 0 || offset > size {
+		return connect.NewError(connect.CodeOutOfRange, fmt.Errorf("offset must be between 0 and %d", size))
+	}
+	h := sha256.New()
+	// the bytes before the offset are read for the sha256 of the whole blob
+	if _, err := io.CopyN(h, r, offset); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read blob: %w", err))
+	}
+
+	buf := make([]byte, chunkSize)
+	for first := true; ; first = false {
+		n, err := io.ReadFull(r, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read blob: %w", err))
+		}
+		h.Write(buf[:n])
+		res := &tdf_objectv1.DownloadTdfBlobResponse{Offset: offset, Data: buf[:n]}
+		if first {
+			res.Size = size
+		}
+		offset += int64(n)
+
+		last := offset >= size || err != nil
+		if last {
+			if offset != size {
+				return connect.NewError(connect.CodeDataLoss, fmt.Errorf("blob of %d bytes has %d bytes", size, offset))
+			}
+			res.Sha256 = hex.EncodeToString(h.Sum(nil))
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// openTdfBlob returns a reader of the TDF blob of a tdf_object, inline or in the blob store, and its size
+func (s *TdfObjectServer) openTdfBlob(ctx context.Context, row db.GetTdfObjectRow) (io.ReadCloser, int64, error) {
+	if uri := row.TdfUri.String; uri != "" {
+		if s.Blobs == nil || !s.Blobs.Owns(uri) {
+			return nil, 0, connect.NewError(connect.CodeFailedPrecondition, errors.New("the blob of the tdf_object is at its tdf_uri"))
+		}
+		r, size, err := s.Blobs.Open(ctx, uri)
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return nil, 0, connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_object %s has no blob", row.ID))
+		} else if err != nil {
+			slog.ErrorContext(ctx, "error opening blob", slog.String("id", row.ID.String()), slog.String("error", err.Error()))
+			return nil, 0, connect.NewError(connect.CodeInternal, err)
+		}
+		return r, size, nil
+	}
+	if len(row.TdfBlob) == 0 {
+		return nil, 0, connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_object %s has no blob", row.ID))
+	}
+	return io.NopCloser(bytes.NewReader(row.TdfBlob)), int64(len(row.TdfBlob)), nil
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/virtru-corp/dsp-cop/pkg/uploads"
+)
+
+var Test_uploadErrorTests = []struct {
+	name string
+	err  error
+	want connect.Code
+}{
+	{"invalid", fmt.Errorf("%w: size", uploads.ErrInvalid), connect.CodeInvalidArgument},
+	{"past the size", fmt.Errorf("%w: 1 byte past 7", uploads.ErrSize), connect.CodeInvalidArgument},
+	{"not found", uploads.ErrNotFound, connect.CodeNotFound},
+	{"busy", uploads.ErrBusy, connect.CodeAborted},
+	{"offset", fmt.Errorf("%w: got 0, want 3", uploads.ErrOffset), connect.CodeFailedPrecondition},
+	{"disk", errors.New("no space left on device"), connect.CodeInternal},
+}
+
+func Test_uploadError(t *testing.T) {
+	for _, tt := range Test_uploadErrorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := connect.CodeOf(uploadError(tt.err)); got != tt.want {
+				t.Errorf("uploadError() code = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
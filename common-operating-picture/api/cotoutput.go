@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	geos "github.com/twpayne/go-geos"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/db"
+	activeclients "github.com/virtru-corp/dsp-cop/pkg/activeClients"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"github.com/virtru-corp/dsp-cop/pkg/cot"
+	"github.com/virtru-corp/dsp-cop/pkg/geo"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// cotOutputQueueSize is the number of broadcasts queued for an output before broadcasts are dropped
+	cotOutputQueueSize = 256
+	// cotDefaultType is the CoT type of events of an output without a type, an unknown ground unit
+	cotDefaultType = "a-u-G"
+	// cotDefaultStale is the stale time of events of an output without a stale time
+	cotDefaultStale = 300 * time.Second
+)
+
+// cotOutput sends the new tdf_objects broadcast to the StreamTdfObjects clients to a TAK destination as CoT events,
+// limited to the tdf_objects the entitlements of the output's service identity can see.
+type cotOutput struct {
+	cfg            config.CoTOutput
+	srcTypes       map[string]bool
+	excludeSrcType string
+	queries        *db.Queries
+	identity       *serviceIdentity
+	sender         *cot.Sender
+	queue          chan []*tdf_objectv1.TdfObject
+
+	// header display field of each src_type
+	headersLock sync.Mutex
+	headers     map[string]string
+}
+
+// startCoTOutput subscribes an output to the tdf_object broadcasts until the context is done
+func startCoTOutput(ctx context.Context, c *config.Config, o config.CoTOutput, q *db.Queries, clients *activeclients.ActiveClients) {
+	out := &cotOutput{
+		cfg:      o,
+		srcTypes: make(map[string]bool),
+		queries:  q,
+		identity: newServiceIdentity(ctx, c, o.ClientID, o.ClientSecret, o.TokenURL),
+		sender:   &cot.Sender{Network: o.Network, Addr: o.Addr},
+		queue:    make(chan []*tdf_objectv1.TdfObject, cotOutputQueueSize),
+		headers:  make(map[string]string),
+	}
+	for _, srcType := range o.SrcTypes {
+		out.srcTypes[strings.ToLower(srcType)] = true
+	}
+	// do not echo the events of the CoT listener back to TAK unless asked to
+	if len(out.srcTypes) == 0 && c.CoT.Enabled {
+		out.excludeSrcType = strings.ToLower(c.CoT.SrcType)
+	}
+
+	id := "cot-output-" + o.Name + "-" + uuid.NewString()
+	clients.AddStream(id, out)
+	slog.InfoContext(ctx, "starting CoT output",
+		slog.String("name", o.Name),
+		slog.String("network", o.Network),
+		slog.String("addr", o.Addr),
+	)
+
+	go func() {
+		defer func() {
+			clients.Remove(id)
+			out.sender.Close()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case objs := <-out.queue:
+				out.send(ctx, objs)
+			}
+		}
+	}()
+}
+
+// Send queues the tdf_objects of a broadcast, never blocking the broadcast. System events are ignored.
+func (o *cotOutput) Send(message interface{}) error {
+	m, ok := message.(*tdf_objectv1.StreamTdfObjectsResponse)
+	if !ok || len(m.TdfObjects) == 0 {
+		return nil
+	}
+
+	// the broadcast objects are shared with the other clients and filtering prunes them
+	objs := make([]*tdf_objectv1.TdfObject, 0, len(m.TdfObjects))
+	for _, t := range m.TdfObjects {
+		if o.sends(t.SrcType) {
+			objs = append(objs, proto.Clone(t).(*tdf_objectv1.TdfObject))
+		}
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+
+	select {
+	case o.queue <- objs:
+	default:
+		slog.Warn("CoT output queue full, dropping tdf_objects", slog.String("output", o.cfg.Name), slog.Int("count", len(objs)))
+	}
+	return nil
+}
+
+func (o *cotOutput) sends(srcType string) bool {
+	if len(o.srcTypes) > 0 {
+		return o.srcTypes[srcType]
+	}
+	return srcType != o.excludeSrcType
+}
+
+func (o *cotOutput) send(ctx context.Context, objs []*tdf_objectv1.TdfObject) {
+	entitlements, err := o.identity.getEntitlements()
+	if err != nil {
+		// fail closed, nothing is sent without the entitlements of the service identity
+		slog.ErrorContext(ctx, "error getting CoT output entitlements", slog.String("output", o.cfg.Name), slog.String("error", err.Error()))
+		return
+	}
+
+	for _, t := range filterTdfObjects(objs, entitlements) {
+		e, ok := o.event(ctx, t)
+		if !ok {
+			continue
+		}
+		if err := o.sender.Send(e); err != nil {
+			slog.ErrorContext(ctx, "error sending CoT event", slog.String("output", o.cfg.Name), slog.String("id", t.Id), slog.String("error", err.Error()))
+		}
+	}
+}
+
+// event returns the CoT event of a tdf_object with a geometry, placed at its centroid. Entities keep the same uid
+// across events so TAK clients move their marker, and the callsign is the src_type header display field.
+func (o *cotOutput) event(ctx context.Context, t *tdf_objectv1.TdfObject) (cot.Event, bool) {
+	if t.Geo == "" {
+		return cot.Event{}, false
+	}
+	g, err := geos.NewGeomFromWKT(t.Geo)
+	if err != nil {
+		if g, err = geos.NewGeomFromGeoJSON(t.Geo); err != nil {
+			slog.WarnContext(ctx, "skipping tdf_object without a valid geometry", slog.String("id", t.Id), slog.String("error", err.Error()))
+			return cot.Event{}, false
+		}
+	}
+	if g.IsEmpty() {
+		return cot.Event{}, false
+	}
+	centroid := g.Centroid()
+
+	uid := "COP-" + t.SrcType + "-" + t.Id
+	if t.EntityKey != "" {
+		uid = "COP-" + t.SrcType + "-" + t.EntityKey
+	}
+
+	// the broadcast leaves out the metadata, which is read once the tdf_object is known to be visible
+	fields := make(map[string]interface{})
+	if id, err := uuid.Parse(t.Id); err == nil {
+		metadata, err := o.queries.GetTdfObjectMetadata(ctx, id)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			slog.WarnContext(ctx, "error getting tdf_object metadata", slog.String("id", t.Id), slog.String("error", err.Error()))
+		}
+		geo.SetJSONProperties(fields, metadata)
+	}
+	geo.SetJSONProperties(fields, []byte(t.Search))
+	callsign := fieldString(fields, o.header(ctx, t.SrcType))
+	if callsign == "" {
+		callsign = t.EntityKey
+	}
+
+	cotType := o.cfg.Type
+	if cotType == "" {
+		cotType = cotDefaultType
+	}
+	stale := time.Duration(o.cfg.Stale) * time.Second
+	if stale == 0 {
+		stale = cotDefaultStale
+	}
+
+	return cot.NewEvent(uid, cotType, callsign, t.SrcType, centroid.Y(), centroid.X(), t.Ts.AsTime(), stale), true
+}
+
+// header returns the header display field of a src_type, looked up once per src_type
+func (o *cotOutput) header(ctx context.Context, srcType string) string {
+	o.headersLock.Lock()
+	defer o.headersLock.Unlock()
+	if header, ok := o.headers[srcType]; ok {
+		return header
+	}
+
+	header := ""
+	st, err := dbQuerySrcType(ctx, o.queries, srcType)
+	if err != nil {
+		slog.WarnContext(ctx, "error getting src_type of CoT event", slog.String("src_type", srcType), slog.String("error", err.Error()))
+	} else {
+		header = st.GetMetadata().GetDisplayFields().GetHeader()
+	}
+	o.headers[srcType] = header
+	return header
+}
@@ -0,0 +1,369 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	geos "github.com/twpayne/go-geos"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/db"
+	activeclients "github.com/virtru-corp/dsp-cop/pkg/activeClients"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *TdfObjectServer) CreateGeofence(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.CreateGeofenceRequest],
+) (*connect.Response[tdf_objectv1.CreateGeofenceResponse], error) {
+
+	geo, err := geofenceGeomFromGeoJSON(req.Msg.Geo)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var search []byte
+	if req.Msg.Search != "" {
+		search = []byte(req.Msg.Search)
+	}
+
+	id, err := s.DBQueries.CreateGeofence(ctx, db.CreateGeofenceParams{
+		Name:         req.Msg.Name,
+		Geo:          geo,
+		Search:       search,
+		DwellSeconds: int32(req.Msg.DwellSeconds),
+		SrcType:      strings.ToLower(req.Msg.SrcType),
+	})
+	if err != nil {
+		return nil, db.StatusifyError(err, db.ErrCreateFailure, slog.String("geofence", req.Msg.Name))
+	}
+
+	res := connect.NewResponse(&tdf_objectv1.CreateGeofenceResponse{
+		Id: id.String(),
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+func (s *TdfObjectServer) UpdateGeofence(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.UpdateGeofenceRequest],
+) (*connect.Response[tdf_objectv1.UpdateGeofenceResponse], error) {
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err))
+	}
+
+	// Name, Geo, SrcType, Search, DwellSeconds are all optional fields
+	params := db.UpdateGeofenceParams{
+		ID: id,
+	}
+
+	if req.Msg.Name != nil {
+		params.Name = pgtype.Text{String: req.Msg.Name.GetValue(), Valid: true}
+	}
+
+	if req.Msg.Geo != nil {
+		geo, err := geofenceGeomFromGeoJSON(req.Msg.Geo.GetValue())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		params.Geo = geo
+	}
+
+	if req.Msg.SrcType != nil {
+		params.SrcType = pgtype.Text{String: strings.ToLower(req.Msg.SrcType.GetValue()), Valid: true}
+	}
+
+	if req.Msg.Search != nil {
+		// an empty search clears the filter
+		params.Search = []byte(req.Msg.Search.GetValue())
+		if len(params.Search) == 0 {
+			params.Search = []byte("{}")
+		}
+	}
+
+	if req.Msg.DwellSeconds != nil {
+		params.DwellSeconds = pgtype.Int4{Int32: int32(req.Msg.DwellSeconds.GetValue()), Valid: true}
+	}
+
+	updatedId, err := s.DBQueries.UpdateGeofence(ctx, params)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("geofence %s not found", req.Msg.Id))
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error updating geofence", slog.String("id", req.Msg.Id), slog.String("error", err.Error()))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database update failed: %w", err))
+	}
+
+	res := connect.NewResponse(&tdf_objectv1.UpdateGeofenceResponse{
+		Id: updatedId.String(),
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+func (s *TdfObjectServer) GetGeofence(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.GetGeofenceRequest],
+) (*connect.Response[tdf_objectv1.GetGeofenceResponse], error) {
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err))
+	}
+
+	geofence, err := s.DBQueries.GetGeofence(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("geofence %s not found", req.Msg.Id))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&tdf_objectv1.GetGeofenceResponse{
+		Geofence: prepGeofenceForResponse(geofence),
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+func (s *TdfObjectServer) ListGeofences(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.ListGeofencesRequest],
+) (*connect.Response[tdf_objectv1.ListGeofencesResponse], error) {
+
+	geofences, err := s.DBQueries.ListGeofences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resGeofences := make([]*tdf_objectv1.Geofence, 0, len(geofences))
+	for _, g := range geofences {
+		resGeofences = append(resGeofences, prepGeofenceForResponse(db.GetGeofenceRow(g)))
+	}
+
+	res := connect.NewResponse(&tdf_objectv1.ListGeofencesResponse{
+		Geofences: resGeofences,
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+func (s *TdfObjectServer) DeleteGeofence(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.DeleteGeofenceRequest],
+) (*connect.Response[tdf_objectv1.DeleteGeofenceResponse], error) {
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err))
+	}
+
+	deletedId, err := s.DBQueries.DeleteGeofence(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("geofence %s not found", req.Msg.Id))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&tdf_objectv1.DeleteGeofenceResponse{
+		Id: deletedId.String(),
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+func (s *TdfObjectServer) StreamGeofenceEvents(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.StreamGeofenceEventsRequest],
+	stream *connect.ServerStream[tdf_objectv1.StreamGeofenceEventsResponse],
+) error {
+	// the events of tdf_objects are filtered by the entitlements of the client like QueryTdfObjects
+	entitlements, err := s.getEntitlements(req.Header().Get("Authorization"))
+	if err != nil {
+		return err
+	}
+	filter := func(obj *tdf_objectv1.TdfObject) (*tdf_objectv1.TdfObject, bool) {
+		// filterTdfObjects prunes the search attributes of the object, which is shared by the subscribers
+		visible := filterTdfObjects([]*tdf_objectv1.TdfObject{proto.Clone(obj).(*tdf_objectv1.TdfObject)}, entitlements)
+		if len(visible) == 0 {
+			return nil, false
+		}
+		return visible[0], true
+	}
+
+	// generate a unique ID for the client
+	clientId := uuid.New()
+
+	slog.InfoContext(ctx, "client connected to StreamGeofenceEvents",
+		slog.Any("client_id", clientId.String()),
+		slog.Any("geofence_ids", req.Msg.GeofenceIds),
+	)
+	s.ActiveClients.AddGeofence(clientId.String(), req.Peer(), stream, req.Msg.GeofenceIds, filter)
+
+	// remove client from activeClients when context is done (aka client disconnects)
+	defer func() {
+		slog.InfoContext(ctx, "client disconnected from StreamGeofenceEvents", slog.Any("client_id", clientId.String()))
+		s.ActiveClients.Remove(clientId.String())
+	}()
+
+	stream.ResponseHeader().Set("TdfObject-Version", "v1")
+	s.ActiveClients.Emit(
+		clientId.String(),
+		tdf_objectv1.StreamEventType_STREAM_EVENT_TYPE_CONNECTED,
+		"connected on "+time.Now().String(),
+	)
+
+	// loop to keep the stream open until the client disconnects
+	startTime := time.Now()
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		// send heartbeat to client
+		if int(time.Since(startTime).Seconds())%s.Config.Service.StreamHeartbeatInterval == 0 {
+			s.ActiveClients.Emit(
+				clientId.String(), tdf_objectv1.StreamEventType_STREAM_EVENT_TYPE_HEARTBEAT,
+				"alive:"+time.Since(startTime).String(),
+			)
+		}
+	}
+}
+
+// evaluateGeofences emits enter, exit and dwell events for an object to the subscribers of the affected geofences.
+// Entities are identified by their entity_key, or the object id when the object has no entity_key. Dwell is
+// evaluated when the entity reports, so a dwell event is emitted by the first object past the dwell time.
+func evaluateGeofences(ctx context.Context, query *db.Queries, clients *activeclients.ActiveClients, obj *db.TdfObject) error {
+	// position unknown, keep the entity in its current geofences
+	if obj.Geo == nil {
+		return nil
+	}
+
+	entityKey := obj.EntityKey.String
+	if entityKey == "" {
+		entityKey = obj.ID.String()
+	}
+
+	search := obj.Search
+	if len(search) == 0 {
+		search = []byte("null")
+	}
+
+	geofences, err := query.ListGeofencesContaining(ctx, db.ListGeofencesContainingParams{
+		SourceType: obj.SrcType,
+		Search:     search,
+		Geometry:   obj.Geo.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list geofences: %w", err)
+	}
+
+	tdfObject := prepObjForResponse(*obj)
+	inside := make([]uuid.UUID, 0, len(geofences))
+	for _, g := range geofences {
+		inside = append(inside, g.ID)
+
+		occupant, err := query.EnterGeofence(ctx, db.EnterGeofenceParams{
+			GeofenceID: g.ID,
+			SrcType:    obj.SrcType,
+			EntityKey:  entityKey,
+			Ts:         obj.Ts,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to enter geofence %s: %w", g.ID, err)
+		}
+
+		event := &tdf_objectv1.GeofenceEvent{
+			GeofenceId:   g.ID.String(),
+			GeofenceName: g.Name,
+			EntityKey:    entityKey,
+			EnteredAt:    timestamppb.New(occupant.EnteredAt.Time),
+			TdfObject:    tdfObject,
+		}
+
+		dwell := time.Duration(g.DwellSeconds) * time.Second
+		switch {
+		case occupant.Entered:
+			clients.BroadcastGeofenceEvent(tdf_objectv1.StreamEventType_STREAM_EVENT_TYPE_GEOFENCE_ENTER, event)
+		case dwell > 0 && !occupant.DwellNotified && obj.Ts.Time.Sub(occupant.EnteredAt.Time) >= dwell:
+			err := query.MarkGeofenceDwellNotified(ctx, db.MarkGeofenceDwellNotifiedParams{
+				GeofenceID: g.ID,
+				SrcType:    obj.SrcType,
+				EntityKey:  entityKey,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to mark geofence %s dwell: %w", g.ID, err)
+			}
+			clients.BroadcastGeofenceEvent(tdf_objectv1.StreamEventType_STREAM_EVENT_TYPE_GEOFENCE_DWELL, event)
+		}
+	}
+
+	exited, err := query.ExitGeofences(ctx, db.ExitGeofencesParams{
+		SourceType: obj.SrcType,
+		EntityKey:  entityKey,
+		Inside:     inside,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to exit geofences: %w", err)
+	}
+	for _, g := range exited {
+		clients.BroadcastGeofenceEvent(tdf_objectv1.StreamEventType_STREAM_EVENT_TYPE_GEOFENCE_EXIT, &tdf_objectv1.GeofenceEvent{
+			GeofenceId:   g.ID.String(),
+			GeofenceName: g.Name,
+			EntityKey:    entityKey,
+			EnteredAt:    timestamppb.New(g.EnteredAt.Time),
+			TdfObject:    tdfObject,
+		})
+	}
+
+	return nil
+}
+
+// geofenceGeomFromGeoJSON parses a geofence GeoJSON geometry, which must be a Polygon or MultiPolygon.
+func geofenceGeomFromGeoJSON(geojson string) (*geos.Geom, error) {
+	geo, err := geos.NewGeomFromGeoJSON(geojson)
+	if err != nil {
+		return nil, fmt.Errorf("error creating geometry from GeoJSON: %w", err)
+	}
+	if t := geo.TypeID(); t != geos.TypeIDPolygon && t != geos.TypeIDMultiPolygon {
+		return nil, fmt.Errorf("geofence geometry must be a Polygon or MultiPolygon")
+	}
+	return geo, nil
+}
+
+func prepGeofenceForResponse(in db.GetGeofenceRow) *tdf_objectv1.Geofence {
+	geo := ""
+	if g, ok := in.Geo.(*geos.Geom); ok && g != nil {
+		geo = g.ToGeoJSON(0)
+	}
+
+	return &tdf_objectv1.Geofence{
+		Id:           in.ID.String(),
+		Name:         in.Name,
+		Geo:          geo,
+		SrcType:      in.SrcType.String,
+		Search:       string(in.Search),
+		DwellSeconds: uint32(in.DwellSeconds),
+	}
+}
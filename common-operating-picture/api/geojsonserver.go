@@ -0,0 +1,143 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/pkg/audit"
+	"github.com/virtru-corp/dsp-cop/pkg/geo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GeoJSONPath is the path of the GeoJSON FeatureCollection of a tdf_object query
+const GeoJSONPath = "/geojson/tdf_objects"
+
+// geoJSONDefaultRange is the time range queried when the start parameter is omitted
+const geoJSONDefaultRange = 24 * time.Hour
+
+// geoJSONHandler serves QueryTdfObjects as a GeoJSON FeatureCollection for GIS tools, e.g.
+//
+//	GET /geojson/tdf_objects?src_types=vehicle,aircraft&start=2024-06-01T00:00:00Z&search={"callsign":"alpha"}
+//
+// Parameters are src_types (comma separated or repeated), start and end (RFC 3339), geometry (GeoJSON) and search
+// (JSON). Results are filtered by the entitlements of the Authorization header like QueryTdfObjects, the pruned search
+// attributes become feature properties and TDF blobs are left out, only a tdf_uri is linked.
+func (s *TdfObjectServer) geoJSONHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		token := httpToken(r)
+		if token == "" {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		req, err := geoJSONQuery(r.URL.Query(), time.Now().UTC())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		entitlements, err := s.getEntitlements(token)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error getting entitlements", slog.String("error", err.Error()))
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		tdfObjects, err := queryTdfObjectSwitch(r.Context(), s.DBQueries, req)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error querying tdf_objects", slog.String("error", err.Error()))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		tdfObjects = s.auditFilter(r.Context(), token, r.URL.Path, audit.ActionQuery, tdfObjects, entitlements)
+		features := make([]geo.Feature, 0, len(tdfObjects))
+		for _, t := range tdfObjects {
+			features = append(features, tdfObjectFeature(t))
+		}
+
+		w.Header().Set("Content-Type", "application/geo+json")
+		if err := json.NewEncoder(w).Encode(geo.NewFeatureCollection(features)); err != nil {
+			slog.ErrorContext(r.Context(), "error writing GeoJSON", slog.String("error", err.Error()))
+		}
+	})
+}
+
+// httpToken returns the access token of the Authorization header of an HTTP request. GIS tools send the token with a
+// Bearer scheme, which the RPC clients leave out.
+func httpToken(r *http.Request) string {
+	token := r.Header.Get("Authorization")
+	if scheme, rest, ok := strings.Cut(token, " "); ok && strings.EqualFold(scheme, "Bearer") {
+		token = strings.TrimSpace(rest)
+	}
+	return token
+}
+
+// geoJSONQuery returns the QueryTdfObjectsRequest of the GeoJSON endpoint query parameters
+func geoJSONQuery(query url.Values, now time.Time) (*tdf_objectv1.QueryTdfObjectsRequest, error) {
+	req := &tdf_objectv1.QueryTdfObjectsRequest{
+		TsRange: &tdf_objectv1.TimestampSelector{
+			GreaterOrEqualTo: timestamppb.New(now.Add(-geoJSONDefaultRange)),
+		},
+		GeoLocation: query.Get("geometry"),
+		Search:      query.Get("search"),
+	}
+
+	for _, v := range query["src_types"] {
+		for _, srcType := range strings.Split(v, ",") {
+			if srcType = strings.TrimSpace(srcType); srcType != "" {
+				req.SrcTypes = append(req.SrcTypes, strings.ToLower(srcType))
+			}
+		}
+	}
+
+	if v := query.Get("start"); v != "" {
+		start, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start: %w", err)
+		}
+		req.TsRange.GreaterOrEqualTo = timestamppb.New(start)
+	}
+	if v := query.Get("end"); v != "" {
+		end, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end: %w", err)
+		}
+		req.TsRange.LesserOrEqualTo = timestamppb.New(end)
+	}
+
+	if req.Search != "" && !json.Valid([]byte(req.Search)) {
+		return nil, errors.New("invalid search: not JSON")
+	}
+	return req, nil
+}
+
+// tdfObjectFeature returns the GeoJSON Feature of a filtered tdf_object, leaving out the TDF blob
+func tdfObjectFeature(t *tdf_objectv1.TdfObject) geo.Feature {
+	properties := map[string]interface{}{
+		"src_type": t.SrcType,
+		"ts":       t.Ts.AsTime().Format(time.RFC3339Nano),
+	}
+	if t.EntityKey != "" {
+		properties["entity_key"] = t.EntityKey
+	}
+	if t.TdfUri != "" {
+		properties["tdf_uri"] = t.TdfUri
+	}
+	geo.SetJSONProperties(properties, []byte(t.Search))
+
+	return geo.NewFeature(t.Id, t.Geo, properties)
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+)
+
+var Test_geoJSONQueryTests = []struct {
+	test string
+
+	query     string
+	srcTypes  []string
+	wantStart time.Time
+	wantEnd   bool
+	wantErr   bool
+}{
+	{
+		test:      "defaults",
+		query:     "",
+		wantStart: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC).Add(-geoJSONDefaultRange),
+	},
+	{
+		test:      "source types and range",
+		query:     "src_types=Vehicle,aircraft&src_types=vessel&start=2024-05-01T00:00:00Z&end=2024-05-02T00:00:00Z",
+		srcTypes:  []string{"vehicle", "aircraft", "vessel"},
+		wantStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		wantEnd:   true,
+	},
+	{
+		test:    "invalid start",
+		query:   "start=yesterday",
+		wantErr: true,
+	},
+	{
+		test:    "invalid search",
+		query:   "search=" + url.QueryEscape(`{"callsign":`),
+		wantErr: true,
+	},
+}
+
+func Test_geoJSONQuery(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	for _, tt := range Test_geoJSONQueryTests {
+		t.Run(tt.test, func(t *testing.T) {
+			query, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req, err := geoJSONQuery(query, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("geoJSONQuery() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !slices.Equal(req.SrcTypes, tt.srcTypes) {
+				t.Errorf("geoJSONQuery() SrcTypes = %v; want %v", req.SrcTypes, tt.srcTypes)
+			}
+			if start := req.TsRange.GreaterOrEqualTo.AsTime(); !start.Equal(tt.wantStart) {
+				t.Errorf("geoJSONQuery() start = %s; want %s", start, tt.wantStart)
+			}
+			if (req.TsRange.LesserOrEqualTo != nil) != tt.wantEnd {
+				t.Errorf("geoJSONQuery() end = %v; wantEnd %v", req.TsRange.LesserOrEqualTo, tt.wantEnd)
+			}
+		})
+	}
+}
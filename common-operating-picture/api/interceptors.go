@@ -2,29 +2,351 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"connectrpc.com/connect"
 	"connectrpc.com/validate"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"github.com/virtru-corp/dsp-cop/pkg/logger"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// LoggerInterceptor abstracts and logs the request details
-func loggerInterceptor() connect.UnaryInterceptorFunc {
-	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
-		return connect.UnaryFunc(func(
-			ctx context.Context,
-			req connect.AnyRequest,
-		) (connect.AnyResponse, error) {
-			// Log request details
-			slog.InfoContext(ctx, req.Spec().Procedure, "request received",
-				slog.Any("headers", req.Header()),
-				slog.Any("message", req),
+// redacted is the value logged for a redacted header or field
+const redacted = "[REDACTED]"
+
+// Headers and message fields redacted whatever the request_log config
+var (
+	requestLogRedactHeaders   = []string{"Authorization", "Cookie", "Proxy-Authorization"}
+	requestLogSummarizeFields = []string{"metadata"}
+	requestLogPruneFields     = []string{"search"}
+)
+
+// requestLogger logs the RPC requests, unary and streaming, with their status code and duration. Credentials,
+// payloads and plaintext search attributes are redacted from the headers and messages logged.
+type requestLogger struct {
+	level      slog.Level
+	off        bool
+	procedures map[string]requestLogLevel
+
+	redactHeaders map[string]bool
+	summarize     map[string]bool
+	prune         map[string]bool
+	omit          map[string]bool
+}
+
+type requestLogLevel struct {
+	level slog.Level
+	off   bool
+}
+
+func newRequestLogger(c *config.Config) (*requestLogger, error) {
+	l := &requestLogger{
+		procedures:    make(map[string]requestLogLevel),
+		redactHeaders: make(map[string]bool),
+		summarize:     fieldSet(append(requestLogSummarizeFields, c.RequestLog.SummarizeFields...)),
+		prune:         fieldSet(append(requestLogPruneFields, c.RequestLog.PruneFields...)),
+		omit:          fieldSet(c.RequestLog.OmitFields),
+	}
+
+	lvl, err := parseRequestLogLevel(c.RequestLog.Level)
+	if err != nil {
+		return nil, err
+	}
+	l.level, l.off = lvl.level, lvl.off
+	for _, p := range c.RequestLog.Procedures {
+		if l.procedures[p.Procedure], err = parseRequestLogLevel(p.Level); err != nil {
+			return nil, fmt.Errorf("request_log level of %s: %w", p.Procedure, err)
+		}
+	}
+
+	for _, h := range append(requestLogRedactHeaders, c.RequestLog.RedactHeaders...) {
+		l.redactHeaders[http.CanonicalHeaderKey(h)] = true
+	}
+	return l, nil
+}
+
+func parseRequestLogLevel(level string) (requestLogLevel, error) {
+	if strings.EqualFold(level, "OFF") {
+		return requestLogLevel{off: true}, nil
+	}
+	lvl, err := logger.ParseLevel(level)
+	return requestLogLevel{level: lvl}, err
+}
+
+func fieldSet(fields []string) map[string]bool {
+	set := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		set[f] = true
+	}
+	return set
+}
+
+// levelOf returns the level of the requests of a procedure, of its full name or else of its method
+func (l *requestLogger) levelOf(procedure string) (slog.Level, bool) {
+	lvl, ok := l.procedures[procedure]
+	if !ok {
+		lvl, ok = l.procedures[procedure[strings.LastIndex(procedure, "/")+1:]]
+	}
+	if !ok {
+		return l.level, !l.off
+	}
+	return lvl.level, !lvl.off
+}
+
+// enabled returns the level of a request and whether it is logged. Failed requests are logged at WARN at least.
+func (l *requestLogger) enabled(ctx context.Context, procedure string, err error) (slog.Level, bool) {
+	lvl, on := l.levelOf(procedure)
+	if !on {
+		return lvl, false
+	}
+	if err != nil {
+		lvl = max(lvl, slog.LevelWarn)
+	}
+	return lvl, slog.Default().Enabled(ctx, lvl)
+}
+
+func (l *requestLogger) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		res, err := next(ctx, req)
+
+		procedure := req.Spec().Procedure
+		if lvl, ok := l.enabled(ctx, procedure, err); ok {
+			attrs := []slog.Attr{
+				slog.String("procedure", procedure),
+				slog.String("peer", req.Peer().Addr),
+				slog.String("code", statusCode(err)),
+				slog.Duration("duration", time.Since(start)),
+				slog.Any("headers", l.headers(req.Header())),
+			}
+			if msg, ok := req.Any().(proto.Message); ok {
+				attrs = append(attrs, slog.Any("message", l.message(msg.ProtoReflect())))
+			}
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+			slog.LogAttrs(ctx, lvl, "request completed", attrs...)
+		}
+		return res, err
+	}
+}
+
+func (l *requestLogger) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler logs the start of a stream and its end, with the first message received and the number of
+// messages received and sent. The messages of a stream are not logged one by one.
+func (l *requestLogger) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		procedure := conn.Spec().Procedure
+		if lvl, ok := l.enabled(ctx, procedure, nil); ok {
+			slog.LogAttrs(ctx, lvl, "stream started",
+				slog.String("procedure", procedure),
+				slog.String("peer", conn.Peer().Addr),
+				slog.Any("headers", l.headers(conn.RequestHeader())),
 			)
+		}
+
+		counted := &countingConn{StreamingHandlerConn: conn}
+		err := next(ctx, counted)
 
-			return next(ctx, req)
+		if lvl, ok := l.enabled(ctx, procedure, err); ok {
+			attrs := []slog.Attr{
+				slog.String("procedure", procedure),
+				slog.String("peer", conn.Peer().Addr),
+				slog.String("code", statusCode(err)),
+				slog.Duration("duration", time.Since(start)),
+				slog.Int64("received", counted.received.Load()),
+				slog.Int64("sent", counted.sent.Load()),
+			}
+			if first, ok := counted.first.Load().(proto.Message); ok {
+				attrs = append(attrs, slog.Any("message", l.message(first.ProtoReflect())))
+			}
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+			slog.LogAttrs(ctx, lvl, "stream completed", attrs...)
+		}
+		return err
+	}
+}
+
+// countingConn counts the messages of a stream and keeps the first message received
+type countingConn struct {
+	connect.StreamingHandlerConn
+	received atomic.Int64
+	sent     atomic.Int64
+	first    atomic.Value
+}
+
+func (c *countingConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	if c.received.Add(1) == 1 {
+		c.first.Store(msg)
+	}
+	return nil
+}
+
+func (c *countingConn) Send(msg any) error {
+	if err := c.StreamingHandlerConn.Send(msg); err != nil {
+		return err
+	}
+	c.sent.Add(1)
+	return nil
+}
+
+// statusCode returns the code of the status of an error, ok without one
+func statusCode(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	return connect.CodeOf(err).String()
+}
+
+// headers returns headers with the values of the redacted headers replaced
+func (l *requestLogger) headers(h http.Header) map[string][]string {
+	out := make(map[string][]string, len(h))
+	for k, v := range h {
+		if l.redactHeaders[http.CanonicalHeaderKey(k)] {
+			v = []string{redacted}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// message returns the fields of a message to log by their proto names, redacted by the request_log config
+func (l *requestLogger) message(m protoreflect.Message) map[string]any {
+	out := make(map[string]any)
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := string(fd.Name())
+		switch {
+		case l.omit[name]:
+		case l.summarize[name]:
+			out[name] = summarize(fd, v)
+		case l.prune[name]:
+			out[name] = pruneSearch(fd, v)
+		default:
+			out[name] = l.field(fd, v)
+		}
+		return true
+	})
+	return out
+}
+
+func (l *requestLogger) field(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		out := make([]any, list.Len())
+		for i := range out {
+			out[i] = l.value(fd, list.Get(i))
+		}
+		return out
+	case fd.IsMap():
+		out := make(map[string]any)
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			out[k.String()] = l.value(fd.MapValue(), v)
+			return true
 		})
+		return out
+	}
+	return l.value(fd, v)
+}
+
+func (l *requestLogger) value(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		// payloads are never logged
+		return fmt.Sprintf("%d bytes", len(v.Bytes()))
+	case protoreflect.EnumKind:
+		if e := fd.Enum().Values().ByNumber(v.Enum()); e != nil {
+			return string(e.Name())
+		}
+		return v.Enum()
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if ts, ok := v.Message().Interface().(*timestamppb.Timestamp); ok {
+			return ts.AsTime()
+		}
+		if inner, innerValue, ok := unwrap(v.Message()); ok {
+			return l.value(inner, innerValue)
+		}
+		return l.message(v.Message())
+	}
+	return v.Interface()
+}
+
+// unwrap returns the value of a google.protobuf wrapper message, e.g. the string of a StringValue
+func unwrap(m protoreflect.Message) (protoreflect.FieldDescriptor, protoreflect.Value, bool) {
+	d := m.Descriptor()
+	if d.ParentFile().Package() != "google.protobuf" || !strings.HasSuffix(string(d.Name()), "Value") {
+		return nil, protoreflect.Value{}, false
+	}
+	fd := d.Fields().ByName("value")
+	if fd == nil {
+		return nil, protoreflect.Value{}, false
+	}
+	return fd, m.Get(fd), true
+}
+
+// scalar returns the value of a field, unwrapped from a wrapper message
+func scalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) (protoreflect.FieldDescriptor, protoreflect.Value) {
+	if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+		if inner, innerValue, ok := unwrap(v.Message()); ok {
+			return inner, innerValue
+		}
+	}
+	return fd, v
+}
+
+// summarize returns the length of a string or bytes field, the number of entries of a list or map
+func summarize(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch {
+	case fd.IsList():
+		return fmt.Sprintf("%d items", v.List().Len())
+	case fd.IsMap():
+		return fmt.Sprintf("%d entries", v.Map().Len())
+	}
+	fd, v = scalar(fd, v)
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return fmt.Sprintf("%d bytes", len(v.String()))
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("%d bytes", len(v.Bytes()))
+	}
+	return redacted
+}
+
+// pruneSearch returns the attrClassification, attrNeedToKnow and attrRelTo attributes of a search field, as query
+// results are pruned by filterTdfObjects. Other values are redacted.
+func pruneSearch(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	fd, v = scalar(fd, v)
+	if fd.Kind() != protoreflect.StringKind || fd.IsList() || fd.IsMap() {
+		return redacted
 	}
-	return connect.UnaryInterceptorFunc(interceptor)
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(v.String()), &attrs); err != nil {
+		return redacted
+	}
+	pruned := make(map[string]json.RawMessage)
+	for _, k := range []string{"attrClassification", "attrNeedToKnow", "attrRelTo"} {
+		if a, ok := attrs[k]; ok {
+			pruned[k] = a
+		}
+	}
+	return pruned
 }
 
 // ValidationInterceptor abstracts and performs validation on the request
@@ -37,9 +359,14 @@ func validationInterceptor() *validate.Interceptor {
 	return interceptor
 }
 
-func getInterceptors() []connect.Interceptor {
+func getInterceptors(c *config.Config) []connect.Interceptor {
+	requestLogger, err := newRequestLogger(c)
+	if err != nil {
+		slog.Error("failed to create gRPC server request logger", slog.String("error", err.Error()))
+		panic(err)
+	}
 	return []connect.Interceptor{
-		loggerInterceptor(),
+		requestLogger,
 		validationInterceptor(),
 		// Add more interceptors here in the future as needed
 	}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func testRequestLogger(t *testing.T, configure func(c *config.Config)) *requestLogger {
+	c := &config.Config{}
+	c.RequestLog.Level = "INFO"
+	if configure != nil {
+		configure(c)
+	}
+	l, err := newRequestLogger(c)
+	if err != nil {
+		t.Fatalf("newRequestLogger() failed: %v", err)
+	}
+	return l
+}
+
+var Test_requestLoggerMessageTests = []struct {
+	name      string
+	configure func(c *config.Config)
+	msg       proto.Message
+	want      string
+	leaked    []string
+}{
+	{
+		name: "create",
+		msg: &tdf_objectv1.CreateTdfObjectRequest{
+			SrcType:  "vehicle",
+			Search:   `{"attrClassification": "secret", "callsign": "alpha"}`,
+			Metadata: `{"speed": 10}`,
+			TdfBlob:  []byte("ciphertext"),
+		},
+		want:   `{"metadata":"13 bytes","search":{"attrClassification":"secret"},"src_type":"vehicle","tdf_blob":"10 bytes"}`,
+		leaked: []string{"alpha", "speed", "ciphertext"},
+	},
+	{
+		name: "update with wrappers",
+		msg: &tdf_objectv1.UpdateTdfObjectRequest{
+			Id:      "a1",
+			Search:  wrapperspb.String(`{"attrRelTo": ["usa"], "callsign": "alpha"}`),
+			TdfBlob: wrapperspb.Bytes([]byte("ciphertext")),
+		},
+		want:   `{"id":"a1","search":{"attrRelTo":["usa"]},"tdf_blob":"10 bytes"}`,
+		leaked: []string{"alpha", "ciphertext"},
+	},
+	{
+		name: "nested upload start",
+		msg: &tdf_objectv1.UploadTdfBlobRequest{
+			Start: &tdf_objectv1.UploadTdfBlobStart{
+				TdfObject: &tdf_objectv1.CreateTdfObjectRequest{SrcType: "vehicle", Search: "not json"},
+			},
+			Data: []byte("ciphertext"),
+		},
+		want:   `{"data":"10 bytes","start":{"tdf_object":{"search":"[REDACTED]","src_type":"vehicle"}}}`,
+		leaked: []string{"not json", "ciphertext"},
+	},
+	{
+		name: "configured fields",
+		configure: func(c *config.Config) {
+			c.RequestLog.SummarizeFields = []string{"entity_key"}
+			c.RequestLog.OmitFields = []string{"geo"}
+		},
+		msg: &tdf_objectv1.CreateTdfObjectRequest{
+			SrcType:   "vehicle",
+			Geo:       `{"type": "Point", "coordinates": [1, 2]}`,
+			Metadata:  `{"speed": 10}`,
+			EntityKey: "alpha",
+		},
+		want:   `{"entity_key":"5 bytes","metadata":"13 bytes","src_type":"vehicle"}`,
+		leaked: []string{"alpha", "speed", "Point"},
+	},
+}
+
+func Test_requestLoggerMessage(t *testing.T) {
+	for _, tt := range Test_requestLoggerMessageTests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := testRequestLogger(t, tt.configure)
+			b, err := json.Marshal(l.message(tt.msg.ProtoReflect()))
+			if err != nil {
+				t.Fatalf("Marshal() failed: %v", err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("message() = %s; want %s", b, tt.want)
+			}
+			for _, s := range tt.leaked {
+				if strings.Contains(string(b), s) {
+					t.Errorf("message() = %s; leaks %s", b, s)
+				}
+			}
+		})
+	}
+}
+
+func Test_requestLoggerHeaders(t *testing.T) {
+	l := testRequestLogger(t, func(c *config.Config) {
+		c.RequestLog.RedactHeaders = []string{"x-api-key"}
+	})
+	h := http.Header{}
+	h.Set("Authorization", "Bearer token")
+	h.Set("X-Api-Key", "key")
+	h.Set("Content-Type", "application/proto")
+
+	got := l.headers(h)
+	if got["Authorization"][0] != redacted || got["X-Api-Key"][0] != redacted {
+		t.Errorf("headers() = %v; want Authorization and X-Api-Key redacted", got)
+	}
+	if got["Content-Type"][0] != "application/proto" {
+		t.Errorf("headers() = %v; want Content-Type kept", got)
+	}
+}
+
+func Test_requestLoggerLevel(t *testing.T) {
+	l := testRequestLogger(t, func(c *config.Config) {
+		c.RequestLog.Procedures = []config.RequestLogProcedure{
+			{Procedure: "GetLatestPositions", Level: "DEBUG"},
+			{Procedure: "/tdf_object.v1.TdfObjectService/StreamTdfObjects", Level: "OFF"},
+		}
+	})
+
+	if lvl, on := l.levelOf("/tdf_object.v1.TdfObjectService/GetLatestPositions"); !on || lvl != slog.LevelDebug {
+		t.Errorf("levelOf() of a method = %v, %v; want DEBUG", lvl, on)
+	}
+	if _, on := l.levelOf("/tdf_object.v1.TdfObjectService/StreamTdfObjects"); on {
+		t.Error("levelOf() of an OFF procedure is on; want off")
+	}
+	if lvl, on := l.levelOf("/tdf_object.v1.TdfObjectService/GetTdfObject"); !on || lvl != slog.LevelInfo {
+		t.Errorf("levelOf() of another procedure = %v, %v; want INFO", lvl, on)
+	}
+
+	c := &config.Config{}
+	c.RequestLog.Level = "LOUD"
+	if _, err := newRequestLogger(c); err == nil {
+		t.Error("newRequestLogger() of an unknown level succeeded; want error")
+	}
+}
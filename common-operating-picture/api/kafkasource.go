@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/blobstore"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"github.com/virtru-corp/dsp-cop/pkg/kafka"
+)
+
+// runKafkaSource stores the CreateTdfObjectRequests of the configured Kafka topics as the CreateTdfObject RPC does,
+// until the context is done
+func runKafkaSource(ctx context.Context, c *config.Config, q *db.Queries, blobs *blobstore.Blobs) {
+	source, err := kafka.NewSource(c)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to start Kafka source", slog.String("error", err.Error()))
+		return
+	}
+
+	source.Params = func(ctx context.Context, req *tdf_objectv1.CreateTdfObjectRequest) (db.CreateTdfObjectsParams, error) {
+		params, err := newTdfObjectParams(ctx, q, blobs, req)
+		if errors.Is(err, errInvalidGeoJSON) {
+			return params, fmt.Errorf("%w: %w", kafka.ErrPermanent, err)
+		}
+		return params, err
+	}
+	source.Insert = func(ctx context.Context, params []db.CreateTdfObjectsParams) []error {
+		errs := make([]error, len(params))
+		q.CreateTdfObjects(ctx, params).QueryRow(func(i int, _ uuid.UUID, err error) {
+			errs[i] = err
+		})
+		return errs
+	}
+
+	slog.InfoContext(ctx, "starting Kafka source",
+		slog.String("brokers", strings.Join(c.Kafka.Brokers, ",")),
+		slog.String("group", c.Kafka.Source.Group),
+		slog.String("topics", strings.Join(c.Kafka.Source.Topics, ",")),
+	)
+	source.Run(ctx)
+}
@@ -0,0 +1,352 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/pkg/audit"
+	"github.com/virtru-corp/dsp-cop/pkg/geo"
+	"github.com/virtru-corp/dsp-cop/pkg/kml"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// KMLPath is the path of the KML feed of a src_type's recent tdf_objects
+	KMLPath = "/feeds/kml"
+	// KMLNetworkLinkPath is the path of a NetworkLink document refreshing the KML feed
+	KMLNetworkLinkPath = "/feeds/kml/networklink"
+)
+
+// kmlColors are the marker colors of the web interface (see ui/src/pages/SourceTypes/helpers/markers.ts)
+var kmlColors = map[string]string{
+	"default":       "#2a81cb",
+	"topsecret-sci": "#fce83a",
+	"topsecret":     "#ff8c00",
+	"secret":        "#c8102e",
+	"confidential":  "#0033a0",
+	"controlled":    "#502b85",
+	"unclassified":  "#007a33",
+	"employee":      "#2aad27",
+	"sitrep":        "#cb2b3e",
+	// AIS ship categories
+	"cargo":           "#2e8b57",
+	"tanker":          "#b22222",
+	"passenger":       "#1e90ff",
+	"fishing":         "#ff8c00",
+	"tug":             "#daa520",
+	"military":        "#2f4f4f",
+	"pleasure":        "#9370db",
+	"high speed":      "#00ced1",
+	"law enforcement": "#00008b",
+	"red":             "#ff0000",
+	"orange":          "#ffa500",
+	"yellow":          "#ffff00",
+	"green":           "#008000",
+	"blue":            "#0000ff",
+	"indigo":          "#4b0082",
+	"violet":          "#ee82ee",
+	"black":           "#000000",
+}
+
+// kmlParams are the query parameters of the KML feed
+type kmlParams struct {
+	srcType string
+	window  time.Duration
+	kmz     bool
+}
+
+func (s *TdfObjectServer) kmlParams(r *http.Request) (kmlParams, error) {
+	query := r.URL.Query()
+	p := kmlParams{
+		srcType: strings.ToLower(strings.TrimSpace(query.Get("src_type"))),
+		window:  time.Duration(s.Config.KML.Window) * time.Second,
+		kmz:     query.Get("format") == "kmz",
+	}
+	if p.srcType == "" {
+		return p, errors.New("src_type is required")
+	}
+	if v := query.Get("window"); v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil || seconds <= 0 {
+			return p, fmt.Errorf("invalid window %q: expected a number of seconds", v)
+		}
+		p.window = time.Duration(seconds) * time.Second
+	}
+	if f := query.Get("format"); f != "" && f != "kml" && f != "kmz" {
+		return p, fmt.Errorf("invalid format %q: expected kml or kmz", f)
+	}
+	return p, nil
+}
+
+// kmlToken returns the access token of the Authorization header, or of the access_token query parameter for viewers
+// that can not set headers
+func kmlToken(r *http.Request) string {
+	if token := httpToken(r); token != "" {
+		return token
+	}
+	return r.URL.Query().Get("access_token")
+}
+
+// kmlHandler serves the recent tdf_objects of a src_type as KML Placemarks for Google Earth style viewers, e.g.
+//
+//	GET /feeds/kml?src_type=vehicle&window=3600&format=kmz
+//
+// Entities are placed at their latest position within the window, and results are filtered by entitlements like
+// QueryTdfObjects. Placemarks are named and described by the src_type display fields and styled by its map fields,
+// resolving fields from the metadata and pruned search attributes, as TDF blobs can not be decrypted for the viewer.
+func (s *TdfObjectServer) kmlHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		token := kmlToken(r)
+		if token == "" {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		p, err := s.kmlParams(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		entitlements, err := s.getEntitlements(token)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error getting entitlements", slog.String("error", err.Error()))
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		srcType, err := dbQuerySrcType(r.Context(), s.DBQueries, p.srcType)
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.Error(w, "unknown src_type "+p.srcType, http.StatusNotFound)
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "error getting src_type", slog.String("src_type", p.srcType), slog.String("error", err.Error()))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		tdfObjects, err := queryTdfObjectSwitch(r.Context(), s.DBQueries, &tdf_objectv1.QueryTdfObjectsRequest{
+			TsRange:  &tdf_objectv1.TimestampSelector{GreaterOrEqualTo: timestamppb.New(time.Now().UTC().Add(-p.window))},
+			SrcTypes: []string{p.srcType},
+		})
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error querying tdf_objects", slog.String("error", err.Error()))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		tdfObjects = latestPerEntity(s.auditFilter(r.Context(), token, r.URL.Path, audit.ActionQuery, tdfObjects, entitlements))
+
+		doc := kml.NewDocument(kmlDocument(srcType, tdfObjects, s.Config.KML.IconURL))
+		if p.kmz {
+			w.Header().Set("Content-Type", "application/vnd.google-earth.kmz")
+			err = kml.WriteKMZ(w, doc)
+		} else {
+			w.Header().Set("Content-Type", "application/vnd.google-earth.kml+xml")
+			err = kml.Write(w, doc)
+		}
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error writing KML", slog.String("error", err.Error()))
+		}
+	})
+}
+
+// kmlNetworkLinkHandler serves a NetworkLink document that refreshes the KML feed of the same query parameters every
+// kml.refresh_interval seconds, so viewers follow the picture live. An access_token query parameter is passed on to
+// the feed, a token in the Authorization header is not.
+func (s *TdfObjectServer) kmlNetworkLinkHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		if kmlToken(r) == "" {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		p, err := s.kmlParams(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		feed := url.URL{
+			Scheme:   "http",
+			Host:     r.Host,
+			Path:     KMLPath,
+			RawQuery: r.URL.RawQuery,
+		}
+		if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+			feed.Scheme = "https"
+		}
+
+		link := kml.NewNetworkLink("COP "+p.srcType, feed.String(), s.Config.KML.RefreshInterval)
+		w.Header().Set("Content-Type", "application/vnd.google-earth.kml+xml")
+		if err := kml.Write(w, link); err != nil {
+			slog.ErrorContext(r.Context(), "error writing KML", slog.String("error", err.Error()))
+		}
+	})
+}
+
+// latestPerEntity keeps the latest tdf_object of each entity_key of tdf_objects ordered by ts descending. Objects
+// without an entity_key are all kept.
+func latestPerEntity(tdfObjects []*tdf_objectv1.TdfObject) []*tdf_objectv1.TdfObject {
+	seen := make(map[string]bool)
+	latest := make([]*tdf_objectv1.TdfObject, 0, len(tdfObjects))
+	for _, t := range tdfObjects {
+		if t.EntityKey != "" {
+			if seen[t.EntityKey] {
+				continue
+			}
+			seen[t.EntityKey] = true
+		}
+		latest = append(latest, t)
+	}
+	return latest
+}
+
+// kmlDocument returns the KML Document of filtered tdf_objects of a src_type. Placemarks share a Style per icon and
+// color, and tdf_objects without a geometry are left out.
+func kmlDocument(srcType *tdf_objectv1.SrcType, tdfObjects []*tdf_objectv1.TdfObject, iconURL string) kml.Document {
+	doc := kml.Document{Name: srcType.GetId()}
+	metadata := srcType.GetMetadata()
+	styles := make(map[string]string)
+
+	for _, t := range tdfObjects {
+		if t.Geo == "" {
+			continue
+		}
+		geometry, err := kml.GeometryFromGeoJSON(t.Geo)
+		if err != nil {
+			slog.Warn("skipping tdf_object in KML", slog.String("id", t.Id), slog.String("error", err.Error()))
+			continue
+		}
+
+		fields := make(map[string]interface{})
+		geo.SetJSONProperties(fields, []byte(t.Metadata))
+		geo.SetJSONProperties(fields, []byte(t.Search))
+
+		icon, color := kmlIconAndColor(metadata.GetMapFields(), fields)
+		key := icon + "|" + color
+		styleID, ok := styles[key]
+		if !ok {
+			styleID = fmt.Sprintf("style-%d", len(styles)+1)
+			styles[key] = styleID
+			style := kml.Style{ID: styleID, IconStyle: kml.IconStyle{Color: color}}
+			if iconURL != "" && icon != "" {
+				style.IconStyle.Icon = &kml.Icon{Href: strings.ReplaceAll(iconURL, "{icon}", url.PathEscape(icon))}
+			}
+			doc.Styles = append(doc.Styles, style)
+		}
+
+		name := fieldString(fields, metadata.GetDisplayFields().GetHeader())
+		if name == "" {
+			name = t.EntityKey
+		}
+		if name == "" {
+			name = t.Id
+		}
+
+		var details []string
+		for _, field := range metadata.GetDisplayFields().GetDetails() {
+			if v := fieldString(fields, field); v != "" {
+				details = append(details, html.EscapeString(field)+": "+html.EscapeString(v))
+			}
+		}
+
+		doc.Placemarks = append(doc.Placemarks, kml.Placemark{
+			ID:          t.Id,
+			Name:        name,
+			Description: strings.Join(details, "<br/>"),
+			TimeStamp:   kml.NewTimeStamp(t.Ts.AsTime()),
+			StyleURL:    "#" + styleID,
+			Geometry:    geometry,
+		})
+	}
+	return doc
+}
+
+// kmlIconAndColor returns the icon name and KML color of a tdf_object's fields like the web interface markers: the
+// first icon config with a value picks the icon, the first color config picks the color, and the defaults are used
+// otherwise.
+func kmlIconAndColor(mapFields *tdf_objectv1.SrcTypeMetadataMapFields, fields map[string]interface{}) (string, string) {
+	icon := ""
+	for _, c := range mapFields.GetIconConfig() {
+		v := attrValue(fieldString(fields, c.GetField()))
+		if mapped, ok := c.GetValueMap()[v]; ok {
+			v = mapped
+		}
+		if v != "" {
+			icon = v
+			break
+		}
+	}
+	if icon == "" {
+		icon = mapFields.GetIconDefault()
+	}
+
+	color := ""
+	if configs := mapFields.GetColorConfig(); len(configs) > 0 {
+		v := strings.ToLower(attrValue(fieldString(fields, configs[0].GetField())))
+		if mapped, ok := configs[0].GetValueMap()[v]; ok {
+			v = mapped
+		}
+		color = kmlColors[v]
+	} else {
+		color = kmlColors[mapFields.GetColorDefault()]
+	}
+	if color == "" {
+		color = kmlColors["default"]
+	}
+	kmlColor, _ := kml.Color(color)
+	return icon, kmlColor
+}
+
+// attrValue returns the value of an attribute value FQN (.../attr/<name>/value/<value>), or the string as is
+func attrValue(s string) string {
+	if _, v, ok := strings.Cut(s, "/value/"); ok && strings.Contains(s, "/attr/") {
+		return v
+	}
+	return s
+}
+
+// fieldString returns a field as a string, the first element of an array
+func fieldString(fields map[string]interface{}, field string) string {
+	if field == "" {
+		return ""
+	}
+	v := fields[field]
+	if a, ok := v.([]interface{}); ok {
+		if len(a) == 0 {
+			return ""
+		}
+		v = a[0]
+	}
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"testing"
+
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+)
+
+func Test_kmlIconAndColor(t *testing.T) {
+	mapFields := &tdf_objectv1.SrcTypeMetadataMapFields{
+		IconDefault: "employee",
+		IconConfig: []*tdf_objectv1.SrcTypeMetadataMapFieldConfig{{
+			Field:    "attrClassification",
+			ValueMap: map[string]string{"secret": "secret-icon"},
+		}},
+		ColorDefault: "employee",
+		ColorConfig: []*tdf_objectv1.SrcTypeMetadataMapFieldConfig{{
+			Field: "favoriteColor",
+		}},
+	}
+
+	icon, color := kmlIconAndColor(mapFields, map[string]interface{}{
+		"attrClassification": []interface{}{"https://example.com/attr/classification/value/secret"},
+		"favoriteColor":      "Red",
+	})
+	if icon != "secret-icon" || color != "ff0000ff" {
+		t.Errorf("kmlIconAndColor() = %s, %s; want secret-icon, ff0000ff", icon, color)
+	}
+
+	icon, color = kmlIconAndColor(mapFields, map[string]interface{}{})
+	if icon != "employee" || color != "ffcb812a" {
+		t.Errorf("kmlIconAndColor() without fields = %s, %s; want employee, ffcb812a", icon, color)
+	}
+}
+
+func Test_latestPerEntity(t *testing.T) {
+	got := latestPerEntity([]*tdf_objectv1.TdfObject{
+		{Id: "1", EntityKey: "alpha"},
+		{Id: "2"},
+		{Id: "3", EntityKey: "alpha"},
+		{Id: "4"},
+	})
+	if len(got) != 3 || got[0].Id != "1" || got[1].Id != "2" || got[2].Id != "4" {
+		t.Errorf("latestPerEntity() = %v", got)
+	}
+}
@@ -0,0 +1,490 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	geos "github.com/twpayne/go-geos"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/audit"
+	"github.com/virtru-corp/dsp-cop/pkg/dspClient"
+	"github.com/virtru-corp/dsp-cop/pkg/ogc"
+)
+
+// OGCPath is the path of the OGC API - Features landing page
+const OGCPath = "/ogc"
+
+const (
+	// ogcDefaultLimit is the number of items of a page when the limit parameter is omitted
+	ogcDefaultLimit = 10
+	// ogcMaxLimit is the largest limit parameter accepted
+	ogcMaxLimit = 10000
+)
+
+// ogcHandler serves a read-only OGC API - Features of the tdf_objects for partner GIS systems, one collection per
+// src_type:
+//
+//	GET /ogc
+//	GET /ogc/conformance
+//	GET /ogc/collections
+//	GET /ogc/collections/{collectionId}
+//	GET /ogc/collections/{collectionId}/queryables
+//	GET /ogc/collections/{collectionId}/items?bbox=-10,40,10,60&datetime=2024-06-01T00:00:00Z/..&limit=100
+//	GET /ogc/collections/{collectionId}/items/{featureId}
+//	GET /ogc/collections/{collectionId}/items/{featureId}/tdf
+//
+// Everything but the landing page and conformance requires the Authorization header. Items are filtered by its
+// entitlements like QueryTdfObjects, and the TDF of an item is linked as an enclosure rather than inlined.
+func (s *TdfObjectServer) ogcHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+OGCPath, s.ogcLandingPage)
+	mux.HandleFunc("GET "+OGCPath+"/{$}", s.ogcLandingPage)
+	mux.HandleFunc("GET "+OGCPath+"/conformance", s.ogcConformance)
+	mux.HandleFunc("GET "+OGCPath+"/collections", s.ogcCollections)
+	mux.HandleFunc("GET "+OGCPath+"/collections/{collectionId}", s.ogcCollection)
+	mux.HandleFunc("GET "+OGCPath+"/collections/{collectionId}/queryables", s.ogcQueryables)
+	mux.HandleFunc("GET "+OGCPath+"/collections/{collectionId}/items", s.ogcItems)
+	mux.HandleFunc("GET "+OGCPath+"/collections/{collectionId}/items/{featureId}", s.ogcItem)
+	mux.HandleFunc("GET "+OGCPath+"/collections/{collectionId}/items/{featureId}/tdf", s.ogcItemTDF)
+	return mux
+}
+
+func (s *TdfObjectServer) ogcLandingPage(w http.ResponseWriter, r *http.Request) {
+	base := ogcBaseURL(r)
+	writeOGC(w, r, ogc.MediaTypeJSON, ogc.LandingPage{
+		Title:       "DSP COP",
+		Description: "Records of the common operating picture, one collection per source type",
+		Links: []ogc.Link{
+			{Href: base, Rel: "self", Type: ogc.MediaTypeJSON, Title: "This document"},
+			{Href: base + "/conformance", Rel: "conformance", Type: ogc.MediaTypeJSON, Title: "Conformance classes"},
+			{Href: base + "/collections", Rel: "data", Type: ogc.MediaTypeJSON, Title: "Collections"},
+		},
+	})
+}
+
+func (s *TdfObjectServer) ogcConformance(w http.ResponseWriter, r *http.Request) {
+	writeOGC(w, r, ogc.MediaTypeJSON, ogc.Conformance{ConformsTo: ogc.ConformsTo})
+}
+
+func (s *TdfObjectServer) ogcCollections(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.ogcEntitlements(w, r); !ok {
+		return
+	}
+
+	ids, err := s.DBQueries.ListSrcTypes(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error listing src_types", slog.String("error", err.Error()))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	base := ogcBaseURL(r)
+	collections := make([]ogc.Collection, 0, len(ids))
+	for _, id := range ids {
+		srcType, err := dbQuerySrcType(r.Context(), s.DBQueries, id)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error getting src_type", slog.String("src_type", id), slog.String("error", err.Error()))
+			continue
+		}
+		collections = append(collections, srcTypeCollection(base, srcType))
+	}
+
+	writeOGC(w, r, ogc.MediaTypeJSON, ogc.Collections{
+		Links: []ogc.Link{
+			{Href: base + "/collections", Rel: "self", Type: ogc.MediaTypeJSON, Title: "This document"},
+		},
+		Collections: collections,
+	})
+}
+
+func (s *TdfObjectServer) ogcCollection(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.ogcEntitlements(w, r); !ok {
+		return
+	}
+	srcType, ok := s.ogcSrcType(w, r)
+	if !ok {
+		return
+	}
+	writeOGC(w, r, ogc.MediaTypeJSON, srcTypeCollection(ogcBaseURL(r), srcType))
+}
+
+func (s *TdfObjectServer) ogcQueryables(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.ogcEntitlements(w, r); !ok {
+		return
+	}
+	srcType, ok := s.ogcSrcType(w, r)
+	if !ok {
+		return
+	}
+	writeOGC(w, r, ogc.MediaTypeSchema, srcTypeQueryables(ogcBaseURL(r), srcType))
+}
+
+func (s *TdfObjectServer) ogcItems(w http.ResponseWriter, r *http.Request) {
+	entitlements, ok := s.ogcEntitlements(w, r)
+	if !ok {
+		return
+	}
+	srcType, ok := s.ogcSrcType(w, r)
+	if !ok {
+		return
+	}
+
+	base := ogcBaseURL(r)
+	query := r.URL.Query()
+	params, limit, err := ogcItemsQuery(query, srcType.GetId(), srcTypeQueryables(base, srcType))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	page, hasTDF, next, err := s.ogcItemsPage(r.Context(), params, limit, entitlements)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error querying tdf_objects", slog.String("error", err.Error()))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	// the tdf_objects read up to the last one of the page are audited, so the next page does not audit them again
+	page = s.auditFilter(r.Context(), httpToken(r), r.URL.Path, audit.ActionQuery, page, entitlements)
+
+	items := base + "/collections/" + url.PathEscape(srcType.GetId()) + "/items"
+	features := make([]ogc.Feature, 0, len(page))
+	for _, t := range page {
+		features = append(features, ogcFeature(base, t, hasTDF[t.Id]))
+	}
+
+	pageLink := func(rel, title, cursor string) ogc.Link {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		q.Set("limit", strconv.Itoa(limit))
+		if cursor != "" {
+			q.Set("cursor", cursor)
+		}
+		return ogc.Link{Href: items + "?" + q.Encode(), Rel: rel, Type: ogc.MediaTypeGeoJSON, Title: title}
+	}
+	links := []ogc.Link{pageLink("self", "This document", query.Get("cursor"))}
+	if next && len(page) > 0 {
+		last := page[len(page)-1]
+		links = append(links, pageLink("next", "Next page", ogcCursor(last.Ts.AsTime(), last.Id)))
+	}
+	links = append(links, ogc.Link{Href: base + "/collections/" + url.PathEscape(srcType.GetId()), Rel: "collection", Type: ogc.MediaTypeJSON})
+
+	writeOGC(w, r, ogc.MediaTypeGeoJSON, ogc.FeatureCollection{
+		Type:           "FeatureCollection",
+		Features:       features,
+		TimeStamp:      time.Now().UTC().Format(time.RFC3339),
+		NumberReturned: len(features),
+		Links:          links,
+	})
+}
+
+// ogcItemsPage reads the tdf_objects of params in pages of the database until limit of them are visible to the
+// entitlements, returning the tdf_objects read up to the last visible one, whether they have a TDF by id, and whether
+// more visible tdf_objects follow. The tdf_blobs are left out, the features only link them.
+func (s *TdfObjectServer) ogcItemsPage(
+	ctx context.Context,
+	params db.ListTdfObjectsPageParams,
+	limit int,
+	entitlements dspClient.Entitlements,
+) ([]*tdf_objectv1.TdfObject, map[string]bool, bool, error) {
+	var read []*tdf_objectv1.TdfObject
+	hasTDF := make(map[string]bool)
+	visible := 0
+	// one more than the limit is read to know whether a next page follows
+	params.Limit = int32(limit + 1)
+	for {
+		rows, err := s.DBQueries.ListTdfObjectsPage(ctx, params)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		for _, row := range rows {
+			geo, _ := row.Geo.(*geos.Geom)
+			t := prepObjForResponse(db.TdfObject{
+				ID:        row.ID,
+				Ts:        row.Ts,
+				SrcType:   row.SrcType,
+				Geo:       geo,
+				Search:    row.Search,
+				Metadata:  row.Metadata,
+				TdfUri:    row.TdfUri,
+				EntityKey: row.EntityKey,
+				Version:   row.Version,
+			})
+			if visible == limit {
+				// the tdf_objects following the last visible one are read again by the next page
+				if tdfObjectVisible(t, entitlements) {
+					return read, hasTDF, true, nil
+				}
+				continue
+			}
+			if tdfObjectVisible(t, entitlements) {
+				visible++
+			}
+			read = append(read, t)
+			hasTDF[t.Id] = row.HasTdfBlob || row.TdfUri.String != ""
+		}
+		if len(rows) < int(params.Limit) {
+			break
+		}
+		last := rows[len(rows)-1]
+		params.BeforeTs, params.BeforeID = last.Ts, last.ID
+	}
+	return read, hasTDF, false, nil
+}
+
+func (s *TdfObjectServer) ogcItem(w http.ResponseWriter, r *http.Request) {
+	t, ok := s.ogcTdfObject(w, r, audit.ActionView)
+	if !ok {
+		return
+	}
+	writeOGC(w, r, ogc.MediaTypeGeoJSON, ogcFeature(ogcBaseURL(r), t, len(t.TdfBlob) > 0 || t.TdfUri != ""))
+}
+
+// ogcItemTDF serves the TDF of an item, or redirects to its tdf_uri (a download URL of the blob store) when the TDF is
+// stored elsewhere
+func (s *TdfObjectServer) ogcItemTDF(w http.ResponseWriter, r *http.Request) {
+	t, ok := s.ogcTdfObject(w, r, audit.ActionDownload)
+	if !ok {
+		return
+	}
+	if t.TdfUri != "" {
+		s.signTdfUris(r.Context(), []*tdf_objectv1.TdfObject{t})
+		http.Redirect(w, r, t.TdfUri, http.StatusFound)
+		return
+	}
+	if len(t.TdfBlob) == 0 {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.tdf"`, t.Id))
+	w.Header().Set("Content-Length", strconv.Itoa(len(t.TdfBlob)))
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := w.Write(t.TdfBlob); err != nil {
+		slog.ErrorContext(r.Context(), "error writing TDF", slog.String("id", t.Id), slog.String("error", err.Error()))
+	}
+}
+
+// ogcEntitlements returns the entitlements of the Authorization header, writing an error when there are none
+func (s *TdfObjectServer) ogcEntitlements(w http.ResponseWriter, r *http.Request) (dspClient.Entitlements, bool) {
+	token := httpToken(r)
+	if token == "" {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return nil, false
+	}
+	entitlements, err := s.getEntitlements(token)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error getting entitlements", slog.String("error", err.Error()))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return nil, false
+	}
+	return entitlements, true
+}
+
+// ogcSrcType returns the src_type of the collection of a request, writing an error when it does not exist
+func (s *TdfObjectServer) ogcSrcType(w http.ResponseWriter, r *http.Request) (*tdf_objectv1.SrcType, bool) {
+	id := r.PathValue("collectionId")
+	srcType, err := dbQuerySrcType(r.Context(), s.DBQueries, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, "unknown collection "+id, http.StatusNotFound)
+		return nil, false
+	} else if err != nil {
+		slog.ErrorContext(r.Context(), "error getting src_type", slog.String("src_type", id), slog.String("error", err.Error()))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return nil, false
+	}
+	return srcType, true
+}
+
+// ogcTdfObject returns the filtered tdf_object of the item of a request, writing an error when it does not exist in
+// the collection or is not visible to the caller's entitlements. The access is audited as action.
+func (s *TdfObjectServer) ogcTdfObject(w http.ResponseWriter, r *http.Request, action string) (*tdf_objectv1.TdfObject, bool) {
+	entitlements, ok := s.ogcEntitlements(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	id, err := uuid.Parse(r.PathValue("featureId"))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return nil, false
+	}
+	row, err := s.DBQueries.GetTdfObject(r.Context(), id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return nil, false
+	} else if err != nil {
+		slog.ErrorContext(r.Context(), "error getting tdf_object", slog.String("id", id.String()), slog.String("error", err.Error()))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return nil, false
+	}
+
+	geo, _ := row.Geo.(*geos.Geom)
+	objs := s.auditFilter(r.Context(), httpToken(r), r.URL.Path, action, []*tdf_objectv1.TdfObject{prepObjForResponse(db.TdfObject{
+		ID:        row.ID,
+		Ts:        row.Ts,
+		SrcType:   row.SrcType,
+		Geo:       geo,
+		Search:    row.Search,
+		Metadata:  row.Metadata,
+		TdfBlob:   row.TdfBlob,
+		TdfUri:    row.TdfUri,
+		EntityKey: row.EntityKey,
+		Version:   row.Version,
+	})}, entitlements)
+	// items of other collections and items the caller can not see are not found alike
+	if len(objs) == 0 || objs[0].SrcType != r.PathValue("collectionId") {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return nil, false
+	}
+	return objs[0], true
+}
+
+// ogcItemsQuery returns the ListTdfObjectsPage parameters and the limit of the items query parameters of a
+// collection, starting after the cursor of a next link. Without a datetime all the tdf_objects up to now are queried.
+func ogcItemsQuery(query url.Values, srcType string, queryables map[string]interface{}) (db.ListTdfObjectsPageParams, int, error) {
+	now := time.Now().UTC()
+	params := db.ListTdfObjectsPageParams{
+		SourceType: srcType,
+		StartTime:  pgtype.Timestamp{Time: time.Unix(0, 0).UTC(), Valid: true},
+		EndTime:    pgtype.Timestamp{Time: now, Valid: true},
+		BeforeTs:   pgtype.Timestamp{Time: now, Valid: true},
+		BeforeID:   uuid.Max,
+	}
+
+	if v := query.Get("bbox"); v != "" {
+		if crs := query.Get("bbox-crs"); crs != "" && crs != ogc.CRS84 {
+			return params, 0, fmt.Errorf("unsupported bbox-crs %s, only %s is supported", crs, ogc.CRS84)
+		}
+		bbox, err := ogc.ParseBBox(v)
+		if err != nil {
+			return params, 0, err
+		}
+		geo, err := geos.NewGeomFromGeoJSON(bbox.GeoJSON())
+		if err != nil {
+			return params, 0, err
+		}
+		params.Geometry = geo.String()
+	}
+
+	if v := query.Get("datetime"); v != "" {
+		start, end, err := ogc.ParseDatetime(v)
+		if err != nil {
+			return params, 0, err
+		}
+		if start != nil {
+			params.StartTime.Time = *start
+		}
+		if end != nil {
+			params.EndTime.Time = *end
+			params.BeforeTs.Time = *end
+		}
+	}
+
+	search, err := ogc.PropertyFilter(queryables, query)
+	if err != nil {
+		return params, 0, err
+	}
+	params.Search = search
+
+	limit := ogcDefaultLimit
+	if v := query.Get("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit < 1 || limit > ogcMaxLimit {
+			return params, 0, fmt.Errorf("invalid limit %q: expected a number from 1 to %d", v, ogcMaxLimit)
+		}
+	}
+	if v := query.Get("cursor"); v != "" {
+		ts, id, ok := strings.Cut(v, ",")
+		before, tsErr := time.Parse(time.RFC3339Nano, ts)
+		beforeID, idErr := uuid.Parse(id)
+		if !ok || tsErr != nil || idErr != nil {
+			return params, 0, fmt.Errorf("invalid cursor %q: expected the cursor of a next link", v)
+		}
+		params.BeforeTs.Time, params.BeforeID = before, beforeID
+	}
+	return params, limit, nil
+}
+
+// ogcCursor returns the cursor of the page after the tdf_object of ts and id, tdf_objects being ordered by ts and id
+// descending
+func ogcCursor(ts time.Time, id string) string {
+	return ts.UTC().Format(time.RFC3339Nano) + "," + id
+}
+
+// srcTypeCollection returns the collection of a src_type, titled by its form_schema
+func srcTypeCollection(base string, srcType *tdf_objectv1.SrcType) ogc.Collection {
+	formSchema := srcType.GetFormSchema().AsMap()
+	title, _ := formSchema["title"].(string)
+	if title == "" {
+		title = srcType.GetId()
+	}
+	description, _ := formSchema["description"].(string)
+
+	collection := base + "/collections/" + url.PathEscape(srcType.GetId())
+	return ogc.Collection{
+		ID:          srcType.GetId(),
+		Title:       title,
+		Description: description,
+		ItemType:    "feature",
+		CRS:         []string{ogc.CRS84},
+		Links: []ogc.Link{
+			{Href: collection, Rel: "self", Type: ogc.MediaTypeJSON, Title: "This collection"},
+			{Href: collection + "/items", Rel: "items", Type: ogc.MediaTypeGeoJSON, Title: "Items"},
+			{Href: collection + "/queryables", Rel: "http://www.opengis.net/def/rel/ogc/1.0/queryables", Type: ogc.MediaTypeSchema, Title: "Queryables"},
+		},
+	}
+}
+
+// srcTypeQueryables returns the queryables of a src_type, its search fields described by its form_schema
+func srcTypeQueryables(base string, srcType *tdf_objectv1.SrcType) map[string]interface{} {
+	collection := srcTypeCollection(base, srcType)
+	return ogc.Queryables(base+"/collections/"+url.PathEscape(srcType.GetId())+"/queryables", collection.Title,
+		srcType.GetFormSchema().AsMap(), srcType.GetMetadata().GetSearchFields())
+}
+
+// ogcFeature returns the item of a filtered tdf_object, linking its TDF as an enclosure when it has one
+func ogcFeature(base string, t *tdf_objectv1.TdfObject, hasTDF bool) ogc.Feature {
+	collection := base + "/collections/" + url.PathEscape(t.SrcType)
+	item := collection + "/items/" + url.PathEscape(t.Id)
+	links := []ogc.Link{
+		{Href: item, Rel: "self", Type: ogc.MediaTypeGeoJSON, Title: "This item"},
+		{Href: collection, Rel: "collection", Type: ogc.MediaTypeJSON, Title: "The collection of this item"},
+	}
+	if hasTDF {
+		links = append(links, ogc.Link{Href: item + "/tdf", Rel: "enclosure", Type: "application/octet-stream", Title: "Encrypted payload (TDF)"})
+	}
+	return ogc.Feature{Feature: tdfObjectFeature(t), Links: links}
+}
+
+// ogcBaseURL returns the URL of the landing page of a request, behind a TLS terminating proxy setting
+// X-Forwarded-Proto
+func ogcBaseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + OGCPath
+}
+
+func writeOGC(w http.ResponseWriter, r *http.Request, contentType string, v interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.ErrorContext(r.Context(), "error writing OGC API response", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/virtru-corp/dsp-cop/pkg/ogc"
+)
+
+var Test_ogcItemsQueryTests = []struct {
+	test string
+
+	query      string
+	wantStart  time.Time
+	wantEnd    time.Time
+	wantGeo    bool
+	wantSearch string
+	wantLimit  int
+	wantBefore time.Time
+	wantErr    bool
+}{
+	{
+		test:      "defaults",
+		query:     "",
+		wantStart: time.Unix(0, 0),
+		wantLimit: ogcDefaultLimit,
+	},
+	{
+		test:       "bbox, datetime, paging and properties",
+		query:      "bbox=-10,40,10,60&datetime=2024-06-01T00:00:00Z/2024-06-02T00:00:00Z&limit=100&name=alpha",
+		wantStart:  time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		wantEnd:    time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC),
+		wantGeo:    true,
+		wantSearch: `{"name":"alpha"}`,
+		wantLimit:  100,
+		wantBefore: time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		test:       "cursor",
+		query:      "cursor=" + url.QueryEscape(ogcCursor(time.Date(2024, 6, 1, 12, 0, 0, 500000000, time.UTC), "0b3d2b7e-6f6b-4c6e-9d6a-0c8b3f0c7a11")),
+		wantStart:  time.Unix(0, 0),
+		wantLimit:  ogcDefaultLimit,
+		wantBefore: time.Date(2024, 6, 1, 12, 0, 0, 500000000, time.UTC),
+	},
+	{
+		test:    "unsupported bbox-crs",
+		query:   "bbox=0,0,1,1&bbox-crs=" + url.QueryEscape("http://www.opengis.net/def/crs/EPSG/0/3857"),
+		wantErr: true,
+	},
+	{
+		test:    "limit over the maximum",
+		query:   "limit=10001",
+		wantErr: true,
+	},
+	{
+		test:    "invalid cursor",
+		query:   "cursor=10",
+		wantErr: true,
+	},
+}
+
+func Test_ogcItemsQuery(t *testing.T) {
+	queryables := ogc.Queryables("", "", map[string]interface{}{}, []string{"name"})
+	for _, tt := range Test_ogcItemsQueryTests {
+		t.Run(tt.test, func(t *testing.T) {
+			query, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			params, limit, err := ogcItemsQuery(query, "vehicles", queryables)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ogcItemsQuery() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if params.SourceType != "vehicles" {
+				t.Errorf("ogcItemsQuery() SourceType = %s; want vehicles", params.SourceType)
+			}
+			if start := params.StartTime.Time; !start.Equal(tt.wantStart) {
+				t.Errorf("ogcItemsQuery() start = %s; want %s", start, tt.wantStart)
+			}
+			if end := params.EndTime.Time; !tt.wantEnd.IsZero() && !end.Equal(tt.wantEnd) {
+				t.Errorf("ogcItemsQuery() end = %s; want %s", end, tt.wantEnd)
+			}
+			if (params.Geometry != nil) != tt.wantGeo {
+				t.Errorf("ogcItemsQuery() Geometry = %v; wantGeo %v", params.Geometry, tt.wantGeo)
+			}
+			if string(params.Search) != tt.wantSearch {
+				t.Errorf("ogcItemsQuery() Search = %s; want %s", params.Search, tt.wantSearch)
+			}
+			if limit != tt.wantLimit {
+				t.Errorf("ogcItemsQuery() limit = %d; want %d", limit, tt.wantLimit)
+			}
+			if before := params.BeforeTs.Time; !tt.wantBefore.IsZero() && !before.Equal(tt.wantBefore) {
+				t.Errorf("ogcItemsQuery() before = %s; want %s", before, tt.wantBefore)
+			}
+		})
+	}
+}
+
+func Test_ogcHandler(t *testing.T) {
+	h := (&TdfObjectServer{}).ogcHandler()
+
+	res := httptest.NewRecorder()
+	h.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "https://cop.example/ogc/conformance", nil))
+	if res.Code != http.StatusOK {
+		t.Fatalf("GET /ogc/conformance = %d; want %d", res.Code, http.StatusOK)
+	}
+	var conformance ogc.Conformance
+	if err := json.NewDecoder(res.Body).Decode(&conformance); err != nil || len(conformance.ConformsTo) == 0 {
+		t.Errorf("GET /ogc/conformance = %v, %v; want conformance classes", conformance, err)
+	}
+
+	res = httptest.NewRecorder()
+	h.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "https://cop.example/ogc", nil))
+	var landing ogc.LandingPage
+	if err := json.NewDecoder(res.Body).Decode(&landing); err != nil {
+		t.Fatalf("GET /ogc is not a landing page: %v", err)
+	}
+	if len(landing.Links) == 0 || landing.Links[0].Href != "https://cop.example/ogc" {
+		t.Errorf("GET /ogc links = %v; want https://cop.example/ogc first", landing.Links)
+	}
+
+	res = httptest.NewRecorder()
+	h.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "https://cop.example/ogc/collections/vehicles/items", nil))
+	if res.Code != http.StatusUnauthorized {
+		t.Errorf("GET items without a token = %d; want %d", res.Code, http.StatusUnauthorized)
+	}
+
+	res = httptest.NewRecorder()
+	h.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "https://cop.example/ogc/collections", nil))
+	if res.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST /ogc/collections = %d; want %d", res.Code, http.StatusMethodNotAllowed)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -19,10 +20,13 @@ import (
 	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
 	"github.com/virtru-corp/dsp-cop/db"
 	activeclients "github.com/virtru-corp/dsp-cop/pkg/activeClients"
+	"github.com/virtru-corp/dsp-cop/pkg/kafka"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func connectPgxListener(pool *pgxpool.Pool, clients *activeclients.ActiveClients, channel string) *pgxlisten.Listener {
+func connectPgxListener(pool *pgxpool.Pool, clients *activeclients.ActiveClients, webhooks *webhookDispatcher, sink *kafka.Sink, channel string, updateChannel string, deleteChannel string) *pgxlisten.Listener {
+	query := db.New(pool)
 	slog.Info("starting pgx listener")
 	l := &pgxlisten.Listener{
 		Connect: func(ctx context.Context) (*pgx.Conn, error) {
@@ -41,39 +45,148 @@ func connectPgxListener(pool *pgxpool.Pool, clients *activeclients.ActiveClients
 
 	slog.Info("subscribing to channel", slog.String("channel", channel))
 	l.Handle(channel, pgxlisten.HandlerFunc(func(ctx context.Context, notification *pgconn.Notification, conn *pgx.Conn) error {
-		slog.InfoContext(ctx, "notification received", slog.String("channel", notification.Channel), slog.String("payload", notification.Payload))
+		slog.InfoContext(ctx, "notification received", slog.String("channel", notification.Channel), slog.String("id", notificationID(notification.Payload)))
 
-		obj, err := parsePgNotifyPayload(notification.Payload)
+		obj, err := notifiedTdfObject(ctx, query, notification.Payload)
 		if err != nil {
-			slog.ErrorContext(ctx, "failed to parse payload", slog.String("error", err.Error()))
+			slog.ErrorContext(ctx, "failed to read notified tdf_object", slog.String("error", err.Error()))
+			return nil
+		} else if obj == nil {
+			// deleted since the notification
+			return nil
+		}
+
+		tdfObject := streamTdfObject(obj)
+		// webhook deliveries are filtered by their own entitlements, keep them apart from the broadcast object
+		delivered := proto.Clone(tdfObject).(*tdf_objectv1.TdfObject)
+		clients.BroadcastTdfObjects([]*tdf_objectv1.TdfObject{tdfObject})
+
+		if err := evaluateGeofences(ctx, query, clients, obj); err != nil {
+			slog.ErrorContext(ctx, "failed to evaluate geofences", slog.String("error", err.Error()))
+		}
+
+		if err := webhooks.dispatch(ctx, obj, delivered); err != nil {
+			slog.ErrorContext(ctx, "failed to dispatch webhooks", slog.String("error", err.Error()))
+		}
+
+		if sink != nil {
+			sink.Publish(ctx, kafka.EventCreated, prepObjForResponse(*obj))
+		}
+
+		return nil
+	}))
+
+	// updated objects are broadcast with their new version and may have moved in or out of a geofence
+	slog.Info("subscribing to channel", slog.String("channel", updateChannel))
+	l.Handle(updateChannel, pgxlisten.HandlerFunc(func(ctx context.Context, notification *pgconn.Notification, conn *pgx.Conn) error {
+		slog.InfoContext(ctx, "notification received", slog.String("channel", notification.Channel), slog.String("id", notificationID(notification.Payload)))
+
+		obj, err := notifiedTdfObject(ctx, query, notification.Payload)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to read notified tdf_object", slog.String("error", err.Error()))
+			return nil
+		} else if obj == nil {
+			// deleted since the notification
 			return nil
 		}
 
-		clients.BroadcastTdfObjects([]*tdf_objectv1.TdfObject{
-			{
-				Id:      obj.ID.String(),
-				Ts:      timestamppb.New(obj.Ts.Time),
-				SrcType: obj.SrcType,
-				Geo:     obj.Geo.String(),
-				Search:  string(obj.Search),
-				TdfBlob: obj.TdfBlob,
-			},
-		})
+		clients.BroadcastUpdatedTdfObjects([]*tdf_objectv1.TdfObject{streamTdfObject(obj)})
+
+		if err := evaluateGeofences(ctx, query, clients, obj); err != nil {
+			slog.ErrorContext(ctx, "failed to evaluate geofences", slog.String("error", err.Error()))
+		}
+
+		if sink != nil {
+			sink.Publish(ctx, kafka.EventUpdated, prepObjForResponse(*obj))
+		}
 
 		return nil
 	}))
 
+	// deleted objects are only published to Kafka, the payload has no tdf_blob
+	if sink != nil {
+		slog.Info("subscribing to channel", slog.String("channel", deleteChannel))
+		l.Handle(deleteChannel, pgxlisten.HandlerFunc(func(ctx context.Context, notification *pgconn.Notification, conn *pgx.Conn) error {
+			slog.DebugContext(ctx, "notification received", slog.String("channel", notification.Channel), slog.String("id", notificationID(notification.Payload)))
+
+			obj, err := parsePgNotifyPayload(notification.Payload)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to parse payload", slog.String("error", err.Error()))
+				return nil
+			}
+
+			sink.Publish(ctx, kafka.EventDeleted, &tdf_objectv1.TdfObject{
+				Id:        obj.ID.String(),
+				Ts:        timestamppb.New(obj.Ts.Time),
+				SrcType:   obj.SrcType,
+				EntityKey: obj.EntityKey.String,
+			})
+			return nil
+		}))
+	}
+
 	return l
 }
 
+// pgNotifyKey is the payload of the notifications of rows too large for a notification, which are read by their id
+type pgNotifyKey struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// notificationID returns the id of the row of a notification for the log, which leaves out the rest of the payload.
+// An empty id is returned for a payload without one.
+func notificationID(payload string) string {
+	var key struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal([]byte(payload), &key); err != nil {
+		return ""
+	}
+	return key.ID
+}
+
+// notifiedTdfObject reads the tdf_object of a notification with a pgNotifyKey payload, nil when it no longer exists
+func notifiedTdfObject(ctx context.Context, query *db.Queries, payload string) (*db.TdfObject, error) {
+	var key pgNotifyKey
+	if err := json.Unmarshal([]byte(payload), &key); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+	obj, err := query.GetNotifiedTdfObject(ctx, key.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get tdf_object %s: %w", key.ID, err)
+	}
+	return &obj, nil
+}
+
+// streamTdfObject returns the TdfObject of a notification as broadcast to the StreamTdfObjects clients. The broadcast
+// is not filtered per client, so it leaves out the metadata.
+func streamTdfObject(obj *db.TdfObject) *tdf_objectv1.TdfObject {
+	return &tdf_objectv1.TdfObject{
+		Id:        obj.ID.String(),
+		Ts:        timestamppb.New(obj.Ts.Time),
+		SrcType:   obj.SrcType,
+		Geo:       obj.Geo.String(),
+		Search:    string(obj.Search),
+		TdfBlob:   obj.TdfBlob,
+		TdfUri:    obj.TdfUri.String,
+		EntityKey: obj.EntityKey.String,
+		Version:   obj.Version,
+	}
+}
+
 type tmpTdfObject struct {
-	Id       string          `json:"id"`
-	Ts       string          `json:"ts"`
-	SrcType  string          `json:"src_type"`
-	Geo      json.RawMessage `json:"geo"`
-	Search   json.RawMessage `json:"search"`
-	TdfBlob string          `json:"tdf_blob"`
-	TdfUri  string          `json:"tdf_uri"`
+	Id        string          `json:"id"`
+	Ts        string          `json:"ts"`
+	SrcType   string          `json:"src_type"`
+	Geo       json.RawMessage `json:"geo"`
+	Search    json.RawMessage `json:"search"`
+	Metadata  json.RawMessage `json:"metadata"`
+	TdfBlob   string          `json:"tdf_blob"`
+	TdfUri    string          `json:"tdf_uri"`
+	EntityKey string          `json:"entity_key"`
+	Version   int32           `json:"version"`
 }
 
 const pgTimeFormat = "2006-01-02T15:04:05"
@@ -101,6 +214,9 @@ func parsePgNotifyPayload(payload string) (*db.TdfObject, error) {
 	object.Ts = pgtype.Timestamp{Time: ts, Valid: true}
 
 	object.SrcType = tmp.SrcType
+	object.Version = tmp.Version
+	object.EntityKey = pgtype.Text{String: tmp.EntityKey, Valid: tmp.EntityKey != ""}
+	object.TdfUri = pgtype.Text{String: tmp.TdfUri, Valid: tmp.TdfUri != ""}
 
 	if tmp.Geo != nil && string(tmp.Geo) != "null" && string(tmp.Geo) != "" {
 		geojson, err := tmp.Geo.MarshalJSON()
@@ -120,8 +236,12 @@ func parsePgNotifyPayload(payload string) (*db.TdfObject, error) {
 	}
 	object.Search = search
 
+	if len(tmp.Metadata) > 0 && string(tmp.Metadata) != "null" {
+		object.Metadata = tmp.Metadata
+	}
+
 	// postgres converts bytea to a hex string when jsonified
-	if tmp.TdfBlob[:2] == "\\x" {
+	if strings.HasPrefix(tmp.TdfBlob, "\\x") {
 		// remove the leading \x
 		tmp.TdfBlob = tmp.TdfBlob[2:]
 		// decode the hex string
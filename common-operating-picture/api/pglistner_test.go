@@ -1,11 +1,20 @@
 package api
 
 import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/twmb/franz-go/pkg/kgo"
 	geos "github.com/twpayne/go-geos"
+	"github.com/virtru-corp/dsp-cop/db"
+	activeclients "github.com/virtru-corp/dsp-cop/pkg/activeClients"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"github.com/virtru-corp/dsp-cop/pkg/kafka"
 )
 
 var Test_parsePgNotifyPayloadTests = []struct {
@@ -18,6 +27,9 @@ var Test_parsePgNotifyPayloadTests = []struct {
 	search         string
 	tdfblob        string
 	tdfblobDecoded string
+	entityKey      string
+	wantEntityKey  string
+	version        int32
 }{
 	{
 		test:    "valid payload",
@@ -32,6 +44,9 @@ var Test_parsePgNotifyPayloadTests = []struct {
 			"496a6f6952334a685a5731686448526c636977675357356a4c694973496d646c6279493665333139",
 		tdfblobDecoded: "eyJ0aXRsZSI6IlRoZSB0aGVuIGNhbi4iLCJjb250ZW50IjoiV2hhdCBkbyBjbHVtcCBzbyBub25lIGZvciBvdmVyIHRoaXM" +
 			"gcmVndWxhcmx5IG5pZ2h0bHkuIiwiYXV0aG9yIjoiRGVsbGEgTGVobmVyIiwic291cmNlIjoiR3JhZW1hdHRlciwgSW5jLiIsImdlbyI6e319",
+		entityKey:     `"a1b2c3"`,
+		wantEntityKey: "a1b2c3",
+		version:       3,
 	},
 	{
 		test:           "valid payload with emptys",
@@ -42,6 +57,9 @@ var Test_parsePgNotifyPayloadTests = []struct {
 		search:         "null",
 		tdfblob:        "null",
 		tdfblobDecoded: "",
+		entityKey:      "null",
+		wantEntityKey:  "",
+		version:        1,
 	},
 }
 
@@ -56,7 +74,9 @@ func Test_parsePgNotifyPayload(t *testing.T) {
 					"geo":` + tt.geojson + `,
 					"search":` + tt.search + `,
 					"tdf_blob":"` + tt.tdfblob + `",
-					"tdf_uri":null
+					"tdf_uri":null,
+					"entity_key":` + tt.entityKey + `,
+					"version":` + strconv.Itoa(int(tt.version)) + `
 				}
 			`
 			object, err := parsePgNotifyPayload(payload)
@@ -93,6 +113,137 @@ func Test_parsePgNotifyPayload(t *testing.T) {
 			if string(object.TdfBlob) != tt.tdfblobDecoded {
 				t.Errorf("object.TdfBlob = %s; want %s", object.TdfBlob, tt.tdfblobDecoded)
 			}
+			if object.EntityKey.String != tt.wantEntityKey || object.EntityKey.Valid != (tt.wantEntityKey != "") {
+				t.Errorf("object.EntityKey = %v; want %s", object.EntityKey, tt.wantEntityKey)
+			}
+			if object.Version != tt.version {
+				t.Errorf("object.Version = %d; want %d", object.Version, tt.version)
+			}
+		})
+	}
+}
+
+var Test_notificationIDTests = []struct {
+	test    string
+	payload string
+	want    string
+}{
+	{test: "key payload", payload: `{"id": "a1"}`, want: "a1"},
+	{test: "row payload", payload: `{"id": "a1", "search": {"callsign": "alpha"}, "tdf_blob": "ciphertext"}`, want: "a1"},
+	{test: "invalid payload", payload: `not json`, want: ""},
+}
+
+func Test_notificationID(t *testing.T) {
+	for _, tt := range Test_notificationIDTests {
+		t.Run(tt.test, func(t *testing.T) {
+			if got := notificationID(tt.payload); got != tt.want {
+				t.Errorf("notificationID() = %q; want %q", got, tt.want)
+			}
 		})
 	}
 }
+
+// Test_connectPgxListenerMovedTdfObject runs against the database of DSP_COP_TEST_DATABASE_URL and the brokers of
+// DSP_COP_TEST_KAFKA_BROKERS, checking an update moving a row to another partition is published as one update
+func Test_connectPgxListenerMovedTdfObject(t *testing.T) {
+	brokers := os.Getenv("DSP_COP_TEST_KAFKA_BROKERS")
+	if brokers == "" {
+		t.Skip("DSP_COP_TEST_KAFKA_BROKERS is not set")
+	}
+	pool := testDBPool(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cfg := &config.Config{}
+	cfg.Kafka.Brokers = strings.Split(brokers, ",")
+	cfg.Kafka.ClientID = "dsp-cop-test"
+	cfg.Kafka.Sink.TopicPrefix = "dsp-cop-test." + uuid.NewString() + "."
+	srcType := "moved"
+
+	sink, err := kafka.NewSink(cfg)
+	if err != nil {
+		t.Fatalf("NewSink() failed: %v", err)
+	}
+	defer sink.Close(ctx)
+
+	opts, err := kafka.ClientOptions(cfg)
+	if err != nil {
+		t.Fatalf("ClientOptions() failed: %v", err)
+	}
+	consumer, err := kgo.NewClient(append(opts,
+		kgo.ConsumeTopics(cfg.Kafka.Sink.TopicPrefix+srcType),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+		kgo.AllowAutoTopicCreation(),
+	)...)
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+	defer consumer.Close()
+
+	queries := db.New(pool)
+	listener := connectPgxListener(pool, &activeclients.ActiveClients{}, startWebhookDispatcher(ctx, cfg, queries), sink,
+		pgNotifyChannel, pgNotifyUpdateChannel, pgNotifyDeleteChannel)
+	listenCtx, stop := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		_ = listener.Listen(listenCtx)
+		close(done)
+	}()
+	defer func() {
+		stop()
+		<-done
+	}()
+	defer func() {
+		_, _ = pool.Exec(context.Background(), "DELETE FROM tdf_objects WHERE src_type = $1 AND search->>'test' = $2",
+			srcType, cfg.Kafka.Sink.TopicPrefix)
+	}()
+
+	insert := func() string {
+		var id string
+		err := pool.QueryRow(ctx, "INSERT INTO tdf_objects (src_type, search) VALUES ($1, jsonb_build_object('test', $2::TEXT)) RETURNING id::TEXT",
+			srcType, cfg.Kafka.Sink.TopicPrefix).Scan(&id)
+		if err != nil {
+			t.Fatalf("failed to insert tdf_object: %v", err)
+		}
+		return id
+	}
+	// events returns the events of id consumed until the timeout
+	events := func(id string, timeout time.Duration) []string {
+		pollCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		var events []string
+		for pollCtx.Err() == nil {
+			consumer.PollFetches(pollCtx).EachRecord(func(r *kgo.Record) {
+				if string(r.Key) != id {
+					return
+				}
+				for _, h := range r.Headers {
+					if h.Key == kafka.EventHeader {
+						events = append(events, string(h.Value))
+					}
+				}
+			})
+		}
+		return events
+	}
+
+	// the listener subscribes in the background, insert until its created event is published
+	var id string
+	for id == "" {
+		if ctx.Err() != nil {
+			t.Fatal("no created event consumed")
+		}
+		inserted := insert()
+		if got := events(inserted, 2*time.Second); len(got) > 0 {
+			id = inserted
+		}
+	}
+
+	if _, err := pool.Exec(ctx, "UPDATE tdf_objects SET ts = '2000-01-01' WHERE id = $1", id); err != nil {
+		t.Fatalf("failed to move tdf_object: %v", err)
+	}
+	if got := events(id, 5*time.Second); len(got) != 1 || got[0] != kafka.EventUpdated {
+		t.Errorf("events of moved tdf_object = %v; want [%s]", got, kafka.EventUpdated)
+	}
+}
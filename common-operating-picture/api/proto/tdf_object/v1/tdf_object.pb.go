@@ -41,7 +41,12 @@ const (
 	StreamEventType_STREAM_EVENT_TYPE_SERVER_ERROR  StreamEventType = 11
 	StreamEventType_STREAM_EVENT_TYPE_DATA_ERROR    StreamEventType = 12
 	// tdf_objects stream events
-	StreamEventType_STREAM_EVENT_TYPE_TDF_OBJECTS_NEW StreamEventType = 20
+	StreamEventType_STREAM_EVENT_TYPE_TDF_OBJECTS_NEW     StreamEventType = 20
+	StreamEventType_STREAM_EVENT_TYPE_TDF_OBJECTS_UPDATED StreamEventType = 21
+	// geofence stream events
+	StreamEventType_STREAM_EVENT_TYPE_GEOFENCE_ENTER StreamEventType = 30
+	StreamEventType_STREAM_EVENT_TYPE_GEOFENCE_EXIT  StreamEventType = 31
+	StreamEventType_STREAM_EVENT_TYPE_GEOFENCE_DWELL StreamEventType = 32
 )
 
 // Enum value maps for StreamEventType.
@@ -58,19 +63,27 @@ var (
 		11: "STREAM_EVENT_TYPE_SERVER_ERROR",
 		12: "STREAM_EVENT_TYPE_DATA_ERROR",
 		20: "STREAM_EVENT_TYPE_TDF_OBJECTS_NEW",
+		21: "STREAM_EVENT_TYPE_TDF_OBJECTS_UPDATED",
+		30: "STREAM_EVENT_TYPE_GEOFENCE_ENTER",
+		31: "STREAM_EVENT_TYPE_GEOFENCE_EXIT",
+		32: "STREAM_EVENT_TYPE_GEOFENCE_DWELL",
 	}
 	StreamEventType_value = map[string]int32{
-		"STREAM_EVENT_TYPE_UNSPECIFIED":     0,
-		"STREAM_EVENT_TYPE_STARTUP":         1,
-		"STREAM_EVENT_TYPE_SHUTDOWN":        2,
-		"STREAM_EVENT_TYPE_RESTART":         3,
-		"STREAM_EVENT_TYPE_MAINTENANCE":     4,
-		"STREAM_EVENT_TYPE_CONNECTED":       5,
-		"STREAM_EVENT_TYPE_HEARTBEAT":       6,
-		"STREAM_EVENT_TYPE_GENERIC_ERROR":   10,
-		"STREAM_EVENT_TYPE_SERVER_ERROR":    11,
-		"STREAM_EVENT_TYPE_DATA_ERROR":      12,
-		"STREAM_EVENT_TYPE_TDF_OBJECTS_NEW": 20,
+		"STREAM_EVENT_TYPE_UNSPECIFIED":         0,
+		"STREAM_EVENT_TYPE_STARTUP":             1,
+		"STREAM_EVENT_TYPE_SHUTDOWN":            2,
+		"STREAM_EVENT_TYPE_RESTART":             3,
+		"STREAM_EVENT_TYPE_MAINTENANCE":         4,
+		"STREAM_EVENT_TYPE_CONNECTED":           5,
+		"STREAM_EVENT_TYPE_HEARTBEAT":           6,
+		"STREAM_EVENT_TYPE_GENERIC_ERROR":       10,
+		"STREAM_EVENT_TYPE_SERVER_ERROR":        11,
+		"STREAM_EVENT_TYPE_DATA_ERROR":          12,
+		"STREAM_EVENT_TYPE_TDF_OBJECTS_NEW":     20,
+		"STREAM_EVENT_TYPE_TDF_OBJECTS_UPDATED": 21,
+		"STREAM_EVENT_TYPE_GEOFENCE_ENTER":      30,
+		"STREAM_EVENT_TYPE_GEOFENCE_EXIT":       31,
+		"STREAM_EVENT_TYPE_GEOFENCE_DWELL":      32,
 	}
 )
 
@@ -121,6 +134,10 @@ type TdfObject struct {
 	TdfBlob []byte `protobuf:"bytes,7,opt,name=tdf_blob,json=tdfBlob,proto3" json:"tdf_blob,omitempty"`
 	// tdf data uri
 	TdfUri string `protobuf:"bytes,8,opt,name=tdf_uri,json=tdfUri,proto3" json:"tdf_uri,omitempty"`
+	// identifies the entity (e.g. aircraft, vessel) the data is about
+	EntityKey string `protobuf:"bytes,9,opt,name=entity_key,json=entityKey,proto3" json:"entity_key,omitempty"`
+	// incremented by every update, passed as expected_version of UpdateTdfObjectRequest
+	Version int32 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *TdfObject) Reset() {
@@ -211,6 +228,20 @@ func (x *TdfObject) GetTdfUri() string {
 	return ""
 }
 
+func (x *TdfObject) GetEntityKey() string {
+	if x != nil {
+		return x.EntityKey
+	}
+	return ""
+}
+
+func (x *TdfObject) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type SrcTypeUiSchemaFieldConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -521,6 +552,8 @@ type SrcTypeMetadata struct {
 	TsField       string                        `protobuf:"bytes,4,opt,name=ts_field,json=tsField,proto3" json:"ts_field,omitempty"`
 	DisplayFields *SrcTypeMetadataDisplayFields `protobuf:"bytes,5,opt,name=display_fields,json=displayFields,proto3" json:"display_fields,omitempty"`
 	MapFields     *SrcTypeMetadataMapFields     `protobuf:"bytes,6,opt,name=map_fields,json=mapFields,proto3" json:"map_fields,omitempty"`
+	// search or metadata field used as the entity_key when one is not provided
+	EntityField string `protobuf:"bytes,7,opt,name=entity_field,json=entityField,proto3" json:"entity_field,omitempty"`
 }
 
 func (x *SrcTypeMetadata) Reset() {
@@ -597,6 +630,13 @@ func (x *SrcTypeMetadata) GetMapFields() *SrcTypeMetadataMapFields {
 	return nil
 }
 
+func (x *SrcTypeMetadata) GetEntityField() string {
+	if x != nil {
+		return x.EntityField
+	}
+	return ""
+}
+
 type SrcType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -742,6 +782,8 @@ type CreateTdfObjectRequest struct {
 	TdfUri string `protobuf:"bytes,6,opt,name=tdf_uri,json=tdfUri,proto3" json:"tdf_uri,omitempty"`
 	// timestamp of data
 	Ts *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ts,proto3" json:"ts,omitempty"`
+	// identifies the entity (e.g. aircraft, vessel) the data is about
+	EntityKey string `protobuf:"bytes,8,opt,name=entity_key,json=entityKey,proto3" json:"entity_key,omitempty"`
 }
 
 func (x *CreateTdfObjectRequest) Reset() {
@@ -825,6 +867,13 @@ func (x *CreateTdfObjectRequest) GetTs() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CreateTdfObjectRequest) GetEntityKey() string {
+	if x != nil {
+		return x.EntityKey
+	}
+	return ""
+}
+
 type CreateTdfObjectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -893,6 +942,10 @@ type UpdateTdfObjectRequest struct {
 	TdfUri *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=tdf_uri,json=tdfUri,proto3" json:"tdf_uri,omitempty"`
 	// timestamp of data
 	Ts *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ts,proto3" json:"ts,omitempty"`
+	// identifies the entity (e.g. aircraft, vessel) the data is about
+	EntityKey *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=entity_key,json=entityKey,proto3" json:"entity_key,omitempty"`
+	// version the update is based on, the update fails with ABORTED when the tdf_object has another version
+	ExpectedVersion *wrapperspb.Int32Value `protobuf:"bytes,10,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
 func (x *UpdateTdfObjectRequest) Reset() {
@@ -983,12 +1036,30 @@ func (x *UpdateTdfObjectRequest) GetTs() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UpdateTdfObjectRequest) GetEntityKey() *wrapperspb.StringValue {
+	if x != nil {
+		return x.EntityKey
+	}
+	return nil
+}
+
+func (x *UpdateTdfObjectRequest) GetExpectedVersion() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return nil
+}
+
 type UpdateTdfObjectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// revision recording the version the update replaced
+	Revision int32 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// version of the updated tdf_object
+	Version int32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *UpdateTdfObjectResponse) Reset() {
@@ -1030,6 +1101,20 @@ func (x *UpdateTdfObjectResponse) GetId() string {
 	return ""
 }
 
+func (x *UpdateTdfObjectResponse) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *UpdateTdfObjectResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GetTdfObjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1129,11 +1214,12 @@ type QueryTdfObjectsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TsRange     *TimestampSelector `protobuf:"bytes,1,opt,name=ts_range,json=tsRange,proto3" json:"ts_range,omitempty"`
-	SrcType     string             `protobuf:"bytes,2,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
-	GeoLocation string             `protobuf:"bytes,3,opt,name=geo_location,json=geoLocation,proto3" json:"geo_location,omitempty"`
-	Search      string             `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
-	Metadata    string             `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TsRange *TimestampSelector `protobuf:"bytes,1,opt,name=ts_range,json=tsRange,proto3" json:"ts_range,omitempty"`
+	// source types to query, an empty list queries all source types
+	SrcTypes    []string `protobuf:"bytes,2,rep,name=src_types,json=srcTypes,proto3" json:"src_types,omitempty"`
+	GeoLocation string   `protobuf:"bytes,3,opt,name=geo_location,json=geoLocation,proto3" json:"geo_location,omitempty"`
+	Search      string   `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	Metadata    string   `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (x *QueryTdfObjectsRequest) Reset() {
@@ -1175,11 +1261,11 @@ func (x *QueryTdfObjectsRequest) GetTsRange() *TimestampSelector {
 	return nil
 }
 
-func (x *QueryTdfObjectsRequest) GetSrcType() string {
+func (x *QueryTdfObjectsRequest) GetSrcTypes() []string {
 	if x != nil {
-		return x.SrcType
+		return x.SrcTypes
 	}
-	return ""
+	return nil
 }
 
 func (x *QueryTdfObjectsRequest) GetGeoLocation() string {
@@ -1250,14 +1336,18 @@ func (x *QueryTdfObjectsResponse) GetTdfObjects() []*TdfObject {
 	return nil
 }
 
-type StreamTdfObjectsRequest struct {
+type GetLatestPositionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	TsRange *TimestampSelector `protobuf:"bytes,1,opt,name=ts_range,json=tsRange,proto3" json:"ts_range,omitempty"`
+	// source types to query, an empty list queries all source types
+	SrcTypes []string `protobuf:"bytes,2,rep,name=src_types,json=srcTypes,proto3" json:"src_types,omitempty"`
 }
 
-func (x *StreamTdfObjectsRequest) Reset() {
-	*x = StreamTdfObjectsRequest{}
+func (x *GetLatestPositionsRequest) Reset() {
+	*x = GetLatestPositionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1265,13 +1355,13 @@ func (x *StreamTdfObjectsRequest) Reset() {
 	}
 }
 
-func (x *StreamTdfObjectsRequest) String() string {
+func (x *GetLatestPositionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamTdfObjectsRequest) ProtoMessage() {}
+func (*GetLatestPositionsRequest) ProtoMessage() {}
 
-func (x *StreamTdfObjectsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLatestPositionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1283,23 +1373,36 @@ func (x *StreamTdfObjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamTdfObjectsRequest.ProtoReflect.Descriptor instead.
-func (*StreamTdfObjectsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLatestPositionsRequest.ProtoReflect.Descriptor instead.
+func (*GetLatestPositionsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{17}
 }
 
-type StreamTdfObjectsResponse struct {
+func (x *GetLatestPositionsRequest) GetTsRange() *TimestampSelector {
+	if x != nil {
+		return x.TsRange
+	}
+	return nil
+}
+
+func (x *GetLatestPositionsRequest) GetSrcTypes() []string {
+	if x != nil {
+		return x.SrcTypes
+	}
+	return nil
+}
+
+type GetLatestPositionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventType   StreamEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=tdf_object.v1.StreamEventType" json:"event_type,omitempty"`
-	EventDetail string          `protobuf:"bytes,2,opt,name=event_detail,json=eventDetail,proto3" json:"event_detail,omitempty"`
-	TdfObjects  []*TdfObject    `protobuf:"bytes,6,rep,name=tdf_objects,json=tdfObjects,proto3" json:"tdf_objects,omitempty"`
+	// most recent tdf_object of each entity
+	TdfObjects []*TdfObject `protobuf:"bytes,1,rep,name=tdf_objects,json=tdfObjects,proto3" json:"tdf_objects,omitempty"`
 }
 
-func (x *StreamTdfObjectsResponse) Reset() {
-	*x = StreamTdfObjectsResponse{}
+func (x *GetLatestPositionsResponse) Reset() {
+	*x = GetLatestPositionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1307,13 +1410,13 @@ func (x *StreamTdfObjectsResponse) Reset() {
 	}
 }
 
-func (x *StreamTdfObjectsResponse) String() string {
+func (x *GetLatestPositionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamTdfObjectsResponse) ProtoMessage() {}
+func (*GetLatestPositionsResponse) ProtoMessage() {}
 
-func (x *StreamTdfObjectsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLatestPositionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1325,40 +1428,30 @@ func (x *StreamTdfObjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamTdfObjectsResponse.ProtoReflect.Descriptor instead.
-func (*StreamTdfObjectsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLatestPositionsResponse.ProtoReflect.Descriptor instead.
+func (*GetLatestPositionsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *StreamTdfObjectsResponse) GetEventType() StreamEventType {
-	if x != nil {
-		return x.EventType
-	}
-	return StreamEventType_STREAM_EVENT_TYPE_UNSPECIFIED
-}
-
-func (x *StreamTdfObjectsResponse) GetEventDetail() string {
-	if x != nil {
-		return x.EventDetail
-	}
-	return ""
-}
-
-func (x *StreamTdfObjectsResponse) GetTdfObjects() []*TdfObject {
+func (x *GetLatestPositionsResponse) GetTdfObjects() []*TdfObject {
 	if x != nil {
 		return x.TdfObjects
 	}
 	return nil
 }
 
-type ListSrcTypesRequest struct {
+type GetTrackRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	TsRange   *TimestampSelector `protobuf:"bytes,1,opt,name=ts_range,json=tsRange,proto3" json:"ts_range,omitempty"`
+	SrcType   string             `protobuf:"bytes,2,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
+	EntityKey string             `protobuf:"bytes,3,opt,name=entity_key,json=entityKey,proto3" json:"entity_key,omitempty"`
 }
 
-func (x *ListSrcTypesRequest) Reset() {
-	*x = ListSrcTypesRequest{}
+func (x *GetTrackRequest) Reset() {
+	*x = GetTrackRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1366,13 +1459,13 @@ func (x *ListSrcTypesRequest) Reset() {
 	}
 }
 
-func (x *ListSrcTypesRequest) String() string {
+func (x *GetTrackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSrcTypesRequest) ProtoMessage() {}
+func (*GetTrackRequest) ProtoMessage() {}
 
-func (x *ListSrcTypesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTrackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1384,21 +1477,47 @@ func (x *ListSrcTypesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSrcTypesRequest.ProtoReflect.Descriptor instead.
-func (*ListSrcTypesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTrackRequest.ProtoReflect.Descriptor instead.
+func (*GetTrackRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{19}
 }
 
-type ListSrcTypesResponse struct {
+func (x *GetTrackRequest) GetTsRange() *TimestampSelector {
+	if x != nil {
+		return x.TsRange
+	}
+	return nil
+}
+
+func (x *GetTrackRequest) GetSrcType() string {
+	if x != nil {
+		return x.SrcType
+	}
+	return ""
+}
+
+func (x *GetTrackRequest) GetEntityKey() string {
+	if x != nil {
+		return x.EntityKey
+	}
+	return ""
+}
+
+type GetTrackResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SrcTypes []string `protobuf:"bytes,1,rep,name=src_types,json=srcTypes,proto3" json:"src_types,omitempty"`
+	// GeoJSON LineString of the entity positions ordered by time
+	Geo string `protobuf:"bytes,1,opt,name=geo,proto3" json:"geo,omitempty"`
+	// timestamp of each LineString coordinate
+	Ts []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=ts,proto3" json:"ts,omitempty"`
+	// tdf_object id of each LineString coordinate
+	Ids []string `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *ListSrcTypesResponse) Reset() {
-	*x = ListSrcTypesResponse{}
+func (x *GetTrackResponse) Reset() {
+	*x = GetTrackResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1406,13 +1525,13 @@ func (x *ListSrcTypesResponse) Reset() {
 	}
 }
 
-func (x *ListSrcTypesResponse) String() string {
+func (x *GetTrackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSrcTypesResponse) ProtoMessage() {}
+func (*GetTrackResponse) ProtoMessage() {}
 
-func (x *ListSrcTypesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTrackResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1424,28 +1543,40 @@ func (x *ListSrcTypesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSrcTypesResponse.ProtoReflect.Descriptor instead.
-func (*ListSrcTypesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTrackResponse.ProtoReflect.Descriptor instead.
+func (*GetTrackResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListSrcTypesResponse) GetSrcTypes() []string {
+func (x *GetTrackResponse) GetGeo() string {
 	if x != nil {
-		return x.SrcTypes
+		return x.Geo
+	}
+	return ""
+}
+
+func (x *GetTrackResponse) GetTs() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Ts
 	}
 	return nil
 }
 
-type GetSrcTypeRequest struct {
+func (x *GetTrackResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type StreamTdfObjectsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	SrcType string `protobuf:"bytes,1,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
 }
 
-func (x *GetSrcTypeRequest) Reset() {
-	*x = GetSrcTypeRequest{}
+func (x *StreamTdfObjectsRequest) Reset() {
+	*x = StreamTdfObjectsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1453,13 +1584,13 @@ func (x *GetSrcTypeRequest) Reset() {
 	}
 }
 
-func (x *GetSrcTypeRequest) String() string {
+func (x *StreamTdfObjectsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSrcTypeRequest) ProtoMessage() {}
+func (*StreamTdfObjectsRequest) ProtoMessage() {}
 
-func (x *GetSrcTypeRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamTdfObjectsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1471,28 +1602,23 @@ func (x *GetSrcTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSrcTypeRequest.ProtoReflect.Descriptor instead.
-func (*GetSrcTypeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamTdfObjectsRequest.ProtoReflect.Descriptor instead.
+func (*StreamTdfObjectsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetSrcTypeRequest) GetSrcType() string {
-	if x != nil {
-		return x.SrcType
-	}
-	return ""
-}
-
-type GetSrcTypeResponse struct {
+type StreamTdfObjectsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SrcType *SrcType `protobuf:"bytes,1,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
+	EventType   StreamEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=tdf_object.v1.StreamEventType" json:"event_type,omitempty"`
+	EventDetail string          `protobuf:"bytes,2,opt,name=event_detail,json=eventDetail,proto3" json:"event_detail,omitempty"`
+	TdfObjects  []*TdfObject    `protobuf:"bytes,6,rep,name=tdf_objects,json=tdfObjects,proto3" json:"tdf_objects,omitempty"`
 }
 
-func (x *GetSrcTypeResponse) Reset() {
-	*x = GetSrcTypeResponse{}
+func (x *StreamTdfObjectsResponse) Reset() {
+	*x = StreamTdfObjectsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1500,13 +1626,13 @@ func (x *GetSrcTypeResponse) Reset() {
 	}
 }
 
-func (x *GetSrcTypeResponse) String() string {
+func (x *StreamTdfObjectsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSrcTypeResponse) ProtoMessage() {}
+func (*StreamTdfObjectsResponse) ProtoMessage() {}
 
-func (x *GetSrcTypeResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamTdfObjectsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1518,26 +1644,51 @@ func (x *GetSrcTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSrcTypeResponse.ProtoReflect.Descriptor instead.
-func (*GetSrcTypeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamTdfObjectsResponse.ProtoReflect.Descriptor instead.
+func (*StreamTdfObjectsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetSrcTypeResponse) GetSrcType() *SrcType {
+func (x *StreamTdfObjectsResponse) GetEventType() StreamEventType {
 	if x != nil {
-		return x.SrcType
+		return x.EventType
+	}
+	return StreamEventType_STREAM_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *StreamTdfObjectsResponse) GetEventDetail() string {
+	if x != nil {
+		return x.EventDetail
+	}
+	return ""
+}
+
+func (x *StreamTdfObjectsResponse) GetTdfObjects() []*TdfObject {
+	if x != nil {
+		return x.TdfObjects
 	}
 	return nil
 }
 
-type GetEntitlementsRequest struct {
+type Geofence struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// GeoJSON polygon of the geofence
+	Geo string `protobuf:"bytes,3,opt,name=geo,proto3" json:"geo,omitempty"`
+	// only tdf_objects of this source type are evaluated, empty evaluates all source types
+	SrcType string `protobuf:"bytes,4,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
+	// only tdf_objects whose search index contains this json are evaluated
+	Search string `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	// seconds an entity must stay inside before a dwell event is emitted, 0 disables dwell events
+	DwellSeconds uint32 `protobuf:"varint,6,opt,name=dwell_seconds,json=dwellSeconds,proto3" json:"dwell_seconds,omitempty"`
 }
 
-func (x *GetEntitlementsRequest) Reset() {
-	*x = GetEntitlementsRequest{}
+func (x *Geofence) Reset() {
+	*x = Geofence{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1545,13 +1696,13 @@ func (x *GetEntitlementsRequest) Reset() {
 	}
 }
 
-func (x *GetEntitlementsRequest) String() string {
+func (x *Geofence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntitlementsRequest) ProtoMessage() {}
+func (*Geofence) ProtoMessage() {}
 
-func (x *GetEntitlementsRequest) ProtoReflect() protoreflect.Message {
+func (x *Geofence) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1563,21 +1714,71 @@ func (x *GetEntitlementsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntitlementsRequest.ProtoReflect.Descriptor instead.
-func (*GetEntitlementsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Geofence.ProtoReflect.Descriptor instead.
+func (*Geofence) Descriptor() ([]byte, []int) {
 	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{23}
 }
 
-type GetEntitlementsResponse struct {
+func (x *Geofence) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Geofence) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Geofence) GetGeo() string {
+	if x != nil {
+		return x.Geo
+	}
+	return ""
+}
+
+func (x *Geofence) GetSrcType() string {
+	if x != nil {
+		return x.SrcType
+	}
+	return ""
+}
+
+func (x *Geofence) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *Geofence) GetDwellSeconds() uint32 {
+	if x != nil {
+		return x.DwellSeconds
+	}
+	return 0
+}
+
+type CreateGeofenceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entitlements map[string]bool `protobuf:"bytes,1,rep,name=entitlements,proto3" json:"entitlements,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// GeoJSON polygon of the geofence
+	Geo string `protobuf:"bytes,2,opt,name=geo,proto3" json:"geo,omitempty"`
+	// only tdf_objects of this source type are evaluated, empty evaluates all source types
+	SrcType string `protobuf:"bytes,3,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
+	// only tdf_objects whose search index contains this json are evaluated
+	Search string `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	// seconds an entity must stay inside before a dwell event is emitted, 0 disables dwell events
+	DwellSeconds uint32 `protobuf:"varint,5,opt,name=dwell_seconds,json=dwellSeconds,proto3" json:"dwell_seconds,omitempty"`
 }
 
-func (x *GetEntitlementsResponse) Reset() {
-	*x = GetEntitlementsResponse{}
+func (x *CreateGeofenceRequest) Reset() {
+	*x = CreateGeofenceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1585,13 +1786,13 @@ func (x *GetEntitlementsResponse) Reset() {
 	}
 }
 
-func (x *GetEntitlementsResponse) String() string {
+func (x *CreateGeofenceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntitlementsResponse) ProtoMessage() {}
+func (*CreateGeofenceRequest) ProtoMessage() {}
 
-func (x *GetEntitlementsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateGeofenceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1603,449 +1804,4395 @@ func (x *GetEntitlementsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntitlementsResponse.ProtoReflect.Descriptor instead.
-func (*GetEntitlementsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateGeofenceRequest.ProtoReflect.Descriptor instead.
+func (*CreateGeofenceRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetEntitlementsResponse) GetEntitlements() map[string]bool {
+func (x *CreateGeofenceRequest) GetName() string {
 	if x != nil {
-		return x.Entitlements
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGeofenceRequest) GetGeo() string {
+	if x != nil {
+		return x.Geo
+	}
+	return ""
+}
+
+func (x *CreateGeofenceRequest) GetSrcType() string {
+	if x != nil {
+		return x.SrcType
+	}
+	return ""
+}
+
+func (x *CreateGeofenceRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *CreateGeofenceRequest) GetDwellSeconds() uint32 {
+	if x != nil {
+		return x.DwellSeconds
+	}
+	return 0
+}
+
+type CreateGeofenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateGeofenceResponse) Reset() {
+	*x = CreateGeofenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGeofenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGeofenceResponse) ProtoMessage() {}
+
+func (x *CreateGeofenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGeofenceResponse.ProtoReflect.Descriptor instead.
+func (*CreateGeofenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateGeofenceResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Uses wrappers to enable optional fields
+type UpdateGeofenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// GeoJSON polygon of the geofence
+	Geo *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=geo,proto3" json:"geo,omitempty"`
+	// an empty string evaluates all source types
+	SrcType      *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
+	Search       *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	DwellSeconds *wrapperspb.UInt32Value `protobuf:"bytes,6,opt,name=dwell_seconds,json=dwellSeconds,proto3" json:"dwell_seconds,omitempty"`
+}
+
+func (x *UpdateGeofenceRequest) Reset() {
+	*x = UpdateGeofenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGeofenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGeofenceRequest) ProtoMessage() {}
+
+func (x *UpdateGeofenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGeofenceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGeofenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateGeofenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateGeofenceRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
 	}
 	return nil
 }
 
-var File_proto_tdf_object_v1_tdf_object_proto protoreflect.FileDescriptor
+func (x *UpdateGeofenceRequest) GetGeo() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Geo
+	}
+	return nil
+}
 
-var file_proto_tdf_object_v1_tdf_object_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xdc, 0x01, 0x0a, 0x09, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2a, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73,
-	0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
-	0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08,
-	0x74, 0x64, 0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x74, 0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x64, 0x66, 0x5f, 0x75,
-	0x72, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x64, 0x66, 0x55, 0x72, 0x69,
-	0x22, 0x72, 0x0a, 0x1a, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x55, 0x69, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20,
-	0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x65, 0x22, 0xe6, 0x01, 0x0a, 0x0f, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65,
-	0x55, 0x69, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x52,
-	0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x55, 0x69, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x1a, 0x69, 0x0a, 0x10, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x55,
-	0x69, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a,
-	0x1c, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22,
-	0xca, 0x01, 0x0a, 0x1d, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x56, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x4d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x74, 0x64, 0x66, 0x5f,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x70,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x70, 0x1a,
-	0x3b, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xfe, 0x01, 0x0a,
-	0x18, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x63, 0x6f,
-	0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x69, 0x63, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x69,
-	0x63, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d,
-	0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x69,
-	0x63, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6c,
-	0x6f, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x4e, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xab, 0x02,
-	0x0a, 0x0f, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x65, 0x6f, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x65, 0x6f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x23,
-	0x0a, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x52, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x52, 0x09, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x07,
-	0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x6d, 0x5f,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x12, 0x3b, 0x0a, 0x09, 0x75, 0x69, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x55, 0x69, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x52, 0x08, 0x75, 0x69, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x3a,
-	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xaf, 0x01, 0x0a, 0x11, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x12, 0x51, 0x0a, 0x13, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x72, 0x5f, 0x65,
-	0x71, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
-	0x01, 0x52, 0x10, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x4f, 0x72, 0x45, 0x71, 0x75, 0x61,
-	0x6c, 0x54, 0x6f, 0x12, 0x47, 0x0a, 0x12, 0x6c, 0x65, 0x73, 0x73, 0x65, 0x72, 0x5f, 0x6f, 0x72,
-	0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x65, 0x73,
-	0x73, 0x65, 0x72, 0x4f, 0x72, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x54, 0x6f, 0x22, 0xe1, 0x01, 0x0a,
-	0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
-	0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x65,
-	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x19, 0x0a, 0x08, 0x74, 0x64, 0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x74, 0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x07, 0x74,
-	0x64, 0x66, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x64,
-	0x66, 0x55, 0x72, 0x69, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x73,
-	0x22, 0x29, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa4, 0x03, 0x0a, 0x16,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37,
-	0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
-	0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x38, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x08, 0x74, 0x64, 0x66, 0x5f, 0x62,
-	0x6c, 0x6f, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x74, 0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12,
-	0x35, 0x0a, 0x07, 0x74, 0x64, 0x66, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
-	0x74, 0x64, 0x66, 0x55, 0x72, 0x69, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02,
-	0x74, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x09, 0x74, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xd7, 0x01,
-	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x74, 0x73, 0x5f, 0x72,
-	0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x64, 0x66,
-	0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x06, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x74, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a,
-	0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6f, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x65, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x54, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x0a, 0x74, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x19, 0x0a,
-	0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc9, 0x01, 0x0a, 0x18, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x74, 0x64, 0x66, 0x5f,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x39, 0x0a, 0x0b, 0x74, 0x64, 0x66, 0x5f, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
-	0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x64, 0x66,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0a, 0x74, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04,
-	0x08, 0x05, 0x10, 0x06, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x72, 0x63, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x14, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x22, 0x36, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
-	0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x22, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53,
-	0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
-	0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70,
-	0x65, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e,
-	0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x89, 0x03, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54,
-	0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a,
-	0x19, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x55, 0x50, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a,
-	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19,
-	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x53,
-	0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x04, 0x12, 0x1f,
-	0x0a, 0x1b, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12,
-	0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x10, 0x06,
-	0x12, 0x23, 0x0a, 0x1f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x49, 0x43, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0x0a, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45,
-	0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x0b, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x52,
-	0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44,
-	0x41, 0x54, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x0c, 0x12, 0x25, 0x0a, 0x21, 0x53,
-	0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x54, 0x44, 0x46, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x53, 0x5f, 0x4e, 0x45, 0x57,
-	0x10, 0x14, 0x32, 0x96, 0x06, 0x0a, 0x10, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x74, 0x64, 0x66,
-	0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x25,
-	0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x22, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x25, 0x2e,
-	0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67,
-	0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x64, 0x66,
-	0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x72,
-	0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x72, 0x63, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0c,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x74,
-	0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x64, 0x66,
-	0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x45, 0x5a, 0x43, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x72, 0x75,
-	0x2d, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x64, 0x73, 0x70, 0x2d, 0x63, 0x6f, 0x70, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *UpdateGeofenceRequest) GetSrcType() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SrcType
+	}
+	return nil
 }
 
-var (
-	file_proto_tdf_object_v1_tdf_object_proto_rawDescOnce sync.Once
-	file_proto_tdf_object_v1_tdf_object_proto_rawDescData = file_proto_tdf_object_v1_tdf_object_proto_rawDesc
-)
+func (x *UpdateGeofenceRequest) GetSearch() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
 
-func file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP() []byte {
-	file_proto_tdf_object_v1_tdf_object_proto_rawDescOnce.Do(func() {
-		file_proto_tdf_object_v1_tdf_object_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_tdf_object_v1_tdf_object_proto_rawDescData)
-	})
-	return file_proto_tdf_object_v1_tdf_object_proto_rawDescData
+func (x *UpdateGeofenceRequest) GetDwellSeconds() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.DwellSeconds
+	}
+	return nil
 }
 
-var file_proto_tdf_object_v1_tdf_object_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_tdf_object_v1_tdf_object_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
-var file_proto_tdf_object_v1_tdf_object_proto_goTypes = []interface{}{
-	(StreamEventType)(0),                  // 0: tdf_object.v1.StreamEventType
-	(*TdfObject)(nil),                     // 1: tdf_object.v1.TdfObject
-	(*SrcTypeUiSchemaFieldConfig)(nil),    // 2: tdf_object.v1.SrcTypeUiSchemaFieldConfig
-	(*SrcTypeUiSchema)(nil),               // 3: tdf_object.v1.SrcTypeUiSchema
-	(*SrcTypeMetadataDisplayFields)(nil),  // 4: tdf_object.v1.SrcTypeMetadataDisplayFields
-	(*SrcTypeMetadataMapFieldConfig)(nil), // 5: tdf_object.v1.SrcTypeMetadataMapFieldConfig
-	(*SrcTypeMetadataMapFields)(nil),      // 6: tdf_object.v1.SrcTypeMetadataMapFields
-	(*SrcTypeMetadata)(nil),               // 7: tdf_object.v1.SrcTypeMetadata
-	(*SrcType)(nil),                       // 8: tdf_object.v1.SrcType
-	(*TimestampSelector)(nil),             // 9: tdf_object.v1.TimestampSelector
-	(*CreateTdfObjectRequest)(nil),        // 10: tdf_object.v1.CreateTdfObjectRequest
-	(*CreateTdfObjectResponse)(nil),       // 11: tdf_object.v1.CreateTdfObjectResponse
-	(*UpdateTdfObjectRequest)(nil),        // 12: tdf_object.v1.UpdateTdfObjectRequest
-	(*UpdateTdfObjectResponse)(nil),       // 13: tdf_object.v1.UpdateTdfObjectResponse
-	(*GetTdfObjectRequest)(nil),           // 14: tdf_object.v1.GetTdfObjectRequest
-	(*GetTdfObjectResponse)(nil),          // 15: tdf_object.v1.GetTdfObjectResponse
-	(*QueryTdfObjectsRequest)(nil),        // 16: tdf_object.v1.QueryTdfObjectsRequest
-	(*QueryTdfObjectsResponse)(nil),       // 17: tdf_object.v1.QueryTdfObjectsResponse
-	(*StreamTdfObjectsRequest)(nil),       // 18: tdf_object.v1.StreamTdfObjectsRequest
-	(*StreamTdfObjectsResponse)(nil),      // 19: tdf_object.v1.StreamTdfObjectsResponse
-	(*ListSrcTypesRequest)(nil),           // 20: tdf_object.v1.ListSrcTypesRequest
-	(*ListSrcTypesResponse)(nil),          // 21: tdf_object.v1.ListSrcTypesResponse
-	(*GetSrcTypeRequest)(nil),             // 22: tdf_object.v1.GetSrcTypeRequest
-	(*GetSrcTypeResponse)(nil),            // 23: tdf_object.v1.GetSrcTypeResponse
-	(*GetEntitlementsRequest)(nil),        // 24: tdf_object.v1.GetEntitlementsRequest
-	(*GetEntitlementsResponse)(nil),       // 25: tdf_object.v1.GetEntitlementsResponse
-	nil,                                   // 26: tdf_object.v1.SrcTypeUiSchema.FieldConfigEntry
-	nil,                                   // 27: tdf_object.v1.SrcTypeMetadataMapFieldConfig.ValueMapEntry
-	nil,                                   // 28: tdf_object.v1.GetEntitlementsResponse.EntitlementsEntry
-	(*timestamppb.Timestamp)(nil),         // 29: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),               // 30: google.protobuf.Struct
-	(*wrapperspb.StringValue)(nil),        // 31: google.protobuf.StringValue
-	(*wrapperspb.BytesValue)(nil),         // 32: google.protobuf.BytesValue
+type UpdateGeofenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
-var file_proto_tdf_object_v1_tdf_object_proto_depIdxs = []int32{
-	29, // 0: tdf_object.v1.TdfObject.ts:type_name -> google.protobuf.Timestamp
-	26, // 1: tdf_object.v1.SrcTypeUiSchema.field_config:type_name -> tdf_object.v1.SrcTypeUiSchema.FieldConfigEntry
-	27, // 2: tdf_object.v1.SrcTypeMetadataMapFieldConfig.valueMap:type_name -> tdf_object.v1.SrcTypeMetadataMapFieldConfig.ValueMapEntry
-	5,  // 3: tdf_object.v1.SrcTypeMetadataMapFields.iconConfig:type_name -> tdf_object.v1.SrcTypeMetadataMapFieldConfig
-	5,  // 4: tdf_object.v1.SrcTypeMetadataMapFields.colorConfig:type_name -> tdf_object.v1.SrcTypeMetadataMapFieldConfig
-	4,  // 5: tdf_object.v1.SrcTypeMetadata.display_fields:type_name -> tdf_object.v1.SrcTypeMetadataDisplayFields
-	6,  // 6: tdf_object.v1.SrcTypeMetadata.map_fields:type_name -> tdf_object.v1.SrcTypeMetadataMapFields
-	30, // 7: tdf_object.v1.SrcType.form_schema:type_name -> google.protobuf.Struct
-	3,  // 8: tdf_object.v1.SrcType.ui_schema:type_name -> tdf_object.v1.SrcTypeUiSchema
-	7,  // 9: tdf_object.v1.SrcType.metadata:type_name -> tdf_object.v1.SrcTypeMetadata
-	29, // 10: tdf_object.v1.TimestampSelector.greater_or_equal_to:type_name -> google.protobuf.Timestamp
-	29, // 11: tdf_object.v1.TimestampSelector.lesser_or_equal_to:type_name -> google.protobuf.Timestamp
-	29, // 12: tdf_object.v1.CreateTdfObjectRequest.ts:type_name -> google.protobuf.Timestamp
-	31, // 13: tdf_object.v1.UpdateTdfObjectRequest.src_type:type_name -> google.protobuf.StringValue
-	31, // 14: tdf_object.v1.UpdateTdfObjectRequest.geo:type_name -> google.protobuf.StringValue
-	31, // 15: tdf_object.v1.UpdateTdfObjectRequest.search:type_name -> google.protobuf.StringValue
-	31, // 16: tdf_object.v1.UpdateTdfObjectRequest.metadata:type_name -> google.protobuf.StringValue
-	32, // 17: tdf_object.v1.UpdateTdfObjectRequest.tdf_blob:type_name -> google.protobuf.BytesValue
-	31, // 18: tdf_object.v1.UpdateTdfObjectRequest.tdf_uri:type_name -> google.protobuf.StringValue
-	29, // 19: tdf_object.v1.UpdateTdfObjectRequest.ts:type_name -> google.protobuf.Timestamp
-	1,  // 20: tdf_object.v1.GetTdfObjectResponse.tdf_object:type_name -> tdf_object.v1.TdfObject
-	9,  // 21: tdf_object.v1.QueryTdfObjectsRequest.ts_range:type_name -> tdf_object.v1.TimestampSelector
-	1,  // 22: tdf_object.v1.QueryTdfObjectsResponse.tdf_objects:type_name -> tdf_object.v1.TdfObject
-	0,  // 23: tdf_object.v1.StreamTdfObjectsResponse.event_type:type_name -> tdf_object.v1.StreamEventType
-	1,  // 24: tdf_object.v1.StreamTdfObjectsResponse.tdf_objects:type_name -> tdf_object.v1.TdfObject
-	8,  // 25: tdf_object.v1.GetSrcTypeResponse.src_type:type_name -> tdf_object.v1.SrcType
-	28, // 26: tdf_object.v1.GetEntitlementsResponse.entitlements:type_name -> tdf_object.v1.GetEntitlementsResponse.EntitlementsEntry
-	2,  // 27: tdf_object.v1.SrcTypeUiSchema.FieldConfigEntry.value:type_name -> tdf_object.v1.SrcTypeUiSchemaFieldConfig
-	10, // 28: tdf_object.v1.TdfObjectService.CreateTdfObject:input_type -> tdf_object.v1.CreateTdfObjectRequest
-	12, // 29: tdf_object.v1.TdfObjectService.UpdateTdfObject:input_type -> tdf_object.v1.UpdateTdfObjectRequest
-	14, // 30: tdf_object.v1.TdfObjectService.GetTdfObject:input_type -> tdf_object.v1.GetTdfObjectRequest
-	16, // 31: tdf_object.v1.TdfObjectService.QueryTdfObjects:input_type -> tdf_object.v1.QueryTdfObjectsRequest
-	18, // 32: tdf_object.v1.TdfObjectService.StreamTdfObjects:input_type -> tdf_object.v1.StreamTdfObjectsRequest
-	22, // 33: tdf_object.v1.TdfObjectService.GetSrcType:input_type -> tdf_object.v1.GetSrcTypeRequest
-	20, // 34: tdf_object.v1.TdfObjectService.ListSrcTypes:input_type -> tdf_object.v1.ListSrcTypesRequest
-	24, // 35: tdf_object.v1.TdfObjectService.GetEntitlements:input_type -> tdf_object.v1.GetEntitlementsRequest
-	11, // 36: tdf_object.v1.TdfObjectService.CreateTdfObject:output_type -> tdf_object.v1.CreateTdfObjectResponse
-	13, // 37: tdf_object.v1.TdfObjectService.UpdateTdfObject:output_type -> tdf_object.v1.UpdateTdfObjectResponse
-	15, // 38: tdf_object.v1.TdfObjectService.GetTdfObject:output_type -> tdf_object.v1.GetTdfObjectResponse
-	17, // 39: tdf_object.v1.TdfObjectService.QueryTdfObjects:output_type -> tdf_object.v1.QueryTdfObjectsResponse
-	19, // 40: tdf_object.v1.TdfObjectService.StreamTdfObjects:output_type -> tdf_object.v1.StreamTdfObjectsResponse
-	23, // 41: tdf_object.v1.TdfObjectService.GetSrcType:output_type -> tdf_object.v1.GetSrcTypeResponse
-	21, // 42: tdf_object.v1.TdfObjectService.ListSrcTypes:output_type -> tdf_object.v1.ListSrcTypesResponse
-	25, // 43: tdf_object.v1.TdfObjectService.GetEntitlements:output_type -> tdf_object.v1.GetEntitlementsResponse
-	36, // [36:44] is the sub-list for method output_type
-	28, // [28:36] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+
+func (x *UpdateGeofenceResponse) Reset() {
+	*x = UpdateGeofenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func init() { file_proto_tdf_object_v1_tdf_object_proto_init() }
-func file_proto_tdf_object_v1_tdf_object_proto_init() {
-	if File_proto_tdf_object_v1_tdf_object_proto != nil {
-		return
+func (x *UpdateGeofenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGeofenceResponse) ProtoMessage() {}
+
+func (x *UpdateGeofenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TdfObject); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGeofenceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGeofenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateGeofenceResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGeofenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetGeofenceRequest) Reset() {
+	*x = GetGeofenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGeofenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGeofenceRequest) ProtoMessage() {}
+
+func (x *GetGeofenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGeofenceRequest.ProtoReflect.Descriptor instead.
+func (*GetGeofenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetGeofenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGeofenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Geofence *Geofence `protobuf:"bytes,1,opt,name=geofence,proto3" json:"geofence,omitempty"`
+}
+
+func (x *GetGeofenceResponse) Reset() {
+	*x = GetGeofenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGeofenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGeofenceResponse) ProtoMessage() {}
+
+func (x *GetGeofenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGeofenceResponse.ProtoReflect.Descriptor instead.
+func (*GetGeofenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetGeofenceResponse) GetGeofence() *Geofence {
+	if x != nil {
+		return x.Geofence
+	}
+	return nil
+}
+
+type ListGeofencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListGeofencesRequest) Reset() {
+	*x = ListGeofencesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGeofencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGeofencesRequest) ProtoMessage() {}
+
+func (x *ListGeofencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGeofencesRequest.ProtoReflect.Descriptor instead.
+func (*ListGeofencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{30}
+}
+
+type ListGeofencesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Geofences []*Geofence `protobuf:"bytes,1,rep,name=geofences,proto3" json:"geofences,omitempty"`
+}
+
+func (x *ListGeofencesResponse) Reset() {
+	*x = ListGeofencesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGeofencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGeofencesResponse) ProtoMessage() {}
+
+func (x *ListGeofencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGeofencesResponse.ProtoReflect.Descriptor instead.
+func (*ListGeofencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListGeofencesResponse) GetGeofences() []*Geofence {
+	if x != nil {
+		return x.Geofences
+	}
+	return nil
+}
+
+type DeleteGeofenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteGeofenceRequest) Reset() {
+	*x = DeleteGeofenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGeofenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGeofenceRequest) ProtoMessage() {}
+
+func (x *DeleteGeofenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGeofenceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGeofenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteGeofenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteGeofenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteGeofenceResponse) Reset() {
+	*x = DeleteGeofenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGeofenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGeofenceResponse) ProtoMessage() {}
+
+func (x *DeleteGeofenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGeofenceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGeofenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteGeofenceResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GeofenceEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GeofenceId   string `protobuf:"bytes,1,opt,name=geofence_id,json=geofenceId,proto3" json:"geofence_id,omitempty"`
+	GeofenceName string `protobuf:"bytes,2,opt,name=geofence_name,json=geofenceName,proto3" json:"geofence_name,omitempty"`
+	// entity_key of the tdf_object, or its id when it has no entity_key
+	EntityKey string `protobuf:"bytes,3,opt,name=entity_key,json=entityKey,proto3" json:"entity_key,omitempty"`
+	// timestamp of the first tdf_object inside the geofence
+	EnteredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=entered_at,json=enteredAt,proto3" json:"entered_at,omitempty"`
+	// tdf_object that triggered the event
+	TdfObject *TdfObject `protobuf:"bytes,5,opt,name=tdf_object,json=tdfObject,proto3" json:"tdf_object,omitempty"`
+}
+
+func (x *GeofenceEvent) Reset() {
+	*x = GeofenceEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeofenceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeofenceEvent) ProtoMessage() {}
+
+func (x *GeofenceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeofenceEvent.ProtoReflect.Descriptor instead.
+func (*GeofenceEvent) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GeofenceEvent) GetGeofenceId() string {
+	if x != nil {
+		return x.GeofenceId
+	}
+	return ""
+}
+
+func (x *GeofenceEvent) GetGeofenceName() string {
+	if x != nil {
+		return x.GeofenceName
+	}
+	return ""
+}
+
+func (x *GeofenceEvent) GetEntityKey() string {
+	if x != nil {
+		return x.EntityKey
+	}
+	return ""
+}
+
+func (x *GeofenceEvent) GetEnteredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnteredAt
+	}
+	return nil
+}
+
+func (x *GeofenceEvent) GetTdfObject() *TdfObject {
+	if x != nil {
+		return x.TdfObject
+	}
+	return nil
+}
+
+type StreamGeofenceEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// geofences to subscribe to, an empty list subscribes to all geofences
+	GeofenceIds []string `protobuf:"bytes,1,rep,name=geofence_ids,json=geofenceIds,proto3" json:"geofence_ids,omitempty"`
+}
+
+func (x *StreamGeofenceEventsRequest) Reset() {
+	*x = StreamGeofenceEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamGeofenceEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamGeofenceEventsRequest) ProtoMessage() {}
+
+func (x *StreamGeofenceEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamGeofenceEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamGeofenceEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StreamGeofenceEventsRequest) GetGeofenceIds() []string {
+	if x != nil {
+		return x.GeofenceIds
+	}
+	return nil
+}
+
+type StreamGeofenceEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventType     StreamEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=tdf_object.v1.StreamEventType" json:"event_type,omitempty"`
+	EventDetail   string          `protobuf:"bytes,2,opt,name=event_detail,json=eventDetail,proto3" json:"event_detail,omitempty"`
+	GeofenceEvent *GeofenceEvent  `protobuf:"bytes,3,opt,name=geofence_event,json=geofenceEvent,proto3" json:"geofence_event,omitempty"`
+}
+
+func (x *StreamGeofenceEventsResponse) Reset() {
+	*x = StreamGeofenceEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamGeofenceEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamGeofenceEventsResponse) ProtoMessage() {}
+
+func (x *StreamGeofenceEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamGeofenceEventsResponse.ProtoReflect.Descriptor instead.
+func (*StreamGeofenceEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StreamGeofenceEventsResponse) GetEventType() StreamEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return StreamEventType_STREAM_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *StreamGeofenceEventsResponse) GetEventDetail() string {
+	if x != nil {
+		return x.EventDetail
+	}
+	return ""
+}
+
+func (x *StreamGeofenceEventsResponse) GetGeofenceEvent() *GeofenceEvent {
+	if x != nil {
+		return x.GeofenceEvent
+	}
+	return nil
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// URL new tdf_objects are POSTed to
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// only tdf_objects of this source type are delivered, empty delivers all source types
+	SrcType string `protobuf:"bytes,4,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
+	// only tdf_objects intersecting this GeoJSON geometry are delivered, empty delivers tdf_objects anywhere
+	Geo string `protobuf:"bytes,5,opt,name=geo,proto3" json:"geo,omitempty"`
+	// only tdf_objects whose search index contains this json are delivered
+	Search string `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`
+	// OIDC client of the service identity whose entitlements limit the tdf_objects delivered
+	ClientId string `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSrcType() string {
+	if x != nil {
+		return x.SrcType
+	}
+	return ""
+}
+
+func (x *Webhook) GetGeo() string {
+	if x != nil {
+		return x.Geo
+	}
+	return ""
+}
+
+func (x *Webhook) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *Webhook) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// URL new tdf_objects are POSTed to
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// only tdf_objects of this source type are delivered, empty delivers all source types
+	SrcType string `protobuf:"bytes,3,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
+	// only tdf_objects intersecting this GeoJSON geometry are delivered, empty delivers tdf_objects anywhere
+	Geo string `protobuf:"bytes,4,opt,name=geo,proto3" json:"geo,omitempty"`
+	// only tdf_objects whose search index contains this json are delivered
+	Search string `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	// HMAC-SHA256 secret signing the deliveries, at least 32 characters. It is never returned
+	Secret string `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+	// OIDC client credentials of the service identity whose entitlements limit the tdf_objects delivered
+	ClientId     string `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string `protobuf:"bytes,8,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSrcType() string {
+	if x != nil {
+		return x.SrcType
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetGeo() string {
+	if x != nil {
+		return x.Geo
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateWebhookResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Uses wrappers to enable optional fields
+type UpdateWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url  *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// an empty string delivers all source types
+	SrcType *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
+	// an empty string delivers tdf_objects anywhere
+	Geo          *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=geo,proto3" json:"geo,omitempty"`
+	Search       *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`
+	Secret       *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=secret,proto3" json:"secret,omitempty"`
+	ClientId     *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetUrl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetSrcType() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SrcType
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetGeo() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Geo
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetSearch() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetSecret() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetClientId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ClientId
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetClientSecret() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+type UpdateWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateWebhookResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetWebhookRequest) Reset() {
+	*x = GetWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookRequest) ProtoMessage() {}
+
+func (x *GetWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+}
+
+func (x *GetWebhookResponse) Reset() {
+	*x = GetWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookResponse) ProtoMessage() {}
+
+func (x *GetWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{44}
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteWebhookResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// delivery of a webhook that failed after all its attempts
+type WebhookDeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId   string `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	TdfObjectId string `protobuf:"bytes,3,opt,name=tdf_object_id,json=tdfObjectId,proto3" json:"tdf_object_id,omitempty"`
+	// JSON body of the delivery
+	Payload  string `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Attempts uint32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// error of the last attempt
+	Error     string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *WebhookDeadLetter) Reset() {
+	*x = WebhookDeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeadLetter) ProtoMessage() {}
+
+func (x *WebhookDeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeadLetter.ProtoReflect.Descriptor instead.
+func (*WebhookDeadLetter) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *WebhookDeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetter) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetter) GetTdfObjectId() string {
+	if x != nil {
+		return x.TdfObjectId
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetter) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetter) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDeadLetter) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListWebhookDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WebhookId string `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+}
+
+func (x *ListWebhookDeadLettersRequest) Reset() {
+	*x = ListWebhookDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListWebhookDeadLettersRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type ListWebhookDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetters []*WebhookDeadLetter `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+}
+
+func (x *ListWebhookDeadLettersResponse) Reset() {
+	*x = ListWebhookDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListWebhookDeadLettersResponse) GetDeadLetters() []*WebhookDeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+// first message of an upload, starting or resuming it
+type UploadTdfBlobStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// upload to resume, empty starts a new upload
+	UploadId string `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// size in bytes of the blob
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// hex SHA-256 of the blob, checked once the upload is complete
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// tdf_object whose TDF the blob replaces
+	TdfObjectId string `protobuf:"bytes,4,opt,name=tdf_object_id,json=tdfObjectId,proto3" json:"tdf_object_id,omitempty"`
+	// tdf_object created with the blob when tdf_object_id is empty, its tdf_blob and tdf_uri are ignored
+	TdfObject *CreateTdfObjectRequest `protobuf:"bytes,5,opt,name=tdf_object,json=tdfObject,proto3" json:"tdf_object,omitempty"`
+}
+
+func (x *UploadTdfBlobStart) Reset() {
+	*x = UploadTdfBlobStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadTdfBlobStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadTdfBlobStart) ProtoMessage() {}
+
+func (x *UploadTdfBlobStart) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadTdfBlobStart.ProtoReflect.Descriptor instead.
+func (*UploadTdfBlobStart) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UploadTdfBlobStart) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadTdfBlobStart) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadTdfBlobStart) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *UploadTdfBlobStart) GetTdfObjectId() string {
+	if x != nil {
+		return x.TdfObjectId
+	}
+	return ""
+}
+
+func (x *UploadTdfBlobStart) GetTdfObject() *CreateTdfObjectRequest {
+	if x != nil {
+		return x.TdfObject
+	}
+	return nil
+}
+
+type UploadTdfBlobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// set in the first message of the stream only
+	Start *UploadTdfBlobStart `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// offset of the chunk in the blob, which must be the number of bytes already received
+	Offset int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data   []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UploadTdfBlobRequest) Reset() {
+	*x = UploadTdfBlobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadTdfBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadTdfBlobRequest) ProtoMessage() {}
+
+func (x *UploadTdfBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadTdfBlobRequest.ProtoReflect.Descriptor instead.
+func (*UploadTdfBlobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UploadTdfBlobRequest) GetStart() *UploadTdfBlobStart {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *UploadTdfBlobRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadTdfBlobRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadTdfBlobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId string `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// number of bytes received, a resumed upload continues from this offset
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// tdf_object the blob is attached to, set once the upload is complete
+	TdfObjectId string `protobuf:"bytes,3,opt,name=tdf_object_id,json=tdfObjectId,proto3" json:"tdf_object_id,omitempty"`
+}
+
+func (x *UploadTdfBlobResponse) Reset() {
+	*x = UploadTdfBlobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadTdfBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadTdfBlobResponse) ProtoMessage() {}
+
+func (x *UploadTdfBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadTdfBlobResponse.ProtoReflect.Descriptor instead.
+func (*UploadTdfBlobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UploadTdfBlobResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadTdfBlobResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadTdfBlobResponse) GetTdfObjectId() string {
+	if x != nil {
+		return x.TdfObjectId
+	}
+	return ""
+}
+
+type DownloadTdfBlobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tdf_object of the blob
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// offset the download starts from, to resume a download
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// maximum bytes per chunk, 0 is 1 MiB
+	ChunkSize int32 `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+}
+
+func (x *DownloadTdfBlobRequest) Reset() {
+	*x = DownloadTdfBlobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTdfBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTdfBlobRequest) ProtoMessage() {}
+
+func (x *DownloadTdfBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTdfBlobRequest.ProtoReflect.Descriptor instead.
+func (*DownloadTdfBlobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DownloadTdfBlobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DownloadTdfBlobRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadTdfBlobRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+type DownloadTdfBlobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// offset of the chunk in the blob
+	Offset int64  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// size in bytes of the blob, set in the first message
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// hex SHA-256 of the whole blob, set in the last message
+	Sha256 string `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *DownloadTdfBlobResponse) Reset() {
+	*x = DownloadTdfBlobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTdfBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTdfBlobResponse) ProtoMessage() {}
+
+func (x *DownloadTdfBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTdfBlobResponse.ProtoReflect.Descriptor instead.
+func (*DownloadTdfBlobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DownloadTdfBlobResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadTdfBlobResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadTdfBlobResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DownloadTdfBlobResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// file attached to a tdf_object or tdf_note, with its own classification
+type TdfAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ts *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	// tdf_object or tdf_note the file is attached to
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// "tdf_object" or "tdf_note"
+	ParentType string `protobuf:"bytes,4,opt,name=parent_type,json=parentType,proto3" json:"parent_type,omitempty"`
+	// file name, e.g. "photo.jpg"
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// MIME type of the attached file, e.g. "image/jpeg"
+	MimeType string `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// size in bytes of the tdf
+	Size int64 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	// plaintext json search index
+	Search string `protobuf:"bytes,8,opt,name=search,proto3" json:"search,omitempty"`
+	// tdf bytes, only returned by GetTdfAttachment
+	TdfBlob []byte `protobuf:"bytes,9,opt,name=tdf_blob,json=tdfBlob,proto3" json:"tdf_blob,omitempty"`
+	// tdf data uri, only returned by GetTdfAttachment
+	TdfUri string `protobuf:"bytes,10,opt,name=tdf_uri,json=tdfUri,proto3" json:"tdf_uri,omitempty"`
+}
+
+func (x *TdfAttachment) Reset() {
+	*x = TdfAttachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TdfAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TdfAttachment) ProtoMessage() {}
+
+func (x *TdfAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TdfAttachment.ProtoReflect.Descriptor instead.
+func (*TdfAttachment) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TdfAttachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TdfAttachment) GetTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ts
+	}
+	return nil
+}
+
+func (x *TdfAttachment) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *TdfAttachment) GetParentType() string {
+	if x != nil {
+		return x.ParentType
+	}
+	return ""
+}
+
+func (x *TdfAttachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TdfAttachment) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *TdfAttachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TdfAttachment) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *TdfAttachment) GetTdfBlob() []byte {
+	if x != nil {
+		return x.TdfBlob
+	}
+	return nil
+}
+
+func (x *TdfAttachment) GetTdfUri() string {
+	if x != nil {
+		return x.TdfUri
+	}
+	return ""
+}
+
+type CreateTdfAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParentId string `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// "tdf_object" or "tdf_note"
+	ParentType string `protobuf:"bytes,2,opt,name=parent_type,json=parentType,proto3" json:"parent_type,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MimeType   string `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// plaintext json search index, with the classification of the attachment
+	Search  string `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	TdfBlob []byte `protobuf:"bytes,6,opt,name=tdf_blob,json=tdfBlob,proto3" json:"tdf_blob,omitempty"`
+	// tdf data uri, instead of tdf_blob
+	TdfUri string `protobuf:"bytes,7,opt,name=tdf_uri,json=tdfUri,proto3" json:"tdf_uri,omitempty"`
+	// size in bytes of the tdf at tdf_uri, the size of tdf_blob is used when it is set
+	Size int64 `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *CreateTdfAttachmentRequest) Reset() {
+	*x = CreateTdfAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTdfAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTdfAttachmentRequest) ProtoMessage() {}
+
+func (x *CreateTdfAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTdfAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTdfAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateTdfAttachmentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateTdfAttachmentRequest) GetParentType() string {
+	if x != nil {
+		return x.ParentType
+	}
+	return ""
+}
+
+func (x *CreateTdfAttachmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTdfAttachmentRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *CreateTdfAttachmentRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *CreateTdfAttachmentRequest) GetTdfBlob() []byte {
+	if x != nil {
+		return x.TdfBlob
+	}
+	return nil
+}
+
+func (x *CreateTdfAttachmentRequest) GetTdfUri() string {
+	if x != nil {
+		return x.TdfUri
+	}
+	return ""
+}
+
+func (x *CreateTdfAttachmentRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type CreateTdfAttachmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateTdfAttachmentResponse) Reset() {
+	*x = CreateTdfAttachmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTdfAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTdfAttachmentResponse) ProtoMessage() {}
+
+func (x *CreateTdfAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTdfAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateTdfAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateTdfAttachmentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTdfAttachmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tdf_object or tdf_note of the attachments
+	ParentId string `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+}
+
+func (x *ListTdfAttachmentsRequest) Reset() {
+	*x = ListTdfAttachmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTdfAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTdfAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListTdfAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTdfAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListTdfAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListTdfAttachmentsRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type ListTdfAttachmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// attachments without their tdf_blob and tdf_uri
+	TdfAttachments []*TdfAttachment `protobuf:"bytes,1,rep,name=tdf_attachments,json=tdfAttachments,proto3" json:"tdf_attachments,omitempty"`
+}
+
+func (x *ListTdfAttachmentsResponse) Reset() {
+	*x = ListTdfAttachmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTdfAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTdfAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListTdfAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTdfAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListTdfAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListTdfAttachmentsResponse) GetTdfAttachments() []*TdfAttachment {
+	if x != nil {
+		return x.TdfAttachments
+	}
+	return nil
+}
+
+type GetTdfAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetTdfAttachmentRequest) Reset() {
+	*x = GetTdfAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTdfAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTdfAttachmentRequest) ProtoMessage() {}
+
+func (x *GetTdfAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTdfAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetTdfAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetTdfAttachmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTdfAttachmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TdfAttachment *TdfAttachment `protobuf:"bytes,1,opt,name=tdf_attachment,json=tdfAttachment,proto3" json:"tdf_attachment,omitempty"`
+}
+
+func (x *GetTdfAttachmentResponse) Reset() {
+	*x = GetTdfAttachmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTdfAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTdfAttachmentResponse) ProtoMessage() {}
+
+func (x *GetTdfAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTdfAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*GetTdfAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetTdfAttachmentResponse) GetTdfAttachment() *TdfAttachment {
+	if x != nil {
+		return x.TdfAttachment
+	}
+	return nil
+}
+
+// version of a tdf_object before a change, with who changed it and when
+type TdfObjectRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of the change, counting from 1 per tdf_object
+	Revision int32 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// the tdf_object as it was before the change
+	TdfObject *TdfObject `protobuf:"bytes,2,opt,name=tdf_object,json=tdfObject,proto3" json:"tdf_object,omitempty"`
+	// columns the change modified, e.g. "search", "geo"
+	ChangedFields []string               `protobuf:"bytes,3,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	// user of the token of the change, "anonymous" without one
+	ChangedBy string `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	// "update", "revert" or "delete"
+	Operation string `protobuf:"bytes,6,opt,name=operation,proto3" json:"operation,omitempty"`
+}
+
+func (x *TdfObjectRevision) Reset() {
+	*x = TdfObjectRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TdfObjectRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TdfObjectRevision) ProtoMessage() {}
+
+func (x *TdfObjectRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TdfObjectRevision.ProtoReflect.Descriptor instead.
+func (*TdfObjectRevision) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *TdfObjectRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *TdfObjectRevision) GetTdfObject() *TdfObject {
+	if x != nil {
+		return x.TdfObject
+	}
+	return nil
+}
+
+func (x *TdfObjectRevision) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *TdfObjectRevision) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *TdfObjectRevision) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *TdfObjectRevision) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+type ListTdfObjectRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListTdfObjectRevisionsRequest) Reset() {
+	*x = ListTdfObjectRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTdfObjectRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTdfObjectRevisionsRequest) ProtoMessage() {}
+
+func (x *ListTdfObjectRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTdfObjectRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTdfObjectRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListTdfObjectRevisionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTdfObjectRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// revisions visible to the caller, latest first
+	Revisions []*TdfObjectRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *ListTdfObjectRevisionsResponse) Reset() {
+	*x = ListTdfObjectRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTdfObjectRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTdfObjectRevisionsResponse) ProtoMessage() {}
+
+func (x *ListTdfObjectRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTdfObjectRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTdfObjectRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListTdfObjectRevisionsResponse) GetRevisions() []*TdfObjectRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type RevertTdfObjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// revision whose version is restored
+	Revision int32 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *RevertTdfObjectRequest) Reset() {
+	*x = RevertTdfObjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertTdfObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertTdfObjectRequest) ProtoMessage() {}
+
+func (x *RevertTdfObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertTdfObjectRequest.ProtoReflect.Descriptor instead.
+func (*RevertTdfObjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RevertTdfObjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevertTdfObjectRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RevertTdfObjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// revision recording the version the revert replaced
+	Revision int32 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *RevertTdfObjectResponse) Reset() {
+	*x = RevertTdfObjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertTdfObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertTdfObjectResponse) ProtoMessage() {}
+
+func (x *RevertTdfObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertTdfObjectResponse.ProtoReflect.Descriptor instead.
+func (*RevertTdfObjectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RevertTdfObjectResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevertTdfObjectResponse) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type ListSrcTypesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSrcTypesRequest) Reset() {
+	*x = ListSrcTypesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSrcTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSrcTypesRequest) ProtoMessage() {}
+
+func (x *ListSrcTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSrcTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListSrcTypesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{68}
+}
+
+type ListSrcTypesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcTypes []string `protobuf:"bytes,1,rep,name=src_types,json=srcTypes,proto3" json:"src_types,omitempty"`
+}
+
+func (x *ListSrcTypesResponse) Reset() {
+	*x = ListSrcTypesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSrcTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSrcTypesResponse) ProtoMessage() {}
+
+func (x *ListSrcTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSrcTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListSrcTypesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListSrcTypesResponse) GetSrcTypes() []string {
+	if x != nil {
+		return x.SrcTypes
+	}
+	return nil
+}
+
+type GetSrcTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcType string `protobuf:"bytes,1,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
+}
+
+func (x *GetSrcTypeRequest) Reset() {
+	*x = GetSrcTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSrcTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSrcTypeRequest) ProtoMessage() {}
+
+func (x *GetSrcTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSrcTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetSrcTypeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetSrcTypeRequest) GetSrcType() string {
+	if x != nil {
+		return x.SrcType
+	}
+	return ""
+}
+
+type GetSrcTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcType *SrcType `protobuf:"bytes,1,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
+}
+
+func (x *GetSrcTypeResponse) Reset() {
+	*x = GetSrcTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSrcTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSrcTypeResponse) ProtoMessage() {}
+
+func (x *GetSrcTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSrcTypeResponse.ProtoReflect.Descriptor instead.
+func (*GetSrcTypeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetSrcTypeResponse) GetSrcType() *SrcType {
+	if x != nil {
+		return x.SrcType
+	}
+	return nil
+}
+
+type GetEntitlementsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetEntitlementsRequest) Reset() {
+	*x = GetEntitlementsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntitlementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntitlementsRequest) ProtoMessage() {}
+
+func (x *GetEntitlementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntitlementsRequest.ProtoReflect.Descriptor instead.
+func (*GetEntitlementsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{72}
+}
+
+type GetEntitlementsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entitlements map[string]bool `protobuf:"bytes,1,rep,name=entitlements,proto3" json:"entitlements,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetEntitlementsResponse) Reset() {
+	*x = GetEntitlementsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntitlementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntitlementsResponse) ProtoMessage() {}
+
+func (x *GetEntitlementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tdf_object_v1_tdf_object_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntitlementsResponse.ProtoReflect.Descriptor instead.
+func (*GetEntitlementsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetEntitlementsResponse) GetEntitlements() map[string]bool {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+var File_proto_tdf_object_v1_tdf_object_proto protoreflect.FileDescriptor
+
+var file_proto_tdf_object_v1_tdf_object_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x95, 0x02, 0x0a, 0x09, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2a, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x64, 0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x74, 0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x64, 0x66, 0x5f, 0x75,
+	0x72, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x64, 0x66, 0x55, 0x72, 0x69,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x1a, 0x53, 0x72, 0x63,
+	0x54, 0x79, 0x70, 0x65, 0x55, 0x69, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x64,
+	0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x64, 0x67, 0x65,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x22, 0xe6, 0x01,
+	0x0a, 0x0f, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x55, 0x69, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72,
+	0x63, 0x54, 0x79, 0x70, 0x65, 0x55, 0x69, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x69, 0x0a, 0x10, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x3f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x55, 0x69, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x1c, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x1d, 0x53, 0x72, 0x63,
+	0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x56, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x70, 0x1a, 0x3b, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xfe, 0x01, 0x0a, 0x18, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x69, 0x63, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x69, 0x63, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x4e, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x64,
+	0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x63, 0x54,
+	0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x70, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xce, 0x02, 0x0a, 0x0f, 0x53, 0x72, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x65,
+	0x6f, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67,
+	0x65, 0x6f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x74, 0x74, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x52, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0d, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0a,
+	0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x09, 0x6d, 0x61, 0x70, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xcc, 0x01, 0x0a, 0x07, 0x53, 0x72, 0x63, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x3b, 0x0a,
+	0x09, 0x75, 0x69, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x55, 0x69, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x08, 0x75, 0x69, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74,
+	0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x63,
+	0x54, 0x79, 0x70, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xaf, 0x01, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x51, 0x0a, 0x13,
+	0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x72, 0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c,
+	0x5f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x10, 0x67,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x4f, 0x72, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x54, 0x6f, 0x12,
+	0x47, 0x0a, 0x12, 0x6c, 0x65, 0x73, 0x73, 0x65, 0x72, 0x5f, 0x6f, 0x72, 0x5f, 0x65, 0x71, 0x75,
+	0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x65, 0x73, 0x73, 0x65, 0x72, 0x4f,
+	0x72, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x54, 0x6f, 0x22, 0x80, 0x02, 0x0a, 0x16, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x73,
+	0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x64, 0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x74, 0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x64, 0x66, 0x5f, 0x75,
+	0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x64, 0x66, 0x55, 0x72, 0x69,
+	0x12, 0x2a, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x29, 0x0a, 0x17, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa9, 0x04, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x72, 0x63,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x67,
+	0x65, 0x6f, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x36, 0x0a, 0x08, 0x74, 0x64, 0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x07, 0x74, 0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x35, 0x0a, 0x07, 0x74, 0x64,
+	0x66, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x74, 0x64, 0x66, 0x55, 0x72,
+	0x69, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x73, 0x12, 0x3b, 0x0a,
+	0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x46, 0x0a, 0x10, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x2d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x4f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x74, 0x64,
+	0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x09, 0x74, 0x64, 0x66, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x64, 0x66,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
+	0x0a, 0x08, 0x74, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x74, 0x73, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6f, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x65, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x54, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x0a, 0x74, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x7d, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x74, 0x73,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74,
+	0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x74, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x74, 0x64,
+	0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0a, 0x74, 0x64, 0x66, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x74, 0x73, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x64,
+	0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x06, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x74, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x21,
+	0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x25, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x62, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x67, 0x65, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x12, 0x2a,
+	0x0a, 0x02, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x19, 0x0a, 0x17,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc9, 0x01, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x39, 0x0a, 0x0b, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64,
+	0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x64, 0x66, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0a, 0x74, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08,
+	0x05, 0x10, 0x06, 0x22, 0x98, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x77, 0x65,
+	0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xa5,
+	0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x28, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0xc3, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x66, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x03, 0x67, 0x65, 0x6f, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x12, 0x41, 0x0a, 0x0d, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x28, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4a,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x67, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x08, 0x67, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x4e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x67,
+	0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x67, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x22, 0x2f, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x66,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x65, 0x6f,
+	0x66, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe8, 0x01,
+	0x0a, 0x0d, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x67, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x4b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x37, 0x0a, 0x0a, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x09, 0x74,
+	0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x40, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6f, 0x66, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x67,
+	0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x1c, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x43, 0x0a,
+	0x0e, 0x67, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x0d, 0x67, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x67, 0x65,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xfb, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x67,
+	0x65, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x23, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x22, 0x35, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x4a, 0x04, 0x08,
+	0x02, 0x10, 0x03, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xe3, 0x03, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x37,
+	0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
+	0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x34, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x41,
+	0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x22, 0x27, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22,
+	0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x22, 0x2e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xed, 0x01, 0x0a, 0x11,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0d, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x46, 0x0a, 0x1d, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0a,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x64,
+	0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x64,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x12, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x64,
+	0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x44,
+	0x0a, 0x0a, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x09, 0x74, 0x64, 0x66, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x22, 0x7b, 0x0a, 0x14, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x64,
+	0x66, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x64,
+	0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x54, 0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x70, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x64, 0x66, 0x42, 0x6c,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x22, 0x0a, 0x0d, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54,
+	0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x71, 0x0a, 0x17,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22,
+	0x9a, 0x02, 0x0a, 0x0d, 0x54, 0x64, 0x66, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x64, 0x66, 0x5f,
+	0x62, 0x6c, 0x6f, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x64, 0x66, 0x42,
+	0x6c, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x64, 0x66, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x64, 0x66, 0x55, 0x72, 0x69, 0x22, 0x83, 0x02, 0x0a,
+	0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x09, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x27, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0a, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x64,
+	0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x64,
+	0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x64, 0x66, 0x5f, 0x75, 0x72, 0x69,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x64, 0x66, 0x55, 0x72, 0x69, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x2d, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x40, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x64, 0x66, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x64, 0x66, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x0f, 0x74, 0x64, 0x66, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x64, 0x66,
+	0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x64, 0x66, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x74, 0x64, 0x66, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x31, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54,
+	0x64, 0x66, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5f, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x54, 0x64, 0x66, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x74, 0x64, 0x66, 0x5f, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x64, 0x66, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x74,
+	0x64, 0x66, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x87, 0x02, 0x0a,
+	0x11, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37,
+	0x0a, 0x0a, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x09, 0x74, 0x64,
+	0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x64,
+	0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x60, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x54, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x15,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x72, 0x63,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x36, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x64, 0x66,
+	0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a,
+	0x3f, 0x0a, 0x11, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x2a, 0xa5, 0x04, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x52, 0x45, 0x41,
+	0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x52, 0x54, 0x55, 0x50, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x48, 0x55, 0x54,
+	0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54,
+	0x41, 0x52, 0x54, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x54,
+	0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x52, 0x45,
+	0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f,
+	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x52,
+	0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48,
+	0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x10, 0x06, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x54,
+	0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x47, 0x45, 0x4e, 0x45, 0x52, 0x49, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x0a, 0x12,
+	0x22, 0x0a, 0x1e, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x0b, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x0c, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x44, 0x46, 0x5f, 0x4f,
+	0x42, 0x4a, 0x45, 0x43, 0x54, 0x53, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x14, 0x12, 0x29, 0x0a, 0x25,
+	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x54, 0x44, 0x46, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x53, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x15, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x54, 0x52, 0x45, 0x41,
+	0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x45, 0x4f,
+	0x46, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x1e, 0x12, 0x23, 0x0a,
+	0x1f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x47, 0x45, 0x4f, 0x46, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x45, 0x58, 0x49, 0x54,
+	0x10, 0x1f, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x45, 0x4f, 0x46, 0x45, 0x4e, 0x43, 0x45,
+	0x5f, 0x44, 0x57, 0x45, 0x4c, 0x4c, 0x10, 0x20, 0x32, 0xca, 0x16, 0x0a, 0x10, 0x54, 0x64, 0x66,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a,
+	0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x25, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x64,
+	0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x62, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64,
+	0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x64, 0x66, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x22, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x64, 0x66, 0x5f,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x64, 0x66,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x66,
+	0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x74, 0x64, 0x66,
+	0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x1e, 0x2e,
+	0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x67, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x64, 0x66, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74,
+	0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x72, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x64, 0x66, 0x5f,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x72, 0x63,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59,
+	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x22,
+	0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x72, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x74,
+	0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x24, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x66,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f,
+	0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x24, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6f,
+	0x66, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x21,
+	0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6f,
+	0x66, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47,
+	0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x65,
+	0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a,
+	0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x74, 0x64, 0x66,
+	0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x47, 0x65, 0x6f, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x23, 0x2e, 0x74,
+	0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x23, 0x2e, 0x74, 0x64, 0x66,
+	0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x20, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x22, 0x2e, 0x74,
+	0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x23, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x2c, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e,
+	0x0a, 0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12,
+	0x23, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x64, 0x66, 0x42, 0x6c,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x64,
+	0x0a, 0x0f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x64, 0x66, 0x42, 0x6c, 0x6f,
+	0x62, 0x12, 0x25, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x64, 0x66, 0x42, 0x6c, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x54, 0x64, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x6e, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x64,
+	0x66, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x74, 0x64,
+	0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x64, 0x66, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x64, 0x66,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x64, 0x66, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x74, 0x64, 0x66,
+	0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x64, 0x66, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x64, 0x66, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x64, 0x66, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x64, 0x66, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x64, 0x66, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x62, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64,
+	0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x74, 0x54, 0x64, 0x66, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x72, 0x75, 0x2d, 0x63, 0x6f, 0x72, 0x70, 0x2f,
+	0x64, 0x73, 0x70, 0x2d, 0x63, 0x6f, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x3b,
+	0x74, 0x64, 0x66, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_tdf_object_v1_tdf_object_proto_rawDescOnce sync.Once
+	file_proto_tdf_object_v1_tdf_object_proto_rawDescData = file_proto_tdf_object_v1_tdf_object_proto_rawDesc
+)
+
+func file_proto_tdf_object_v1_tdf_object_proto_rawDescGZIP() []byte {
+	file_proto_tdf_object_v1_tdf_object_proto_rawDescOnce.Do(func() {
+		file_proto_tdf_object_v1_tdf_object_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_tdf_object_v1_tdf_object_proto_rawDescData)
+	})
+	return file_proto_tdf_object_v1_tdf_object_proto_rawDescData
+}
+
+var file_proto_tdf_object_v1_tdf_object_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_tdf_object_v1_tdf_object_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
+var file_proto_tdf_object_v1_tdf_object_proto_goTypes = []interface{}{
+	(StreamEventType)(0),                   // 0: tdf_object.v1.StreamEventType
+	(*TdfObject)(nil),                      // 1: tdf_object.v1.TdfObject
+	(*SrcTypeUiSchemaFieldConfig)(nil),     // 2: tdf_object.v1.SrcTypeUiSchemaFieldConfig
+	(*SrcTypeUiSchema)(nil),                // 3: tdf_object.v1.SrcTypeUiSchema
+	(*SrcTypeMetadataDisplayFields)(nil),   // 4: tdf_object.v1.SrcTypeMetadataDisplayFields
+	(*SrcTypeMetadataMapFieldConfig)(nil),  // 5: tdf_object.v1.SrcTypeMetadataMapFieldConfig
+	(*SrcTypeMetadataMapFields)(nil),       // 6: tdf_object.v1.SrcTypeMetadataMapFields
+	(*SrcTypeMetadata)(nil),                // 7: tdf_object.v1.SrcTypeMetadata
+	(*SrcType)(nil),                        // 8: tdf_object.v1.SrcType
+	(*TimestampSelector)(nil),              // 9: tdf_object.v1.TimestampSelector
+	(*CreateTdfObjectRequest)(nil),         // 10: tdf_object.v1.CreateTdfObjectRequest
+	(*CreateTdfObjectResponse)(nil),        // 11: tdf_object.v1.CreateTdfObjectResponse
+	(*UpdateTdfObjectRequest)(nil),         // 12: tdf_object.v1.UpdateTdfObjectRequest
+	(*UpdateTdfObjectResponse)(nil),        // 13: tdf_object.v1.UpdateTdfObjectResponse
+	(*GetTdfObjectRequest)(nil),            // 14: tdf_object.v1.GetTdfObjectRequest
+	(*GetTdfObjectResponse)(nil),           // 15: tdf_object.v1.GetTdfObjectResponse
+	(*QueryTdfObjectsRequest)(nil),         // 16: tdf_object.v1.QueryTdfObjectsRequest
+	(*QueryTdfObjectsResponse)(nil),        // 17: tdf_object.v1.QueryTdfObjectsResponse
+	(*GetLatestPositionsRequest)(nil),      // 18: tdf_object.v1.GetLatestPositionsRequest
+	(*GetLatestPositionsResponse)(nil),     // 19: tdf_object.v1.GetLatestPositionsResponse
+	(*GetTrackRequest)(nil),                // 20: tdf_object.v1.GetTrackRequest
+	(*GetTrackResponse)(nil),               // 21: tdf_object.v1.GetTrackResponse
+	(*StreamTdfObjectsRequest)(nil),        // 22: tdf_object.v1.StreamTdfObjectsRequest
+	(*StreamTdfObjectsResponse)(nil),       // 23: tdf_object.v1.StreamTdfObjectsResponse
+	(*Geofence)(nil),                       // 24: tdf_object.v1.Geofence
+	(*CreateGeofenceRequest)(nil),          // 25: tdf_object.v1.CreateGeofenceRequest
+	(*CreateGeofenceResponse)(nil),         // 26: tdf_object.v1.CreateGeofenceResponse
+	(*UpdateGeofenceRequest)(nil),          // 27: tdf_object.v1.UpdateGeofenceRequest
+	(*UpdateGeofenceResponse)(nil),         // 28: tdf_object.v1.UpdateGeofenceResponse
+	(*GetGeofenceRequest)(nil),             // 29: tdf_object.v1.GetGeofenceRequest
+	(*GetGeofenceResponse)(nil),            // 30: tdf_object.v1.GetGeofenceResponse
+	(*ListGeofencesRequest)(nil),           // 31: tdf_object.v1.ListGeofencesRequest
+	(*ListGeofencesResponse)(nil),          // 32: tdf_object.v1.ListGeofencesResponse
+	(*DeleteGeofenceRequest)(nil),          // 33: tdf_object.v1.DeleteGeofenceRequest
+	(*DeleteGeofenceResponse)(nil),         // 34: tdf_object.v1.DeleteGeofenceResponse
+	(*GeofenceEvent)(nil),                  // 35: tdf_object.v1.GeofenceEvent
+	(*StreamGeofenceEventsRequest)(nil),    // 36: tdf_object.v1.StreamGeofenceEventsRequest
+	(*StreamGeofenceEventsResponse)(nil),   // 37: tdf_object.v1.StreamGeofenceEventsResponse
+	(*Webhook)(nil),                        // 38: tdf_object.v1.Webhook
+	(*CreateWebhookRequest)(nil),           // 39: tdf_object.v1.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),          // 40: tdf_object.v1.CreateWebhookResponse
+	(*UpdateWebhookRequest)(nil),           // 41: tdf_object.v1.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),          // 42: tdf_object.v1.UpdateWebhookResponse
+	(*GetWebhookRequest)(nil),              // 43: tdf_object.v1.GetWebhookRequest
+	(*GetWebhookResponse)(nil),             // 44: tdf_object.v1.GetWebhookResponse
+	(*ListWebhooksRequest)(nil),            // 45: tdf_object.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),           // 46: tdf_object.v1.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),           // 47: tdf_object.v1.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),          // 48: tdf_object.v1.DeleteWebhookResponse
+	(*WebhookDeadLetter)(nil),              // 49: tdf_object.v1.WebhookDeadLetter
+	(*ListWebhookDeadLettersRequest)(nil),  // 50: tdf_object.v1.ListWebhookDeadLettersRequest
+	(*ListWebhookDeadLettersResponse)(nil), // 51: tdf_object.v1.ListWebhookDeadLettersResponse
+	(*UploadTdfBlobStart)(nil),             // 52: tdf_object.v1.UploadTdfBlobStart
+	(*UploadTdfBlobRequest)(nil),           // 53: tdf_object.v1.UploadTdfBlobRequest
+	(*UploadTdfBlobResponse)(nil),          // 54: tdf_object.v1.UploadTdfBlobResponse
+	(*DownloadTdfBlobRequest)(nil),         // 55: tdf_object.v1.DownloadTdfBlobRequest
+	(*DownloadTdfBlobResponse)(nil),        // 56: tdf_object.v1.DownloadTdfBlobResponse
+	(*TdfAttachment)(nil),                  // 57: tdf_object.v1.TdfAttachment
+	(*CreateTdfAttachmentRequest)(nil),     // 58: tdf_object.v1.CreateTdfAttachmentRequest
+	(*CreateTdfAttachmentResponse)(nil),    // 59: tdf_object.v1.CreateTdfAttachmentResponse
+	(*ListTdfAttachmentsRequest)(nil),      // 60: tdf_object.v1.ListTdfAttachmentsRequest
+	(*ListTdfAttachmentsResponse)(nil),     // 61: tdf_object.v1.ListTdfAttachmentsResponse
+	(*GetTdfAttachmentRequest)(nil),        // 62: tdf_object.v1.GetTdfAttachmentRequest
+	(*GetTdfAttachmentResponse)(nil),       // 63: tdf_object.v1.GetTdfAttachmentResponse
+	(*TdfObjectRevision)(nil),              // 64: tdf_object.v1.TdfObjectRevision
+	(*ListTdfObjectRevisionsRequest)(nil),  // 65: tdf_object.v1.ListTdfObjectRevisionsRequest
+	(*ListTdfObjectRevisionsResponse)(nil), // 66: tdf_object.v1.ListTdfObjectRevisionsResponse
+	(*RevertTdfObjectRequest)(nil),         // 67: tdf_object.v1.RevertTdfObjectRequest
+	(*RevertTdfObjectResponse)(nil),        // 68: tdf_object.v1.RevertTdfObjectResponse
+	(*ListSrcTypesRequest)(nil),            // 69: tdf_object.v1.ListSrcTypesRequest
+	(*ListSrcTypesResponse)(nil),           // 70: tdf_object.v1.ListSrcTypesResponse
+	(*GetSrcTypeRequest)(nil),              // 71: tdf_object.v1.GetSrcTypeRequest
+	(*GetSrcTypeResponse)(nil),             // 72: tdf_object.v1.GetSrcTypeResponse
+	(*GetEntitlementsRequest)(nil),         // 73: tdf_object.v1.GetEntitlementsRequest
+	(*GetEntitlementsResponse)(nil),        // 74: tdf_object.v1.GetEntitlementsResponse
+	nil,                                    // 75: tdf_object.v1.SrcTypeUiSchema.FieldConfigEntry
+	nil,                                    // 76: tdf_object.v1.SrcTypeMetadataMapFieldConfig.ValueMapEntry
+	nil,                                    // 77: tdf_object.v1.GetEntitlementsResponse.EntitlementsEntry
+	(*timestamppb.Timestamp)(nil),          // 78: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                // 79: google.protobuf.Struct
+	(*wrapperspb.StringValue)(nil),         // 80: google.protobuf.StringValue
+	(*wrapperspb.BytesValue)(nil),          // 81: google.protobuf.BytesValue
+	(*wrapperspb.Int32Value)(nil),          // 82: google.protobuf.Int32Value
+	(*wrapperspb.UInt32Value)(nil),         // 83: google.protobuf.UInt32Value
+}
+var file_proto_tdf_object_v1_tdf_object_proto_depIdxs = []int32{
+	78, // 0: tdf_object.v1.TdfObject.ts:type_name -> google.protobuf.Timestamp
+	75, // 1: tdf_object.v1.SrcTypeUiSchema.field_config:type_name -> tdf_object.v1.SrcTypeUiSchema.FieldConfigEntry
+	76, // 2: tdf_object.v1.SrcTypeMetadataMapFieldConfig.valueMap:type_name -> tdf_object.v1.SrcTypeMetadataMapFieldConfig.ValueMapEntry
+	5,  // 3: tdf_object.v1.SrcTypeMetadataMapFields.iconConfig:type_name -> tdf_object.v1.SrcTypeMetadataMapFieldConfig
+	5,  // 4: tdf_object.v1.SrcTypeMetadataMapFields.colorConfig:type_name -> tdf_object.v1.SrcTypeMetadataMapFieldConfig
+	4,  // 5: tdf_object.v1.SrcTypeMetadata.display_fields:type_name -> tdf_object.v1.SrcTypeMetadataDisplayFields
+	6,  // 6: tdf_object.v1.SrcTypeMetadata.map_fields:type_name -> tdf_object.v1.SrcTypeMetadataMapFields
+	79, // 7: tdf_object.v1.SrcType.form_schema:type_name -> google.protobuf.Struct
+	3,  // 8: tdf_object.v1.SrcType.ui_schema:type_name -> tdf_object.v1.SrcTypeUiSchema
+	7,  // 9: tdf_object.v1.SrcType.metadata:type_name -> tdf_object.v1.SrcTypeMetadata
+	78, // 10: tdf_object.v1.TimestampSelector.greater_or_equal_to:type_name -> google.protobuf.Timestamp
+	78, // 11: tdf_object.v1.TimestampSelector.lesser_or_equal_to:type_name -> google.protobuf.Timestamp
+	78, // 12: tdf_object.v1.CreateTdfObjectRequest.ts:type_name -> google.protobuf.Timestamp
+	80, // 13: tdf_object.v1.UpdateTdfObjectRequest.src_type:type_name -> google.protobuf.StringValue
+	80, // 14: tdf_object.v1.UpdateTdfObjectRequest.geo:type_name -> google.protobuf.StringValue
+	80, // 15: tdf_object.v1.UpdateTdfObjectRequest.search:type_name -> google.protobuf.StringValue
+	80, // 16: tdf_object.v1.UpdateTdfObjectRequest.metadata:type_name -> google.protobuf.StringValue
+	81, // 17: tdf_object.v1.UpdateTdfObjectRequest.tdf_blob:type_name -> google.protobuf.BytesValue
+	80, // 18: tdf_object.v1.UpdateTdfObjectRequest.tdf_uri:type_name -> google.protobuf.StringValue
+	78, // 19: tdf_object.v1.UpdateTdfObjectRequest.ts:type_name -> google.protobuf.Timestamp
+	80, // 20: tdf_object.v1.UpdateTdfObjectRequest.entity_key:type_name -> google.protobuf.StringValue
+	82, // 21: tdf_object.v1.UpdateTdfObjectRequest.expected_version:type_name -> google.protobuf.Int32Value
+	1,  // 22: tdf_object.v1.GetTdfObjectResponse.tdf_object:type_name -> tdf_object.v1.TdfObject
+	9,  // 23: tdf_object.v1.QueryTdfObjectsRequest.ts_range:type_name -> tdf_object.v1.TimestampSelector
+	1,  // 24: tdf_object.v1.QueryTdfObjectsResponse.tdf_objects:type_name -> tdf_object.v1.TdfObject
+	9,  // 25: tdf_object.v1.GetLatestPositionsRequest.ts_range:type_name -> tdf_object.v1.TimestampSelector
+	1,  // 26: tdf_object.v1.GetLatestPositionsResponse.tdf_objects:type_name -> tdf_object.v1.TdfObject
+	9,  // 27: tdf_object.v1.GetTrackRequest.ts_range:type_name -> tdf_object.v1.TimestampSelector
+	78, // 28: tdf_object.v1.GetTrackResponse.ts:type_name -> google.protobuf.Timestamp
+	0,  // 29: tdf_object.v1.StreamTdfObjectsResponse.event_type:type_name -> tdf_object.v1.StreamEventType
+	1,  // 30: tdf_object.v1.StreamTdfObjectsResponse.tdf_objects:type_name -> tdf_object.v1.TdfObject
+	80, // 31: tdf_object.v1.UpdateGeofenceRequest.name:type_name -> google.protobuf.StringValue
+	80, // 32: tdf_object.v1.UpdateGeofenceRequest.geo:type_name -> google.protobuf.StringValue
+	80, // 33: tdf_object.v1.UpdateGeofenceRequest.src_type:type_name -> google.protobuf.StringValue
+	80, // 34: tdf_object.v1.UpdateGeofenceRequest.search:type_name -> google.protobuf.StringValue
+	83, // 35: tdf_object.v1.UpdateGeofenceRequest.dwell_seconds:type_name -> google.protobuf.UInt32Value
+	24, // 36: tdf_object.v1.GetGeofenceResponse.geofence:type_name -> tdf_object.v1.Geofence
+	24, // 37: tdf_object.v1.ListGeofencesResponse.geofences:type_name -> tdf_object.v1.Geofence
+	78, // 38: tdf_object.v1.GeofenceEvent.entered_at:type_name -> google.protobuf.Timestamp
+	1,  // 39: tdf_object.v1.GeofenceEvent.tdf_object:type_name -> tdf_object.v1.TdfObject
+	0,  // 40: tdf_object.v1.StreamGeofenceEventsResponse.event_type:type_name -> tdf_object.v1.StreamEventType
+	35, // 41: tdf_object.v1.StreamGeofenceEventsResponse.geofence_event:type_name -> tdf_object.v1.GeofenceEvent
+	80, // 42: tdf_object.v1.UpdateWebhookRequest.name:type_name -> google.protobuf.StringValue
+	80, // 43: tdf_object.v1.UpdateWebhookRequest.url:type_name -> google.protobuf.StringValue
+	80, // 44: tdf_object.v1.UpdateWebhookRequest.src_type:type_name -> google.protobuf.StringValue
+	80, // 45: tdf_object.v1.UpdateWebhookRequest.geo:type_name -> google.protobuf.StringValue
+	80, // 46: tdf_object.v1.UpdateWebhookRequest.search:type_name -> google.protobuf.StringValue
+	80, // 47: tdf_object.v1.UpdateWebhookRequest.secret:type_name -> google.protobuf.StringValue
+	80, // 48: tdf_object.v1.UpdateWebhookRequest.client_id:type_name -> google.protobuf.StringValue
+	80, // 49: tdf_object.v1.UpdateWebhookRequest.client_secret:type_name -> google.protobuf.StringValue
+	38, // 50: tdf_object.v1.GetWebhookResponse.webhook:type_name -> tdf_object.v1.Webhook
+	38, // 51: tdf_object.v1.ListWebhooksResponse.webhooks:type_name -> tdf_object.v1.Webhook
+	78, // 52: tdf_object.v1.WebhookDeadLetter.created_at:type_name -> google.protobuf.Timestamp
+	49, // 53: tdf_object.v1.ListWebhookDeadLettersResponse.dead_letters:type_name -> tdf_object.v1.WebhookDeadLetter
+	10, // 54: tdf_object.v1.UploadTdfBlobStart.tdf_object:type_name -> tdf_object.v1.CreateTdfObjectRequest
+	52, // 55: tdf_object.v1.UploadTdfBlobRequest.start:type_name -> tdf_object.v1.UploadTdfBlobStart
+	78, // 56: tdf_object.v1.TdfAttachment.ts:type_name -> google.protobuf.Timestamp
+	57, // 57: tdf_object.v1.ListTdfAttachmentsResponse.tdf_attachments:type_name -> tdf_object.v1.TdfAttachment
+	57, // 58: tdf_object.v1.GetTdfAttachmentResponse.tdf_attachment:type_name -> tdf_object.v1.TdfAttachment
+	1,  // 59: tdf_object.v1.TdfObjectRevision.tdf_object:type_name -> tdf_object.v1.TdfObject
+	78, // 60: tdf_object.v1.TdfObjectRevision.changed_at:type_name -> google.protobuf.Timestamp
+	64, // 61: tdf_object.v1.ListTdfObjectRevisionsResponse.revisions:type_name -> tdf_object.v1.TdfObjectRevision
+	8,  // 62: tdf_object.v1.GetSrcTypeResponse.src_type:type_name -> tdf_object.v1.SrcType
+	77, // 63: tdf_object.v1.GetEntitlementsResponse.entitlements:type_name -> tdf_object.v1.GetEntitlementsResponse.EntitlementsEntry
+	2,  // 64: tdf_object.v1.SrcTypeUiSchema.FieldConfigEntry.value:type_name -> tdf_object.v1.SrcTypeUiSchemaFieldConfig
+	10, // 65: tdf_object.v1.TdfObjectService.CreateTdfObject:input_type -> tdf_object.v1.CreateTdfObjectRequest
+	12, // 66: tdf_object.v1.TdfObjectService.UpdateTdfObject:input_type -> tdf_object.v1.UpdateTdfObjectRequest
+	14, // 67: tdf_object.v1.TdfObjectService.GetTdfObject:input_type -> tdf_object.v1.GetTdfObjectRequest
+	16, // 68: tdf_object.v1.TdfObjectService.QueryTdfObjects:input_type -> tdf_object.v1.QueryTdfObjectsRequest
+	18, // 69: tdf_object.v1.TdfObjectService.GetLatestPositions:input_type -> tdf_object.v1.GetLatestPositionsRequest
+	20, // 70: tdf_object.v1.TdfObjectService.GetTrack:input_type -> tdf_object.v1.GetTrackRequest
+	22, // 71: tdf_object.v1.TdfObjectService.StreamTdfObjects:input_type -> tdf_object.v1.StreamTdfObjectsRequest
+	71, // 72: tdf_object.v1.TdfObjectService.GetSrcType:input_type -> tdf_object.v1.GetSrcTypeRequest
+	69, // 73: tdf_object.v1.TdfObjectService.ListSrcTypes:input_type -> tdf_object.v1.ListSrcTypesRequest
+	73, // 74: tdf_object.v1.TdfObjectService.GetEntitlements:input_type -> tdf_object.v1.GetEntitlementsRequest
+	25, // 75: tdf_object.v1.TdfObjectService.CreateGeofence:input_type -> tdf_object.v1.CreateGeofenceRequest
+	27, // 76: tdf_object.v1.TdfObjectService.UpdateGeofence:input_type -> tdf_object.v1.UpdateGeofenceRequest
+	29, // 77: tdf_object.v1.TdfObjectService.GetGeofence:input_type -> tdf_object.v1.GetGeofenceRequest
+	31, // 78: tdf_object.v1.TdfObjectService.ListGeofences:input_type -> tdf_object.v1.ListGeofencesRequest
+	33, // 79: tdf_object.v1.TdfObjectService.DeleteGeofence:input_type -> tdf_object.v1.DeleteGeofenceRequest
+	36, // 80: tdf_object.v1.TdfObjectService.StreamGeofenceEvents:input_type -> tdf_object.v1.StreamGeofenceEventsRequest
+	39, // 81: tdf_object.v1.TdfObjectService.CreateWebhook:input_type -> tdf_object.v1.CreateWebhookRequest
+	41, // 82: tdf_object.v1.TdfObjectService.UpdateWebhook:input_type -> tdf_object.v1.UpdateWebhookRequest
+	43, // 83: tdf_object.v1.TdfObjectService.GetWebhook:input_type -> tdf_object.v1.GetWebhookRequest
+	45, // 84: tdf_object.v1.TdfObjectService.ListWebhooks:input_type -> tdf_object.v1.ListWebhooksRequest
+	47, // 85: tdf_object.v1.TdfObjectService.DeleteWebhook:input_type -> tdf_object.v1.DeleteWebhookRequest
+	50, // 86: tdf_object.v1.TdfObjectService.ListWebhookDeadLetters:input_type -> tdf_object.v1.ListWebhookDeadLettersRequest
+	53, // 87: tdf_object.v1.TdfObjectService.UploadTdfBlob:input_type -> tdf_object.v1.UploadTdfBlobRequest
+	55, // 88: tdf_object.v1.TdfObjectService.DownloadTdfBlob:input_type -> tdf_object.v1.DownloadTdfBlobRequest
+	58, // 89: tdf_object.v1.TdfObjectService.CreateTdfAttachment:input_type -> tdf_object.v1.CreateTdfAttachmentRequest
+	60, // 90: tdf_object.v1.TdfObjectService.ListTdfAttachments:input_type -> tdf_object.v1.ListTdfAttachmentsRequest
+	62, // 91: tdf_object.v1.TdfObjectService.GetTdfAttachment:input_type -> tdf_object.v1.GetTdfAttachmentRequest
+	65, // 92: tdf_object.v1.TdfObjectService.ListTdfObjectRevisions:input_type -> tdf_object.v1.ListTdfObjectRevisionsRequest
+	67, // 93: tdf_object.v1.TdfObjectService.RevertTdfObject:input_type -> tdf_object.v1.RevertTdfObjectRequest
+	11, // 94: tdf_object.v1.TdfObjectService.CreateTdfObject:output_type -> tdf_object.v1.CreateTdfObjectResponse
+	13, // 95: tdf_object.v1.TdfObjectService.UpdateTdfObject:output_type -> tdf_object.v1.UpdateTdfObjectResponse
+	15, // 96: tdf_object.v1.TdfObjectService.GetTdfObject:output_type -> tdf_object.v1.GetTdfObjectResponse
+	17, // 97: tdf_object.v1.TdfObjectService.QueryTdfObjects:output_type -> tdf_object.v1.QueryTdfObjectsResponse
+	19, // 98: tdf_object.v1.TdfObjectService.GetLatestPositions:output_type -> tdf_object.v1.GetLatestPositionsResponse
+	21, // 99: tdf_object.v1.TdfObjectService.GetTrack:output_type -> tdf_object.v1.GetTrackResponse
+	23, // 100: tdf_object.v1.TdfObjectService.StreamTdfObjects:output_type -> tdf_object.v1.StreamTdfObjectsResponse
+	72, // 101: tdf_object.v1.TdfObjectService.GetSrcType:output_type -> tdf_object.v1.GetSrcTypeResponse
+	70, // 102: tdf_object.v1.TdfObjectService.ListSrcTypes:output_type -> tdf_object.v1.ListSrcTypesResponse
+	74, // 103: tdf_object.v1.TdfObjectService.GetEntitlements:output_type -> tdf_object.v1.GetEntitlementsResponse
+	26, // 104: tdf_object.v1.TdfObjectService.CreateGeofence:output_type -> tdf_object.v1.CreateGeofenceResponse
+	28, // 105: tdf_object.v1.TdfObjectService.UpdateGeofence:output_type -> tdf_object.v1.UpdateGeofenceResponse
+	30, // 106: tdf_object.v1.TdfObjectService.GetGeofence:output_type -> tdf_object.v1.GetGeofenceResponse
+	32, // 107: tdf_object.v1.TdfObjectService.ListGeofences:output_type -> tdf_object.v1.ListGeofencesResponse
+	34, // 108: tdf_object.v1.TdfObjectService.DeleteGeofence:output_type -> tdf_object.v1.DeleteGeofenceResponse
+	37, // 109: tdf_object.v1.TdfObjectService.StreamGeofenceEvents:output_type -> tdf_object.v1.StreamGeofenceEventsResponse
+	40, // 110: tdf_object.v1.TdfObjectService.CreateWebhook:output_type -> tdf_object.v1.CreateWebhookResponse
+	42, // 111: tdf_object.v1.TdfObjectService.UpdateWebhook:output_type -> tdf_object.v1.UpdateWebhookResponse
+	44, // 112: tdf_object.v1.TdfObjectService.GetWebhook:output_type -> tdf_object.v1.GetWebhookResponse
+	46, // 113: tdf_object.v1.TdfObjectService.ListWebhooks:output_type -> tdf_object.v1.ListWebhooksResponse
+	48, // 114: tdf_object.v1.TdfObjectService.DeleteWebhook:output_type -> tdf_object.v1.DeleteWebhookResponse
+	51, // 115: tdf_object.v1.TdfObjectService.ListWebhookDeadLetters:output_type -> tdf_object.v1.ListWebhookDeadLettersResponse
+	54, // 116: tdf_object.v1.TdfObjectService.UploadTdfBlob:output_type -> tdf_object.v1.UploadTdfBlobResponse
+	56, // 117: tdf_object.v1.TdfObjectService.DownloadTdfBlob:output_type -> tdf_object.v1.DownloadTdfBlobResponse
+	59, // 118: tdf_object.v1.TdfObjectService.CreateTdfAttachment:output_type -> tdf_object.v1.CreateTdfAttachmentResponse
+	61, // 119: tdf_object.v1.TdfObjectService.ListTdfAttachments:output_type -> tdf_object.v1.ListTdfAttachmentsResponse
+	63, // 120: tdf_object.v1.TdfObjectService.GetTdfAttachment:output_type -> tdf_object.v1.GetTdfAttachmentResponse
+	66, // 121: tdf_object.v1.TdfObjectService.ListTdfObjectRevisions:output_type -> tdf_object.v1.ListTdfObjectRevisionsResponse
+	68, // 122: tdf_object.v1.TdfObjectService.RevertTdfObject:output_type -> tdf_object.v1.RevertTdfObjectResponse
+	94, // [94:123] is the sub-list for method output_type
+	65, // [65:94] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
+}
+
+func init() { file_proto_tdf_object_v1_tdf_object_proto_init() }
+func file_proto_tdf_object_v1_tdf_object_proto_init() {
+	if File_proto_tdf_object_v1_tdf_object_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TdfObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrcTypeUiSchemaFieldConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrcTypeUiSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrcTypeMetadataDisplayFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrcTypeMetadataMapFieldConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrcTypeMetadataMapFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrcTypeMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrcType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimestampSelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTdfObjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTdfObjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTdfObjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTdfObjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTdfObjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTdfObjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTdfObjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTdfObjectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLatestPositionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLatestPositionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamTdfObjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamTdfObjectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Geofence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGeofenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGeofenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGeofenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGeofenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGeofenceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2056,8 +6203,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SrcTypeUiSchemaFieldConfig); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGeofenceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2068,8 +6215,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SrcTypeUiSchema); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGeofencesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2080,8 +6227,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SrcTypeMetadataDisplayFields); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGeofencesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2092,8 +6239,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SrcTypeMetadataMapFieldConfig); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGeofenceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2104,8 +6251,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SrcTypeMetadataMapFields); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGeofenceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2116,8 +6263,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SrcTypeMetadata); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeofenceEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2128,8 +6275,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SrcType); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamGeofenceEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2140,8 +6287,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimestampSelector); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamGeofenceEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2152,8 +6299,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTdfObjectRequest); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2164,8 +6311,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTdfObjectResponse); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2176,8 +6323,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTdfObjectRequest); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2188,8 +6335,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTdfObjectResponse); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2200,8 +6347,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTdfObjectRequest); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWebhookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2212,8 +6359,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTdfObjectResponse); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2224,8 +6371,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTdfObjectsRequest); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebhookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2236,8 +6383,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTdfObjectsResponse); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2248,8 +6395,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamTdfObjectsRequest); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2260,8 +6407,8 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamTdfObjectsResponse); i {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2272,7 +6419,259 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadTdfBlobStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadTdfBlobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadTdfBlobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadTdfBlobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadTdfBlobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TdfAttachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTdfAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTdfAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTdfAttachmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTdfAttachmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTdfAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTdfAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TdfObjectRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTdfObjectRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTdfObjectRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertTdfObjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertTdfObjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSrcTypesRequest); i {
 			case 0:
 				return &v.state
@@ -2284,7 +6683,7 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSrcTypesResponse); i {
 			case 0:
 				return &v.state
@@ -2296,7 +6695,7 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSrcTypeRequest); i {
 			case 0:
 				return &v.state
@@ -2308,7 +6707,7 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSrcTypeResponse); i {
 			case 0:
 				return &v.state
@@ -2320,7 +6719,7 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEntitlementsRequest); i {
 			case 0:
 				return &v.state
@@ -2332,7 +6731,7 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tdf_object_v1_tdf_object_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEntitlementsResponse); i {
 			case 0:
 				return &v.state
@@ -2351,7 +6750,7 @@ func file_proto_tdf_object_v1_tdf_object_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_tdf_object_v1_tdf_object_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   28,
+			NumMessages:   77,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -45,6 +45,12 @@ const (
 	// TdfObjectServiceQueryTdfObjectsProcedure is the fully-qualified name of the TdfObjectService's
 	// QueryTdfObjects RPC.
 	TdfObjectServiceQueryTdfObjectsProcedure = "/tdf_object.v1.TdfObjectService/QueryTdfObjects"
+	// TdfObjectServiceGetLatestPositionsProcedure is the fully-qualified name of the TdfObjectService's
+	// GetLatestPositions RPC.
+	TdfObjectServiceGetLatestPositionsProcedure = "/tdf_object.v1.TdfObjectService/GetLatestPositions"
+	// TdfObjectServiceGetTrackProcedure is the fully-qualified name of the TdfObjectService's GetTrack
+	// RPC.
+	TdfObjectServiceGetTrackProcedure = "/tdf_object.v1.TdfObjectService/GetTrack"
 	// TdfObjectServiceStreamTdfObjectsProcedure is the fully-qualified name of the TdfObjectService's
 	// StreamTdfObjects RPC.
 	TdfObjectServiceStreamTdfObjectsProcedure = "/tdf_object.v1.TdfObjectService/StreamTdfObjects"
@@ -57,19 +63,97 @@ const (
 	// TdfObjectServiceGetEntitlementsProcedure is the fully-qualified name of the TdfObjectService's
 	// GetEntitlements RPC.
 	TdfObjectServiceGetEntitlementsProcedure = "/tdf_object.v1.TdfObjectService/GetEntitlements"
+	// TdfObjectServiceCreateGeofenceProcedure is the fully-qualified name of the TdfObjectService's
+	// CreateGeofence RPC.
+	TdfObjectServiceCreateGeofenceProcedure = "/tdf_object.v1.TdfObjectService/CreateGeofence"
+	// TdfObjectServiceUpdateGeofenceProcedure is the fully-qualified name of the TdfObjectService's
+	// UpdateGeofence RPC.
+	TdfObjectServiceUpdateGeofenceProcedure = "/tdf_object.v1.TdfObjectService/UpdateGeofence"
+	// TdfObjectServiceGetGeofenceProcedure is the fully-qualified name of the TdfObjectService's
+	// GetGeofence RPC.
+	TdfObjectServiceGetGeofenceProcedure = "/tdf_object.v1.TdfObjectService/GetGeofence"
+	// TdfObjectServiceListGeofencesProcedure is the fully-qualified name of the TdfObjectService's
+	// ListGeofences RPC.
+	TdfObjectServiceListGeofencesProcedure = "/tdf_object.v1.TdfObjectService/ListGeofences"
+	// TdfObjectServiceDeleteGeofenceProcedure is the fully-qualified name of the TdfObjectService's
+	// DeleteGeofence RPC.
+	TdfObjectServiceDeleteGeofenceProcedure = "/tdf_object.v1.TdfObjectService/DeleteGeofence"
+	// TdfObjectServiceStreamGeofenceEventsProcedure is the fully-qualified name of the
+	// TdfObjectService's StreamGeofenceEvents RPC.
+	TdfObjectServiceStreamGeofenceEventsProcedure = "/tdf_object.v1.TdfObjectService/StreamGeofenceEvents"
+	// TdfObjectServiceCreateWebhookProcedure is the fully-qualified name of the TdfObjectService's
+	// CreateWebhook RPC.
+	TdfObjectServiceCreateWebhookProcedure = "/tdf_object.v1.TdfObjectService/CreateWebhook"
+	// TdfObjectServiceUpdateWebhookProcedure is the fully-qualified name of the TdfObjectService's
+	// UpdateWebhook RPC.
+	TdfObjectServiceUpdateWebhookProcedure = "/tdf_object.v1.TdfObjectService/UpdateWebhook"
+	// TdfObjectServiceGetWebhookProcedure is the fully-qualified name of the TdfObjectService's
+	// GetWebhook RPC.
+	TdfObjectServiceGetWebhookProcedure = "/tdf_object.v1.TdfObjectService/GetWebhook"
+	// TdfObjectServiceListWebhooksProcedure is the fully-qualified name of the TdfObjectService's
+	// ListWebhooks RPC.
+	TdfObjectServiceListWebhooksProcedure = "/tdf_object.v1.TdfObjectService/ListWebhooks"
+	// TdfObjectServiceDeleteWebhookProcedure is the fully-qualified name of the TdfObjectService's
+	// DeleteWebhook RPC.
+	TdfObjectServiceDeleteWebhookProcedure = "/tdf_object.v1.TdfObjectService/DeleteWebhook"
+	// TdfObjectServiceListWebhookDeadLettersProcedure is the fully-qualified name of the
+	// TdfObjectService's ListWebhookDeadLetters RPC.
+	TdfObjectServiceListWebhookDeadLettersProcedure = "/tdf_object.v1.TdfObjectService/ListWebhookDeadLetters"
+	// TdfObjectServiceUploadTdfBlobProcedure is the fully-qualified name of the TdfObjectService's
+	// UploadTdfBlob RPC.
+	TdfObjectServiceUploadTdfBlobProcedure = "/tdf_object.v1.TdfObjectService/UploadTdfBlob"
+	// TdfObjectServiceDownloadTdfBlobProcedure is the fully-qualified name of the TdfObjectService's
+	// DownloadTdfBlob RPC.
+	TdfObjectServiceDownloadTdfBlobProcedure = "/tdf_object.v1.TdfObjectService/DownloadTdfBlob"
+	// TdfObjectServiceCreateTdfAttachmentProcedure is the fully-qualified name of the
+	// TdfObjectService's CreateTdfAttachment RPC.
+	TdfObjectServiceCreateTdfAttachmentProcedure = "/tdf_object.v1.TdfObjectService/CreateTdfAttachment"
+	// TdfObjectServiceListTdfAttachmentsProcedure is the fully-qualified name of the TdfObjectService's
+	// ListTdfAttachments RPC.
+	TdfObjectServiceListTdfAttachmentsProcedure = "/tdf_object.v1.TdfObjectService/ListTdfAttachments"
+	// TdfObjectServiceGetTdfAttachmentProcedure is the fully-qualified name of the TdfObjectService's
+	// GetTdfAttachment RPC.
+	TdfObjectServiceGetTdfAttachmentProcedure = "/tdf_object.v1.TdfObjectService/GetTdfAttachment"
+	// TdfObjectServiceListTdfObjectRevisionsProcedure is the fully-qualified name of the
+	// TdfObjectService's ListTdfObjectRevisions RPC.
+	TdfObjectServiceListTdfObjectRevisionsProcedure = "/tdf_object.v1.TdfObjectService/ListTdfObjectRevisions"
+	// TdfObjectServiceRevertTdfObjectProcedure is the fully-qualified name of the TdfObjectService's
+	// RevertTdfObject RPC.
+	TdfObjectServiceRevertTdfObjectProcedure = "/tdf_object.v1.TdfObjectService/RevertTdfObject"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	tdfObjectServiceServiceDescriptor                = v1.File_proto_tdf_object_v1_tdf_object_proto.Services().ByName("TdfObjectService")
-	tdfObjectServiceCreateTdfObjectMethodDescriptor  = tdfObjectServiceServiceDescriptor.Methods().ByName("CreateTdfObject")
-	tdfObjectServiceUpdateTdfObjectMethodDescriptor  = tdfObjectServiceServiceDescriptor.Methods().ByName("UpdateTdfObject")
-	tdfObjectServiceGetTdfObjectMethodDescriptor     = tdfObjectServiceServiceDescriptor.Methods().ByName("GetTdfObject")
-	tdfObjectServiceQueryTdfObjectsMethodDescriptor  = tdfObjectServiceServiceDescriptor.Methods().ByName("QueryTdfObjects")
-	tdfObjectServiceStreamTdfObjectsMethodDescriptor = tdfObjectServiceServiceDescriptor.Methods().ByName("StreamTdfObjects")
-	tdfObjectServiceGetSrcTypeMethodDescriptor       = tdfObjectServiceServiceDescriptor.Methods().ByName("GetSrcType")
-	tdfObjectServiceListSrcTypesMethodDescriptor     = tdfObjectServiceServiceDescriptor.Methods().ByName("ListSrcTypes")
-	tdfObjectServiceGetEntitlementsMethodDescriptor  = tdfObjectServiceServiceDescriptor.Methods().ByName("GetEntitlements")
+	tdfObjectServiceServiceDescriptor                      = v1.File_proto_tdf_object_v1_tdf_object_proto.Services().ByName("TdfObjectService")
+	tdfObjectServiceCreateTdfObjectMethodDescriptor        = tdfObjectServiceServiceDescriptor.Methods().ByName("CreateTdfObject")
+	tdfObjectServiceUpdateTdfObjectMethodDescriptor        = tdfObjectServiceServiceDescriptor.Methods().ByName("UpdateTdfObject")
+	tdfObjectServiceGetTdfObjectMethodDescriptor           = tdfObjectServiceServiceDescriptor.Methods().ByName("GetTdfObject")
+	tdfObjectServiceQueryTdfObjectsMethodDescriptor        = tdfObjectServiceServiceDescriptor.Methods().ByName("QueryTdfObjects")
+	tdfObjectServiceGetLatestPositionsMethodDescriptor     = tdfObjectServiceServiceDescriptor.Methods().ByName("GetLatestPositions")
+	tdfObjectServiceGetTrackMethodDescriptor               = tdfObjectServiceServiceDescriptor.Methods().ByName("GetTrack")
+	tdfObjectServiceStreamTdfObjectsMethodDescriptor       = tdfObjectServiceServiceDescriptor.Methods().ByName("StreamTdfObjects")
+	tdfObjectServiceGetSrcTypeMethodDescriptor             = tdfObjectServiceServiceDescriptor.Methods().ByName("GetSrcType")
+	tdfObjectServiceListSrcTypesMethodDescriptor           = tdfObjectServiceServiceDescriptor.Methods().ByName("ListSrcTypes")
+	tdfObjectServiceGetEntitlementsMethodDescriptor        = tdfObjectServiceServiceDescriptor.Methods().ByName("GetEntitlements")
+	tdfObjectServiceCreateGeofenceMethodDescriptor         = tdfObjectServiceServiceDescriptor.Methods().ByName("CreateGeofence")
+	tdfObjectServiceUpdateGeofenceMethodDescriptor         = tdfObjectServiceServiceDescriptor.Methods().ByName("UpdateGeofence")
+	tdfObjectServiceGetGeofenceMethodDescriptor            = tdfObjectServiceServiceDescriptor.Methods().ByName("GetGeofence")
+	tdfObjectServiceListGeofencesMethodDescriptor          = tdfObjectServiceServiceDescriptor.Methods().ByName("ListGeofences")
+	tdfObjectServiceDeleteGeofenceMethodDescriptor         = tdfObjectServiceServiceDescriptor.Methods().ByName("DeleteGeofence")
+	tdfObjectServiceStreamGeofenceEventsMethodDescriptor   = tdfObjectServiceServiceDescriptor.Methods().ByName("StreamGeofenceEvents")
+	tdfObjectServiceCreateWebhookMethodDescriptor          = tdfObjectServiceServiceDescriptor.Methods().ByName("CreateWebhook")
+	tdfObjectServiceUpdateWebhookMethodDescriptor          = tdfObjectServiceServiceDescriptor.Methods().ByName("UpdateWebhook")
+	tdfObjectServiceGetWebhookMethodDescriptor             = tdfObjectServiceServiceDescriptor.Methods().ByName("GetWebhook")
+	tdfObjectServiceListWebhooksMethodDescriptor           = tdfObjectServiceServiceDescriptor.Methods().ByName("ListWebhooks")
+	tdfObjectServiceDeleteWebhookMethodDescriptor          = tdfObjectServiceServiceDescriptor.Methods().ByName("DeleteWebhook")
+	tdfObjectServiceListWebhookDeadLettersMethodDescriptor = tdfObjectServiceServiceDescriptor.Methods().ByName("ListWebhookDeadLetters")
+	tdfObjectServiceUploadTdfBlobMethodDescriptor          = tdfObjectServiceServiceDescriptor.Methods().ByName("UploadTdfBlob")
+	tdfObjectServiceDownloadTdfBlobMethodDescriptor        = tdfObjectServiceServiceDescriptor.Methods().ByName("DownloadTdfBlob")
+	tdfObjectServiceCreateTdfAttachmentMethodDescriptor    = tdfObjectServiceServiceDescriptor.Methods().ByName("CreateTdfAttachment")
+	tdfObjectServiceListTdfAttachmentsMethodDescriptor     = tdfObjectServiceServiceDescriptor.Methods().ByName("ListTdfAttachments")
+	tdfObjectServiceGetTdfAttachmentMethodDescriptor       = tdfObjectServiceServiceDescriptor.Methods().ByName("GetTdfAttachment")
+	tdfObjectServiceListTdfObjectRevisionsMethodDescriptor = tdfObjectServiceServiceDescriptor.Methods().ByName("ListTdfObjectRevisions")
+	tdfObjectServiceRevertTdfObjectMethodDescriptor        = tdfObjectServiceServiceDescriptor.Methods().ByName("RevertTdfObject")
 )
 
 // TdfObjectServiceClient is a client for the tdf_object.v1.TdfObjectService service.
@@ -78,10 +162,34 @@ type TdfObjectServiceClient interface {
 	UpdateTdfObject(context.Context, *connect.Request[v1.UpdateTdfObjectRequest]) (*connect.Response[v1.UpdateTdfObjectResponse], error)
 	GetTdfObject(context.Context, *connect.Request[v1.GetTdfObjectRequest]) (*connect.Response[v1.GetTdfObjectResponse], error)
 	QueryTdfObjects(context.Context, *connect.Request[v1.QueryTdfObjectsRequest]) (*connect.Response[v1.QueryTdfObjectsResponse], error)
+	GetLatestPositions(context.Context, *connect.Request[v1.GetLatestPositionsRequest]) (*connect.Response[v1.GetLatestPositionsResponse], error)
+	GetTrack(context.Context, *connect.Request[v1.GetTrackRequest]) (*connect.Response[v1.GetTrackResponse], error)
 	StreamTdfObjects(context.Context, *connect.Request[v1.StreamTdfObjectsRequest]) (*connect.ServerStreamForClient[v1.StreamTdfObjectsResponse], error)
 	GetSrcType(context.Context, *connect.Request[v1.GetSrcTypeRequest]) (*connect.Response[v1.GetSrcTypeResponse], error)
 	ListSrcTypes(context.Context, *connect.Request[v1.ListSrcTypesRequest]) (*connect.Response[v1.ListSrcTypesResponse], error)
 	GetEntitlements(context.Context, *connect.Request[v1.GetEntitlementsRequest]) (*connect.Response[v1.GetEntitlementsResponse], error)
+	CreateGeofence(context.Context, *connect.Request[v1.CreateGeofenceRequest]) (*connect.Response[v1.CreateGeofenceResponse], error)
+	UpdateGeofence(context.Context, *connect.Request[v1.UpdateGeofenceRequest]) (*connect.Response[v1.UpdateGeofenceResponse], error)
+	GetGeofence(context.Context, *connect.Request[v1.GetGeofenceRequest]) (*connect.Response[v1.GetGeofenceResponse], error)
+	ListGeofences(context.Context, *connect.Request[v1.ListGeofencesRequest]) (*connect.Response[v1.ListGeofencesResponse], error)
+	DeleteGeofence(context.Context, *connect.Request[v1.DeleteGeofenceRequest]) (*connect.Response[v1.DeleteGeofenceResponse], error)
+	StreamGeofenceEvents(context.Context, *connect.Request[v1.StreamGeofenceEventsRequest]) (*connect.ServerStreamForClient[v1.StreamGeofenceEventsResponse], error)
+	CreateWebhook(context.Context, *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error)
+	UpdateWebhook(context.Context, *connect.Request[v1.UpdateWebhookRequest]) (*connect.Response[v1.UpdateWebhookResponse], error)
+	GetWebhook(context.Context, *connect.Request[v1.GetWebhookRequest]) (*connect.Response[v1.GetWebhookResponse], error)
+	ListWebhooks(context.Context, *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error)
+	DeleteWebhook(context.Context, *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error)
+	ListWebhookDeadLetters(context.Context, *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error)
+	// uploads a TDF blob in chunks, resumable from the offset of the upload, and attaches it to a new or existing
+	// tdf_object once complete
+	UploadTdfBlob(context.Context) *connect.ClientStreamForClient[v1.UploadTdfBlobRequest, v1.UploadTdfBlobResponse]
+	// downloads the TDF blob of a tdf_object in chunks from an offset
+	DownloadTdfBlob(context.Context, *connect.Request[v1.DownloadTdfBlobRequest]) (*connect.ServerStreamForClient[v1.DownloadTdfBlobResponse], error)
+	CreateTdfAttachment(context.Context, *connect.Request[v1.CreateTdfAttachmentRequest]) (*connect.Response[v1.CreateTdfAttachmentResponse], error)
+	ListTdfAttachments(context.Context, *connect.Request[v1.ListTdfAttachmentsRequest]) (*connect.Response[v1.ListTdfAttachmentsResponse], error)
+	GetTdfAttachment(context.Context, *connect.Request[v1.GetTdfAttachmentRequest]) (*connect.Response[v1.GetTdfAttachmentResponse], error)
+	ListTdfObjectRevisions(context.Context, *connect.Request[v1.ListTdfObjectRevisionsRequest]) (*connect.Response[v1.ListTdfObjectRevisionsResponse], error)
+	RevertTdfObject(context.Context, *connect.Request[v1.RevertTdfObjectRequest]) (*connect.Response[v1.RevertTdfObjectResponse], error)
 }
 
 // NewTdfObjectServiceClient constructs a client for the tdf_object.v1.TdfObjectService service. By
@@ -118,6 +226,18 @@ func NewTdfObjectServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(tdfObjectServiceQueryTdfObjectsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getLatestPositions: connect.NewClient[v1.GetLatestPositionsRequest, v1.GetLatestPositionsResponse](
+			httpClient,
+			baseURL+TdfObjectServiceGetLatestPositionsProcedure,
+			connect.WithSchema(tdfObjectServiceGetLatestPositionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getTrack: connect.NewClient[v1.GetTrackRequest, v1.GetTrackResponse](
+			httpClient,
+			baseURL+TdfObjectServiceGetTrackProcedure,
+			connect.WithSchema(tdfObjectServiceGetTrackMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		streamTdfObjects: connect.NewClient[v1.StreamTdfObjectsRequest, v1.StreamTdfObjectsResponse](
 			httpClient,
 			baseURL+TdfObjectServiceStreamTdfObjectsProcedure,
@@ -142,19 +262,154 @@ func NewTdfObjectServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(tdfObjectServiceGetEntitlementsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		createGeofence: connect.NewClient[v1.CreateGeofenceRequest, v1.CreateGeofenceResponse](
+			httpClient,
+			baseURL+TdfObjectServiceCreateGeofenceProcedure,
+			connect.WithSchema(tdfObjectServiceCreateGeofenceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateGeofence: connect.NewClient[v1.UpdateGeofenceRequest, v1.UpdateGeofenceResponse](
+			httpClient,
+			baseURL+TdfObjectServiceUpdateGeofenceProcedure,
+			connect.WithSchema(tdfObjectServiceUpdateGeofenceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getGeofence: connect.NewClient[v1.GetGeofenceRequest, v1.GetGeofenceResponse](
+			httpClient,
+			baseURL+TdfObjectServiceGetGeofenceProcedure,
+			connect.WithSchema(tdfObjectServiceGetGeofenceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listGeofences: connect.NewClient[v1.ListGeofencesRequest, v1.ListGeofencesResponse](
+			httpClient,
+			baseURL+TdfObjectServiceListGeofencesProcedure,
+			connect.WithSchema(tdfObjectServiceListGeofencesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteGeofence: connect.NewClient[v1.DeleteGeofenceRequest, v1.DeleteGeofenceResponse](
+			httpClient,
+			baseURL+TdfObjectServiceDeleteGeofenceProcedure,
+			connect.WithSchema(tdfObjectServiceDeleteGeofenceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		streamGeofenceEvents: connect.NewClient[v1.StreamGeofenceEventsRequest, v1.StreamGeofenceEventsResponse](
+			httpClient,
+			baseURL+TdfObjectServiceStreamGeofenceEventsProcedure,
+			connect.WithSchema(tdfObjectServiceStreamGeofenceEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createWebhook: connect.NewClient[v1.CreateWebhookRequest, v1.CreateWebhookResponse](
+			httpClient,
+			baseURL+TdfObjectServiceCreateWebhookProcedure,
+			connect.WithSchema(tdfObjectServiceCreateWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateWebhook: connect.NewClient[v1.UpdateWebhookRequest, v1.UpdateWebhookResponse](
+			httpClient,
+			baseURL+TdfObjectServiceUpdateWebhookProcedure,
+			connect.WithSchema(tdfObjectServiceUpdateWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getWebhook: connect.NewClient[v1.GetWebhookRequest, v1.GetWebhookResponse](
+			httpClient,
+			baseURL+TdfObjectServiceGetWebhookProcedure,
+			connect.WithSchema(tdfObjectServiceGetWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhooks: connect.NewClient[v1.ListWebhooksRequest, v1.ListWebhooksResponse](
+			httpClient,
+			baseURL+TdfObjectServiceListWebhooksProcedure,
+			connect.WithSchema(tdfObjectServiceListWebhooksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhook: connect.NewClient[v1.DeleteWebhookRequest, v1.DeleteWebhookResponse](
+			httpClient,
+			baseURL+TdfObjectServiceDeleteWebhookProcedure,
+			connect.WithSchema(tdfObjectServiceDeleteWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeadLetters: connect.NewClient[v1.ListWebhookDeadLettersRequest, v1.ListWebhookDeadLettersResponse](
+			httpClient,
+			baseURL+TdfObjectServiceListWebhookDeadLettersProcedure,
+			connect.WithSchema(tdfObjectServiceListWebhookDeadLettersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		uploadTdfBlob: connect.NewClient[v1.UploadTdfBlobRequest, v1.UploadTdfBlobResponse](
+			httpClient,
+			baseURL+TdfObjectServiceUploadTdfBlobProcedure,
+			connect.WithSchema(tdfObjectServiceUploadTdfBlobMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		downloadTdfBlob: connect.NewClient[v1.DownloadTdfBlobRequest, v1.DownloadTdfBlobResponse](
+			httpClient,
+			baseURL+TdfObjectServiceDownloadTdfBlobProcedure,
+			connect.WithSchema(tdfObjectServiceDownloadTdfBlobMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createTdfAttachment: connect.NewClient[v1.CreateTdfAttachmentRequest, v1.CreateTdfAttachmentResponse](
+			httpClient,
+			baseURL+TdfObjectServiceCreateTdfAttachmentProcedure,
+			connect.WithSchema(tdfObjectServiceCreateTdfAttachmentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTdfAttachments: connect.NewClient[v1.ListTdfAttachmentsRequest, v1.ListTdfAttachmentsResponse](
+			httpClient,
+			baseURL+TdfObjectServiceListTdfAttachmentsProcedure,
+			connect.WithSchema(tdfObjectServiceListTdfAttachmentsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getTdfAttachment: connect.NewClient[v1.GetTdfAttachmentRequest, v1.GetTdfAttachmentResponse](
+			httpClient,
+			baseURL+TdfObjectServiceGetTdfAttachmentProcedure,
+			connect.WithSchema(tdfObjectServiceGetTdfAttachmentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTdfObjectRevisions: connect.NewClient[v1.ListTdfObjectRevisionsRequest, v1.ListTdfObjectRevisionsResponse](
+			httpClient,
+			baseURL+TdfObjectServiceListTdfObjectRevisionsProcedure,
+			connect.WithSchema(tdfObjectServiceListTdfObjectRevisionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revertTdfObject: connect.NewClient[v1.RevertTdfObjectRequest, v1.RevertTdfObjectResponse](
+			httpClient,
+			baseURL+TdfObjectServiceRevertTdfObjectProcedure,
+			connect.WithSchema(tdfObjectServiceRevertTdfObjectMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // tdfObjectServiceClient implements TdfObjectServiceClient.
 type tdfObjectServiceClient struct {
-	createTdfObject  *connect.Client[v1.CreateTdfObjectRequest, v1.CreateTdfObjectResponse]
-	updateTdfObject  *connect.Client[v1.UpdateTdfObjectRequest, v1.UpdateTdfObjectResponse]
-	getTdfObject     *connect.Client[v1.GetTdfObjectRequest, v1.GetTdfObjectResponse]
-	queryTdfObjects  *connect.Client[v1.QueryTdfObjectsRequest, v1.QueryTdfObjectsResponse]
-	streamTdfObjects *connect.Client[v1.StreamTdfObjectsRequest, v1.StreamTdfObjectsResponse]
-	getSrcType       *connect.Client[v1.GetSrcTypeRequest, v1.GetSrcTypeResponse]
-	listSrcTypes     *connect.Client[v1.ListSrcTypesRequest, v1.ListSrcTypesResponse]
-	getEntitlements  *connect.Client[v1.GetEntitlementsRequest, v1.GetEntitlementsResponse]
+	createTdfObject        *connect.Client[v1.CreateTdfObjectRequest, v1.CreateTdfObjectResponse]
+	updateTdfObject        *connect.Client[v1.UpdateTdfObjectRequest, v1.UpdateTdfObjectResponse]
+	getTdfObject           *connect.Client[v1.GetTdfObjectRequest, v1.GetTdfObjectResponse]
+	queryTdfObjects        *connect.Client[v1.QueryTdfObjectsRequest, v1.QueryTdfObjectsResponse]
+	getLatestPositions     *connect.Client[v1.GetLatestPositionsRequest, v1.GetLatestPositionsResponse]
+	getTrack               *connect.Client[v1.GetTrackRequest, v1.GetTrackResponse]
+	streamTdfObjects       *connect.Client[v1.StreamTdfObjectsRequest, v1.StreamTdfObjectsResponse]
+	getSrcType             *connect.Client[v1.GetSrcTypeRequest, v1.GetSrcTypeResponse]
+	listSrcTypes           *connect.Client[v1.ListSrcTypesRequest, v1.ListSrcTypesResponse]
+	getEntitlements        *connect.Client[v1.GetEntitlementsRequest, v1.GetEntitlementsResponse]
+	createGeofence         *connect.Client[v1.CreateGeofenceRequest, v1.CreateGeofenceResponse]
+	updateGeofence         *connect.Client[v1.UpdateGeofenceRequest, v1.UpdateGeofenceResponse]
+	getGeofence            *connect.Client[v1.GetGeofenceRequest, v1.GetGeofenceResponse]
+	listGeofences          *connect.Client[v1.ListGeofencesRequest, v1.ListGeofencesResponse]
+	deleteGeofence         *connect.Client[v1.DeleteGeofenceRequest, v1.DeleteGeofenceResponse]
+	streamGeofenceEvents   *connect.Client[v1.StreamGeofenceEventsRequest, v1.StreamGeofenceEventsResponse]
+	createWebhook          *connect.Client[v1.CreateWebhookRequest, v1.CreateWebhookResponse]
+	updateWebhook          *connect.Client[v1.UpdateWebhookRequest, v1.UpdateWebhookResponse]
+	getWebhook             *connect.Client[v1.GetWebhookRequest, v1.GetWebhookResponse]
+	listWebhooks           *connect.Client[v1.ListWebhooksRequest, v1.ListWebhooksResponse]
+	deleteWebhook          *connect.Client[v1.DeleteWebhookRequest, v1.DeleteWebhookResponse]
+	listWebhookDeadLetters *connect.Client[v1.ListWebhookDeadLettersRequest, v1.ListWebhookDeadLettersResponse]
+	uploadTdfBlob          *connect.Client[v1.UploadTdfBlobRequest, v1.UploadTdfBlobResponse]
+	downloadTdfBlob        *connect.Client[v1.DownloadTdfBlobRequest, v1.DownloadTdfBlobResponse]
+	createTdfAttachment    *connect.Client[v1.CreateTdfAttachmentRequest, v1.CreateTdfAttachmentResponse]
+	listTdfAttachments     *connect.Client[v1.ListTdfAttachmentsRequest, v1.ListTdfAttachmentsResponse]
+	getTdfAttachment       *connect.Client[v1.GetTdfAttachmentRequest, v1.GetTdfAttachmentResponse]
+	listTdfObjectRevisions *connect.Client[v1.ListTdfObjectRevisionsRequest, v1.ListTdfObjectRevisionsResponse]
+	revertTdfObject        *connect.Client[v1.RevertTdfObjectRequest, v1.RevertTdfObjectResponse]
 }
 
 // CreateTdfObject calls tdf_object.v1.TdfObjectService.CreateTdfObject.
@@ -177,6 +432,16 @@ func (c *tdfObjectServiceClient) QueryTdfObjects(ctx context.Context, req *conne
 	return c.queryTdfObjects.CallUnary(ctx, req)
 }
 
+// GetLatestPositions calls tdf_object.v1.TdfObjectService.GetLatestPositions.
+func (c *tdfObjectServiceClient) GetLatestPositions(ctx context.Context, req *connect.Request[v1.GetLatestPositionsRequest]) (*connect.Response[v1.GetLatestPositionsResponse], error) {
+	return c.getLatestPositions.CallUnary(ctx, req)
+}
+
+// GetTrack calls tdf_object.v1.TdfObjectService.GetTrack.
+func (c *tdfObjectServiceClient) GetTrack(ctx context.Context, req *connect.Request[v1.GetTrackRequest]) (*connect.Response[v1.GetTrackResponse], error) {
+	return c.getTrack.CallUnary(ctx, req)
+}
+
 // StreamTdfObjects calls tdf_object.v1.TdfObjectService.StreamTdfObjects.
 func (c *tdfObjectServiceClient) StreamTdfObjects(ctx context.Context, req *connect.Request[v1.StreamTdfObjectsRequest]) (*connect.ServerStreamForClient[v1.StreamTdfObjectsResponse], error) {
 	return c.streamTdfObjects.CallServerStream(ctx, req)
@@ -197,16 +462,135 @@ func (c *tdfObjectServiceClient) GetEntitlements(ctx context.Context, req *conne
 	return c.getEntitlements.CallUnary(ctx, req)
 }
 
+// CreateGeofence calls tdf_object.v1.TdfObjectService.CreateGeofence.
+func (c *tdfObjectServiceClient) CreateGeofence(ctx context.Context, req *connect.Request[v1.CreateGeofenceRequest]) (*connect.Response[v1.CreateGeofenceResponse], error) {
+	return c.createGeofence.CallUnary(ctx, req)
+}
+
+// UpdateGeofence calls tdf_object.v1.TdfObjectService.UpdateGeofence.
+func (c *tdfObjectServiceClient) UpdateGeofence(ctx context.Context, req *connect.Request[v1.UpdateGeofenceRequest]) (*connect.Response[v1.UpdateGeofenceResponse], error) {
+	return c.updateGeofence.CallUnary(ctx, req)
+}
+
+// GetGeofence calls tdf_object.v1.TdfObjectService.GetGeofence.
+func (c *tdfObjectServiceClient) GetGeofence(ctx context.Context, req *connect.Request[v1.GetGeofenceRequest]) (*connect.Response[v1.GetGeofenceResponse], error) {
+	return c.getGeofence.CallUnary(ctx, req)
+}
+
+// ListGeofences calls tdf_object.v1.TdfObjectService.ListGeofences.
+func (c *tdfObjectServiceClient) ListGeofences(ctx context.Context, req *connect.Request[v1.ListGeofencesRequest]) (*connect.Response[v1.ListGeofencesResponse], error) {
+	return c.listGeofences.CallUnary(ctx, req)
+}
+
+// DeleteGeofence calls tdf_object.v1.TdfObjectService.DeleteGeofence.
+func (c *tdfObjectServiceClient) DeleteGeofence(ctx context.Context, req *connect.Request[v1.DeleteGeofenceRequest]) (*connect.Response[v1.DeleteGeofenceResponse], error) {
+	return c.deleteGeofence.CallUnary(ctx, req)
+}
+
+// StreamGeofenceEvents calls tdf_object.v1.TdfObjectService.StreamGeofenceEvents.
+func (c *tdfObjectServiceClient) StreamGeofenceEvents(ctx context.Context, req *connect.Request[v1.StreamGeofenceEventsRequest]) (*connect.ServerStreamForClient[v1.StreamGeofenceEventsResponse], error) {
+	return c.streamGeofenceEvents.CallServerStream(ctx, req)
+}
+
+// CreateWebhook calls tdf_object.v1.TdfObjectService.CreateWebhook.
+func (c *tdfObjectServiceClient) CreateWebhook(ctx context.Context, req *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error) {
+	return c.createWebhook.CallUnary(ctx, req)
+}
+
+// UpdateWebhook calls tdf_object.v1.TdfObjectService.UpdateWebhook.
+func (c *tdfObjectServiceClient) UpdateWebhook(ctx context.Context, req *connect.Request[v1.UpdateWebhookRequest]) (*connect.Response[v1.UpdateWebhookResponse], error) {
+	return c.updateWebhook.CallUnary(ctx, req)
+}
+
+// GetWebhook calls tdf_object.v1.TdfObjectService.GetWebhook.
+func (c *tdfObjectServiceClient) GetWebhook(ctx context.Context, req *connect.Request[v1.GetWebhookRequest]) (*connect.Response[v1.GetWebhookResponse], error) {
+	return c.getWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhooks calls tdf_object.v1.TdfObjectService.ListWebhooks.
+func (c *tdfObjectServiceClient) ListWebhooks(ctx context.Context, req *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error) {
+	return c.listWebhooks.CallUnary(ctx, req)
+}
+
+// DeleteWebhook calls tdf_object.v1.TdfObjectService.DeleteWebhook.
+func (c *tdfObjectServiceClient) DeleteWebhook(ctx context.Context, req *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error) {
+	return c.deleteWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhookDeadLetters calls tdf_object.v1.TdfObjectService.ListWebhookDeadLetters.
+func (c *tdfObjectServiceClient) ListWebhookDeadLetters(ctx context.Context, req *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error) {
+	return c.listWebhookDeadLetters.CallUnary(ctx, req)
+}
+
+// UploadTdfBlob calls tdf_object.v1.TdfObjectService.UploadTdfBlob.
+func (c *tdfObjectServiceClient) UploadTdfBlob(ctx context.Context) *connect.ClientStreamForClient[v1.UploadTdfBlobRequest, v1.UploadTdfBlobResponse] {
+	return c.uploadTdfBlob.CallClientStream(ctx)
+}
+
+// DownloadTdfBlob calls tdf_object.v1.TdfObjectService.DownloadTdfBlob.
+func (c *tdfObjectServiceClient) DownloadTdfBlob(ctx context.Context, req *connect.Request[v1.DownloadTdfBlobRequest]) (*connect.ServerStreamForClient[v1.DownloadTdfBlobResponse], error) {
+	return c.downloadTdfBlob.CallServerStream(ctx, req)
+}
+
+// CreateTdfAttachment calls tdf_object.v1.TdfObjectService.CreateTdfAttachment.
+func (c *tdfObjectServiceClient) CreateTdfAttachment(ctx context.Context, req *connect.Request[v1.CreateTdfAttachmentRequest]) (*connect.Response[v1.CreateTdfAttachmentResponse], error) {
+	return c.createTdfAttachment.CallUnary(ctx, req)
+}
+
+// ListTdfAttachments calls tdf_object.v1.TdfObjectService.ListTdfAttachments.
+func (c *tdfObjectServiceClient) ListTdfAttachments(ctx context.Context, req *connect.Request[v1.ListTdfAttachmentsRequest]) (*connect.Response[v1.ListTdfAttachmentsResponse], error) {
+	return c.listTdfAttachments.CallUnary(ctx, req)
+}
+
+// GetTdfAttachment calls tdf_object.v1.TdfObjectService.GetTdfAttachment.
+func (c *tdfObjectServiceClient) GetTdfAttachment(ctx context.Context, req *connect.Request[v1.GetTdfAttachmentRequest]) (*connect.Response[v1.GetTdfAttachmentResponse], error) {
+	return c.getTdfAttachment.CallUnary(ctx, req)
+}
+
+// ListTdfObjectRevisions calls tdf_object.v1.TdfObjectService.ListTdfObjectRevisions.
+func (c *tdfObjectServiceClient) ListTdfObjectRevisions(ctx context.Context, req *connect.Request[v1.ListTdfObjectRevisionsRequest]) (*connect.Response[v1.ListTdfObjectRevisionsResponse], error) {
+	return c.listTdfObjectRevisions.CallUnary(ctx, req)
+}
+
+// RevertTdfObject calls tdf_object.v1.TdfObjectService.RevertTdfObject.
+func (c *tdfObjectServiceClient) RevertTdfObject(ctx context.Context, req *connect.Request[v1.RevertTdfObjectRequest]) (*connect.Response[v1.RevertTdfObjectResponse], error) {
+	return c.revertTdfObject.CallUnary(ctx, req)
+}
+
 // TdfObjectServiceHandler is an implementation of the tdf_object.v1.TdfObjectService service.
 type TdfObjectServiceHandler interface {
 	CreateTdfObject(context.Context, *connect.Request[v1.CreateTdfObjectRequest]) (*connect.Response[v1.CreateTdfObjectResponse], error)
 	UpdateTdfObject(context.Context, *connect.Request[v1.UpdateTdfObjectRequest]) (*connect.Response[v1.UpdateTdfObjectResponse], error)
 	GetTdfObject(context.Context, *connect.Request[v1.GetTdfObjectRequest]) (*connect.Response[v1.GetTdfObjectResponse], error)
 	QueryTdfObjects(context.Context, *connect.Request[v1.QueryTdfObjectsRequest]) (*connect.Response[v1.QueryTdfObjectsResponse], error)
+	GetLatestPositions(context.Context, *connect.Request[v1.GetLatestPositionsRequest]) (*connect.Response[v1.GetLatestPositionsResponse], error)
+	GetTrack(context.Context, *connect.Request[v1.GetTrackRequest]) (*connect.Response[v1.GetTrackResponse], error)
 	StreamTdfObjects(context.Context, *connect.Request[v1.StreamTdfObjectsRequest], *connect.ServerStream[v1.StreamTdfObjectsResponse]) error
 	GetSrcType(context.Context, *connect.Request[v1.GetSrcTypeRequest]) (*connect.Response[v1.GetSrcTypeResponse], error)
 	ListSrcTypes(context.Context, *connect.Request[v1.ListSrcTypesRequest]) (*connect.Response[v1.ListSrcTypesResponse], error)
 	GetEntitlements(context.Context, *connect.Request[v1.GetEntitlementsRequest]) (*connect.Response[v1.GetEntitlementsResponse], error)
+	CreateGeofence(context.Context, *connect.Request[v1.CreateGeofenceRequest]) (*connect.Response[v1.CreateGeofenceResponse], error)
+	UpdateGeofence(context.Context, *connect.Request[v1.UpdateGeofenceRequest]) (*connect.Response[v1.UpdateGeofenceResponse], error)
+	GetGeofence(context.Context, *connect.Request[v1.GetGeofenceRequest]) (*connect.Response[v1.GetGeofenceResponse], error)
+	ListGeofences(context.Context, *connect.Request[v1.ListGeofencesRequest]) (*connect.Response[v1.ListGeofencesResponse], error)
+	DeleteGeofence(context.Context, *connect.Request[v1.DeleteGeofenceRequest]) (*connect.Response[v1.DeleteGeofenceResponse], error)
+	StreamGeofenceEvents(context.Context, *connect.Request[v1.StreamGeofenceEventsRequest], *connect.ServerStream[v1.StreamGeofenceEventsResponse]) error
+	CreateWebhook(context.Context, *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error)
+	UpdateWebhook(context.Context, *connect.Request[v1.UpdateWebhookRequest]) (*connect.Response[v1.UpdateWebhookResponse], error)
+	GetWebhook(context.Context, *connect.Request[v1.GetWebhookRequest]) (*connect.Response[v1.GetWebhookResponse], error)
+	ListWebhooks(context.Context, *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error)
+	DeleteWebhook(context.Context, *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error)
+	ListWebhookDeadLetters(context.Context, *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error)
+	// uploads a TDF blob in chunks, resumable from the offset of the upload, and attaches it to a new or existing
+	// tdf_object once complete
+	UploadTdfBlob(context.Context, *connect.ClientStream[v1.UploadTdfBlobRequest]) (*connect.Response[v1.UploadTdfBlobResponse], error)
+	// downloads the TDF blob of a tdf_object in chunks from an offset
+	DownloadTdfBlob(context.Context, *connect.Request[v1.DownloadTdfBlobRequest], *connect.ServerStream[v1.DownloadTdfBlobResponse]) error
+	CreateTdfAttachment(context.Context, *connect.Request[v1.CreateTdfAttachmentRequest]) (*connect.Response[v1.CreateTdfAttachmentResponse], error)
+	ListTdfAttachments(context.Context, *connect.Request[v1.ListTdfAttachmentsRequest]) (*connect.Response[v1.ListTdfAttachmentsResponse], error)
+	GetTdfAttachment(context.Context, *connect.Request[v1.GetTdfAttachmentRequest]) (*connect.Response[v1.GetTdfAttachmentResponse], error)
+	ListTdfObjectRevisions(context.Context, *connect.Request[v1.ListTdfObjectRevisionsRequest]) (*connect.Response[v1.ListTdfObjectRevisionsResponse], error)
+	RevertTdfObject(context.Context, *connect.Request[v1.RevertTdfObjectRequest]) (*connect.Response[v1.RevertTdfObjectResponse], error)
 }
 
 // NewTdfObjectServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -239,6 +623,18 @@ func NewTdfObjectServiceHandler(svc TdfObjectServiceHandler, opts ...connect.Han
 		connect.WithSchema(tdfObjectServiceQueryTdfObjectsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	tdfObjectServiceGetLatestPositionsHandler := connect.NewUnaryHandler(
+		TdfObjectServiceGetLatestPositionsProcedure,
+		svc.GetLatestPositions,
+		connect.WithSchema(tdfObjectServiceGetLatestPositionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceGetTrackHandler := connect.NewUnaryHandler(
+		TdfObjectServiceGetTrackProcedure,
+		svc.GetTrack,
+		connect.WithSchema(tdfObjectServiceGetTrackMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	tdfObjectServiceStreamTdfObjectsHandler := connect.NewServerStreamHandler(
 		TdfObjectServiceStreamTdfObjectsProcedure,
 		svc.StreamTdfObjects,
@@ -263,6 +659,120 @@ func NewTdfObjectServiceHandler(svc TdfObjectServiceHandler, opts ...connect.Han
 		connect.WithSchema(tdfObjectServiceGetEntitlementsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	tdfObjectServiceCreateGeofenceHandler := connect.NewUnaryHandler(
+		TdfObjectServiceCreateGeofenceProcedure,
+		svc.CreateGeofence,
+		connect.WithSchema(tdfObjectServiceCreateGeofenceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceUpdateGeofenceHandler := connect.NewUnaryHandler(
+		TdfObjectServiceUpdateGeofenceProcedure,
+		svc.UpdateGeofence,
+		connect.WithSchema(tdfObjectServiceUpdateGeofenceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceGetGeofenceHandler := connect.NewUnaryHandler(
+		TdfObjectServiceGetGeofenceProcedure,
+		svc.GetGeofence,
+		connect.WithSchema(tdfObjectServiceGetGeofenceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceListGeofencesHandler := connect.NewUnaryHandler(
+		TdfObjectServiceListGeofencesProcedure,
+		svc.ListGeofences,
+		connect.WithSchema(tdfObjectServiceListGeofencesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceDeleteGeofenceHandler := connect.NewUnaryHandler(
+		TdfObjectServiceDeleteGeofenceProcedure,
+		svc.DeleteGeofence,
+		connect.WithSchema(tdfObjectServiceDeleteGeofenceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceStreamGeofenceEventsHandler := connect.NewServerStreamHandler(
+		TdfObjectServiceStreamGeofenceEventsProcedure,
+		svc.StreamGeofenceEvents,
+		connect.WithSchema(tdfObjectServiceStreamGeofenceEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceCreateWebhookHandler := connect.NewUnaryHandler(
+		TdfObjectServiceCreateWebhookProcedure,
+		svc.CreateWebhook,
+		connect.WithSchema(tdfObjectServiceCreateWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceUpdateWebhookHandler := connect.NewUnaryHandler(
+		TdfObjectServiceUpdateWebhookProcedure,
+		svc.UpdateWebhook,
+		connect.WithSchema(tdfObjectServiceUpdateWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceGetWebhookHandler := connect.NewUnaryHandler(
+		TdfObjectServiceGetWebhookProcedure,
+		svc.GetWebhook,
+		connect.WithSchema(tdfObjectServiceGetWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceListWebhooksHandler := connect.NewUnaryHandler(
+		TdfObjectServiceListWebhooksProcedure,
+		svc.ListWebhooks,
+		connect.WithSchema(tdfObjectServiceListWebhooksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceDeleteWebhookHandler := connect.NewUnaryHandler(
+		TdfObjectServiceDeleteWebhookProcedure,
+		svc.DeleteWebhook,
+		connect.WithSchema(tdfObjectServiceDeleteWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceListWebhookDeadLettersHandler := connect.NewUnaryHandler(
+		TdfObjectServiceListWebhookDeadLettersProcedure,
+		svc.ListWebhookDeadLetters,
+		connect.WithSchema(tdfObjectServiceListWebhookDeadLettersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceUploadTdfBlobHandler := connect.NewClientStreamHandler(
+		TdfObjectServiceUploadTdfBlobProcedure,
+		svc.UploadTdfBlob,
+		connect.WithSchema(tdfObjectServiceUploadTdfBlobMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceDownloadTdfBlobHandler := connect.NewServerStreamHandler(
+		TdfObjectServiceDownloadTdfBlobProcedure,
+		svc.DownloadTdfBlob,
+		connect.WithSchema(tdfObjectServiceDownloadTdfBlobMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceCreateTdfAttachmentHandler := connect.NewUnaryHandler(
+		TdfObjectServiceCreateTdfAttachmentProcedure,
+		svc.CreateTdfAttachment,
+		connect.WithSchema(tdfObjectServiceCreateTdfAttachmentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceListTdfAttachmentsHandler := connect.NewUnaryHandler(
+		TdfObjectServiceListTdfAttachmentsProcedure,
+		svc.ListTdfAttachments,
+		connect.WithSchema(tdfObjectServiceListTdfAttachmentsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceGetTdfAttachmentHandler := connect.NewUnaryHandler(
+		TdfObjectServiceGetTdfAttachmentProcedure,
+		svc.GetTdfAttachment,
+		connect.WithSchema(tdfObjectServiceGetTdfAttachmentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceListTdfObjectRevisionsHandler := connect.NewUnaryHandler(
+		TdfObjectServiceListTdfObjectRevisionsProcedure,
+		svc.ListTdfObjectRevisions,
+		connect.WithSchema(tdfObjectServiceListTdfObjectRevisionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	tdfObjectServiceRevertTdfObjectHandler := connect.NewUnaryHandler(
+		TdfObjectServiceRevertTdfObjectProcedure,
+		svc.RevertTdfObject,
+		connect.WithSchema(tdfObjectServiceRevertTdfObjectMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/tdf_object.v1.TdfObjectService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TdfObjectServiceCreateTdfObjectProcedure:
@@ -273,6 +783,10 @@ func NewTdfObjectServiceHandler(svc TdfObjectServiceHandler, opts ...connect.Han
 			tdfObjectServiceGetTdfObjectHandler.ServeHTTP(w, r)
 		case TdfObjectServiceQueryTdfObjectsProcedure:
 			tdfObjectServiceQueryTdfObjectsHandler.ServeHTTP(w, r)
+		case TdfObjectServiceGetLatestPositionsProcedure:
+			tdfObjectServiceGetLatestPositionsHandler.ServeHTTP(w, r)
+		case TdfObjectServiceGetTrackProcedure:
+			tdfObjectServiceGetTrackHandler.ServeHTTP(w, r)
 		case TdfObjectServiceStreamTdfObjectsProcedure:
 			tdfObjectServiceStreamTdfObjectsHandler.ServeHTTP(w, r)
 		case TdfObjectServiceGetSrcTypeProcedure:
@@ -281,6 +795,44 @@ func NewTdfObjectServiceHandler(svc TdfObjectServiceHandler, opts ...connect.Han
 			tdfObjectServiceListSrcTypesHandler.ServeHTTP(w, r)
 		case TdfObjectServiceGetEntitlementsProcedure:
 			tdfObjectServiceGetEntitlementsHandler.ServeHTTP(w, r)
+		case TdfObjectServiceCreateGeofenceProcedure:
+			tdfObjectServiceCreateGeofenceHandler.ServeHTTP(w, r)
+		case TdfObjectServiceUpdateGeofenceProcedure:
+			tdfObjectServiceUpdateGeofenceHandler.ServeHTTP(w, r)
+		case TdfObjectServiceGetGeofenceProcedure:
+			tdfObjectServiceGetGeofenceHandler.ServeHTTP(w, r)
+		case TdfObjectServiceListGeofencesProcedure:
+			tdfObjectServiceListGeofencesHandler.ServeHTTP(w, r)
+		case TdfObjectServiceDeleteGeofenceProcedure:
+			tdfObjectServiceDeleteGeofenceHandler.ServeHTTP(w, r)
+		case TdfObjectServiceStreamGeofenceEventsProcedure:
+			tdfObjectServiceStreamGeofenceEventsHandler.ServeHTTP(w, r)
+		case TdfObjectServiceCreateWebhookProcedure:
+			tdfObjectServiceCreateWebhookHandler.ServeHTTP(w, r)
+		case TdfObjectServiceUpdateWebhookProcedure:
+			tdfObjectServiceUpdateWebhookHandler.ServeHTTP(w, r)
+		case TdfObjectServiceGetWebhookProcedure:
+			tdfObjectServiceGetWebhookHandler.ServeHTTP(w, r)
+		case TdfObjectServiceListWebhooksProcedure:
+			tdfObjectServiceListWebhooksHandler.ServeHTTP(w, r)
+		case TdfObjectServiceDeleteWebhookProcedure:
+			tdfObjectServiceDeleteWebhookHandler.ServeHTTP(w, r)
+		case TdfObjectServiceListWebhookDeadLettersProcedure:
+			tdfObjectServiceListWebhookDeadLettersHandler.ServeHTTP(w, r)
+		case TdfObjectServiceUploadTdfBlobProcedure:
+			tdfObjectServiceUploadTdfBlobHandler.ServeHTTP(w, r)
+		case TdfObjectServiceDownloadTdfBlobProcedure:
+			tdfObjectServiceDownloadTdfBlobHandler.ServeHTTP(w, r)
+		case TdfObjectServiceCreateTdfAttachmentProcedure:
+			tdfObjectServiceCreateTdfAttachmentHandler.ServeHTTP(w, r)
+		case TdfObjectServiceListTdfAttachmentsProcedure:
+			tdfObjectServiceListTdfAttachmentsHandler.ServeHTTP(w, r)
+		case TdfObjectServiceGetTdfAttachmentProcedure:
+			tdfObjectServiceGetTdfAttachmentHandler.ServeHTTP(w, r)
+		case TdfObjectServiceListTdfObjectRevisionsProcedure:
+			tdfObjectServiceListTdfObjectRevisionsHandler.ServeHTTP(w, r)
+		case TdfObjectServiceRevertTdfObjectProcedure:
+			tdfObjectServiceRevertTdfObjectHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -306,6 +858,14 @@ func (UnimplementedTdfObjectServiceHandler) QueryTdfObjects(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.QueryTdfObjects is not implemented"))
 }
 
+func (UnimplementedTdfObjectServiceHandler) GetLatestPositions(context.Context, *connect.Request[v1.GetLatestPositionsRequest]) (*connect.Response[v1.GetLatestPositionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.GetLatestPositions is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) GetTrack(context.Context, *connect.Request[v1.GetTrackRequest]) (*connect.Response[v1.GetTrackResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.GetTrack is not implemented"))
+}
+
 func (UnimplementedTdfObjectServiceHandler) StreamTdfObjects(context.Context, *connect.Request[v1.StreamTdfObjectsRequest], *connect.ServerStream[v1.StreamTdfObjectsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.StreamTdfObjects is not implemented"))
 }
@@ -321,3 +881,79 @@ func (UnimplementedTdfObjectServiceHandler) ListSrcTypes(context.Context, *conne
 func (UnimplementedTdfObjectServiceHandler) GetEntitlements(context.Context, *connect.Request[v1.GetEntitlementsRequest]) (*connect.Response[v1.GetEntitlementsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.GetEntitlements is not implemented"))
 }
+
+func (UnimplementedTdfObjectServiceHandler) CreateGeofence(context.Context, *connect.Request[v1.CreateGeofenceRequest]) (*connect.Response[v1.CreateGeofenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.CreateGeofence is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) UpdateGeofence(context.Context, *connect.Request[v1.UpdateGeofenceRequest]) (*connect.Response[v1.UpdateGeofenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.UpdateGeofence is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) GetGeofence(context.Context, *connect.Request[v1.GetGeofenceRequest]) (*connect.Response[v1.GetGeofenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.GetGeofence is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) ListGeofences(context.Context, *connect.Request[v1.ListGeofencesRequest]) (*connect.Response[v1.ListGeofencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.ListGeofences is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) DeleteGeofence(context.Context, *connect.Request[v1.DeleteGeofenceRequest]) (*connect.Response[v1.DeleteGeofenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.DeleteGeofence is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) StreamGeofenceEvents(context.Context, *connect.Request[v1.StreamGeofenceEventsRequest], *connect.ServerStream[v1.StreamGeofenceEventsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.StreamGeofenceEvents is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) CreateWebhook(context.Context, *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.CreateWebhook is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) UpdateWebhook(context.Context, *connect.Request[v1.UpdateWebhookRequest]) (*connect.Response[v1.UpdateWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.UpdateWebhook is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) GetWebhook(context.Context, *connect.Request[v1.GetWebhookRequest]) (*connect.Response[v1.GetWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.GetWebhook is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) ListWebhooks(context.Context, *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.ListWebhooks is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) DeleteWebhook(context.Context, *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.DeleteWebhook is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) ListWebhookDeadLetters(context.Context, *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.ListWebhookDeadLetters is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) UploadTdfBlob(context.Context, *connect.ClientStream[v1.UploadTdfBlobRequest]) (*connect.Response[v1.UploadTdfBlobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.UploadTdfBlob is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) DownloadTdfBlob(context.Context, *connect.Request[v1.DownloadTdfBlobRequest], *connect.ServerStream[v1.DownloadTdfBlobResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.DownloadTdfBlob is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) CreateTdfAttachment(context.Context, *connect.Request[v1.CreateTdfAttachmentRequest]) (*connect.Response[v1.CreateTdfAttachmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.CreateTdfAttachment is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) ListTdfAttachments(context.Context, *connect.Request[v1.ListTdfAttachmentsRequest]) (*connect.Response[v1.ListTdfAttachmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.ListTdfAttachments is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) GetTdfAttachment(context.Context, *connect.Request[v1.GetTdfAttachmentRequest]) (*connect.Response[v1.GetTdfAttachmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.GetTdfAttachment is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) ListTdfObjectRevisions(context.Context, *connect.Request[v1.ListTdfObjectRevisionsRequest]) (*connect.Response[v1.ListTdfObjectRevisionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.ListTdfObjectRevisions is not implemented"))
+}
+
+func (UnimplementedTdfObjectServiceHandler) RevertTdfObject(context.Context, *connect.Request[v1.RevertTdfObjectRequest]) (*connect.Response[v1.RevertTdfObjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tdf_object.v1.TdfObjectService.RevertTdfObject is not implemented"))
+}
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/audit"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Operations of tdf_object_revisions, the kind of change a revision records
+const (
+	revisionOperationUpdate = "update"
+	revisionOperationRevert = "revert"
+)
+
+// ListTdfObjectRevisions returns the past versions of a tdf_object the caller can see, latest first. Each version is
+// filtered by its own search attributes, the classification it had before the change.
+func (s *TdfObjectServer) ListTdfObjectRevisions(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.ListTdfObjectRevisionsRequest],
+) (*connect.Response[tdf_objectv1.ListTdfObjectRevisionsResponse], error) {
+	token := req.Header().Get("Authorization")
+	entitlements, err := s.getEntitlements(token)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err))
+	}
+
+	rows, err := s.DBQueries.ListTdfObjectRevisions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*tdf_objectv1.TdfObjectRevision, 0, len(rows))
+	tdfObjects := make([]*tdf_objectv1.TdfObject, 0, len(rows))
+	var allowed, filtered []auditObject
+	for _, r := range rows {
+		obj := revisionTdfObject(db.GetTdfObjectRevisionRow(r))
+		audited := tdfObjectAudit(obj)
+		visible := filterTdfObjects([]*tdf_objectv1.TdfObject{obj}, entitlements)
+		if len(visible) == 0 {
+			filtered = append(filtered, audited)
+			continue
+		}
+		allowed = append(allowed, audited)
+		revisions = append(revisions, &tdf_objectv1.TdfObjectRevision{
+			Revision:      r.Revision,
+			TdfObject:     visible[0],
+			ChangedFields: r.ChangedFields,
+			ChangedAt:     timestamppb.New(r.ChangedAt.Time),
+			ChangedBy:     r.ChangedBy,
+			Operation:     r.Operation,
+		})
+		tdfObjects = append(tdfObjects, visible[0])
+	}
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionView, audit.DecisionAllowed, allowed...)
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionView, audit.DecisionFiltered, filtered...)
+	s.signTdfUris(ctx, tdfObjects)
+
+	res := connect.NewResponse(&tdf_objectv1.ListTdfObjectRevisionsResponse{
+		Revisions: revisions,
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+// RevertTdfObject restores the version of a tdf_object recorded by a revision. The revert is a change like an update,
+// the replaced version is recorded as a new revision and blobs of earlier versions are kept.
+func (s *TdfObjectServer) RevertTdfObject(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.RevertTdfObjectRequest],
+) (*connect.Response[tdf_objectv1.RevertTdfObjectResponse], error) {
+	token := req.Header().Get("Authorization")
+	entitlements, err := s.getEntitlements(token)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err))
+	}
+
+	// the caller has to see the current version and the one restored, others are not found alike
+	current, err := s.DBQueries.GetTdfObject(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_object %s not found", req.Msg.Id))
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting tdf_object: %w", err))
+	}
+	if len(filterTdfObjects([]*tdf_objectv1.TdfObject{{Search: string(current.Search)}}, entitlements)) == 0 {
+		s.audit(ctx, token, req.Spec().Procedure, audit.ActionRevert, audit.DecisionFiltered,
+			auditObject{id: req.Msg.Id, srcType: current.SrcType, search: string(current.Search)})
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_object %s not found", req.Msg.Id))
+	}
+	r, err := s.DBQueries.GetTdfObjectRevision(ctx, db.GetTdfObjectRevisionParams{TdfObjectID: id, Revision: req.Msg.Revision})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("revision %d of tdf_object %s not found", req.Msg.Revision, req.Msg.Id))
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting revision: %w", err))
+	}
+	restored := tdfObjectAudit(revisionTdfObject(r))
+	if len(filterTdfObjects([]*tdf_objectv1.TdfObject{revisionTdfObject(r)}, entitlements)) == 0 {
+		s.audit(ctx, token, req.Spec().Procedure, audit.ActionRevert, audit.DecisionFiltered, restored)
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("revision %d of tdf_object %s not found", req.Msg.Revision, req.Msg.Id))
+	}
+
+	var revision int32
+	err = s.changeTdfObject(ctx, tokenUser(token), revisionOperationRevert, func(q *db.Queries) error {
+		_, err := q.RevertTdfObject(ctx, db.RevertTdfObjectParams{
+			Ts:       pgtype.Timestamp{Time: time.Now().UTC(), Valid: true},
+			ID:       id,
+			Revision: req.Msg.Revision,
+		})
+		if err != nil {
+			return err
+		}
+		revision, err = q.GetLatestTdfObjectRevision(ctx, id)
+		return err
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// deleted since it was read
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_object %s not found", req.Msg.Id))
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database update failed: %w", err))
+	}
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionRevert, audit.DecisionAllowed, restored)
+
+	res := connect.NewResponse(&tdf_objectv1.RevertTdfObjectResponse{
+		Id:       id.String(),
+		Revision: revision,
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+// changeTdfObject runs fn in a transaction which sets the user and the operation the tdf_objects trigger records in
+// the revisions of the updates of fn
+func (s *TdfObjectServer) changeTdfObject(
+	ctx context.Context,
+	changedBy, operation string,
+	fn func(q *db.Queries) error,
+) error {
+	tx, err := s.DBPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.DBQueries.WithTx(tx)
+	if err := q.SetTdfObjectChange(ctx, db.SetTdfObjectChangeParams{ChangedBy: changedBy, Operation: operation}); err != nil {
+		return err
+	}
+	if err := fn(q); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// revisionTdfObject returns the tdf_object version of a revision
+func revisionTdfObject(r db.GetTdfObjectRevisionRow) *tdf_objectv1.TdfObject {
+	return prepObjForResponse(db.TdfObject{
+		ID:        r.TdfObjectID,
+		Ts:        r.Ts,
+		SrcType:   r.SrcType,
+		Geo:       r.Geo,
+		Search:    r.Search,
+		Metadata:  r.Metadata,
+		TdfBlob:   r.TdfBlob,
+		TdfUri:    r.TdfUri,
+		EntityKey: r.EntityKey,
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"strings"
 	"sync"
 	"syscall"
@@ -25,13 +26,24 @@ import (
 	"github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1/tdf_objectv1connect"
 	"github.com/virtru-corp/dsp-cop/db"
 	activeclients "github.com/virtru-corp/dsp-cop/pkg/activeClients"
+	"github.com/virtru-corp/dsp-cop/pkg/audit"
+	"github.com/virtru-corp/dsp-cop/pkg/blobstore"
 	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"github.com/virtru-corp/dsp-cop/pkg/cot"
+	"github.com/virtru-corp/dsp-cop/pkg/kafka"
+	"github.com/virtru-corp/dsp-cop/pkg/mqtt"
+	"github.com/virtru-corp/dsp-cop/pkg/partitions"
+	"github.com/virtru-corp/dsp-cop/pkg/retention"
+	"github.com/virtru-corp/dsp-cop/pkg/tdf"
 	"github.com/virtru-corp/dsp-cop/pkg/ui"
+	"github.com/virtru-corp/dsp-cop/pkg/uploads"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
 
 const pgNotifyChannel = "tdf_objects_inserted"
+const pgNotifyUpdateChannel = "tdf_objects_updated"
+const pgNotifyDeleteChannel = "tdf_objects_deleted"
 
 var shutdownServer func()
 var EntitlementCacheWeight = int64(1000)
@@ -65,16 +77,6 @@ type CopServer struct {
 }
 
 func NewCopServer(c *config.Config, staticFs fs.FS) *CopServer {
-	// Create cache
-	cache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: 1e7,     // number of keys to track frequency of (10M).
-		MaxCost:     1 << 30, // maximum cost of cache (1GB).
-		BufferItems: 64,      // number of keys per Get buffer.
-	})
-	if err != nil {
-		panic(err)
-	}
-
 	dbCtx := context.Background()
 
 	// Create database connection
@@ -84,10 +86,63 @@ func NewCopServer(c *config.Config, staticFs fs.FS) *CopServer {
 		panic(err)
 	}
 
+	switch c.DBMigrate {
+	case "up":
+		migrations, err := db.MigrateUp(dbCtx, dbPool)
+		if err != nil {
+			slog.ErrorContext(dbCtx, "Error migrating database schema", slog.String("error", err.Error()))
+			panic(err)
+		}
+		for _, m := range migrations {
+			slog.InfoContext(dbCtx, "applied schema migration", slog.Int("version", m.Version), slog.String("name", m.Name))
+		}
+	case "check":
+		if err := db.CheckSchemaVersion(dbCtx, dbPool); err != nil {
+			slog.ErrorContext(dbCtx, "Error checking database schema", slog.String("error", err.Error()))
+			panic(err)
+		}
+	}
+
 	clients := &activeclients.ActiveClients{}
 
+	// Keep large TDF payloads out of tdf_objects
+	blobs, err := blobstore.New(dbCtx, c)
+	if err != nil {
+		slog.ErrorContext(dbCtx, "Error creating blob store", slog.String("error", err.Error()))
+		panic(err)
+	}
+
+	// Keep the partial chunked uploads of the blob store until complete or expired
+	var ups *uploads.Dir
+	if blobs != nil {
+		ups, err = uploads.New(c.Uploads.Dir, c.Uploads.MaxSize)
+		if err != nil {
+			slog.ErrorContext(dbCtx, "Error creating uploads directory", slog.String("error", err.Error()))
+			panic(err)
+		}
+		go ups.Run(dbCtx, c.Uploads.Expiry)
+	}
+
+	// Publish the changes of tdf_objects to Kafka
+	var sink *kafka.Sink
+	if c.Kafka.Sink.Enabled {
+		sink, err = kafka.NewSink(c)
+		if err != nil {
+			slog.ErrorContext(dbCtx, "Error creating Kafka sink", slog.String("error", err.Error()))
+			panic(err)
+		}
+	}
+
+	// Record who accessed which data in the hash chained audit log
+	auditLog, err := audit.New(dbCtx, c, dbPool)
+	if err != nil {
+		slog.ErrorContext(dbCtx, "Error creating audit log", slog.String("error", err.Error()))
+		panic(err)
+	}
+
 	// Create pgx listener
-	listener := connectPgxListener(dbPool, clients, pgNotifyChannel)
+	webhooks := startWebhookDispatcher(dbCtx, c, db.New(dbPool))
+	listener := connectPgxListener(dbPool, clients, webhooks, sink, pgNotifyChannel, pgNotifyUpdateChannel, pgNotifyDeleteChannel)
 	go func() {
 		if err := listener.Listen(dbCtx); err != nil {
 			slog.ErrorContext(dbCtx, "pgx listener error", slog.String("error", err.Error()))
@@ -95,27 +150,60 @@ func NewCopServer(c *config.Config, staticFs fs.FS) *CopServer {
 		}
 	}()
 
+	// Create tdf_objects partitions ahead of time
+	go partitions.Run(dbCtx, db.New(dbPool), c)
+
+	// Purge tdf_objects past their retention
+	go retention.Run(dbCtx, dbPool, c, blobs)
+
 	// Create SDK client
-	sdk, err := initSdk(c)
+	sdk, err := InitSDK(c)
 	if err != nil {
 		slog.Error("failed initialize sdk", slog.String("error", err.Error()))
 		panic(err)
 	}
 
+	// Send new tdf_objects to TAK clients as CoT events
+	for _, o := range c.CoT.Outputs {
+		startCoTOutput(dbCtx, c, o, db.New(dbPool), clients)
+	}
+
+	// Store CoT events of TAK devices
+	if c.CoT.Enabled {
+		go cot.Run(dbCtx, db.New(dbPool), c, tdf.Handler{SDK: sdk, PlatformEndpoint: c.PlatformEndpoint})
+	}
+
+	// Store the payloads of MQTT sensor topics
+	if c.MQTT.Enabled {
+		go mqtt.Run(dbCtx, db.New(dbPool), c, tdf.Handler{SDK: sdk, PlatformEndpoint: c.PlatformEndpoint})
+	}
+
+	// Store the CreateTdfObjectRequests of Kafka topics
+	if c.Kafka.Source.Enabled {
+		go runKafkaSource(dbCtx, c, db.New(dbPool), blobs)
+	}
+
 	shutdownServer = func() {
 		slog.Info("shutting down the server")
 		dbCtx.Done()
 		clients.Shutdown()
+		if sink != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			sink.Close(ctx)
+		}
+		if err := auditLog.Close(); err != nil {
+			slog.Error("error closing audit log", slog.String("error", err.Error()))
+		}
 	}
 
-	tdfServer := &TdfObjectServer{
-		Config:        c,
-		DBQueries:     db.New(dbPool),
-		ActiveClients: clients,
-		SDK:           sdk,
-		// ristretto cache
-		cache: cache,
+	tdfServer, err := NewTdfObjectServer(c, dbPool, sdk, blobs)
+	if err != nil {
+		panic(err)
 	}
+	tdfServer.ActiveClients = clients
+	tdfServer.Uploads = ups
+	tdfServer.Audit = auditLog
 
 	// Inject window variables into index.html
 	mfs, err := ui.InjectWindowVars(c, staticFs)
@@ -137,7 +225,7 @@ func NewCopServer(c *config.Config, staticFs fs.FS) *CopServer {
 		// sdk client
 		SDK: sdk,
 		// ristretto cache
-		cache: cache,
+		cache: tdfServer.cache,
 	}
 }
 
@@ -219,7 +307,7 @@ func createGrpcServer(server *TdfObjectServer) *http.Server {
 	// Register TdfObjectService on gRPC server.
 	path, handler := tdf_objectv1connect.NewTdfObjectServiceHandler(
 		server,
-		connect.WithInterceptors(getInterceptors()...),
+		connect.WithInterceptors(getInterceptors(server.Config)...),
 	)
 	mux.Handle(path, cors.New(cors.Options{
 		AllowedOrigins: []string{server.Config.Service.CORSOrigin},
@@ -227,13 +315,16 @@ func createGrpcServer(server *TdfObjectServer) *http.Server {
 		AllowedHeaders: append(connectcors.AllowedHeaders(), "Authorization"),
 		ExposedHeaders: connectcors.ExposedHeaders(),
 		MaxAge:         7200, // 2 hours in seconds
-	}).Handler(handler))
+	}).Handler(extendDeadlines(handler, server.Config.Uploads.Timeout,
+		tdf_objectv1connect.TdfObjectServiceUploadTdfBlobProcedure,
+		tdf_objectv1connect.TdfObjectServiceDownloadTdfBlobProcedure,
+	)))
 
 	// Register TdfNoteService on gRPC server.
 	// Ensure you're using the correct handler generated for the TdfNoteService
 	pathNote, handlerNote := tdf_notev1connect.NewTdfNoteServiceHandler(
 		server, // your service implementation here
-		connect.WithInterceptors(getInterceptors()...), // apply any interceptors you need
+		connect.WithInterceptors(getInterceptors(server.Config)...), // apply any interceptors you need
 	)
 	mux.Handle(pathNote, cors.New(cors.Options{
 		AllowedOrigins: []string{server.Config.Service.CORSOrigin},
@@ -243,6 +334,43 @@ func createGrpcServer(server *TdfObjectServer) *http.Server {
 		MaxAge:         7200, // 2 hours in seconds
 	}).Handler(handlerNote))
 
+	// Register the GeoJSON endpoint for GIS tools, without a method so CORS preflight requests reach the handler.
+	mux.Handle(GeoJSONPath, cors.New(cors.Options{
+		AllowedOrigins: []string{server.Config.Service.CORSOrigin},
+		AllowedMethods: []string{http.MethodGet},
+		AllowedHeaders: []string{"Authorization"},
+		MaxAge:         7200, // 2 hours in seconds
+	}).Handler(server.geoJSONHandler()))
+
+	// Register the KML feeds for Google Earth style viewers.
+	kmlCors := cors.New(cors.Options{
+		AllowedOrigins: []string{server.Config.Service.CORSOrigin},
+		AllowedMethods: []string{http.MethodGet},
+		AllowedHeaders: []string{"Authorization"},
+		MaxAge:         7200, // 2 hours in seconds
+	})
+	mux.Handle(KMLPath, kmlCors.Handler(server.kmlHandler()))
+	mux.Handle(KMLNetworkLinkPath, kmlCors.Handler(server.kmlNetworkLinkHandler()))
+
+	// Register the OGC API - Features for partner GIS systems.
+	ogcHandler := cors.New(cors.Options{
+		AllowedOrigins: []string{server.Config.Service.CORSOrigin},
+		AllowedMethods: []string{http.MethodGet},
+		AllowedHeaders: []string{"Authorization"},
+		MaxAge:         7200, // 2 hours in seconds
+	}).Handler(server.ogcHandler())
+	mux.Handle(OGCPath, ogcHandler)
+	mux.Handle(OGCPath+"/", ogcHandler)
+
+	// Register the download URLs of the filesystem blob store, authorized by their signature.
+	if fsStore, ok := server.blobStore().(*blobstore.FS); ok {
+		mux.Handle(BlobsPath, cors.New(cors.Options{
+			AllowedOrigins: []string{server.Config.Service.CORSOrigin},
+			AllowedMethods: []string{http.MethodGet},
+			MaxAge:         7200, // 2 hours in seconds
+		}).Handler(http.StripPrefix(strings.TrimSuffix(BlobsPath, "/"), fsStore)))
+	}
+
 	// Return the HTTP server with the mux
 	return &http.Server{
 		Addr:         ":" + server.Config.Service.GrpcPort,
@@ -253,7 +381,26 @@ func createGrpcServer(server *TdfObjectServer) *http.Server {
 	}
 }
 
-func initSdk(c *config.Config) (*sdk.SDK, error) {
+// extendDeadlines lets the calls of long running procedures read and write for timeout instead of the
+// grpc_read_timeout and grpc_write_timeout of the server
+func extendDeadlines(h http.Handler, timeout time.Duration, procedures ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if slices.Contains(procedures, r.URL.Path) {
+			rc := http.NewResponseController(w)
+			deadline := time.Now().Add(timeout)
+			if err := rc.SetReadDeadline(deadline); err != nil {
+				slog.WarnContext(r.Context(), "failed to extend read deadline", slog.String("error", err.Error()))
+			}
+			if err := rc.SetWriteDeadline(deadline); err != nil {
+				slog.WarnContext(r.Context(), "failed to extend write deadline", slog.String("error", err.Error()))
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// InitSDK creates the SDK client of the server's client credentials
+func InitSDK(c *config.Config) (*sdk.SDK, error) {
 	maskedSecret := strings.Repeat("*", len(c.OIDCClientSecretForServer))
 	slog.Info("initalizing SDK client and validating platform and IDP",
 		slog.String("platform_endpoint", c.PlatformEndpoint),
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"github.com/virtru-corp/dsp-cop/pkg/dspClient"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// serviceIdentity is an OIDC client authenticated with client credentials. Its entitlements decide which
+// tdf_objects are sent to a destination outside of a user session, such as a CoT output or a webhook.
+type serviceIdentity struct {
+	platformEndpoint string
+	tokens           oauth2.TokenSource
+
+	// entitlements of the service identity, refreshed after EntitlementCacheTTL
+	lock           sync.Mutex
+	entitlements   dspClient.Entitlements
+	entitlementsAt time.Time
+}
+
+// newServiceIdentity returns the service identity of client credentials, an empty token URL is the token endpoint
+// of the deprecated IdP URL
+func newServiceIdentity(ctx context.Context, c *config.Config, clientID, clientSecret, tokenURL string) *serviceIdentity {
+	if tokenURL == "" {
+		tokenURL = strings.TrimSuffix(c.DeprecatedIdpUrl, "/") + "/protocol/openid-connect/token"
+	}
+	credentials := clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+	}
+	return &serviceIdentity{
+		platformEndpoint: c.PlatformEndpoint,
+		tokens:           credentials.TokenSource(ctx),
+	}
+}
+
+// getEntitlements returns the cached entitlements of the service identity. Callers must fail closed on an error.
+func (s *serviceIdentity) getEntitlements() (dspClient.Entitlements, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.entitlements != nil && time.Since(s.entitlementsAt) < EntitlementCacheTTL {
+		return s.entitlements, nil
+	}
+	token, err := s.tokens.Token()
+	if err != nil {
+		return nil, err
+	}
+	entitlements, err := dspClient.GetEntitlements(s.platformEndpoint+"/shared/entitlements", token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	s.entitlements, s.entitlementsAt = entitlements, time.Now()
+	return entitlements, nil
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
@@ -12,7 +13,9 @@ import (
 	"connectrpc.com/connect"
 	"github.com/dgraph-io/ristretto"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/opentdf/platform/sdk"
 
 	geos "github.com/twpayne/go-geos"
@@ -20,9 +23,13 @@ import (
 	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
 	"github.com/virtru-corp/dsp-cop/db"
 	activeclients "github.com/virtru-corp/dsp-cop/pkg/activeClients"
+	"github.com/virtru-corp/dsp-cop/pkg/audit"
+	"github.com/virtru-corp/dsp-cop/pkg/blobstore"
 	"github.com/virtru-corp/dsp-cop/pkg/config"
 	"github.com/virtru-corp/dsp-cop/pkg/dspClient"
+	"github.com/virtru-corp/dsp-cop/pkg/uploads"
 	"github.com/virtru-corp/dsp-cop/pkg/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type TdfObjectServer struct {
@@ -31,9 +38,42 @@ type TdfObjectServer struct {
 	DBQueries     *db.Queries
 	SDK           *sdk.SDK
 
+	// DBPool runs the transactions of changes of tdf_objects, which record who made them in tdf_object_revisions
+	DBPool *pgxpool.Pool
+
+	// Blobs keeps the TDF payloads above the blob_store threshold, nil keeps every payload inline
+	Blobs *blobstore.Blobs
+
+	// Uploads keeps the partial uploads of UploadTdfBlob, nil without a blob store
+	Uploads *uploads.Dir
+
+	// Audit records who accessed which data, nil records nothing
+	Audit *audit.Logger
+
 	cache *ristretto.Cache
 }
 
+// NewTdfObjectServer returns the TdfObjectServer of a database pool and blob store, caching the entitlements of the
+// tokens of its callers. The ActiveClients, Uploads and Audit of the server are optional.
+func NewTdfObjectServer(c *config.Config, dbPool *pgxpool.Pool, sdk *sdk.SDK, blobs *blobstore.Blobs) (*TdfObjectServer, error) {
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1e7,     // number of keys to track frequency of (10M).
+		MaxCost:     1 << 30, // maximum cost of cache (1GB).
+		BufferItems: 64,      // number of keys per Get buffer.
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create entitlements cache: %w", err)
+	}
+	return &TdfObjectServer{
+		Config:    c,
+		DBQueries: db.New(dbPool),
+		DBPool:    dbPool,
+		SDK:       sdk,
+		Blobs:     blobs,
+		cache:     cache,
+	}, nil
+}
+
 func (s *TdfObjectServer) CreateTdfNote(
 	ctx context.Context,
 	req *connect.Request[tdf_notev1.CreateTdfNoteRequest],
@@ -47,6 +87,11 @@ func (s *TdfObjectServer) CreateTdfNote(
 	COMMENT ON COLUMN tdf_notes.parent_id IS 'foreign key, corresponds to primary key id of tdf_objects entry';
 	COMMENT ON COLUMN tdf_notes.tdf_uri IS 'tdf data uri';
 	*/
+	// a token is checked with the platform before its user is recorded in the audit log
+	if _, err := s.changedBy(req.Header().Get("Authorization")); err != nil {
+		return nil, err
+	}
+
 	var ts pgtype.Timestamp
 	if req.Msg.Ts != nil {
 		ts = pgtype.Timestamp{Time: req.Msg.GetTs().AsTime().UTC(), Valid: true}
@@ -86,6 +131,8 @@ func (s *TdfObjectServer) CreateTdfNote(
 	if respErr != nil {
 		return nil, respErr
 	}
+	s.audit(ctx, req.Header().Get("Authorization"), req.Spec().Procedure, audit.ActionCreate, audit.DecisionAllowed,
+		auditObject{id: newId.String(), search: string(search)})
 
 	res := connect.NewResponse(&tdf_notev1.CreateTdfNoteResponse{
 		Id: newId.String(),
@@ -106,6 +153,11 @@ func (s *TdfObjectServer) UpdateTdfObject(
 		return nil, fmt.Errorf("invalid ID format: %w", err)
 	}
 
+	changedBy, err := s.changedBy(req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
 	// Build query parameters, UUID added at initialization as it is required
 	params := db.UpdateTdfObjectParams{
 		ID: objUUID,
@@ -118,7 +170,7 @@ func (s *TdfObjectServer) UpdateTdfObject(
 		params.Ts = pgtype.Timestamp{Time: time.Now().UTC(), Valid: true}
 	}
 
-	// SrcTpe, Geo, Search, TdfBlob, TdfUri are all optional fields
+	// SrcTpe, Geo, Search, TdfBlob, TdfUri, EntityKey are all optional fields
 	if req.Msg.SrcType != nil {
 		params.SrcType = pgtype.Text{
 			String: strings.ToLower(req.Msg.SrcType.GetValue()),
@@ -151,23 +203,87 @@ func (s *TdfObjectServer) UpdateTdfObject(
 			String: req.Msg.TdfUri.GetValue(),
 			Valid:  true,
 		}
+	} else if req.Msg.TdfBlob != nil {
+		// a new payload replaces the payload of either column, the other one is emptied. Its key is of the src_type
+		// the tdf_object has after the update.
+		srcType := params.SrcType.String
+		if !params.SrcType.Valid {
+			if srcType, err = s.DBQueries.GetTdfObjectSrcType(ctx, objUUID); errors.Is(err, pgx.ErrNoRows) {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_object %s not found", req.Msg.Id))
+			} else if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database query failed: %w", err))
+			}
+		}
+		uri, err := s.Blobs.Offload(ctx, srcType, params.TdfBlob)
+		if err != nil {
+			slog.ErrorContext(ctx, "Error storing blob", slog.String("id", req.Msg.Id), slog.String("error", err.Error()))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("blob store failed: %w", err))
+		}
+		if uri != "" {
+			params.TdfBlob = []byte{}
+		}
+		params.TdfUri = pgtype.Text{String: uri, Valid: true}
+	}
+
+	if req.Msg.EntityKey != nil {
+		params.EntityKey = pgtype.Text{
+			String: req.Msg.EntityKey.GetValue(),
+			Valid:  true,
+		}
+	}
+
+	// ExpectedVersion is optional, without it the update applies to any version
+	if req.Msg.ExpectedVersion != nil {
+		params.ExpectedVersion = pgtype.Int4{
+			Int32: req.Msg.ExpectedVersion.GetValue(),
+			Valid: true,
+		}
 	}
 
 	// Call update function and passing the update parameters
+	// the previous version is recorded as a revision in the same transaction
 	var respErr *connect.Error
-	updatedObject, err := s.DBQueries.UpdateTdfObject(ctx, params)
+	var updatedObject db.UpdateTdfObjectRow
+	var revision int32
+	err = s.changeTdfObject(ctx, changedBy, revisionOperationUpdate, func(q *db.Queries) error {
+		var err error
+		if updatedObject, err = q.UpdateTdfObject(ctx, params); err != nil {
+			return err
+		}
+		revision, err = q.GetLatestTdfObjectRevision(ctx, objUUID)
+		return err
+	})
 
-	if err != nil {
+	if errors.Is(err, pgx.ErrNoRows) {
+		respErr = connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_object %s not found", req.Msg.Id))
+		// no row matches an existing tdf_object when it is at another version than expected
+		if params.ExpectedVersion.Valid {
+			if current, getErr := s.DBQueries.GetTdfObject(ctx, objUUID); getErr == nil {
+				respErr = connect.NewError(connect.CodeAborted, fmt.Errorf("tdf_object %s is at version %d, expected %d",
+					req.Msg.Id, current.Version, params.ExpectedVersion.Int32))
+			}
+		}
+	} else if err != nil {
 		respErr = connect.NewError(connect.CodeInternal, fmt.Errorf("database update failed: %w", err))
 	}
+	if err != nil {
+		if req.Msg.TdfUri == nil {
+			s.Blobs.Discard(ctx, params.TdfUri.String)
+		}
+	}
 
 	if respErr != nil {
 		slog.ErrorContext(ctx, "Error updating record", slog.String("id", req.Msg.Id), slog.String("error", err.Error()))
 		return nil, respErr
 	}
+	// the classification is the one stored by the update, which keeps the search of the tdf_object without one
+	s.audit(ctx, req.Header().Get("Authorization"), req.Spec().Procedure, audit.ActionUpdate, audit.DecisionAllowed,
+		auditObject{id: updatedObject.ID.String(), srcType: updatedObject.SrcType, search: string(updatedObject.Search)})
 
 	res := connect.NewResponse(&tdf_objectv1.UpdateTdfObjectResponse{
-		Id: updatedObject.ID.String(),
+		Id:       updatedObject.ID.String(),
+		Revision: revision,
+		Version:  updatedObject.Version,
 	})
 	res.Header().Set("TdfObject-Version", "v1")
 
@@ -197,44 +313,25 @@ func (s *TdfObjectServer) CreateTdfObject(
 	// encrypted := b.String()
 	// slog.DebugContext(ctx, "Encrypted bytes", slog.String("bytes", encrypted))
 
-	geo, err := geos.NewGeomFromGeoJSON(req.Msg.Geo)
-	if err != nil {
-		return nil, fmt.Errorf("error creating geometry from GeoJSON: %w", err)
-	}
-
-	search := []byte(req.Msg.Search)
-	if len(search) == 0 {
-		// todo: figure out how to use with NULL db type
-		search = []byte("null")
-	}
-
-	metadata := []byte(req.Msg.Metadata)
-	if len(metadata) == 0 {
-		metadata = []byte("{}")
+	// a token is checked with the platform before its user is recorded in the audit log
+	if _, err := s.changedBy(req.Header().Get("Authorization")); err != nil {
+		return nil, err
 	}
 
-	var ts pgtype.Timestamp
-	if req.Msg.Ts != nil {
-		ts = pgtype.Timestamp{Time: req.Msg.GetTs().AsTime().UTC(), Valid: true}
-	} else {
-		ts = pgtype.Timestamp{Time: time.Now().UTC(), Valid: true}
+	params, err := newTdfObjectParams(ctx, s.DBQueries, s.Blobs, req.Msg)
+	if err != nil {
+		return nil, err
 	}
 
 	var newId uuid.UUID
 	var respErr *connect.Error
-	s.DBQueries.CreateTdfObjects(ctx, []db.CreateTdfObjectsParams{
-		{
-			SrcType: strings.ToLower(req.Msg.SrcType),
-			Ts:      ts,
-			Geo:     geo,
-			Search:  search,
-			Metadata: metadata,
-			TdfBlob: req.Msg.TdfBlob,
-		},
-	}).QueryRow(func(i int, id uuid.UUID, err error) {
+	s.DBQueries.CreateTdfObjects(ctx, []db.CreateTdfObjectsParams{params}).QueryRow(func(i int, id uuid.UUID, err error) {
 		if err != nil {
 			slog.ErrorContext(ctx, "Error inserting record", slog.String("error", err.Error()))
 			respErr = db.StatusifyError(err, db.ErrCreateFailure, slog.String("src_type", req.Msg.SrcType))
+			if req.Msg.TdfUri == "" {
+				s.Blobs.Discard(ctx, params.TdfUri.String)
+			}
 		}
 		newId = id
 	})
@@ -242,6 +339,8 @@ func (s *TdfObjectServer) CreateTdfObject(
 	if respErr != nil {
 		return nil, respErr
 	}
+	s.audit(ctx, req.Header().Get("Authorization"), req.Spec().Procedure, audit.ActionCreate, audit.DecisionAllowed,
+		auditObject{id: newId.String(), srcType: params.SrcType, search: string(params.Search)})
 
 	res := connect.NewResponse(&tdf_objectv1.CreateTdfObjectResponse{
 		Id: newId.String(),
@@ -251,11 +350,81 @@ func (s *TdfObjectServer) CreateTdfObject(
 	return res, nil
 }
 
+// errInvalidGeoJSON is the error of a CreateTdfObjectRequest whose geo is not GeoJSON
+var errInvalidGeoJSON = errors.New("error creating geometry from GeoJSON")
+
+// newTdfObjectParams returns the CreateTdfObjects parameters of a CreateTdfObjectRequest, moving a TDF payload above
+// the blob_store threshold to the blob store
+func newTdfObjectParams(ctx context.Context, q *db.Queries, blobs *blobstore.Blobs, msg *tdf_objectv1.CreateTdfObjectRequest) (db.CreateTdfObjectsParams, error) {
+	geo, err := geos.NewGeomFromGeoJSON(msg.Geo)
+	if err != nil {
+		return db.CreateTdfObjectsParams{}, fmt.Errorf("%w: %w", errInvalidGeoJSON, err)
+	}
+
+	search := []byte(msg.Search)
+	if len(search) == 0 {
+		// todo: figure out how to use with NULL db type
+		search = []byte("null")
+	}
+
+	metadata := []byte(msg.Metadata)
+	if len(metadata) == 0 {
+		metadata = []byte("{}")
+	}
+
+	var ts pgtype.Timestamp
+	if msg.Ts != nil {
+		ts = pgtype.Timestamp{Time: msg.GetTs().AsTime().UTC(), Valid: true}
+	} else {
+		ts = pgtype.Timestamp{Time: time.Now().UTC(), Valid: true}
+	}
+
+	// fall back to the src_type entityField when the entity key is not provided
+	srcType := strings.ToLower(msg.SrcType)
+	entityKey := msg.EntityKey
+	if entityKey == "" {
+		entityKey, err = LookupEntityKey(ctx, q, srcType, metadata, search)
+		if err != nil {
+			slog.ErrorContext(ctx, "error looking up entity key", slog.String("src_type", srcType), slog.String("error", err.Error()))
+			return db.CreateTdfObjectsParams{}, connect.NewError(connect.CodeInternal, fmt.Errorf("error looking up entity key: %w", err))
+		}
+	}
+
+	tdfBlob, tdfUri := msg.TdfBlob, msg.TdfUri
+	if tdfUri == "" {
+		uri, err := blobs.Offload(ctx, srcType, tdfBlob)
+		if err != nil {
+			slog.ErrorContext(ctx, "error storing blob", slog.String("src_type", srcType), slog.String("error", err.Error()))
+			return db.CreateTdfObjectsParams{}, connect.NewError(connect.CodeInternal, fmt.Errorf("error storing blob: %w", err))
+		}
+		if uri != "" {
+			tdfBlob, tdfUri = nil, uri
+		}
+	}
+
+	return db.CreateTdfObjectsParams{
+		SrcType:   srcType,
+		Ts:        ts,
+		Geo:       geo,
+		Search:    search,
+		Metadata:  metadata,
+		TdfBlob:   tdfBlob,
+		TdfUri:    pgtype.Text{String: tdfUri, Valid: tdfUri != ""},
+		EntityKey: pgtype.Text{String: entityKey, Valid: entityKey != ""},
+	}, nil
+}
+
 func (s *TdfObjectServer) GetTdfNote(
 	ctx context.Context,
 	req *connect.Request[tdf_notev1.GetTdfNoteRequest],
 ) (*connect.Response[tdf_notev1.GetTdfNoteResponse], error) {
 
+	token := req.Header().Get("Authorization")
+	entitlements, err := s.getEntitlements(token)
+	if err != nil {
+		return nil, err
+	}
+
 	uuid, err := uuid.Parse(req.Msg.Id)
 	if err != nil {
 		return nil, err
@@ -265,6 +434,13 @@ func (s *TdfObjectServer) GetTdfNote(
 	if err != nil {
 		return nil, err
 	}
+	// notes the caller can not see are not found alike, as attachments
+	visible := attachmentVisible(tdfObject.Search, entitlements)
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionView, auditDecision(visible),
+		auditObject{id: uuid.String(), search: string(tdfObject.Search)})
+	if !visible {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_note %s not found", req.Msg.Id))
+	}
 
 	res := connect.NewResponse(&tdf_notev1.GetTdfNoteResponse{
 		TdfNote: prepNoteForResponse(db.TdfNote{
@@ -291,20 +467,39 @@ func (s *TdfObjectServer) GetTdfObject(
 		return nil, err
 	}
 
+	token := req.Header().Get("Authorization")
+	entitlements, err := s.getEntitlements(token)
+	if err != nil {
+		return nil, err
+	}
+
 	tdfObject, err := s.DBQueries.GetTdfObject(ctx, uuid)
 	if err != nil {
 		return nil, err
 	}
 
+	obj := prepObjForResponse(db.TdfObject{
+		ID:        uuid,
+		Ts:        tdfObject.Ts,
+		SrcType:   tdfObject.SrcType,
+		Geo:       tdfObject.Geo.(*geos.Geom),
+		Search:    tdfObject.Search,
+		TdfBlob:   tdfObject.TdfBlob,
+		TdfUri:    tdfObject.TdfUri,
+		EntityKey: tdfObject.EntityKey,
+		Version:   tdfObject.Version,
+	})
+	// only the download URLs of a tdf_object the caller can see are signed, tdf_objects the caller can not see are not
+	// found alike
+	objs := s.auditFilter(ctx, token, req.Spec().Procedure, audit.ActionView, []*tdf_objectv1.TdfObject{obj}, entitlements)
+	if len(objs) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tdf_object %s not found", req.Msg.Id))
+	}
+	obj = objs[0]
+	s.signTdfUris(ctx, []*tdf_objectv1.TdfObject{obj})
+
 	res := connect.NewResponse(&tdf_objectv1.GetTdfObjectResponse{
-		TdfObject: prepObjForResponse(db.TdfObject{
-			ID:      uuid,
-			Ts:      tdfObject.Ts,
-			SrcType: tdfObject.SrcType,
-			Geo:     tdfObject.Geo.(*geos.Geom),
-			TdfBlob: tdfObject.TdfBlob,
-			TdfUri:  tdfObject.TdfUri,
-		}),
+		TdfObject: obj,
 	})
 	res.Header().Set("TdfObject-Version", "v1")
 
@@ -370,13 +565,16 @@ func (s *TdfObjectServer) QueryTdfNotes(
 	// TODO: additional work is needed here to get the attributes for the TDFs
 	// filter out TDFs that the user does not have access to
 	filteredTdfObjects := make([]*tdf_notev1.TdfNote, 0, len(tdfNotes))
+	var allowed, filtered []auditObject
 	for _, t := range tdfNotes {
+		audited := auditObject{id: t.ID.String(), search: string(t.Search)}
 		if len(t.Search) > 0 {
 			// Unmarshal the search string into a map
 			var searchAttributes util.TDFObjectSearchAttributes
 			if err := json.Unmarshal([]byte(t.Search), &searchAttributes); err != nil {
 				// TODO: diagnose conflict w/ current search setup causing this err to proc.
 				slog.Error("error unmarshalling search string", slog.String("error", err.Error()))
+				filtered = append(filtered, audited)
 				continue
 			}
 
@@ -385,6 +583,7 @@ func (s *TdfObjectServer) QueryTdfNotes(
 				if err != nil {
 					slog.Error("error trimming TDF visibility", slog.String("error", err.Error()))
 				}
+				filtered = append(filtered, audited)
 				continue
 			}
 		}
@@ -405,7 +604,10 @@ func (s *TdfObjectServer) QueryTdfNotes(
 
 		// Append the newly created TdfNote to the filtered list
 		filteredTdfObjects = append(filteredTdfObjects, tdfNote)
+		allowed = append(allowed, audited)
 	}
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionQuery, audit.DecisionAllowed, allowed...)
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionQuery, audit.DecisionFiltered, filtered...)
 
 	res := connect.NewResponse(&tdf_notev1.QueryTdfNotesResponse{
 		TdfNotes: filteredTdfObjects,
@@ -430,8 +632,160 @@ func (s *TdfObjectServer) QueryTdfObjects(
 	if err != nil {
 		return nil, err
 	}
+	tdfObjects = s.auditFilter(ctx, token, req.Spec().Procedure, audit.ActionQuery, tdfObjects, entitlements)
+	s.signTdfUris(ctx, tdfObjects)
+
+	res := connect.NewResponse(&tdf_objectv1.QueryTdfObjectsResponse{
+		TdfObjects: tdfObjects,
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+func (s *TdfObjectServer) GetLatestPositions(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.GetLatestPositionsRequest],
+) (*connect.Response[tdf_objectv1.GetLatestPositionsResponse], error) {
+	token := req.Header().Get("Authorization")
+	entitlements, err := s.getEntitlements(token)
+	if err != nil {
+		return nil, err
+	}
+
+	srcTypes := req.Msg.GetSrcTypes()
+	if srcTypes == nil {
+		srcTypes = []string{}
+	}
+	endTime := pgtype.Timestamp{Time: time.Now().UTC(), Valid: true}
+	if req.Msg.GetTsRange().LesserOrEqualTo != nil {
+		endTime = pgtype.Timestamp{Time: req.Msg.GetTsRange().LesserOrEqualTo.AsTime(), Valid: true}
+	}
+
+	tdfObjects, err := latestVisiblePositions(ctx, s.DBQueries, db.GetLatestPositionsParams{
+		SourceTypes: srcTypes,
+		StartTime:   pgtype.Timestamp{Time: req.Msg.GetTsRange().GreaterOrEqualTo.AsTime(), Valid: true},
+		EndTime:     endTime,
+	}, entitlements)
+	if err != nil {
+		return nil, err
+	}
+	tdfObjects = s.auditFilter(ctx, token, req.Spec().Procedure, audit.ActionQuery, tdfObjects, entitlements)
+	s.signTdfUris(ctx, tdfObjects)
+
+	res := connect.NewResponse(&tdf_objectv1.GetLatestPositionsResponse{
+		TdfObjects: tdfObjects,
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+// latestVisiblePositions returns the latest position of each entity the caller can see. The visibility check runs in
+// the query before the latest row is picked, so when the caller can not see the latest position of an entity its
+// latest visible position is returned instead of dropping the entity.
+func latestVisiblePositions(
+	ctx context.Context,
+	q *db.Queries,
+	params db.GetLatestPositionsParams,
+	entitlements dspClient.Entitlements,
+) ([]*tdf_objectv1.TdfObject, error) {
+	params.Entitlements = make([]string, 0, len(entitlements))
+	for attr, entitled := range entitlements {
+		if entitled {
+			params.Entitlements = append(params.Entitlements, attr)
+		}
+	}
+	return dbQueryLatestPositions(ctx, q, params)
+}
+
+func (s *TdfObjectServer) GetTrack(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.GetTrackRequest],
+) (*connect.Response[tdf_objectv1.GetTrackResponse], error) {
+	token := req.Header().Get("Authorization")
+	entitlements, err := s.getEntitlements(token)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime := pgtype.Timestamp{Time: time.Now().UTC(), Valid: true}
+	if req.Msg.GetTsRange().LesserOrEqualTo != nil {
+		endTime = pgtype.Timestamp{Time: req.Msg.GetTsRange().LesserOrEqualTo.AsTime(), Valid: true}
+	}
+
+	points, err := s.DBQueries.GetTrack(ctx, db.GetTrackParams{
+		SourceType: strings.ToLower(req.Msg.GetSrcType()),
+		EntityKey:  req.Msg.GetEntityKey(),
+		StartTime:  pgtype.Timestamp{Time: req.Msg.GetTsRange().GreaterOrEqualTo.AsTime(), Valid: true},
+		EndTime:    endTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// only include the positions the user has access to
+	track := &tdf_objectv1.GetTrackResponse{}
+	coords := make([][]float64, 0, len(points))
+	var allowed, filtered []auditObject
+	for _, p := range points {
+		audited := auditObject{id: p.ID.String(), srcType: strings.ToLower(req.Msg.GetSrcType()), search: string(p.Search)}
+		if len(p.Search) > 0 {
+			if _, canSee := searchVisibility(string(p.Search), entitlements); !canSee {
+				filtered = append(filtered, audited)
+				continue
+			}
+		}
+		geo, ok := p.Geo.(*geos.Geom)
+		if !ok || geo == nil {
+			continue
+		}
+		coords = append(coords, []float64{geo.X(), geo.Y()})
+		track.Ts = append(track.Ts, timestamppb.New(p.Ts.Time))
+		track.Ids = append(track.Ids, p.ID.String())
+		allowed = append(allowed, audited)
+	}
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionQuery, audit.DecisionAllowed, allowed...)
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionQuery, audit.DecisionFiltered, filtered...)
+
+	// a LineString requires at least two positions
+	if len(coords) > 1 {
+		track.Geo = geos.NewLineString(coords).ToGeoJSON(0)
+	}
+
+	res := connect.NewResponse(track)
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+// searchVisibility unmarshals the search attributes of a tdf_object and checks them against the entitlements.
+func searchVisibility(search string, entitlements dspClient.Entitlements) (util.TDFObjectSearchAttributes, bool) {
+	var searchAttributes util.TDFObjectSearchAttributes
+	if err := json.Unmarshal([]byte(search), &searchAttributes); err != nil {
+		slog.Error("error unmarshalling search string", slog.String("error", err.Error()))
+		return searchAttributes, false
+	}
+
+	canSee, err := util.TrimTDFVisibility(searchAttributes, entitlements)
+	if err != nil {
+		slog.Error("error trimming TDF visibility", slog.String("error", err.Error()))
+	}
+	return searchAttributes, canSee
+}
+
+// tdfObjectVisible reports whether filterTdfObjects keeps a tdf_object, without pruning its search attributes
+func tdfObjectVisible(t *tdf_objectv1.TdfObject, entitlements dspClient.Entitlements) bool {
+	if t.Search == "" {
+		return true
+	}
+	_, canSee := searchVisibility(t.Search, entitlements)
+	return canSee
+}
+
+// filterTdfObjects filters out TDFs that the user does not have access to and prunes the search attributes.
+func filterTdfObjects(tdfObjects []*tdf_objectv1.TdfObject, entitlements dspClient.Entitlements) []*tdf_objectv1.TdfObject {
 	// TODO: additional work is needed here to get the attributes for the TDFs
-	// filter out TDFs that the user does not have access to
 	filteredTdfObjects := make([]*tdf_objectv1.TdfObject, 0, len(tdfObjects))
 	for _, t := range tdfObjects {
 		if t.Search == "" {
@@ -439,26 +793,17 @@ func (s *TdfObjectServer) QueryTdfObjects(
 			filteredTdfObjects = append(filteredTdfObjects, t)
 			continue
 		}
-		// unmarshal the search string into a map
-		var searchAttributes util.TDFObjectSearchAttributes
-		if err := json.Unmarshal([]byte(t.Search), &searchAttributes); err != nil {
-			slog.Error("error unmarshalling search string", slog.String("error", err.Error()))
-			continue
-		}
 
 		// remove plaintext from results to reduce risk of leaking sensitive data
-		canSee, err := util.TrimTDFVisibility(searchAttributes, entitlements)
-		if err != nil {
-			slog.Error("error trimming TDF visibility", slog.String("error", err.Error()))
-		}
+		searchAttributes, canSee := searchVisibility(t.Search, entitlements)
 		if !canSee {
 			continue
 		}
 
 		prunedAttributes := map[string]interface{}{
-		"attrRelTo":          searchAttributes.RelTo,
-		"attrNeedToKnow":     searchAttributes.NeedToKnow,
-		"attrClassification": searchAttributes.Classification,
+			"attrRelTo":          searchAttributes.RelTo,
+			"attrNeedToKnow":     searchAttributes.NeedToKnow,
+			"attrClassification": searchAttributes.Classification,
 		}
 
 		prunedJSON, err := json.Marshal(prunedAttributes)
@@ -472,12 +817,7 @@ func (s *TdfObjectServer) QueryTdfObjects(
 		filteredTdfObjects = append(filteredTdfObjects, t)
 	}
 
-	res := connect.NewResponse(&tdf_objectv1.QueryTdfObjectsResponse{
-		TdfObjects: filteredTdfObjects,
-	})
-	res.Header().Set("TdfObject-Version", "v1")
-
-	return res, nil
+	return filteredTdfObjects
 }
 
 func (s *TdfObjectServer) StreamTdfNotes(
@@ -485,6 +825,12 @@ func (s *TdfObjectServer) StreamTdfNotes(
 	req *connect.Request[tdf_notev1.StreamTdfNotesRequest],
 	stream *connect.ServerStream[tdf_notev1.StreamTdfNotesResponse],
 ) error {
+	// the subscription is recorded with the user of the token once the platform accepted it
+	token := req.Header().Get("Authorization")
+	if _, err := s.getEntitlements(token); err != nil {
+		return err
+	}
+
 	// generate a unique ID for the client
 	clientId := uuid.New()
 
@@ -494,6 +840,8 @@ func (s *TdfObjectServer) StreamTdfNotes(
 
 	slog.InfoContext(ctx, "client connected to StreamTdfObjects", slog.Any("client_id", clientId.String()))
 	s.ActiveClients.AddNote(clientId.String(), req.Peer(), stream)
+	// the objects streamed are not recorded one by one, the subscription is
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionQuery, audit.DecisionAllowed)
 
 	// remove client from activeClients when context is done (aka client disconnects)
 	go func() {
@@ -532,6 +880,12 @@ func (s *TdfObjectServer) StreamTdfObjects(
 	req *connect.Request[tdf_objectv1.StreamTdfObjectsRequest],
 	stream *connect.ServerStream[tdf_objectv1.StreamTdfObjectsResponse],
 ) error {
+	// the subscription is recorded with the user of the token once the platform accepted it
+	token := req.Header().Get("Authorization")
+	if _, err := s.getEntitlements(token); err != nil {
+		return err
+	}
+
 	// generate a unique ID for the client
 	clientId := uuid.New()
 
@@ -541,6 +895,8 @@ func (s *TdfObjectServer) StreamTdfObjects(
 
 	slog.InfoContext(ctx, "client connected to StreamTdfObjects", slog.Any("client_id", clientId.String()))
 	s.ActiveClients.Add(clientId.String(), req.Peer(), stream)
+	// the objects streamed are not recorded one by one, the subscription is
+	s.audit(ctx, token, req.Spec().Procedure, audit.ActionQuery, audit.DecisionAllowed)
 
 	// remove client from activeClients when context is done (aka client disconnects)
 	go func() {
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/adsb"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"github.com/virtru-corp/dsp-cop/pkg/dspClient"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type testEncrypter struct{}
+
+func (testEncrypter) EncryptBytes(b []byte, _ []string, _ int) (*bytes.Buffer, error) {
+	return bytes.NewBuffer(b), nil
+}
+
+// Test_NewTdfObjectServerIngester runs the ADS-B ingester of dsp-cop ingest adsb against the database of
+// DSP_COP_TEST_DATABASE_URL, creating then updating an aircraft through the server
+func Test_NewTdfObjectServerIngester(t *testing.T) {
+	pool := testDBPool(t)
+	ctx := context.Background()
+
+	s, err := NewTdfObjectServer(&config.Config{}, pool, nil, nil)
+	if err != nil {
+		t.Fatalf("NewTdfObjectServer() failed: %v", err)
+	}
+	srcType := "adsb-test-" + uuid.NewString()
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), "DELETE FROM tdf_objects WHERE src_type = $1", srcType)
+	})
+
+	in := &adsb.Ingester{
+		Store:     s,
+		Encrypter: testEncrypter{},
+		SrcType:   srcType,
+		Interval:  5 * time.Second,
+		Location:  time.UTC,
+	}
+	for _, m := range []string{
+		"MSG,1,1,1,4CA2D6,1,2024/06/01,12:00:00.000,2024/06/01,12:00:00.000,RYR1234,,,,,,,,,,,0",
+		"MSG,3,1,1,4CA2D6,1,2024/06/01,12:00:01.000,2024/06/01,12:00:01.000,,37000,,,51.47,-0.45,,,0,0,0,0",
+		"MSG,3,1,1,4CA2D6,1,2024/06/01,12:00:07.000,2024/06/01,12:00:07.000,,37025,,,51.47,-0.48,,,0,0,0,0",
+	} {
+		in.HandleLine(ctx, m)
+	}
+	if in.Created != 1 || in.Updated != 1 {
+		t.Fatalf("ingester created %d and updated %d aircraft; want 1 and 1", in.Created, in.Updated)
+	}
+
+	var version, revisions int32
+	var lon float64
+	err = pool.QueryRow(ctx, `SELECT o.version, ST_X(o.geo), (SELECT count(*) FROM tdf_object_revisions r WHERE r.tdf_object_id = o.id)::INTEGER
+		FROM tdf_objects o WHERE o.src_type = $1 AND o.entity_key = '4CA2D6'`, srcType).Scan(&version, &lon, &revisions)
+	if err != nil {
+		t.Fatalf("failed to read the aircraft: %v", err)
+	}
+	if version != 2 || lon != -0.48 || revisions != 1 {
+		t.Errorf("aircraft at version %d, longitude %g with %d revisions; want 2, -0.48 and 1", version, lon, revisions)
+	}
+}
+
+// testVersionServer returns a server of the test database, entitled without a token, and a tdf_object of a src_type of
+// its own at version 1
+func testVersionServer(t *testing.T) (*TdfObjectServer, uuid.UUID) {
+	t.Helper()
+	pool := testDBPool(t)
+	ctx := context.Background()
+
+	s, err := NewTdfObjectServer(&config.Config{}, pool, nil, nil)
+	if err != nil {
+		t.Fatalf("NewTdfObjectServer() failed: %v", err)
+	}
+	s.cache.SetWithTTL(EntitlementCacheKey, dspClient.Entitlements{}, EntitlementCacheWeight, EntitlementCacheTTL)
+	s.cache.Wait()
+
+	srcType := "version-test-" + uuid.NewString()
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), "DELETE FROM tdf_objects WHERE src_type = $1", srcType)
+	})
+	var id uuid.UUID
+	if err := pool.QueryRow(ctx, `INSERT INTO tdf_objects (src_type, metadata) VALUES ($1, '{"v": 1}') RETURNING id`, srcType).Scan(&id); err != nil {
+		t.Fatalf("failed to insert tdf_object: %v", err)
+	}
+	return s, id
+}
+
+func Test_UpdateTdfObjectVersion(t *testing.T) {
+	s, id := testVersionServer(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		test            string
+		metadata        string
+		expectedVersion *wrapperspb.Int32Value
+		wantVersion     int32
+		wantCode        connect.Code
+	}{
+		{test: "expected version matches", metadata: `{"v": 2}`, expectedVersion: wrapperspb.Int32(1), wantVersion: 2},
+		{test: "expected version mismatch", metadata: `{"v": 3}`, expectedVersion: wrapperspb.Int32(1), wantCode: connect.CodeAborted},
+		// the trigger increments the version of updates without an expected version too
+		{test: "without expected version", metadata: `{"v": 3}`, wantVersion: 3},
+	}
+	for _, tt := range tests {
+		res, err := s.UpdateTdfObject(ctx, connect.NewRequest(&tdf_objectv1.UpdateTdfObjectRequest{
+			Id:              id.String(),
+			Metadata:        wrapperspb.String(tt.metadata),
+			ExpectedVersion: tt.expectedVersion,
+		}))
+		if tt.wantCode != 0 {
+			if connect.CodeOf(err) != tt.wantCode {
+				t.Errorf("%s: UpdateTdfObject() error = %v; want code %s", tt.test, err, tt.wantCode)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: UpdateTdfObject() failed: %v", tt.test, err)
+		}
+		if res.Msg.Version != tt.wantVersion {
+			t.Errorf("%s: UpdateTdfObject() version = %d; want %d", tt.test, res.Msg.Version, tt.wantVersion)
+		}
+	}
+}
+
+func Test_RevertTdfObjectVersion(t *testing.T) {
+	s, id := testVersionServer(t)
+	ctx := context.Background()
+
+	if _, err := s.UpdateTdfObject(ctx, connect.NewRequest(&tdf_objectv1.UpdateTdfObjectRequest{
+		Id:       id.String(),
+		Metadata: wrapperspb.String(`{"v": 2}`),
+	})); err != nil {
+		t.Fatalf("UpdateTdfObject() failed: %v", err)
+	}
+	res, err := s.RevertTdfObject(ctx, connect.NewRequest(&tdf_objectv1.RevertTdfObjectRequest{
+		Id:       id.String(),
+		Revision: 1,
+	}))
+	if err != nil {
+		t.Fatalf("RevertTdfObject() failed: %v", err)
+	}
+	if res.Msg.Revision != 2 {
+		t.Errorf("RevertTdfObject() revision = %d; want 2", res.Msg.Revision)
+	}
+
+	obj, err := s.DBQueries.GetTdfObject(ctx, id)
+	if err != nil {
+		t.Fatalf("GetTdfObject() failed: %v", err)
+	}
+	if obj.Version != 3 || string(obj.Metadata) != `{"v": 1}` {
+		t.Errorf("reverted tdf_object at version %d with metadata %s; want 3 with {\"v\": 1}", obj.Version, obj.Metadata)
+	}
+}
+
+// Test_latestVisiblePositions checks that an entity whose latest position is hidden from the caller returns its latest
+// visible position, and that an entity without one is left out
+func Test_latestVisiblePositions(t *testing.T) {
+	pool := testDBPool(t)
+	ctx := context.Background()
+
+	srcType := "positions-test-" + uuid.NewString()
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), "DELETE FROM tdf_objects WHERE src_type = $1", srcType)
+	})
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, p := range []struct {
+		entity string
+		search string
+		lon    float64
+		age    time.Duration
+	}{
+		{entity: "visible", search: `{"attrClassification": "A"}`, lon: 1, age: 2 * time.Minute},
+		{entity: "visible", search: `{"attrClassification": ["A"], "attrRelTo": ["X", "Y"]}`, lon: 2, age: time.Minute},
+		{entity: "visible", search: `{"attrClassification": "B"}`, lon: 3},
+		{entity: "hidden", search: `{"attrNeedToKnow": ["A", "B"]}`, lon: 4},
+	} {
+		if _, err := pool.Exec(ctx, `INSERT INTO tdf_objects (ts, src_type, entity_key, search, geo)
+			VALUES ($1, $2, $3, $4, ST_MakePoint($5, 0))`, now.Add(-p.age), srcType, p.entity, p.search, p.lon); err != nil {
+			t.Fatalf("failed to insert tdf_object: %v", err)
+		}
+	}
+
+	positions, err := latestVisiblePositions(ctx, db.New(pool), db.GetLatestPositionsParams{
+		SourceTypes: []string{srcType},
+		StartTime:   pgtype.Timestamp{Time: now.Add(-time.Hour), Valid: true},
+		EndTime:     pgtype.Timestamp{Time: now.Add(time.Hour), Valid: true},
+	}, dspClient.Entitlements{"A": true, "B": false, "Y": true})
+	if err != nil {
+		t.Fatalf("latestVisiblePositions() failed: %v", err)
+	}
+	if len(positions) != 1 || positions[0].EntityKey != "visible" {
+		t.Fatalf("latestVisiblePositions() = %v; want the position of the visible entity only", positions)
+	}
+	if !positions[0].Ts.AsTime().Equal(now.Add(-time.Minute)) {
+		t.Errorf("latestVisiblePositions() position at %s; want the latest visible one at %s", positions[0].Ts.AsTime(), now.Add(-time.Minute))
+	}
+}
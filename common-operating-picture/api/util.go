@@ -3,9 +3,13 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/mitchellh/mapstructure"
 	geos "github.com/twpayne/go-geos"
@@ -23,14 +27,16 @@ func prepObjForResponse(in db.TdfObject) *tdf_objectv1.TdfObject {
 	}
 
 	return &tdf_objectv1.TdfObject{
-		Id:       in.ID.String(),
-		Ts:       timestamppb.New(in.Ts.Time),
-		SrcType:  in.SrcType,
-		Geo:      geo,
-		Search:   string(in.Search),
-		Metadata: string(in.Metadata),
-		TdfBlob:  in.TdfBlob,
-		TdfUri:   in.TdfUri.String,
+		Id:        in.ID.String(),
+		Ts:        timestamppb.New(in.Ts.Time),
+		SrcType:   in.SrcType,
+		Geo:       geo,
+		Search:    string(in.Search),
+		Metadata:  string(in.Metadata),
+		TdfBlob:   in.TdfBlob,
+		TdfUri:    in.TdfUri.String,
+		EntityKey: in.EntityKey.String,
+		Version:   in.Version,
 	}
 }
 
@@ -47,7 +53,11 @@ func prepNoteForResponse(in db.TdfNote) *tdf_notev1.TdfNote {
 }
 
 func queryTdfObjectSwitch(ctx context.Context, q *db.Queries, p *tdf_objectv1.QueryTdfObjectsRequest) ([]*tdf_objectv1.TdfObject, error) {
-	srcType := p.GetSrcType()
+	// an empty list queries all source types, entitlements are still applied per object by the caller
+	srcTypes := p.GetSrcTypes()
+	if srcTypes == nil {
+		srcTypes = []string{}
+	}
 	startTime := pgtype.Timestamp{Time: p.GetTsRange().GreaterOrEqualTo.AsTime(), Valid: true}
 	endTime := pgtype.Timestamp{Time: time.Now().UTC(), Valid: true}
 	if p.GetTsRange().LesserOrEqualTo != nil {
@@ -67,31 +77,31 @@ func queryTdfObjectSwitch(ctx context.Context, q *db.Queries, p *tdf_objectv1.Qu
 
 	if geometry != "" && len(search) > 0 {
 		return dbQuerySearchAndGeo(ctx, q, db.ListTdfObjectsWithSearchAndGeoParams{
-			SourceType: srcType,
-			StartTime:  startTime,
-			EndTime:    endTime,
-			Search:     search,
-			Geometry:   geometry,
+			SourceTypes: srcTypes,
+			StartTime:   startTime,
+			EndTime:     endTime,
+			Search:      search,
+			Geometry:    geometry,
 		})
 	} else if len(search) > 0 {
 		return dbQuerySearch(ctx, q, db.ListTdfObjectsWithSearchParams{
-			SourceType: srcType,
-			StartTime:  startTime,
-			EndTime:    endTime,
-			Search:     search,
+			SourceTypes: srcTypes,
+			StartTime:   startTime,
+			EndTime:     endTime,
+			Search:      search,
 		})
 	} else if geometry != "" {
 		return dbQueryGeo(ctx, q, db.ListTdfObjectsWithGeoParams{
-			SourceType: srcType,
-			StartTime:  startTime,
-			EndTime:    endTime,
-			Geometry:   geometry,
+			SourceTypes: srcTypes,
+			StartTime:   startTime,
+			EndTime:     endTime,
+			Geometry:    geometry,
 		})
 	} else {
 		return dbQuery(ctx, q, db.ListTdfObjectsParams{
-			SourceType: srcType,
-			StartTime:  startTime,
-			EndTime:    endTime,
+			SourceTypes: srcTypes,
+			StartTime:   startTime,
+			EndTime:     endTime,
 		})
 
 	}
@@ -105,13 +115,16 @@ func dbQuerySearchAndGeo(ctx context.Context, query *db.Queries, params db.ListT
 	objs := make([]*tdf_objectv1.TdfObject, 0, len(items))
 	for _, item := range items {
 		objs = append(objs, prepObjForResponse(db.TdfObject{
-			ID:       item.ID,
-			Ts:       item.Ts,
-			SrcType:  item.SrcType,
-			Geo:      item.Geo.(*geos.Geom),
-			Search:   item.Search,
-			Metadata: item.Metadata,
-			TdfBlob:  item.TdfBlob,
+			ID:        item.ID,
+			Ts:        item.Ts,
+			SrcType:   item.SrcType,
+			Geo:       item.Geo.(*geos.Geom),
+			Search:    item.Search,
+			Metadata:  item.Metadata,
+			TdfBlob:   item.TdfBlob,
+			TdfUri:    item.TdfUri,
+			EntityKey: item.EntityKey,
+			Version:   item.Version,
 		}))
 	}
 	return objs, nil
@@ -125,13 +138,16 @@ func dbQuerySearch(ctx context.Context, query *db.Queries, params db.ListTdfObje
 	objs := make([]*tdf_objectv1.TdfObject, 0, len(items))
 	for _, item := range items {
 		objs = append(objs, prepObjForResponse(db.TdfObject{
-			ID:       item.ID,
-			Ts:       item.Ts,
-			SrcType:  item.SrcType,
-			Search:   item.Search,
-			Metadata: item.Metadata,
-			Geo:      item.Geo.(*geos.Geom),
-			TdfBlob:  item.TdfBlob,
+			ID:        item.ID,
+			Ts:        item.Ts,
+			SrcType:   item.SrcType,
+			Search:    item.Search,
+			Metadata:  item.Metadata,
+			Geo:       item.Geo.(*geos.Geom),
+			TdfBlob:   item.TdfBlob,
+			TdfUri:    item.TdfUri,
+			EntityKey: item.EntityKey,
+			Version:   item.Version,
 		}))
 	}
 	return objs, nil
@@ -145,13 +161,16 @@ func dbQueryGeo(ctx context.Context, query *db.Queries, params db.ListTdfObjects
 	objs := make([]*tdf_objectv1.TdfObject, 0, len(items))
 	for _, item := range items {
 		objs = append(objs, prepObjForResponse(db.TdfObject{
-			ID:       item.ID,
-			Ts:       item.Ts,
-			SrcType:  item.SrcType,
-			Search:   item.Search,
-			Metadata: item.Metadata,
-			Geo:      item.Geo.(*geos.Geom),
-			TdfBlob:  item.TdfBlob,
+			ID:        item.ID,
+			Ts:        item.Ts,
+			SrcType:   item.SrcType,
+			Search:    item.Search,
+			Metadata:  item.Metadata,
+			Geo:       item.Geo.(*geos.Geom),
+			TdfBlob:   item.TdfBlob,
+			TdfUri:    item.TdfUri,
+			EntityKey: item.EntityKey,
+			Version:   item.Version,
 		}))
 	}
 	return objs, nil
@@ -165,18 +184,102 @@ func dbQuery(ctx context.Context, query *db.Queries, params db.ListTdfObjectsPar
 	objs := make([]*tdf_objectv1.TdfObject, 0, len(items))
 	for _, item := range items {
 		objs = append(objs, prepObjForResponse(db.TdfObject{
-			ID:       item.ID,
-			Ts:       item.Ts,
-			SrcType:  item.SrcType,
-			Search:   item.Search,
-			Metadata: item.Metadata,
-			Geo:      item.Geo.(*geos.Geom),
-			TdfBlob:  item.TdfBlob,
+			ID:        item.ID,
+			Ts:        item.Ts,
+			SrcType:   item.SrcType,
+			Search:    item.Search,
+			Metadata:  item.Metadata,
+			Geo:       item.Geo.(*geos.Geom),
+			TdfBlob:   item.TdfBlob,
+			TdfUri:    item.TdfUri,
+			EntityKey: item.EntityKey,
+			Version:   item.Version,
 		}))
 	}
 	return objs, nil
 }
 
+func dbQueryLatestPositions(ctx context.Context, query *db.Queries, params db.GetLatestPositionsParams) ([]*tdf_objectv1.TdfObject, error) {
+	items, err := query.GetLatestPositions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]*tdf_objectv1.TdfObject, 0, len(items))
+	for _, item := range items {
+		objs = append(objs, prepObjForResponse(db.TdfObject{
+			ID:        item.ID,
+			Ts:        item.Ts,
+			SrcType:   item.SrcType,
+			Search:    item.Search,
+			Metadata:  item.Metadata,
+			Geo:       item.Geo.(*geos.Geom),
+			TdfBlob:   item.TdfBlob,
+			TdfUri:    item.TdfUri,
+			EntityKey: item.EntityKey,
+			Version:   item.Version,
+		}))
+	}
+	return objs, nil
+}
+
+// LookupEntityKey returns the value of the src_type entityField from the metadata or search JSON of an object.
+// An empty string is returned if the src_type does not exist, has no entityField or the field is not present.
+func LookupEntityKey(ctx context.Context, query *db.Queries, srcTypeId string, metadata []byte, search []byte) (string, error) {
+	srcType, err := query.GetSrcType(ctx, srcTypeId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var srcTypeMetadata dbSrcTypeMetadata
+	if err := json.Unmarshal(srcType.Metadata, &srcTypeMetadata); err != nil {
+		return "", err
+	}
+	if srcTypeMetadata.EntityField == "" {
+		return "", nil
+	}
+
+	for _, doc := range [][]byte{metadata, search} {
+		if v, ok := jsonFieldValue(doc, srcTypeMetadata.EntityField); ok {
+			return v, nil
+		}
+	}
+	return "", nil
+}
+
+// jsonFieldValue returns the scalar value of a dot separated field path (e.g. "vessel.mmsi") in a JSON object.
+func jsonFieldValue(doc []byte, field string) (string, bool) {
+	if len(doc) == 0 {
+		return "", false
+	}
+	var v interface{}
+	if err := json.Unmarshal(doc, &v); err != nil {
+		return "", false
+	}
+	for _, key := range strings.Split(field, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if v, ok = m[key]; !ok {
+			return "", false
+		}
+	}
+
+	switch t := v.(type) {
+	case string:
+		return t, t != ""
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(t), true
+	default:
+		return "", false
+	}
+}
+
 // NOTE: These intermediary structs are required to parse the src_type table JSON fields in the database.
 // The proto structs use snake_case fields for GO JSON marshalling, and seem to not be customizable.
 
@@ -185,6 +288,7 @@ type dbSrcTypeMetadata struct {
 	SearchFields  []string `json:"searchFields"`
 	AttrFields    []string `json:"attrFields"`
 	TsField       string   `json:"tsField,omitempty"`
+	EntityField   string   `json:"entityField,omitempty"`
 	DisplayFields struct {
 		Header  string   `json:"header,omitempty"`
 		Details []string `json:"details,omitempty"`
@@ -296,6 +400,7 @@ func dbQuerySrcType(ctx context.Context, query *db.Queries, srcTypeId string) (*
 			SearchFields: metadata.SearchFields,
 			AttrFields:   metadata.AttrFields,
 			TsField:      metadata.TsField,
+			EntityField:  metadata.EntityField,
 			DisplayFields: &tdf_objectv1.SrcTypeMetadataDisplayFields{
 				Header:  metadata.DisplayFields.Header,
 				Details: metadata.DisplayFields.Details,
@@ -0,0 +1,465 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// webhookEventCreated is the event of a delivery of a new tdf_object
+	webhookEventCreated = "tdf_object.created"
+	// webhookSignatureHeader carries the time and HMAC-SHA256 signature of a delivery, see signWebhookPayload
+	webhookSignatureHeader = "X-Webhook-Signature"
+	// webhookSecretPrefix marks the secrets sealed with webhooks.secret_key. Secrets stored before secrets were
+	// sealed have no prefix and are sealed when the server starts.
+	webhookSecretPrefix = "sealed:v1:"
+)
+
+var errWebhookSecretKey = errors.New("webhooks.secret_key is not configured")
+
+// webhookPayload is the JSON body of a delivery. The id of a delivery is the same for all its attempts, so receivers
+// can drop the deliveries they have already received.
+type webhookPayload struct {
+	ID        string          `json:"id"`
+	WebhookID string          `json:"webhook_id"`
+	Event     string          `json:"event"`
+	TdfObject json.RawMessage `json:"tdf_object,omitempty"`
+}
+
+// webhookDelivery is a tdf_object being delivered to a webhook
+type webhookDelivery struct {
+	id       string
+	webhook  db.ListWebhooksMatchingRow
+	object   *tdf_objectv1.TdfObject
+	attempts int
+}
+
+// webhookDispatcher delivers new tdf_objects to the webhooks whose filters they match, limited to the tdf_objects
+// the entitlements of each webhook's service identity can see. Failed attempts are retried with exponential backoff
+// and deliveries failing all their attempts are recorded as dead letters. Deliveries are kept in memory, so the
+// deliveries pending when the server stops are lost.
+type webhookDispatcher struct {
+	cfg     *config.Config
+	queries *db.Queries
+	secrets *webhookSecrets
+	client  *http.Client
+	queue   chan *webhookDelivery
+
+	// service identities by client credentials
+	identitiesLock sync.Mutex
+	identities     map[string]*serviceIdentity
+}
+
+// startWebhookDispatcher starts the delivery workers until the context is done
+func startWebhookDispatcher(ctx context.Context, c *config.Config, q *db.Queries) *webhookDispatcher {
+	d := &webhookDispatcher{
+		cfg:        c,
+		queries:    q,
+		secrets:    newWebhookSecrets(c.Webhooks.SecretKey),
+		client:     webhookClient(c),
+		queue:      make(chan *webhookDelivery, c.Webhooks.QueueSize),
+		identities: make(map[string]*serviceIdentity),
+	}
+	if err := sealWebhookSecrets(ctx, q, d.secrets); err != nil {
+		slog.ErrorContext(ctx, "failed to seal webhook secrets", slog.String("error", err.Error()))
+	}
+	for i := 0; i < c.Webhooks.Workers; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case delivery := <-d.queue:
+					d.deliver(ctx, delivery)
+				}
+			}
+		}()
+	}
+	return d
+}
+
+// dispatch queues the deliveries of a new tdf_object to the webhooks it matches
+func (d *webhookDispatcher) dispatch(ctx context.Context, obj *db.TdfObject, t *tdf_objectv1.TdfObject) error {
+	search := obj.Search
+	if len(search) == 0 {
+		search = []byte("null")
+	}
+	var geometry interface{}
+	if obj.Geo != nil {
+		geometry = obj.Geo.String()
+	}
+
+	webhooks, err := d.queries.ListWebhooksMatching(ctx, db.ListWebhooksMatchingParams{
+		SourceType: obj.SrcType,
+		Search:     search,
+		Geometry:   geometry,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	for _, w := range webhooks {
+		delivery := &webhookDelivery{
+			id:      uuid.NewString(),
+			webhook: w,
+			// filtering by entitlements prunes the object
+			object: proto.Clone(t).(*tdf_objectv1.TdfObject),
+		}
+		if delivery.webhook.Secret, err = d.secrets.open(w.Secret); err == nil {
+			delivery.webhook.ClientSecret, err = d.secrets.open(w.ClientSecret)
+		}
+		if err != nil {
+			d.deadLetter(ctx, delivery, nil, fmt.Errorf("failed to open webhook secrets: %w", err))
+			continue
+		}
+		d.enqueue(ctx, delivery)
+	}
+	return nil
+}
+
+// enqueue queues a delivery without blocking, a delivery past a full queue is dead-lettered
+func (d *webhookDispatcher) enqueue(ctx context.Context, delivery *webhookDelivery) {
+	if ctx.Err() != nil {
+		return
+	}
+	select {
+	case d.queue <- delivery:
+	default:
+		d.deadLetter(ctx, delivery, nil, errors.New("delivery queue full"))
+	}
+}
+
+// deliver makes an attempt of a delivery, scheduling the next attempt or recording a dead letter when it fails
+func (d *webhookDispatcher) deliver(ctx context.Context, delivery *webhookDelivery) {
+	delivery.attempts++
+	logAttrs := []any{
+		slog.String("webhook", delivery.webhook.Name),
+		slog.String("delivery", delivery.id),
+		slog.String("id", delivery.object.Id),
+		slog.Int("attempt", delivery.attempts),
+	}
+
+	body, visible, err := d.payload(delivery)
+	if err == nil && !visible {
+		slog.DebugContext(ctx, "skipping webhook delivery not visible to its service identity", logAttrs...)
+		return
+	}
+	retry := true
+	if err == nil {
+		if retry, err = d.post(ctx, delivery.webhook, body); err == nil {
+			slog.DebugContext(ctx, "delivered webhook", logAttrs...)
+			return
+		}
+	}
+
+	if !retry || delivery.attempts >= d.cfg.Webhooks.MaxAttempts {
+		slog.ErrorContext(ctx, "webhook delivery failed", append(logAttrs, slog.String("error", err.Error()))...)
+		d.deadLetter(ctx, delivery, body, err)
+		return
+	}
+	backoff := webhookBackoff(d.cfg.Webhooks.InitialBackoff, d.cfg.Webhooks.MaxBackoff, delivery.attempts)
+	slog.WarnContext(ctx, "webhook delivery failed, retrying", append(logAttrs,
+		slog.Duration("backoff", backoff),
+		slog.String("error", err.Error()),
+	)...)
+	time.AfterFunc(backoff, func() { d.enqueue(ctx, delivery) })
+}
+
+// payload returns the body of a delivery, and false when the tdf_object is not visible to the webhook's service
+// identity. Nothing is delivered without the entitlements of the service identity.
+func (d *webhookDispatcher) payload(delivery *webhookDelivery) ([]byte, bool, error) {
+	entitlements, err := d.identity(delivery.webhook).getEntitlements()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get entitlements of the service identity: %w", err)
+	}
+	objs := filterTdfObjects([]*tdf_objectv1.TdfObject{delivery.object}, entitlements)
+	if len(objs) == 0 {
+		return nil, false, nil
+	}
+
+	obj, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(objs[0])
+	if err != nil {
+		return nil, false, err
+	}
+	body, err := json.Marshal(webhookPayload{
+		ID:        delivery.id,
+		WebhookID: delivery.webhook.ID.String(),
+		Event:     webhookEventCreated,
+		TdfObject: obj,
+	})
+	return body, true, err
+}
+
+// post POSTs the body of a delivery to a webhook, returning whether a failed attempt may be retried. Network errors,
+// 408, 429 and 5xx responses are retried.
+func (d *webhookDispatcher) post(ctx context.Context, w db.ListWebhooksMatchingRow, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.Url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", w.ID.String())
+	req.Header.Set(webhookSignatureHeader, signWebhookPayload(w.Secret, time.Now(), body))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return false, nil
+	}
+	retry := res.StatusCode == http.StatusRequestTimeout || res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500
+	return retry, fmt.Errorf("webhook responded %s", res.Status)
+}
+
+// deadLetter records a delivery that failed, without the tdf_object when the delivery has no body
+func (d *webhookDispatcher) deadLetter(ctx context.Context, delivery *webhookDelivery, body []byte, cause error) {
+	if body == nil {
+		body, _ = json.Marshal(webhookPayload{
+			ID:        delivery.id,
+			WebhookID: delivery.webhook.ID.String(),
+			Event:     webhookEventCreated,
+		})
+	}
+	objectID, _ := uuid.Parse(delivery.object.Id)
+
+	err := d.queries.CreateWebhookDeadLetter(context.WithoutCancel(ctx), db.CreateWebhookDeadLetterParams{
+		WebhookID:   delivery.webhook.ID,
+		TdfObjectID: objectID,
+		Payload:     body,
+		Attempts:    int32(delivery.attempts),
+		Error:       cause.Error(),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to record webhook dead letter",
+			slog.String("webhook", delivery.webhook.Name),
+			slog.String("delivery", delivery.id),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// identity returns the service identity of a webhook, shared by the webhooks of the same client credentials
+func (d *webhookDispatcher) identity(w db.ListWebhooksMatchingRow) *serviceIdentity {
+	d.identitiesLock.Lock()
+	defer d.identitiesLock.Unlock()
+	key := w.ClientID + "\x00" + w.ClientSecret
+	identity, ok := d.identities[key]
+	if !ok {
+		identity = newServiceIdentity(context.Background(), d.cfg, w.ClientID, w.ClientSecret, d.cfg.Webhooks.TokenURL)
+		d.identities[key] = identity
+	}
+	return identity
+}
+
+// webhookBackoff returns the wait after a number of failed attempts, doubling from initial up to max
+func webhookBackoff(initial, max time.Duration, attempts int) time.Duration {
+	backoff := initial
+	for i := 1; i < attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+	return min(backoff, max)
+}
+
+// signWebhookPayload returns the signature header of a delivery, "t=<unix time>,v1=<hex HMAC-SHA256>" where the
+// HMAC of the webhook secret is computed over "<unix time>.<body>". Receivers should reject old times to prevent
+// replays.
+func signWebhookPayload(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookSecrets seals the signing secrets and client secrets of the webhooks stored in the database with AES-GCM,
+// keyed by the SHA-256 of webhooks.secret_key. A nil webhookSecrets has no key and only opens unsealed secrets.
+type webhookSecrets struct {
+	aead cipher.AEAD
+}
+
+// newWebhookSecrets returns the webhookSecrets of a key, nil when the key is empty
+func newWebhookSecrets(key string) *webhookSecrets {
+	if key == "" {
+		return nil
+	}
+	k := sha256.Sum256([]byte(key))
+	// a 32 byte key is always valid for AES-256 and GCM
+	block, _ := aes.NewCipher(k[:])
+	aead, _ := cipher.NewGCM(block)
+	return &webhookSecrets{aead: aead}
+}
+
+// seal returns the sealed secret as stored in the database
+func (s *webhookSecrets) seal(secret string) (string, error) {
+	if s == nil {
+		return "", errWebhookSecretKey
+	}
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := s.aead.Seal(nonce, nonce, []byte(secret), nil)
+	return webhookSecretPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// open returns the secret of a stored secret, which is returned as is when it was stored before secrets were sealed
+func (s *webhookSecrets) open(stored string) (string, error) {
+	encoded, ok := strings.CutPrefix(stored, webhookSecretPrefix)
+	if !ok {
+		return stored, nil
+	}
+	if s == nil {
+		return "", errWebhookSecretKey
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < s.aead.NonceSize() {
+		return "", errors.New("invalid sealed secret")
+	}
+	secret, err := s.aead.Open(nil, sealed[:s.aead.NonceSize()], sealed[s.aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to open sealed secret: %w", err)
+	}
+	return string(secret), nil
+}
+
+// sealWebhookSecrets seals the secrets stored before secrets were sealed, nothing is done without a key
+func sealWebhookSecrets(ctx context.Context, q *db.Queries, secrets *webhookSecrets) error {
+	if secrets == nil {
+		return nil
+	}
+	webhooks, err := q.ListWebhookSecrets(ctx)
+	if err != nil {
+		return err
+	}
+	for _, w := range webhooks {
+		params := db.UpdateWebhookParams{ID: w.ID}
+		for _, f := range []struct {
+			stored string
+			param  *pgtype.Text
+		}{
+			{w.Secret, &params.Secret},
+			{w.ClientSecret, &params.ClientSecret},
+		} {
+			if strings.HasPrefix(f.stored, webhookSecretPrefix) {
+				continue
+			}
+			sealed, err := secrets.seal(f.stored)
+			if err != nil {
+				return err
+			}
+			*f.param = pgtype.Text{String: sealed, Valid: true}
+		}
+		if !params.Secret.Valid && !params.ClientSecret.Valid {
+			continue
+		}
+		if _, err := q.UpdateWebhook(ctx, params); err != nil {
+			return fmt.Errorf("failed to seal the secrets of webhook %s: %w", w.ID, err)
+		}
+	}
+	return nil
+}
+
+// webhookClient returns the client of the deliveries. Unless webhooks.allow_private_urls is set, it refuses to
+// connect to addresses which are not public, checked once the host is resolved so a webhook URL can not be pointed
+// at an internal address after it was validated, and it does not use a proxy.
+func webhookClient(c *config.Config) *http.Client {
+	client := &http.Client{Timeout: c.Webhooks.Timeout}
+	if c.Webhooks.AllowPrivateURLs {
+		return client
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   webhookDialControl,
+	}).DialContext
+	client.Transport = transport
+	return client
+}
+
+// webhookDialControl refuses connections to addresses which are not public
+func webhookDialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !publicAddress(ip) {
+		return fmt.Errorf("webhook address %s is not a public address", host)
+	}
+	return nil
+}
+
+// nonPublicNetworks are the special-purpose ranges of the IANA registries that are not globally reachable, besides the
+// ones of the net.IP predicates. The IPv6 translation and 6to4 prefixes embed IPv4 addresses, private ones included.
+var nonPublicNetworks = func() []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range []string{
+		"0.0.0.0/8",      // this network
+		"100.64.0.0/10",  // shared address space, carrier-grade NAT
+		"192.0.0.0/24",   // IETF protocol assignments
+		"192.88.99.0/24", // 6to4 relay anycast
+		"198.18.0.0/15",  // benchmarking
+		"240.0.0.0/4",    // reserved, and the limited broadcast address
+		"64:ff9b::/96",   // IPv4/IPv6 translation
+		"64:ff9b:1::/48", // local-use IPv4/IPv6 translation
+		"100::/64",       // discard-only
+		"2001::/23",      // IETF protocol assignments, Teredo included
+		"2002::/16",      // 6to4
+		"fec0::/10",      // site-local
+	} {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}()
+
+// publicAddress reports whether an address is not a loopback, link-local, private, multicast, unspecified or other
+// special-purpose address
+func publicAddress(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
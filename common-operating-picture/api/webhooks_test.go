@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+)
+
+func Test_signWebhookPayload(t *testing.T) {
+	got := signWebhookPayload("secret", time.Unix(1717243200, 0), []byte(`{"id":"1"}`))
+	// echo -n '1717243200.{"id":"1"}' | openssl dgst -sha256 -hmac secret
+	want := "t=1717243200,v1=a31e98a6a2bc9b420b7047a962f8f36a00a1810a5af9efbfd81a0eabbab56d66"
+	if got != want {
+		t.Fatalf("signWebhookPayload() = %s; want %s", got, want)
+	}
+	if other := signWebhookPayload("other", time.Unix(1717243200, 0), []byte(`{"id":"1"}`)); other == got {
+		t.Errorf("signWebhookPayload() is the same for another secret")
+	}
+	if later := signWebhookPayload("secret", time.Unix(1717243201, 0), []byte(`{"id":"1"}`)); later == got {
+		t.Errorf("signWebhookPayload() is the same at another time")
+	}
+}
+
+func Test_webhookBackoff(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{
+		1:  time.Second,
+		2:  2 * time.Second,
+		4:  8 * time.Second,
+		10: time.Minute,
+	} {
+		if got := webhookBackoff(time.Second, time.Minute, attempts); got != want {
+			t.Errorf("webhookBackoff(%d) = %s; want %s", attempts, got, want)
+		}
+	}
+}
+
+func Test_webhookDispatcherPost(t *testing.T) {
+	status := http.StatusOK
+	var signature string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(webhookSignatureHeader)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	d := &webhookDispatcher{client: srv.Client()}
+	w := db.ListWebhooksMatchingRow{ID: uuid.New(), Url: srv.URL, Secret: "secret"}
+
+	tests := []struct {
+		status    int
+		wantErr   bool
+		wantRetry bool
+	}{
+		{status: http.StatusNoContent},
+		{status: http.StatusInternalServerError, wantErr: true, wantRetry: true},
+		{status: http.StatusTooManyRequests, wantErr: true, wantRetry: true},
+		{status: http.StatusNotFound, wantErr: true},
+	}
+	for _, tt := range tests {
+		status = tt.status
+		retry, err := d.post(context.Background(), w, []byte(`{}`))
+		if (err != nil) != tt.wantErr || retry != tt.wantRetry {
+			t.Errorf("post() with status %d = %v, %v; want error %v, retry %v", tt.status, retry, err, tt.wantErr, tt.wantRetry)
+		}
+		if !strings.HasPrefix(signature, "t=") {
+			t.Errorf("post() signature = %q", signature)
+		}
+	}
+
+	// unreachable webhooks are retried
+	w.Url = "http://127.0.0.1:1"
+	if retry, err := d.post(context.Background(), w, []byte(`{}`)); err == nil || !retry {
+		t.Errorf("post() to an unreachable webhook = %v, %v; want a retried error", retry, err)
+	}
+}
+
+func Test_webhookSecrets(t *testing.T) {
+	secrets := newWebhookSecrets("key")
+	sealed, err := secrets.seal("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(sealed, webhookSecretPrefix) || strings.Contains(sealed, "secret") {
+		t.Errorf("seal() = %s", sealed)
+	}
+	if got, err := secrets.open(sealed); err != nil || got != "secret" {
+		t.Errorf("open() = %q, %v; want secret", got, err)
+	}
+	if _, err := newWebhookSecrets("other").open(sealed); err == nil {
+		t.Errorf("open() with another key succeeded")
+	}
+
+	// secrets stored before secrets were sealed are opened as is, also without a key
+	var none *webhookSecrets
+	if got, err := none.open("secret"); err != nil || got != "secret" {
+		t.Errorf("open() of an unsealed secret = %q, %v; want secret", got, err)
+	}
+	if _, err := none.seal("secret"); !errors.Is(err, errWebhookSecretKey) {
+		t.Errorf("seal() without a key = %v; want %v", err, errWebhookSecretKey)
+	}
+	if _, err := none.open(sealed); !errors.Is(err, errWebhookSecretKey) {
+		t.Errorf("open() without a key = %v; want %v", err, errWebhookSecretKey)
+	}
+}
+
+func Test_validateWebhookURL(t *testing.T) {
+	s := &TdfObjectServer{Config: &config.Config{}}
+	for url, wantErr := range map[string]bool{
+		"https://203.0.113.10/hook":     false,
+		"https://[2001:db8::1]/hook":    false,
+		"ftp://203.0.113.10/hook":       true,
+		"/hook":                         true,
+		"http://127.0.0.1:8080/hook":    true,
+		"http://[::1]/hook":             true,
+		"http://10.0.0.5/hook":          true,
+		"http://192.168.1.1/hook":       true,
+		"http://169.254.169.254/meta":   true,
+		"http://0.0.0.0/hook":           true,
+		"http://100.64.0.1/hook":        true,
+		"http://192.0.0.8/hook":         true,
+		"http://198.18.0.1/hook":        true,
+		"http://240.0.0.1/hook":         true,
+		"http://[64:ff9b::a00:1]/hook":  true,
+		"http://[2002:a00:1::1]/hook":   true,
+		"http://[::ffff:10.0.0.5]/hook": true,
+	} {
+		if err := s.validateWebhookURL(context.Background(), url); (err != nil) != wantErr {
+			t.Errorf("validateWebhookURL(%s) = %v; want error %v", url, err, wantErr)
+		}
+	}
+
+	s.Config.Webhooks.AllowPrivateURLs = true
+	if err := s.validateWebhookURL(context.Background(), "http://127.0.0.1:8080/hook"); err != nil {
+		t.Errorf("validateWebhookURL() with allow_private_urls = %v", err)
+	}
+}
+
+func Test_webhookDialControl(t *testing.T) {
+	if err := webhookDialControl("tcp", "127.0.0.1:80", nil); err == nil {
+		t.Errorf("webhookDialControl() of a loopback address succeeded")
+	}
+	if err := webhookDialControl("tcp", "203.0.113.10:443", nil); err != nil {
+		t.Errorf("webhookDialControl() of a public address = %v", err)
+	}
+}
@@ -0,0 +1,432 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	geos "github.com/twpayne/go-geos"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/db"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// webhookDeadLetterLimit is the number of latest dead letters returned for a webhook
+	webhookDeadLetterLimit = 1000
+	// webhookMinSecretLength is the shortest signing secret of a webhook
+	webhookMinSecretLength = 32
+)
+
+// CreateWebhook creates a webhook owned by the caller. The signing secret is chosen by the caller, and it and the
+// client secret are sealed in the database and never returned.
+func (s *TdfObjectServer) CreateWebhook(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.CreateWebhookRequest],
+) (*connect.Response[tdf_objectv1.CreateWebhookResponse], error) {
+	owner, _, err := s.webhookCaller(req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateWebhookURL(ctx, req.Msg.Url); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var geo *geos.Geom
+	if req.Msg.Geo != "" {
+		if geo, err = geos.NewGeomFromGeoJSON(req.Msg.Geo); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("error creating geometry from GeoJSON: %w", err))
+		}
+	}
+
+	var search []byte
+	if req.Msg.Search != "" {
+		search = []byte(req.Msg.Search)
+	}
+
+	if len(req.Msg.Secret) < webhookMinSecretLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("secret must be at least %d characters", webhookMinSecretLength))
+	}
+	secrets := newWebhookSecrets(s.Config.Webhooks.SecretKey)
+	secret, err := secrets.seal(req.Msg.Secret)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	clientSecret, err := secrets.seal(req.Msg.ClientSecret)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	id, err := s.DBQueries.CreateWebhook(ctx, db.CreateWebhookParams{
+		Name:         req.Msg.Name,
+		Url:          req.Msg.Url,
+		Geo:          geo,
+		Search:       search,
+		Secret:       secret,
+		ClientID:     req.Msg.ClientId,
+		ClientSecret: clientSecret,
+		SrcType:      strings.ToLower(req.Msg.SrcType),
+		CreatedBy:    owner,
+	})
+	if err != nil {
+		return nil, db.StatusifyError(err, db.ErrCreateFailure, slog.String("webhook", req.Msg.Name))
+	}
+
+	res := connect.NewResponse(&tdf_objectv1.CreateWebhookResponse{
+		Id: id.String(),
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+func (s *TdfObjectServer) UpdateWebhook(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.UpdateWebhookRequest],
+) (*connect.Response[tdf_objectv1.UpdateWebhookResponse], error) {
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err))
+	}
+	if err := s.authorizeWebhook(ctx, req.Header().Get("Authorization"), id); err != nil {
+		return nil, err
+	}
+
+	// all fields but the ID are optional
+	params := db.UpdateWebhookParams{
+		ID: id,
+	}
+
+	if req.Msg.Name != nil {
+		params.Name = pgtype.Text{String: req.Msg.Name.GetValue(), Valid: true}
+	}
+
+	if req.Msg.Url != nil {
+		if err := s.validateWebhookURL(ctx, req.Msg.Url.GetValue()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		params.Url = pgtype.Text{String: req.Msg.Url.GetValue(), Valid: true}
+	}
+
+	if req.Msg.SrcType != nil {
+		params.SrcType = pgtype.Text{String: strings.ToLower(req.Msg.SrcType.GetValue()), Valid: true}
+	}
+
+	if req.Msg.Geo != nil {
+		// an empty geo clears the filter
+		if req.Msg.Geo.GetValue() == "" {
+			params.ClearGeo = true
+		} else if params.Geo, err = geos.NewGeomFromGeoJSON(req.Msg.Geo.GetValue()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("error creating geometry from GeoJSON: %w", err))
+		}
+	}
+
+	if req.Msg.Search != nil {
+		// an empty search clears the filter
+		params.Search = []byte(req.Msg.Search.GetValue())
+		if len(params.Search) == 0 {
+			params.Search = []byte("{}")
+		}
+	}
+
+	if req.Msg.Secret != nil && req.Msg.Secret.GetValue() != "" && len(req.Msg.Secret.GetValue()) < webhookMinSecretLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("secret must be at least %d characters", webhookMinSecretLength))
+	}
+
+	// the secret and credentials can be replaced but not cleared, the secrets are sealed
+	secrets := newWebhookSecrets(s.Config.Webhooks.SecretKey)
+	for _, f := range []struct {
+		value  *wrapperspb.StringValue
+		param  *pgtype.Text
+		name   string
+		sealed bool
+	}{
+		{req.Msg.Secret, &params.Secret, "secret", true},
+		{req.Msg.ClientId, &params.ClientID, "client_id", false},
+		{req.Msg.ClientSecret, &params.ClientSecret, "client_secret", true},
+	} {
+		if f.value == nil {
+			continue
+		}
+		if f.value.GetValue() == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s cannot be empty", f.name))
+		}
+		value := f.value.GetValue()
+		if f.sealed {
+			if value, err = secrets.seal(value); err != nil {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+		}
+		*f.param = pgtype.Text{String: value, Valid: true}
+	}
+
+	updatedId, err := s.DBQueries.UpdateWebhook(ctx, params)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("webhook %s not found", req.Msg.Id))
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error updating webhook", slog.String("id", req.Msg.Id), slog.String("error", err.Error()))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database update failed: %w", err))
+	}
+
+	res := connect.NewResponse(&tdf_objectv1.UpdateWebhookResponse{
+		Id: updatedId.String(),
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+func (s *TdfObjectServer) GetWebhook(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.GetWebhookRequest],
+) (*connect.Response[tdf_objectv1.GetWebhookResponse], error) {
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err))
+	}
+	if err := s.authorizeWebhook(ctx, req.Header().Get("Authorization"), id); err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.DBQueries.GetWebhook(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("webhook %s not found", req.Msg.Id))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&tdf_objectv1.GetWebhookResponse{
+		Webhook: prepWebhookForResponse(webhook),
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+func (s *TdfObjectServer) ListWebhooks(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.ListWebhooksRequest],
+) (*connect.Response[tdf_objectv1.ListWebhooksResponse], error) {
+
+	// the webhooks of the caller, or all of them for the webhooks admin role
+	owner, admin, err := s.webhookCaller(req.Header().Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+	webhooks, err := s.DBQueries.ListWebhooks(ctx, db.ListWebhooksParams{Admin: admin, Owner: owner})
+	if err != nil {
+		return nil, err
+	}
+
+	resWebhooks := make([]*tdf_objectv1.Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		resWebhooks = append(resWebhooks, prepWebhookForResponse(db.GetWebhookRow(w)))
+	}
+
+	res := connect.NewResponse(&tdf_objectv1.ListWebhooksResponse{
+		Webhooks: resWebhooks,
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+func (s *TdfObjectServer) DeleteWebhook(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.DeleteWebhookRequest],
+) (*connect.Response[tdf_objectv1.DeleteWebhookResponse], error) {
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err))
+	}
+	if err := s.authorizeWebhook(ctx, req.Header().Get("Authorization"), id); err != nil {
+		return nil, err
+	}
+
+	deletedId, err := s.DBQueries.DeleteWebhook(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("webhook %s not found", req.Msg.Id))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&tdf_objectv1.DeleteWebhookResponse{
+		Id: deletedId.String(),
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+// ListWebhookDeadLetters returns the latest dead letters of a webhook. The tdf_object of a dead letter is filtered
+// by the entitlements of the caller, and dead letters of tdf_objects the caller cannot see are left out.
+func (s *TdfObjectServer) ListWebhookDeadLetters(
+	ctx context.Context,
+	req *connect.Request[tdf_objectv1.ListWebhookDeadLettersRequest],
+) (*connect.Response[tdf_objectv1.ListWebhookDeadLettersResponse], error) {
+	token := req.Header().Get("Authorization")
+	entitlements, err := s.getEntitlements(token)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.Msg.WebhookId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err))
+	}
+	if err := s.authorizeWebhook(ctx, token, id); err != nil {
+		return nil, err
+	}
+
+	deadLetters, err := s.DBQueries.ListWebhookDeadLetters(ctx, db.ListWebhookDeadLettersParams{
+		WebhookID: id,
+		Limit:     webhookDeadLetterLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resDeadLetters := make([]*tdf_objectv1.WebhookDeadLetter, 0, len(deadLetters))
+	for _, d := range deadLetters {
+		var payload webhookPayload
+		if err := json.Unmarshal(d.Payload, &payload); err != nil {
+			slog.ErrorContext(ctx, "invalid webhook dead letter payload", slog.String("id", d.ID.String()), slog.String("error", err.Error()))
+			continue
+		}
+		if len(payload.TdfObject) > 0 {
+			obj := &tdf_objectv1.TdfObject{}
+			if err := protojson.Unmarshal(payload.TdfObject, obj); err != nil {
+				slog.ErrorContext(ctx, "invalid webhook dead letter payload", slog.String("id", d.ID.String()), slog.String("error", err.Error()))
+				continue
+			}
+			objs := filterTdfObjects([]*tdf_objectv1.TdfObject{obj}, entitlements)
+			if len(objs) == 0 {
+				continue
+			}
+			filtered, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(objs[0])
+			if err != nil {
+				return nil, err
+			}
+			payload.TdfObject = filtered
+		}
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		resDeadLetters = append(resDeadLetters, &tdf_objectv1.WebhookDeadLetter{
+			Id:          d.ID.String(),
+			WebhookId:   d.WebhookID.String(),
+			TdfObjectId: d.TdfObjectID.String(),
+			Payload:     string(body),
+			Attempts:    uint32(d.Attempts),
+			Error:       d.Error,
+			CreatedAt:   timestamppb.New(d.CreatedAt.Time),
+		})
+	}
+
+	res := connect.NewResponse(&tdf_objectv1.ListWebhookDeadLettersResponse{
+		DeadLetters: resDeadLetters,
+	})
+	res.Header().Set("TdfObject-Version", "v1")
+
+	return res, nil
+}
+
+// webhookCaller returns the user of the access token, once validated by getting its entitlements, and whether it has
+// the webhooks admin role. Webhooks can not be managed without a token.
+func (s *TdfObjectServer) webhookCaller(token string) (string, bool, error) {
+	if token == "" {
+		return "", false, connect.NewError(connect.CodeUnauthenticated, errors.New("an access token is required to manage webhooks"))
+	}
+	if _, err := s.getEntitlements(token); err != nil {
+		return "", false, err
+	}
+	claims, _ := parseTokenClaims(token)
+	admin := s.Config.Webhooks.AdminRole != "" && slices.Contains(claims.RealmAccess.Roles, s.Config.Webhooks.AdminRole)
+	return tokenUser(token), admin, nil
+}
+
+// authorizeWebhook checks the caller owns a webhook or has the webhooks admin role. The webhooks of other users are
+// not found, so their ids are not disclosed.
+func (s *TdfObjectServer) authorizeWebhook(ctx context.Context, token string, id uuid.UUID) error {
+	user, admin, err := s.webhookCaller(token)
+	if err != nil {
+		return err
+	}
+	owner, err := s.DBQueries.GetWebhookOwner(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && !admin && owner.String != user) {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("webhook %s not found", id))
+	}
+	return err
+}
+
+// validateWebhookURL checks a webhook URL is an absolute http or https URL. Unless webhooks.allow_private_urls is
+// set, its host must only resolve to public addresses, which the deliveries check again when they connect.
+func (s *TdfObjectServer) validateWebhookURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook url: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("webhook url must be an absolute http or https URL")
+	}
+	if s.Config.Webhooks.AllowPrivateURLs {
+		return nil
+	}
+
+	ips := []net.IP{net.ParseIP(u.Hostname())}
+	if ips[0] == nil {
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+		if err != nil {
+			return fmt.Errorf("failed to resolve webhook host %s: %w", u.Hostname(), err)
+		}
+		ips = ips[:0]
+		for _, a := range addrs {
+			ips = append(ips, a.IP)
+		}
+	}
+	for _, ip := range ips {
+		if !publicAddress(ip) {
+			return fmt.Errorf("webhook host %s is not a public address", u.Hostname())
+		}
+	}
+	return nil
+}
+
+// prepWebhookForResponse returns a webhook without its signing secret and client secret
+func prepWebhookForResponse(in db.GetWebhookRow) *tdf_objectv1.Webhook {
+	geo := ""
+	if in.Geo != nil {
+		geo = in.Geo.ToGeoJSON(0)
+	}
+
+	return &tdf_objectv1.Webhook{
+		Id:       in.ID.String(),
+		Name:     in.Name,
+		Url:      in.Url,
+		SrcType:  in.SrcType.String,
+		Geo:      geo,
+		Search:   string(in.Search),
+		ClientId: in.ClientID,
+	}
+}
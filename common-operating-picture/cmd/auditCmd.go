@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/audit"
+)
+
+const auditVerifyCmdLong = `
+Verify the hash chain of the audit log. Every record holds the hash of the previous record, so a
+record changed, removed or inserted breaks the chain from there on.
+
+Files are verified in the order given, which for a rotated file sink is the oldest rotated file
+first. Without files the configured sink is verified: the rotated files and the file of the file
+sink, or the audit_log table of the postgres sink. Records sent to syslog are verified by the
+collector.
+
+The first record verified is trusted when it is not the first of the chain, as the rotated files
+before it may have been removed by audit.file.max_backups. The command exits with status 1 when
+the chain is broken.
+`
+
+// auditVerifyPageSize is the number of audit_log records read per query
+const auditVerifyPageSize = 1000
+
+var (
+	auditCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "Audit log operations",
+	}
+
+	auditVerifyCmd = &cobra.Command{
+		Use:   "verify [<file>...]",
+		Short: "Verify the hash chain of the audit log",
+		Long:  auditVerifyCmdLong,
+		Run:   auditVerify,
+	}
+)
+
+func init() {
+	auditCmd.AddCommand(auditVerifyCmd)
+	rootCmd.AddCommand(auditCmd)
+}
+
+func auditVerify(cmd *cobra.Command, args []string) {
+	v := &audit.Verifier{}
+	var err error
+	switch {
+	case len(args) > 0:
+		err = audit.VerifyFiles(v, args...)
+	case cfg.Audit.Sink == "file":
+		var files []string
+		if files, err = audit.Files(cfg.Audit.File.Path); err == nil {
+			err = audit.VerifyFiles(v, files...)
+		}
+	case cfg.Audit.Sink == "postgres":
+		err = auditVerifyPostgres(cmd, v)
+	default:
+		fmt.Printf("Audit sink %q can not be verified, give the files to verify\n", cfg.Audit.Sink)
+		return
+	}
+
+	if errors.Is(err, audit.ErrChain) {
+		fmt.Printf("Audit log verification failed after %d records: %v\n", v.Checked, err)
+		// a broken chain fails the scripts and jobs running the verification
+		os.Exit(1)
+	} else if err != nil {
+		fmt.Println("Error verifying audit log", err)
+		return
+	}
+	fmt.Printf("Verified %d audit records\n", v.Checked)
+}
+
+func auditVerifyPostgres(cmd *cobra.Command, v *audit.Verifier) error {
+	pool, err := db.NewPool(cmd.Context(), cfg)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	sink := audit.NewPostgres(pool)
+	var after int64
+	for {
+		records, err := sink.Records(cmd.Context(), after, auditVerifyPageSize)
+		if err != nil {
+			return err
+		}
+		for _, r := range records {
+			if err := v.Check(r); err != nil {
+				return err
+			}
+			after = r.Seq
+		}
+		if len(records) < auditVerifyPageSize {
+			return nil
+		}
+	}
+}
@@ -1,10 +1,14 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log/slog"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -14,25 +18,105 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/spf13/cobra"
+	"github.com/virtru-corp/dsp-cop/api"
 	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/blobstore"
 	"github.com/virtru-corp/dsp-cop/pkg/geo"
 	"github.com/virtru-corp/dsp-cop/pkg/mock"
+	"github.com/virtru-corp/dsp-cop/pkg/records"
+	"github.com/virtru-corp/dsp-cop/pkg/retention"
 )
 
 const dbGetStreamByRangeCmdLong = `
 List stream items between two timestamps.
 
+Multiple source types may be given as a comma-separated list, e.g. "aircraft,vessel". An empty
+source type ("") lists stream items of all source types.
+
 If only one timestamp is provided, the command will list all stream items after that timestamp.
+
+The --output flag selects the format written to stdout: a table (the default), a GeoJSON
+FeatureCollection, NDJSON with one GeoJSON Feature per line, or CSV with the geometry as WKT.
+Search attributes become feature properties and TDF blobs are never written, so the output can be
+opened in QGIS and other GIS tools.
+`
+
+const dbCreateStreamItemCmdLong = `
+Create stream items from a JSON array, a JSON object or NDJSON (one JSON object per line). The
+file is read from stdin when it is omitted or "-".
+
+Each object has a required src_type and optional ts (RFC 3339, defaults to now), geo (GeoJSON
+geometry), search, metadata, tdf_uri and entity_key. The TDF is either tdf_blob, base64 encoded,
+or tdf_blob_file, a path relative to the file. For example:
+
+  {"src_type": "vehicle", "ts": "2024-06-01T12:00:00Z", "geo": {"type": "Point", "coordinates": [-77.03, 38.89]}, "tdf_blob_file": "alpha.tdf"}
+
+As with the create API, the entity_key falls back to the entityField of the source type.
+`
+
+const dbUpdateStreamItemCmdLong = `
+Update the fields of a stream item given as flags. Fields without a flag are left unchanged.
+`
+
+const dbExportCmdLong = `
+Export stream items between two timestamps as NDJSON, one stream item per line with its notes
+nested under it. Source types, timestamps and the geometry and search flags select stream items
+as the list command does.
+
+TDF blobs are exported base64 encoded as they are stored, still encrypted, so an export can be
+moved between enclaves as is. Search and metadata indexes are exported in plaintext.
+
+The export is written to stdout unless --file is given, progress is reported on stderr.
+`
+
+const dbImportCmdLong = `
+Import an NDJSON export of the export command, read from stdin when the file is omitted or "-".
+
+Stream items and notes keep their ids, and records whose id already exists are skipped, so an
+import may be run again after a failure. Records are inserted in batches and progress is reported
+after every batch.
+`
+
+const dbPurgeCmdLong = `
+Purge stream items past the retention rule of their source type.
+
+Rules are read from the "retention" object of the source type metadata, falling back to the
+retention section of the config. When every source type has a max age, the monthly partitions
+older than the largest max age are dropped first. Items older than the max age are removed next,
+then the oldest items over the max rows. Notes, attachments and revisions of removed items are
+removed with them, and the payloads of the blob store of them all.
+
+Revisions of stream items older than retention.revisions.max_age are removed last, then the oldest
+revisions of each item over retention.revisions.max_count. The latest revision of an item is kept,
+unless it records the delete of the item.
+
+Use --dry-run to report what would be removed without removing anything.
 `
 
+const dbMigrateCmdLong = `
+Manage the database schema migrations.
+
+Migrations are numbered files in db/migrations, starting with 0001_schema which creates the
+schema. They are embedded in the binary, and applied migrations are recorded in the
+schema_migrations table.
+Each migration is applied in its own transaction.
+
+The server applies the pending migrations when it starts, set db_migrate in the config to "check"
+or "off" to only check the schema or leave it alone.
+`
+
+// dbCreateBatchSize is the number of stream items inserted per batch by the create command
+const dbCreateBatchSize = 500
+
 var reDateYYYYMM = regexp.MustCompile(`^\d{4}-\d{2}$`)
 var reDateYYYYMMDD = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
 var reDateYYYYMMDDHHMM = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}$`)
 var reDateYYYYMMDDHHMMSS = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z?$`)
 
 var (
-	dbCtx context.Context
-	dbQ   *db.Queries
+	dbCtx  context.Context
+	dbConn *pgxpool.Pool
+	dbQ    *db.Queries
 
 	dbCmd = &cobra.Command{
 		Use:   "db",
@@ -47,27 +131,27 @@ var (
 	}
 
 	dbListStreamCmd = &cobra.Command{
-		Use:   "list <source-type> <from-datetime> [<to-datetime>]",
-		Short: "List stream items of a source-type between two timestamps",
+		Use:   "list <source-type>[,<source-type>...] <from-datetime> [<to-datetime>]",
+		Short: "List stream items of one or more source-types between two timestamps",
 		Long:  dbGetStreamByRangeCmdLong,
 		Args:  cobra.RangeArgs(2, 3),
 		Run:   dbListStream,
 	}
 
-	// unimplemented
 	dbCreateStreamItemCmd = &cobra.Command{
-		Hidden: true,
-		Use:    "create <file>",
-		Short:  "Create stream item",
-		Args:   cobra.MaximumNArgs(1),
+		Use:   "create [<file>]",
+		Short: "Create stream items from a JSON or NDJSON file",
+		Long:  dbCreateStreamItemCmdLong,
+		Args:  cobra.MaximumNArgs(1),
+		Run:   dbCreateStreamItem,
 	}
 
-	// unimplemented
 	dbUpdateStreamItemCmd = &cobra.Command{
-		Hidden: true,
-		Use:    "update <id>",
-		Args:   cobra.ExactArgs(1),
-		Short:  "Update stream item",
+		Use:   "update <id>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Update stream item",
+		Long:  dbUpdateStreamItemCmdLong,
+		Run:   dbUpdateStreamItem,
 	}
 
 	dbDeleteStreamItemCmd = &cobra.Command{
@@ -77,6 +161,61 @@ var (
 		Run:   dbDeleteStreamItem,
 	}
 
+	dbExportCmd = &cobra.Command{
+		Use:   "export <source-type>[,<source-type>...] <from-datetime> [<to-datetime>]",
+		Short: "Export stream items and their notes as NDJSON",
+		Long:  dbExportCmdLong,
+		Args:  cobra.RangeArgs(2, 3),
+		Run:   dbExport,
+	}
+
+	dbImportCmd = &cobra.Command{
+		Use:   "import [<file>]",
+		Short: "Import stream items and their notes from an export",
+		Long:  dbImportCmdLong,
+		Args:  cobra.MaximumNArgs(1),
+		Run:   dbImport,
+	}
+
+	dbPurgeCmd = &cobra.Command{
+		Use:   "purge",
+		Short: "Purge stream items past their retention",
+		Long:  dbPurgeCmdLong,
+		Args:  cobra.NoArgs,
+		Run:   dbPurge,
+	}
+
+	////////////////////////
+	// Migrate commands
+	////////////////////////
+
+	dbMigrateCmd = &cobra.Command{
+		Use:   "migrate",
+		Short: "Database schema migrations",
+		Long:  dbMigrateCmdLong,
+	}
+
+	dbMigrateUpCmd = &cobra.Command{
+		Use:   "up",
+		Short: "Apply pending migrations",
+		Args:  cobra.NoArgs,
+		Run:   dbMigrateUp,
+	}
+
+	dbMigrateDownCmd = &cobra.Command{
+		Use:   "down [<steps>]",
+		Short: "Revert the latest migrations, one by default",
+		Args:  cobra.MaximumNArgs(1),
+		Run:   dbMigrateDown,
+	}
+
+	dbMigrateStatusCmd = &cobra.Command{
+		Use:   "status",
+		Short: "List migrations and whether they are applied",
+		Args:  cobra.NoArgs,
+		Run:   dbMigrateStatus,
+	}
+
 	////////////////////////
 	// Mock commands
 	////////////////////////
@@ -96,30 +235,34 @@ var (
 )
 
 func init() {
-	var conn *pgxpool.Pool
 	dbCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
 		slog.InfoContext(dbCtx, "Connecting to database")
-		if conn == nil {
+		if dbConn == nil {
 			dbCtx = cmd.Context()
 			var err error
 
-			conn, err = db.NewPool(dbCtx, cfg)
+			dbConn, err = db.NewPool(dbCtx, cfg)
 			if err != nil {
 				slog.ErrorContext(dbCtx, "Error connecting to database", err)
 				panic(err)
 			}
 
-			dbQ = db.New(conn)
+			dbQ = db.New(dbConn)
 		}
 	}
 
 	dbCmd.PersistentPostRun = func(cmd *cobra.Command, args []string) {
-		if conn != nil {
+		if dbConn != nil {
 			slog.InfoContext(dbCtx, "Closing database connection")
-			conn.Close()
+			dbConn.Close()
 		}
 	}
 
+	dbMigrateCmd.AddCommand(dbMigrateUpCmd)
+	dbMigrateCmd.AddCommand(dbMigrateDownCmd)
+	dbMigrateCmd.AddCommand(dbMigrateStatusCmd)
+	dbCmd.AddCommand(dbMigrateCmd)
+
 	dbMockCmd.AddCommand(dbMockCreateCmd)
 	dbCmd.AddCommand(dbMockCmd)
 
@@ -130,10 +273,30 @@ func init() {
 	dbCmd.AddCommand(dbListStreamCmd)
 	dbListStreamCmd.Flags().StringP("geometry", "g", "", "Geometry to search for")
 	dbListStreamCmd.Flags().StringP("search", "s", "", "JSON search query")
+	dbListStreamCmd.Flags().StringP("output", "o", outputTable, "Output format: "+strings.Join(listOutputs, "|"))
 	// U - Update
 	dbCmd.AddCommand(dbUpdateStreamItemCmd)
+	dbUpdateStreamItemCmd.Flags().String("ts", "", "Timestamp (e.g. 2024-06-01T12:00:00Z)")
+	dbUpdateStreamItemCmd.Flags().String("src-type", "", "Source type")
+	dbUpdateStreamItemCmd.Flags().StringP("geometry", "g", "", "GeoJSON geometry")
+	dbUpdateStreamItemCmd.Flags().StringP("search", "s", "", "JSON search index")
+	dbUpdateStreamItemCmd.Flags().String("metadata", "", "JSON metadata index")
+	dbUpdateStreamItemCmd.Flags().String("tdf-blob", "", "Base64 encoded TDF")
+	dbUpdateStreamItemCmd.Flags().String("tdf-blob-file", "", "Path of a TDF file")
+	dbUpdateStreamItemCmd.Flags().String("tdf-uri", "", "TDF URI")
+	dbUpdateStreamItemCmd.Flags().String("entity-key", "", "Entity key")
+	dbUpdateStreamItemCmd.MarkFlagsMutuallyExclusive("tdf-blob", "tdf-blob-file")
 	// D - Delete
 	dbCmd.AddCommand(dbDeleteStreamItemCmd)
+	dbCmd.AddCommand(dbPurgeCmd)
+	// Export and import
+	dbCmd.AddCommand(dbExportCmd)
+	dbExportCmd.Flags().StringP("geometry", "g", "", "Geometry to search for")
+	dbExportCmd.Flags().StringP("search", "s", "", "JSON search query")
+	dbExportCmd.Flags().StringP("file", "f", "", "File to write the export to (default stdout)")
+	dbCmd.AddCommand(dbImportCmd)
+	dbImportCmd.Flags().Int("batch-size", 500, "Number of stream items inserted per batch")
+	dbPurgeCmd.Flags().Bool("dry-run", false, "Report what would be purged without removing anything")
 	rootCmd.AddCommand(dbCmd)
 }
 
@@ -152,29 +315,37 @@ func dbGetStreamItem(cmd *cobra.Command, args []string) {
 	fmt.Printf("\tGot record %s: %s, %s\n", item.ID.String(), item.SrcType, item.Ts.Time)
 }
 
-func dbListStream(cmd *cobra.Command, args []string) {
-	sourceType := args[0]
+// parseTime parses an RFC 3339 timestamp or a short date format in UTC (e.g. 2024-06, 2024-06-01T12:00)
+func parseTime(t string) (time.Time, error) {
+	// handle short date formats
+	if reDateYYYYMM.MatchString(t) {
+		t = t + "-01T00:00:00Z"
+	} else if reDateYYYYMMDD.MatchString(t) {
+		t = t + "T00:00:00Z"
+	} else if reDateYYYYMMDDHHMM.MatchString(t) {
+		t = t + ":00Z"
+	} else if reDateYYYYMMDDHHMMSS.MatchString(t) {
+		t = t + "Z"
+	}
+
+	return time.Parse(time.RFC3339, t)
+}
+
+// parseStreamFilter parses the <source-type>[,<source-type>...] <from-datetime> [<to-datetime>] arguments and the
+// geometry and search flags shared by the list and export commands
+func parseStreamFilter(cmd *cobra.Command, args []string) (records.Filter, error) {
+	var filter records.Filter
+	for _, t := range strings.Split(args[0], ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			filter.SrcTypes = append(filter.SrcTypes, strings.ToLower(t))
+		}
+	}
 	startTimeInput := args[1]
 	endTimeInput := ""
 	if len(args) == 3 {
 		endTimeInput = args[2]
 	}
 
-	parseTime := func(t string) (time.Time, error) {
-		// handle short date formats
-		if reDateYYYYMM.MatchString(t) {
-			t = t + "-01T00:00:00Z"
-		} else if reDateYYYYMMDD.MatchString(t) {
-			t = t + "T00:00:00Z"
-		} else if reDateYYYYMMDDHHMM.MatchString(t) {
-			t = t + ":00Z"
-		} else if reDateYYYYMMDDHHMMSS.MatchString(t) {
-			t = t + "Z"
-		}
-
-		return time.Parse(time.RFC3339, t)
-	}
-
 	var parseErr error
 	endTime := time.Now().UTC()
 	startTime, err := parseTime(startTimeInput)
@@ -191,47 +362,72 @@ func dbListStream(cmd *cobra.Command, args []string) {
 	}
 
 	if parseErr != nil {
-		fmt.Printf("Error parsing timestamp(s): %v\n", parseErr)
-		return
+		return filter, fmt.Errorf("error parsing timestamp(s): %w", parseErr)
 	}
-
-	msg := fmt.Sprintf("Getting data for %s between %s and %s", sourceType, startTime.String(), endTime.String())
-
-	includeGeo := false
-	includeSearch := false
-	// check flags
+	filter.Start, filter.End = startTime, endTime
 
 	geometry := cmd.Flag("geometry").Value.String()
-	geoGeom := geometry
 	if geometry != "" {
 		slog.InfoContext(dbCtx, "Searching for geometry", "geometry", geometry)
 
 		// validate the geom
 		if !strings.HasPrefix(geometry, "SRID=") {
-			geoGeom = "SRID=" + strconv.Itoa(geo.DEFAULT_SRID) + ";" + geometry
+			geometry = "SRID=" + strconv.Itoa(geo.DEFAULT_SRID) + ";" + geometry
 		}
+		filter.Geometry = geometry
+	}
+
+	if search := cmd.Flag("search").Value.String(); search != "" {
+		filter.Search = []byte(search)
+	}
+
+	return filter, nil
+}
+
+func dbListStream(cmd *cobra.Command, args []string) {
+	output, _ := cmd.Flags().GetString("output")
+	if !validListOutput(output) {
+		fmt.Printf("Err: Invalid output %s, expected one of %s\n", output, strings.Join(listOutputs, ", "))
+		return
+	}
+	// status messages go to stderr unless a table is listed, keeping stdout parseable
+	status := os.Stderr
+	if output == outputTable {
+		status = os.Stdout
+	}
 
-		includeGeo = true
+	filter, err := parseStreamFilter(cmd, args)
+	if err != nil {
+		fmt.Fprintln(status, err)
+		return
 	}
+	sourceTypes, startTime, endTime := filter.SrcTypes, filter.Start, filter.End
+	geoGeom, search := filter.Geometry, string(filter.Search)
 
-	search := cmd.Flag("search").Value.String()
-	if search != "" {
+	sourceTypeMsg := "all source types"
+	if len(sourceTypes) > 0 {
+		sourceTypeMsg = strings.Join(sourceTypes, ", ")
+	}
+	msg := fmt.Sprintf("Getting data for %s between %s and %s", sourceTypeMsg, startTime.String(), endTime.String())
+
+	includeGeo := geoGeom != ""
+	includeSearch := search != ""
+	if includeSearch {
 		msg += " " + fmt.Sprintf("filtering by search %s", search)
-		includeSearch = true
 	}
 
-	fmt.Println(msg)
+	fmt.Fprintln(status, msg)
 
 	var items []db.ListTdfObjectsRow
 	if includeGeo && includeSearch {
 		slog.DebugContext(dbCtx, "Searching for geometry and filtering by search")
 		var rows []db.ListTdfObjectsWithSearchAndGeoRow
 		rows, err = dbQ.ListTdfObjectsWithSearchAndGeo(dbCtx, db.ListTdfObjectsWithSearchAndGeoParams{
-			SourceType: sourceType,
-			StartTime:  pgtype.Timestamp{Time: startTime, Valid: true},
-			EndTime:    pgtype.Timestamp{Time: endTime, Valid: true},
-			Geometry:   geoGeom,
-			Search:     []byte(search),
+			SourceTypes: sourceTypes,
+			StartTime:   pgtype.Timestamp{Time: startTime, Valid: true},
+			EndTime:     pgtype.Timestamp{Time: endTime, Valid: true},
+			Geometry:    geoGeom,
+			Search:      []byte(search),
 		})
 		if err == nil {
 			for _, r := range rows {
@@ -242,10 +438,10 @@ func dbListStream(cmd *cobra.Command, args []string) {
 		slog.DebugContext(dbCtx, "Searching for geometry")
 		var rows []db.ListTdfObjectsWithGeoRow
 		rows, err = dbQ.ListTdfObjectsWithGeo(dbCtx, db.ListTdfObjectsWithGeoParams{
-			SourceType: sourceType,
-			StartTime:  pgtype.Timestamp{Time: startTime, Valid: true},
-			EndTime:    pgtype.Timestamp{Time: endTime, Valid: true},
-			Geometry:   geoGeom,
+			SourceTypes: sourceTypes,
+			StartTime:   pgtype.Timestamp{Time: startTime, Valid: true},
+			EndTime:     pgtype.Timestamp{Time: endTime, Valid: true},
+			Geometry:    geoGeom,
 		})
 		if err == nil {
 			for _, r := range rows {
@@ -256,10 +452,10 @@ func dbListStream(cmd *cobra.Command, args []string) {
 		slog.DebugContext(dbCtx, "Filtering by search")
 		var rows []db.ListTdfObjectsWithSearchRow
 		rows, err = dbQ.ListTdfObjectsWithSearch(dbCtx, db.ListTdfObjectsWithSearchParams{
-			SourceType: sourceType,
-			StartTime:  pgtype.Timestamp{Time: startTime, Valid: true},
-			EndTime:    pgtype.Timestamp{Time: endTime, Valid: true},
-			Search:     []byte(search),
+			SourceTypes: sourceTypes,
+			StartTime:   pgtype.Timestamp{Time: startTime, Valid: true},
+			EndTime:     pgtype.Timestamp{Time: endTime, Valid: true},
+			Search:      []byte(search),
 		})
 		if err == nil {
 			for _, r := range rows {
@@ -269,21 +465,142 @@ func dbListStream(cmd *cobra.Command, args []string) {
 	} else {
 		slog.DebugContext(dbCtx, "Default search type")
 		items, err = dbQ.ListTdfObjects(dbCtx, db.ListTdfObjectsParams{
-			SourceType: sourceType,
-			StartTime:  pgtype.Timestamp{Time: startTime, Valid: true},
-			EndTime:    pgtype.Timestamp{Time: endTime, Valid: true},
+			SourceTypes: sourceTypes,
+			StartTime:   pgtype.Timestamp{Time: startTime, Valid: true},
+			EndTime:     pgtype.Timestamp{Time: endTime, Valid: true},
 		})
 	}
 
 	if err != nil {
-		fmt.Println("Error getting records", err)
+		fmt.Fprintln(status, "Error getting records", err)
 		return
 	}
 
-	fmt.Printf("...found %d record(s)\n", len(items))
-	for _, r := range items {
-		fmt.Printf("\t%s: %s, %s\n", r.ID.String(), r.SrcType, r.Ts.Time)
+	fmt.Fprintf(status, "...found %d record(s)\n", len(items))
+	if err := writeListOutput(os.Stdout, output, items); err != nil {
+		fmt.Fprintln(status, "Error writing records", err)
+	}
+}
+
+func dbCreateStreamItem(cmd *cobra.Command, args []string) {
+	in, dir := os.Stdin, "."
+	if len(args) == 1 && args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			fmt.Println("Error opening file", err)
+			return
+		}
+		defer f.Close()
+		in, dir = f, filepath.Dir(args[0])
+	}
+
+	recs, err := records.Read(in)
+	if err != nil {
+		fmt.Println("Error reading records", err)
+		return
+	}
+
+	params := make([]db.CreateTdfObjectsParams, 0, len(recs))
+	for i, r := range recs {
+		p, err := r.CreateParams(dir)
+		if err == nil && !p.EntityKey.Valid {
+			var entityKey string
+			entityKey, err = api.LookupEntityKey(dbCtx, dbQ, p.SrcType, p.Metadata, p.Search)
+			p.EntityKey = pgtype.Text{String: entityKey, Valid: entityKey != ""}
+		}
+		if err != nil {
+			fmt.Printf("Error in record %d: %v\n", i+1, err)
+			return
+		}
+		params = append(params, p)
+	}
+
+	fmt.Printf("Inserting %d record(s) into database\n", len(params))
+	for start := 0; start < len(params); start += dbCreateBatchSize {
+		batch := params[start:min(start+dbCreateBatchSize, len(params))]
+		dbQ.CreateTdfObjects(dbCtx, batch).QueryRow(func(i int, id uuid.UUID, err error) {
+			if err != nil {
+				fmt.Printf("Error inserting record %d: %v\n", start+i+1, err)
+				return
+			}
+			fmt.Printf("\tInserted record %s\n", id.String())
+		})
+	}
+}
+
+func dbUpdateStreamItem(cmd *cobra.Command, args []string) {
+	id, err := uuid.Parse(args[0])
+	if err != nil {
+		fmt.Printf("Error parsing UUID %s: %v\n", args[0], err)
+		return
+	}
+
+	params := db.UpdateTdfObjectParams{ID: id}
+	flags := cmd.Flags()
+	if flags.NFlag() == 0 {
+		fmt.Println("Err: No fields to update")
+		return
+	}
+	if flags.Changed("ts") {
+		v, _ := flags.GetString("ts")
+		ts, err := parseTime(v)
+		if err != nil {
+			fmt.Printf("Error parsing timestamp: %v\n", err)
+			return
+		}
+		params.Ts = pgtype.Timestamp{Time: ts.UTC(), Valid: true}
+	}
+	if flags.Changed("src-type") {
+		v, _ := flags.GetString("src-type")
+		params.SrcType = pgtype.Text{String: strings.ToLower(v), Valid: true}
+	}
+	if flags.Changed("geometry") {
+		v, _ := flags.GetString("geometry")
+		params.Geo, err = records.Geometry([]byte(v))
+		if err != nil {
+			fmt.Println("Error parsing geometry", err)
+			return
+		}
+	}
+	if flags.Changed("search") {
+		v, _ := flags.GetString("search")
+		params.Search = []byte(v)
+	}
+	if flags.Changed("metadata") {
+		v, _ := flags.GetString("metadata")
+		params.Metadata = []byte(v)
+	}
+	if flags.Changed("tdf-blob") {
+		v, _ := flags.GetString("tdf-blob")
+		params.TdfBlob, err = base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			fmt.Println("Error decoding TDF", err)
+			return
+		}
+	}
+	if flags.Changed("tdf-blob-file") {
+		v, _ := flags.GetString("tdf-blob-file")
+		params.TdfBlob, err = os.ReadFile(v)
+		if err != nil {
+			fmt.Println("Error reading TDF file", err)
+			return
+		}
+	}
+	if flags.Changed("tdf-uri") {
+		v, _ := flags.GetString("tdf-uri")
+		params.TdfUri = pgtype.Text{String: v, Valid: true}
+	}
+	if flags.Changed("entity-key") {
+		v, _ := flags.GetString("entity-key")
+		params.EntityKey = pgtype.Text{String: v, Valid: true}
+	}
+
+	item, err := dbQ.UpdateTdfObject(dbCtx, params)
+	if err != nil {
+		fmt.Println("Error updating record", err)
+		return
 	}
+	fmt.Printf("\tUpdated record %s: %s, %s\n", item.ID.String(), item.SrcType, item.Ts.Time)
 }
 
 func dbDeleteStreamItem(cmd *cobra.Command, args []string) {
@@ -300,6 +617,193 @@ func dbDeleteStreamItem(cmd *cobra.Command, args []string) {
 		return
 	}
 	fmt.Printf("\tDeleted record %s: %s, %s\n", item.ID.String(), item.SrcType, item.Ts.Time)
+	dbDeleteBlobs()
+}
+
+// dbDeleteBlobs deletes the payloads of the deleted stream items from the blob store
+func dbDeleteBlobs() {
+	blobs, err := blobstore.New(dbCtx, cfg)
+	if err != nil {
+		fmt.Println("Error creating blob store", err)
+		return
+	}
+	deleted, err := retention.DeleteBlobs(dbCtx, dbQ, blobs)
+	if err != nil {
+		fmt.Println("Error deleting blobs", err)
+		return
+	}
+	if deleted > 0 {
+		fmt.Printf("\tDeleted %d blobs\n", deleted)
+	}
+}
+
+func dbPurge(cmd *cobra.Command, args []string) {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	if dryRun {
+		fmt.Println("Dry run, reporting stream items that would be purged")
+	} else {
+		fmt.Println("Purging stream items from database")
+	}
+
+	rules, err := retention.Rules(dbCtx, dbQ, cfg)
+	if err != nil {
+		fmt.Println("Error getting retention rules", err)
+		return
+	}
+
+	partitions, err := retention.DropPartitions(dbCtx, dbQ, rules, dryRun)
+	if err != nil {
+		fmt.Println("Error dropping partitions", err)
+		return
+	}
+	for _, p := range partitions {
+		fmt.Printf("\tpartition %s: expired\n", p)
+	}
+
+	results, err := retention.Purge(dbCtx, dbConn, rules, dryRun)
+	if err != nil {
+		fmt.Println("Error purging records", err)
+		return
+	}
+
+	if len(results) == 0 {
+		fmt.Println("...no source types have a retention rule")
+	}
+	for _, r := range results {
+		fmt.Printf("\t%s (max age %s, max rows %d): %d expired, %d excess\n", r.SrcType, r.MaxAge, r.MaxRows, r.Expired, r.Excess)
+	}
+
+	revisions, err := retention.PurgeRevisions(dbCtx, dbQ, cfg, dryRun)
+	if err != nil {
+		fmt.Println("Error purging revisions", err)
+		return
+	}
+	fmt.Printf("\trevisions (max age %s, max count %d): %d expired, %d excess\n",
+		cfg.Retention.Revisions.MaxAge, cfg.Retention.Revisions.MaxCount, revisions.Expired, revisions.Excess)
+	if !dryRun {
+		dbDeleteBlobs()
+	}
+}
+
+func dbExport(cmd *cobra.Command, args []string) {
+	filter, err := parseStreamFilter(cmd, args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	out := os.Stdout
+	if file, _ := cmd.Flags().GetString("file"); file != "" {
+		f, err := os.Create(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error creating file", err)
+			return
+		}
+		defer f.Close()
+		out = f
+	}
+
+	w := bufio.NewWriter(out)
+	exported, err := records.Export(dbCtx, dbQ, filter, w, func(exported int) {
+		fmt.Fprintf(os.Stderr, "\tExported %d record(s)\n", exported)
+	})
+	if flushErr := w.Flush(); err == nil {
+		err = flushErr
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error exporting records", err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "...exported %d record(s)\n", exported)
+}
+
+func dbImport(cmd *cobra.Command, args []string) {
+	batchSize, _ := cmd.Flags().GetInt("batch-size")
+	if batchSize < 1 {
+		fmt.Printf("Err: Invalid batch size %d\n", batchSize)
+		return
+	}
+
+	in := os.Stdin
+	if len(args) == 1 && args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			fmt.Println("Error opening file", err)
+			return
+		}
+		defer f.Close()
+		in = f
+	}
+
+	fmt.Println("Importing records into database")
+	result, err := records.Import(dbCtx, dbQ, bufio.NewReader(in), batchSize, func(r records.ImportResult) {
+		fmt.Printf("\tImported %d record(s), skipped %d existing record(s), imported %d note(s)\n", r.Imported, r.Skipped, r.Notes)
+	})
+	if err != nil {
+		fmt.Println("Error importing records", err)
+		return
+	}
+	fmt.Printf("...imported %d record(s), skipped %d existing record(s), imported %d note(s)\n", result.Imported, result.Skipped, result.Notes)
+}
+
+func dbMigrateUp(cmd *cobra.Command, args []string) {
+	fmt.Println("Applying pending migrations")
+	migrations, err := db.MigrateUp(dbCtx, dbConn)
+	for _, m := range migrations {
+		fmt.Printf("\tApplied migration %d %s\n", m.Version, m.Name)
+	}
+	if err != nil {
+		fmt.Println("Error applying migrations", err)
+		// a failed migration fails the database setup jobs running it
+		dbConn.Close()
+		os.Exit(1)
+	}
+	if len(migrations) == 0 {
+		fmt.Println("...schema is up to date")
+	}
+}
+
+func dbMigrateDown(cmd *cobra.Command, args []string) {
+	steps := 1
+	if len(args) == 1 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 {
+			fmt.Printf("Err: Invalid steps %s\n", args[0])
+			return
+		}
+		steps = n
+	}
+
+	fmt.Printf("Reverting %d migration(s)\n", steps)
+	migrations, err := db.MigrateDown(dbCtx, dbConn, steps)
+	for _, m := range migrations {
+		fmt.Printf("\tReverted migration %d %s\n", m.Version, m.Name)
+	}
+	if err != nil {
+		fmt.Println("Error reverting migrations", err)
+		return
+	}
+	if len(migrations) == 0 {
+		fmt.Println("...no migrations are applied")
+	}
+}
+
+func dbMigrateStatus(cmd *cobra.Command, args []string) {
+	statuses, err := db.MigrationStatuses(dbCtx, dbConn)
+	if err != nil {
+		fmt.Println("Error getting migration status", err)
+		return
+	}
+	for _, s := range statuses {
+		status := "pending"
+		if s.AppliedAt != nil {
+			status = "applied " + s.AppliedAt.Format(time.RFC3339)
+		}
+		if !s.Known {
+			status += " (unknown to this binary)"
+		}
+		fmt.Printf("\t%04d %s: %s\n", s.Version, s.Name, status)
+	}
 }
 
 func dbMockCreateData(cmd *cobra.Command, args []string) {
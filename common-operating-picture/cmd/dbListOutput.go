@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	geos "github.com/twpayne/go-geos"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/geo"
+)
+
+// Output formats of the db list command
+const (
+	outputTable   = "table"
+	outputGeoJSON = "geojson"
+	outputNDJSON  = "ndjson"
+	outputCSV     = "csv"
+)
+
+var listOutputs = []string{outputTable, outputGeoJSON, outputNDJSON, outputCSV}
+
+func validListOutput(output string) bool {
+	for _, o := range listOutputs {
+		if o == output {
+			return true
+		}
+	}
+	return false
+}
+
+// writeListOutput writes stream items in an output format. The TDF blob is never written, search attributes become
+// GeoJSON properties.
+func writeListOutput(w io.Writer, output string, items []db.ListTdfObjectsRow) error {
+	switch output {
+	case outputGeoJSON:
+		features := make([]geo.Feature, 0, len(items))
+		for _, r := range items {
+			features = append(features, listItemFeature(r))
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(geo.NewFeatureCollection(features))
+	case outputNDJSON:
+		enc := json.NewEncoder(w)
+		for _, r := range items {
+			if err := enc.Encode(listItemFeature(r)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case outputCSV:
+		cw := csv.NewWriter(w)
+		// QGIS picks up geometry from a column named wkt
+		if err := cw.Write([]string{"id", "ts", "src_type", "entity_key", "tdf_uri", "wkt", "search"}); err != nil {
+			return err
+		}
+		for _, r := range items {
+			wkt := ""
+			if g := listItemGeom(r); g != nil {
+				wkt = g.ToWKT()
+			}
+			if err := cw.Write([]string{
+				r.ID.String(),
+				r.Ts.Time.Format(time.RFC3339Nano),
+				r.SrcType,
+				r.EntityKey.String,
+				r.TdfUri.String,
+				wkt,
+				string(r.Search),
+			}); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tSRC TYPE\tTS\tENTITY KEY")
+		for _, r := range items {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", r.ID, r.SrcType, r.Ts.Time.Format(time.RFC3339), r.EntityKey.String)
+		}
+		return tw.Flush()
+	default:
+		return fmt.Errorf("unknown output %q, expected one of %s", output, strings.Join(listOutputs, ", "))
+	}
+}
+
+func listItemGeom(r db.ListTdfObjectsRow) *geos.Geom {
+	g, _ := r.Geo.(*geos.Geom)
+	return g
+}
+
+func listItemFeature(r db.ListTdfObjectsRow) geo.Feature {
+	properties := map[string]interface{}{
+		"src_type": r.SrcType,
+		"ts":       r.Ts.Time.Format(time.RFC3339Nano),
+	}
+	if r.EntityKey.Valid {
+		properties["entity_key"] = r.EntityKey.String
+	}
+	if r.TdfUri.Valid {
+		properties["tdf_uri"] = r.TdfUri.String
+	}
+	geo.SetJSONProperties(properties, r.Search)
+
+	geometry := ""
+	if g := listItemGeom(r); g != nil {
+		geometry = g.ToGeoJSON(0)
+	}
+	return geo.NewFeature(r.ID.String(), geometry, properties)
+}
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/virtru-corp/dsp-cop/api"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/adsb"
+	"github.com/virtru-corp/dsp-cop/pkg/ais"
+	"github.com/virtru-corp/dsp-cop/pkg/blobstore"
+	"github.com/virtru-corp/dsp-cop/pkg/tdf"
+)
+
+const ingestAISCmdLong = `
+Ingest NMEA 0183 AIVDM sentences of an AIS feed, storing every vessel position report as a stream
+item of the ais.src_type source type keyed by MMSI.
+
+The source is one of:
+
+  tcp://host:port   connect to a feed, connecting again when the connection is lost
+  udp://host:port   listen for datagrams of sentences, e.g. udp://:10110
+  <file>            read a file of sentences, "-" reads stdin
+
+Message types 1, 2, 3 and 18 (position reports) are stored with the name, callsign and ship type
+of the latest type 5 and 24 messages (static data) of the vessel. The vessel is wrapped in a TDF of
+the ais.attributes, and the command runs until the file ends or it is interrupted.
+`
+
+const ingestADSBCmdLong = `
+Ingest the SBS-1 BaseStation messages of an ADS-B receiver, such as port 30003 of dump1090, storing
+aircraft as stream items of the adsb.src_type source type keyed by ICAO address.
+
+The source is one of:
+
+  tcp://host:port   connect to a receiver, e.g. tcp://localhost:30003
+  <file>            read a recording of messages, "-" reads stdin
+
+The state of every aircraft is built from its messages. Once its position is known, the aircraft is
+created through the same code path as the CreateTdfObject API and then updated through the
+UpdateTdfObject API at most once per adsb.interval seconds of message time, or created every time
+with adsb.history. The aircraft is wrapped in a TDF of the adsb.attributes.
+
+Message times are the local time of the receiver, use --utc for a receiver in UTC.
+`
+
+var (
+	ingestCmd = &cobra.Command{
+		Use:   "ingest",
+		Short: "Ingest external feeds as stream items",
+	}
+
+	ingestAISCmd = &cobra.Command{
+		Use:   "ais <source>",
+		Short: "Ingest AIS vessel position reports",
+		Long:  ingestAISCmdLong,
+		Args:  cobra.ExactArgs(1),
+		Run:   ingestAIS,
+	}
+
+	ingestADSBCmd = &cobra.Command{
+		Use:   "adsb <source>",
+		Short: "Ingest ADS-B aircraft of SBS-1 BaseStation messages",
+		Long:  ingestADSBCmdLong,
+		Args:  cobra.ExactArgs(1),
+		Run:   ingestADSB,
+	}
+)
+
+func init() {
+	ingestCmd.AddCommand(ingestAISCmd)
+	ingestCmd.AddCommand(ingestADSBCmd)
+	ingestADSBCmd.Flags().Bool("utc", false, "Message times are UTC instead of local time")
+	rootCmd.AddCommand(ingestCmd)
+}
+
+func ingestAIS(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	dbPool, err := db.NewPool(ctx, cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error connecting to database", err)
+		return
+	}
+	defer dbPool.Close()
+
+	sdk, err := api.InitSDK(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error initializing SDK", err)
+		return
+	}
+
+	tdfType := tdf.ZTDF
+	if cfg.AIS.NanoTDF {
+		tdfType = tdf.NanoTDF
+	}
+	in := &ais.Ingester{
+		Queries:    db.New(dbPool),
+		Encrypter:  tdf.Handler{SDK: sdk, PlatformEndpoint: cfg.PlatformEndpoint},
+		SrcType:    strings.ToLower(cfg.AIS.SrcType),
+		AttrValues: cfg.AIS.Attributes,
+		TDFType:    tdfType,
+	}
+
+	slog.InfoContext(ctx, "ingesting AIS", slog.String("source", args[0]), slog.String("src_type", in.SrcType))
+	if err := in.Serve(ctx, args[0]); err != nil {
+		fmt.Fprintln(os.Stderr, "Error ingesting AIS", err)
+	}
+	fmt.Fprintf(os.Stderr, "...stored %d position report(s)\n", in.Stored)
+}
+
+func ingestADSB(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	dbPool, err := db.NewPool(ctx, cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error connecting to database", err)
+		return
+	}
+	defer dbPool.Close()
+
+	sdk, err := api.InitSDK(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error initializing SDK", err)
+		return
+	}
+
+	tdfType := tdf.ZTDF
+	if cfg.ADSB.NanoTDF {
+		tdfType = tdf.NanoTDF
+	}
+	loc := time.Local
+	if utc, _ := cmd.Flags().GetBool("utc"); utc {
+		loc = time.UTC
+	}
+	blobs, err := blobstore.New(ctx, cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error creating blob store", err)
+		return
+	}
+	store, err := api.NewTdfObjectServer(cfg, dbPool, sdk, blobs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error creating tdf_object server", err)
+		return
+	}
+
+	in := &adsb.Ingester{
+		Store:      store,
+		Encrypter:  tdf.Handler{SDK: sdk, PlatformEndpoint: cfg.PlatformEndpoint},
+		SrcType:    strings.ToLower(cfg.ADSB.SrcType),
+		AttrValues: cfg.ADSB.Attributes,
+		TDFType:    tdfType,
+		Interval:   time.Duration(cfg.ADSB.Interval) * time.Second,
+		History:    cfg.ADSB.History,
+		Location:   loc,
+	}
+
+	slog.InfoContext(ctx, "ingesting ADS-B", slog.String("source", args[0]), slog.String("src_type", in.SrcType))
+	if err := in.Serve(ctx, args[0]); err != nil {
+		fmt.Fprintln(os.Stderr, "Error ingesting ADS-B", err)
+	}
+	fmt.Fprintf(os.Stderr, "...created %d and updated %d aircraft record(s)\n", in.Created, in.Updated)
+}
@@ -84,8 +84,8 @@ func (b *CreateNoteObjectBatchResults) Close() error {
 }
 
 const createTdfObjects = `-- name: CreateTdfObjects :batchone
-INSERT INTO tdf_objects (ts, src_type, geo, search, metadata, tdf_blob, tdf_uri)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO tdf_objects (ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, entity_key)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING id
 `
 
@@ -96,19 +96,20 @@ type CreateTdfObjectsBatchResults struct {
 }
 
 type CreateTdfObjectsParams struct {
-	Ts       pgtype.Timestamp `json:"ts"`
-	SrcType  string           `json:"src_type"`
-	Geo      *geos.Geom       `json:"geo"`
-	Search   []byte           `json:"search"`
-	Metadata []byte           `json:"metadata"`
-	TdfBlob  []byte           `json:"tdf_blob"`
-	TdfUri   pgtype.Text      `json:"tdf_uri"`
+	Ts        pgtype.Timestamp `json:"ts"`
+	SrcType   string           `json:"src_type"`
+	Geo       *geos.Geom       `json:"geo"`
+	Search    []byte           `json:"search"`
+	Metadata  []byte           `json:"metadata"`
+	TdfBlob   []byte           `json:"tdf_blob"`
+	TdfUri    pgtype.Text      `json:"tdf_uri"`
+	EntityKey pgtype.Text      `json:"entity_key"`
 }
 
 // CreateTdfObjects
 //
-//	INSERT INTO tdf_objects (ts, src_type, geo, search, metadata, tdf_blob, tdf_uri)
-//	VALUES ($1, $2, $3, $4, $5, $6, $7)
+//	INSERT INTO tdf_objects (ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, entity_key)
+//	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 //	RETURNING id
 func (q *Queries) CreateTdfObjects(ctx context.Context, arg []CreateTdfObjectsParams) *CreateTdfObjectsBatchResults {
 	batch := &pgx.Batch{}
@@ -121,6 +122,7 @@ func (q *Queries) CreateTdfObjects(ctx context.Context, arg []CreateTdfObjectsPa
 			a.Metadata,
 			a.TdfBlob,
 			a.TdfUri,
+			a.EntityKey,
 		}
 		batch.Queue(createTdfObjects, vals...)
 	}
@@ -150,3 +152,165 @@ func (b *CreateTdfObjectsBatchResults) Close() error {
 	b.closed = true
 	return b.br.Close()
 }
+
+const importTdfNotes = `-- name: ImportTdfNotes :batchone
+INSERT INTO tdf_notes (id, ts, parent_id, search, tdf_blob, tdf_uri, _created_at, _created_by)
+VALUES ($1::UUID, $2::TIMESTAMP, $3::UUID, $4::JSONB,
+  $5::BYTEA, $6::TEXT, COALESCE($7::TIMESTAMP, CURRENT_TIMESTAMP),
+  COALESCE($8::TEXT, 'anonymous'))
+ON CONFLICT (id) DO NOTHING
+RETURNING id
+`
+
+type ImportTdfNotesBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type ImportTdfNotesParams struct {
+	ID        uuid.UUID        `json:"id"`
+	Ts        pgtype.Timestamp `json:"ts"`
+	ParentID  uuid.UUID        `json:"parent_id"`
+	Search    []byte           `json:"search"`
+	TdfBlob   []byte           `json:"tdf_blob"`
+	TdfUri    pgtype.Text      `json:"tdf_uri"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	CreatedBy pgtype.Text      `json:"created_by"`
+}
+
+// ImportTdfNotes
+//
+//	INSERT INTO tdf_notes (id, ts, parent_id, search, tdf_blob, tdf_uri, _created_at, _created_by)
+//	VALUES ($1::UUID, $2::TIMESTAMP, $3::UUID, $4::JSONB,
+//	  $5::BYTEA, $6::TEXT, COALESCE($7::TIMESTAMP, CURRENT_TIMESTAMP),
+//	  COALESCE($8::TEXT, 'anonymous'))
+//	ON CONFLICT (id) DO NOTHING
+//	RETURNING id
+func (q *Queries) ImportTdfNotes(ctx context.Context, arg []ImportTdfNotesParams) *ImportTdfNotesBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.ID,
+			a.Ts,
+			a.ParentID,
+			a.Search,
+			a.TdfBlob,
+			a.TdfUri,
+			a.CreatedAt,
+			a.CreatedBy,
+		}
+		batch.Queue(importTdfNotes, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &ImportTdfNotesBatchResults{br, len(arg), false}
+}
+
+func (b *ImportTdfNotesBatchResults) QueryRow(f func(int, uuid.UUID, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var id uuid.UUID
+		if b.closed {
+			if f != nil {
+				f(t, id, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(&id)
+		if f != nil {
+			f(t, id, err)
+		}
+	}
+}
+
+func (b *ImportTdfNotesBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const importTdfObjects = `-- name: ImportTdfObjects :batchone
+INSERT INTO tdf_objects (id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by, entity_key)
+SELECT $1::UUID, $2::TIMESTAMP, $3::TEXT, $4::GEOMETRY,
+  $5::JSONB, $6::JSONB, $7::BYTEA, $8::TEXT,
+  COALESCE($9::TIMESTAMP, CURRENT_TIMESTAMP), COALESCE($10::TEXT, 'anonymous'),
+  $11::TEXT
+WHERE NOT EXISTS (SELECT 1 FROM tdf_objects WHERE id = $1::UUID)
+ON CONFLICT DO NOTHING
+RETURNING id
+`
+
+type ImportTdfObjectsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type ImportTdfObjectsParams struct {
+	ID        uuid.UUID        `json:"id"`
+	Ts        pgtype.Timestamp `json:"ts"`
+	SrcType   string           `json:"src_type"`
+	Geo       *geos.Geom       `json:"geo"`
+	Search    []byte           `json:"search"`
+	Metadata  []byte           `json:"metadata"`
+	TdfBlob   []byte           `json:"tdf_blob"`
+	TdfUri    pgtype.Text      `json:"tdf_uri"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	CreatedBy pgtype.Text      `json:"created_by"`
+	EntityKey pgtype.Text      `json:"entity_key"`
+}
+
+// ImportTdfObjects
+//
+//	INSERT INTO tdf_objects (id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by, entity_key)
+//	SELECT $1::UUID, $2::TIMESTAMP, $3::TEXT, $4::GEOMETRY,
+//	  $5::JSONB, $6::JSONB, $7::BYTEA, $8::TEXT,
+//	  COALESCE($9::TIMESTAMP, CURRENT_TIMESTAMP), COALESCE($10::TEXT, 'anonymous'),
+//	  $11::TEXT
+//	WHERE NOT EXISTS (SELECT 1 FROM tdf_objects WHERE id = $1::UUID)
+//	ON CONFLICT DO NOTHING
+//	RETURNING id
+func (q *Queries) ImportTdfObjects(ctx context.Context, arg []ImportTdfObjectsParams) *ImportTdfObjectsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.ID,
+			a.Ts,
+			a.SrcType,
+			a.Geo,
+			a.Search,
+			a.Metadata,
+			a.TdfBlob,
+			a.TdfUri,
+			a.CreatedAt,
+			a.CreatedBy,
+			a.EntityKey,
+		}
+		batch.Queue(importTdfObjects, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &ImportTdfObjectsBatchResults{br, len(arg), false}
+}
+
+func (b *ImportTdfObjectsBatchResults) QueryRow(f func(int, uuid.UUID, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var id uuid.UUID
+		if b.closed {
+			if f != nil {
+				f(t, id, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(&id)
+		if f != nil {
+			f(t, id, err)
+		}
+	}
+}
+
+func (b *ImportTdfObjectsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
@@ -0,0 +1,302 @@
+package db
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Migrations are numbered files in the migrations directory, e.g. migrations/0002_add_column.up.sql and
+// migrations/0002_add_column.down.sql. Migration 1 creates the schema.
+//
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+// migrationLockID is the advisory lock held while migrating, so servers and commands sharing a database do not
+// apply the same migration twice
+const migrationLockID = 4871320925
+
+const createMigrationTable = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+  version INT PRIMARY KEY,
+  name TEXT NOT NULL,
+  applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`
+
+var reMigrationFile = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+var ErrSchemaOutdated = errors.New("database schema is older than expected")
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Version int
+	Name    string
+	// nil when the migration is not applied
+	AppliedAt *time.Time
+	// false when the migration was applied by a newer version of the binary
+	Known bool
+}
+
+// Migrations returns the embedded migrations ordered by version
+func Migrations() ([]Migration, error) {
+	return parseMigrations(migrationFS)
+}
+
+func parseMigrations(fsys fs.FS) ([]Migration, error) {
+	byVersion := map[int]*Migration{}
+
+	entries, err := fs.ReadDir(fsys, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+	for _, e := range entries {
+		match := reMigrationFile.FindStringSubmatch(e.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %s", e.Name())
+		}
+		version, err := strconv.Atoi(match[1])
+		if err != nil || version < 1 {
+			return nil, fmt.Errorf("invalid migration version in %s", e.Name())
+		}
+		b, err := fs.ReadFile(fsys, path.Join("migrations", e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", e.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d is named both %s and %s", version, m.Name, match[2])
+		}
+		if match[3] == "down" {
+			m.Down = string(b)
+		} else {
+			m.Up = string(b)
+		}
+	}
+
+	if len(byVersion) == 0 {
+		return nil, errors.New("no migrations")
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			return nil, fmt.Errorf("migration %d is missing", i+1)
+		}
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d has no up migration", m.Version)
+		}
+	}
+
+	return migrations, nil
+}
+
+// LatestVersion returns the schema version the binary expects
+func LatestVersion() (int, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return 0, err
+	}
+	return migrations[len(migrations)-1].Version, nil
+}
+
+// SchemaVersion returns the version of the latest applied migration, 0 when no migration is applied
+func SchemaVersion(ctx context.Context, pool *pgxpool.Pool) (int, error) {
+	var version int
+	err := pool.QueryRow(ctx, `
+		SELECT CASE WHEN to_regclass('schema_migrations') IS NULL THEN 0
+		ELSE (SELECT COALESCE(max(version), 0) FROM schema_migrations) END
+	`).Scan(&version)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	return version, nil
+}
+
+// CheckSchemaVersion returns ErrSchemaOutdated when migrations the binary expects are not applied
+func CheckSchemaVersion(ctx context.Context, pool *pgxpool.Pool) error {
+	latest, err := LatestVersion()
+	if err != nil {
+		return err
+	}
+	version, err := SchemaVersion(ctx, pool)
+	if err != nil {
+		return err
+	}
+	if version < latest {
+		return fmt.Errorf("%w: version %d, expected %d, run `dsp-cop db migrate up`", ErrSchemaOutdated, version, latest)
+	}
+	return nil
+}
+
+// MigrationStatuses returns the embedded migrations and the migrations applied to the database
+func MigrationStatuses(ctx context.Context, pool *pgxpool.Pool) ([]MigrationStatus, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []MigrationStatus
+	err = withMigrationLock(ctx, pool, func(conn *pgxpool.Conn) error {
+		applied, err = appliedMigrations(ctx, conn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(migrations))
+	for _, m := range migrations {
+		statuses = append(statuses, MigrationStatus{Version: m.Version, Name: m.Name, Known: true})
+	}
+	for _, a := range applied {
+		if a.Version <= len(statuses) {
+			statuses[a.Version-1].AppliedAt = a.AppliedAt
+			continue
+		}
+		statuses = append(statuses, a)
+	}
+	return statuses, nil
+}
+
+// MigrateUp applies the pending migrations in order and returns the applied migrations
+func MigrateUp(ctx context.Context, pool *pgxpool.Pool) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	err = withMigrationLock(ctx, pool, func(conn *pgxpool.Conn) error {
+		version, err := appliedVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, m := range migrations[min(version, len(migrations)):] {
+			err := pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+				if _, err := tx.Exec(ctx, m.Up); err != nil {
+					return err
+				}
+				_, err := tx.Exec(ctx,
+					"INSERT INTO schema_migrations (version, name) VALUES ($1, $2) ON CONFLICT (version) DO NOTHING",
+					m.Version, m.Name,
+				)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("failed to apply migration %d %s: %w", m.Version, m.Name, err)
+			}
+			done = append(done, m)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// MigrateDown reverts the latest steps migrations and returns the reverted migrations
+func MigrateDown(ctx context.Context, pool *pgxpool.Pool, steps int) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	err = withMigrationLock(ctx, pool, func(conn *pgxpool.Conn) error {
+		version, err := appliedVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if version > len(migrations) {
+			return fmt.Errorf("schema version %d is newer than this binary, which knows up to version %d", version, len(migrations))
+		}
+		for ; steps > 0 && version > 0; steps, version = steps-1, version-1 {
+			m := migrations[version-1]
+			if m.Down == "" {
+				return fmt.Errorf("migration %d %s can not be reverted", m.Version, m.Name)
+			}
+			err := pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+				if _, err := tx.Exec(ctx, m.Down); err != nil {
+					return err
+				}
+				_, err := tx.Exec(ctx, "DELETE FROM schema_migrations WHERE version = $1", m.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("failed to revert migration %d %s: %w", m.Version, m.Name, err)
+			}
+			done = append(done, m)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// withMigrationLock runs fn on a connection holding the migration lock, after creating the schema_migrations table
+func withMigrationLock(ctx context.Context, pool *pgxpool.Pool, fn func(conn *pgxpool.Conn) error) error {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		return fmt.Errorf("failed to lock migrations: %w", err)
+	}
+	defer func() {
+		// the lock is released with the session if the unlock fails
+		_, _ = conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockID)
+	}()
+
+	if _, err := conn.Exec(ctx, createMigrationTable); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+func appliedVersion(ctx context.Context, conn *pgxpool.Conn) (int, error) {
+	var version int
+	if err := conn.QueryRow(ctx, "SELECT COALESCE(max(version), 0) FROM schema_migrations").Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	return version, nil
+}
+
+func appliedMigrations(ctx context.Context, conn *pgxpool.Conn) ([]MigrationStatus, error) {
+	rows, err := conn.Query(ctx, "SELECT version, name, applied_at FROM schema_migrations ORDER BY version")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applied migrations: %w", err)
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (MigrationStatus, error) {
+		var s MigrationStatus
+		var appliedAt time.Time
+		if err := row.Scan(&s.Version, &s.Name, &appliedAt); err != nil {
+			return s, err
+		}
+		s.AppliedAt = &appliedAt
+		return s, nil
+	})
+}
@@ -0,0 +1,19 @@
+-- Reverts 0001_schema.up.sql. Source types, stream items, notes and geofences
+-- are removed. The postgis extension and the schema_migrations table are kept.
+
+DROP TABLE IF EXISTS geofence_occupants;
+DROP TABLE IF EXISTS geofences;
+
+DROP TABLE IF EXISTS tdf_notes;
+DROP FUNCTION IF EXISTS notify_tdf_note_objects_inserted();
+DROP FUNCTION IF EXISTS check_tdf_notes_parent_id();
+
+-- dropping tdf_objects drops its partitions and triggers
+DROP TABLE IF EXISTS tdf_objects;
+DROP FUNCTION IF EXISTS delete_tdf_objects_notes();
+DROP FUNCTION IF EXISTS notify_tdf_objects_inserted();
+DROP FUNCTION IF EXISTS notify_tdf_objects_updated();
+DROP FUNCTION IF EXISTS create_tdf_objects_partitions(TIMESTAMP);
+DROP FUNCTION IF EXISTS drop_tdf_objects_partitions(TIMESTAMP, BOOLEAN);
+
+DROP TABLE IF EXISTS src_types;
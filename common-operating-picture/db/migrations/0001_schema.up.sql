@@ -0,0 +1,377 @@
+CREATE EXTENSION IF NOT EXISTS postgis;
+
+/*
+	#############################################################################
+	### src_types TABLE
+	#############################################################################
+*/
+
+CREATE TABLE IF NOT EXISTS src_types (
+	id TEXT PRIMARY KEY,
+	form_schema JSONB NOT NULL,
+	ui_schema JSONB NULL,
+	metadata JSONB NOT NULL
+);
+
+/*
+	#############################################################################
+	### tdf_objects TABLE
+	#############################################################################
+*/
+
+-- tdf_objects created before partitioning are converted below
+DO $$
+BEGIN
+	IF EXISTS (SELECT 1 FROM pg_class WHERE oid = to_regclass('tdf_objects') AND relkind = 'r') THEN
+		ALTER TABLE tdf_objects RENAME TO tdf_objects_unpartitioned;
+		ALTER TABLE tdf_objects_unpartitioned RENAME CONSTRAINT tdf_objects_pkey TO tdf_objects_unpartitioned_pkey;
+		ALTER INDEX IF EXISTS tdf_objects_entity_key_ts_idx RENAME TO tdf_objects_unpartitioned_entity_key_ts_idx;
+	END IF;
+END;
+$$;
+
+CREATE TABLE IF NOT EXISTS tdf_objects (
+  id UUID NOT NULL DEFAULT gen_random_uuid(),
+  ts TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  src_type TEXT NOT NULL,
+  geo GEOMETRY NULL,
+  search JSONB NULL,
+  metadata JSONB NULL,
+  tdf_blob BYTEA NULL,
+  tdf_uri TEXT NULL,
+	_created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	_created_by TEXT DEFAULT 'anonymous',
+  entity_key TEXT NULL,
+  PRIMARY KEY (id, ts)
+) PARTITION BY RANGE (ts);
+
+COMMENT ON TABLE tdf_objects IS 'stream of tdf data, partitioned by month of ts';
+-- The primary key has to include the partition key ts, so it does not make id unique on its own. Rows are keyed
+-- on id alone (WHERE id = $1), which relies on ids being generated by the database with gen_random_uuid():
+-- inserts never set id, except ImportTdfObjects, which skips ids that already exist, and updates never change it.
+COMMENT ON COLUMN tdf_objects.id IS 'uuid generated by the database, unique across partitions although the primary key is (id, ts)';
+COMMENT ON COLUMN tdf_objects.ts IS 'timestamp generated by the database';
+COMMENT ON COLUMN tdf_objects.src_type IS 'source type of the data';
+COMMENT ON COLUMN tdf_objects.geo IS 'geospatial data';
+COMMENT ON COLUMN tdf_objects.search IS 'plaintext json search index';
+COMMENT ON COLUMN tdf_objects.metadata IS 'plaintext metadata json index';
+COMMENT ON COLUMN tdf_objects.tdf_blob IS 'tdf data blob';
+COMMENT ON COLUMN tdf_objects.tdf_uri IS 'tdf data uri';
+COMMENT ON COLUMN tdf_objects.entity_key IS 'identifies the entity (e.g. aircraft, vessel) the data is about, used to build tracks';
+
+CREATE INDEX IF NOT EXISTS tdf_objects_src_type_ts_idx ON tdf_objects (src_type, ts);
+CREATE INDEX IF NOT EXISTS tdf_objects_geo_idx ON tdf_objects USING GIST (geo);
+CREATE INDEX IF NOT EXISTS tdf_objects_search_idx ON tdf_objects USING GIN (search);
+CREATE INDEX IF NOT EXISTS tdf_objects_metadata_idx ON tdf_objects USING GIN (metadata);
+CREATE INDEX IF NOT EXISTS tdf_objects_entity_key_ts_idx ON tdf_objects (src_type, entity_key, ts DESC) WHERE entity_key IS NOT NULL;
+
+-- Create the tdf_objects_history partition for everything before the first monthly partition, the monthly
+-- partitions (tdf_objects_YYYY_MM) after it through the given timestamp and the tdf_objects_default partition for
+-- records beyond the last monthly partition, returns the created partitions. Records of a month stored in the
+-- default partition are moved to the partition of the month when it is created.
+CREATE OR REPLACE FUNCTION create_tdf_objects_partitions(through_ts TIMESTAMP)
+	RETURNS SETOF TEXT AS $$
+DECLARE
+	month_start TIMESTAMP;
+	partition_name TEXT;
+BEGIN
+	IF to_regclass('tdf_objects_history') IS NULL THEN
+		EXECUTE format(
+			'CREATE TABLE tdf_objects_history PARTITION OF tdf_objects FOR VALUES FROM (MINVALUE) TO (%L)',
+			date_trunc('month', CURRENT_TIMESTAMP)::TIMESTAMP
+		);
+		RETURN NEXT 'tdf_objects_history';
+	END IF;
+
+	IF to_regclass('tdf_objects_default') IS NULL THEN
+		CREATE TABLE tdf_objects_default PARTITION OF tdf_objects DEFAULT;
+		RETURN NEXT 'tdf_objects_default';
+	END IF;
+
+	-- monthly partitions start at the upper bound of the history partition
+	SELECT substring(pg_get_expr(c.relpartbound, c.oid) FROM 'TO \(''([^'']+)''\)')::TIMESTAMP
+	INTO month_start
+	FROM pg_class c
+	WHERE c.oid = to_regclass('tdf_objects_history');
+
+	WHILE month_start <= through_ts LOOP
+		partition_name := 'tdf_objects_' || to_char(month_start, 'YYYY_MM');
+		IF to_regclass(partition_name) IS NULL THEN
+			IF EXISTS (
+				SELECT 1 FROM tdf_objects_default
+				WHERE ts >= month_start AND ts < month_start + INTERVAL '1 month'
+			) THEN
+				-- a partition can not be created while the default partition holds records of its range. The
+				-- records are copied to a plain table, which is attached as the partition, and deleted from the
+				-- detached default partition, which has no triggers, so they are not notified or cascaded as
+				-- deletes and inserts.
+				ALTER TABLE tdf_objects DETACH PARTITION tdf_objects_default;
+				EXECUTE format('CREATE TABLE %I (LIKE tdf_objects INCLUDING DEFAULTS)', partition_name);
+				EXECUTE format(
+					'INSERT INTO %I SELECT * FROM tdf_objects_default WHERE ts >= %L AND ts < %L',
+					partition_name, month_start, month_start + INTERVAL '1 month'
+				);
+				DELETE FROM tdf_objects_default WHERE ts >= month_start AND ts < month_start + INTERVAL '1 month';
+				EXECUTE format(
+					'ALTER TABLE tdf_objects ATTACH PARTITION %I FOR VALUES FROM (%L) TO (%L)',
+					partition_name, month_start, month_start + INTERVAL '1 month'
+				);
+				ALTER TABLE tdf_objects ATTACH PARTITION tdf_objects_default DEFAULT;
+			ELSE
+				EXECUTE format(
+					'CREATE TABLE %I PARTITION OF tdf_objects FOR VALUES FROM (%L) TO (%L)',
+					partition_name, month_start, month_start + INTERVAL '1 month'
+				);
+			END IF;
+			RETURN NEXT partition_name;
+		END IF;
+		month_start := month_start + INTERVAL '1 month';
+	END LOOP;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Detach and drop the monthly partitions that end before the given timestamp and their notes, returns the
+-- dropped partitions. With dry_run the partitions are only returned.
+CREATE OR REPLACE FUNCTION drop_tdf_objects_partitions(before_ts TIMESTAMP, dry_run BOOLEAN)
+	RETURNS SETOF TEXT AS $$
+DECLARE
+	partition_name TEXT;
+BEGIN
+	FOR partition_name IN
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'tdf_objects'::regclass
+		  AND c.relname ~ '^tdf_objects_\d{4}_\d{2}$'
+		  AND to_timestamp(substring(c.relname FROM '\d{4}_\d{2}$'), 'YYYY_MM')::TIMESTAMP + INTERVAL '1 month' <= before_ts
+		ORDER BY c.relname
+	LOOP
+		IF NOT dry_run THEN
+			-- notes are removed by a row trigger, which does not fire when a partition is dropped
+			EXECUTE format('DELETE FROM tdf_notes WHERE parent_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format('ALTER TABLE tdf_objects DETACH PARTITION %I', partition_name);
+			EXECUTE format('DROP TABLE %I', partition_name);
+		END IF;
+		RETURN NEXT partition_name;
+	END LOOP;
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+DECLARE
+	through_ts TIMESTAMP := CURRENT_TIMESTAMP + INTERVAL '3 months';
+BEGIN
+	IF to_regclass('tdf_objects_unpartitioned') IS NOT NULL THEN
+		-- bring tables created before these columns were added up to date
+		ALTER TABLE tdf_objects_unpartitioned ADD COLUMN IF NOT EXISTS metadata JSONB NULL;
+		ALTER TABLE tdf_objects_unpartitioned ADD COLUMN IF NOT EXISTS tdf_uri TEXT NULL;
+		ALTER TABLE tdf_objects_unpartitioned ADD COLUMN IF NOT EXISTS entity_key TEXT NULL;
+		SELECT GREATEST(through_ts, max(ts)) INTO through_ts FROM tdf_objects_unpartitioned;
+	END IF;
+
+	PERFORM create_tdf_objects_partitions(through_ts);
+
+	IF to_regclass('tdf_objects_unpartitioned') IS NOT NULL THEN
+		INSERT INTO tdf_objects (id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by, entity_key)
+		SELECT id, COALESCE(ts, _created_at, CURRENT_TIMESTAMP), src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by, entity_key
+		FROM tdf_objects_unpartitioned;
+
+		-- the tdf_notes foreign key is replaced by triggers, see the tdf_notes TABLE
+		IF to_regclass('tdf_notes') IS NOT NULL THEN
+			ALTER TABLE tdf_notes DROP CONSTRAINT IF EXISTS parent_id;
+		END IF;
+		DROP TABLE tdf_objects_unpartitioned;
+	END IF;
+END;
+$$;
+
+-- Create notification function
+CREATE OR REPLACE FUNCTION notify_tdf_objects_inserted()
+	RETURNS trigger AS $$
+DECLARE
+BEGIN
+	PERFORM pg_notify(
+		CAST('tdf_objects_inserted' AS text),
+		row_to_json(NEW)::TEXT
+	);
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Add trigger to notify on insert
+CREATE OR REPLACE TRIGGER notify_tdf_objects_inserted
+	AFTER INSERT ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE notify_tdf_objects_inserted();
+
+-- Create update notification function, used to re-evaluate geofences. The payload is the id of the row, which is
+-- read by the listener, as a row with its tdf_blob may exceed the 8000 byte limit of notifications.
+CREATE OR REPLACE FUNCTION notify_tdf_objects_updated()
+	RETURNS trigger AS $$
+DECLARE
+BEGIN
+	PERFORM pg_notify(
+		CAST('tdf_objects_updated' AS text),
+		json_build_object('id', NEW.id)::TEXT
+	);
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Add trigger to notify on update
+CREATE OR REPLACE TRIGGER notify_tdf_objects_updated
+	AFTER UPDATE ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE notify_tdf_objects_updated();
+
+
+/*
+	#############################################################################
+	### tdf_notes TABLE
+	#############################################################################
+*/
+CREATE TABLE IF NOT EXISTS tdf_notes (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  ts TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  parent_id UUID NOT NULL,
+  search JSONB NULL,
+  tdf_blob BYTEA NULL,
+  tdf_uri TEXT NULL,
+  _created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  _created_by TEXT DEFAULT 'anonymous'
+);
+
+
+COMMENT ON TABLE tdf_notes IS 'stream of tdf data';
+COMMENT ON COLUMN tdf_notes.id IS 'uuid primary key generated by the database';
+COMMENT ON COLUMN tdf_notes.ts IS 'timestamp generated by the database';
+COMMENT ON COLUMN tdf_notes.search IS 'plaintext json search index';
+COMMENT ON COLUMN tdf_notes.tdf_blob IS 'tdf data blob';
+COMMENT ON COLUMN tdf_notes.parent_id IS 'foreign key, corresponds to primary key id of tdf_objects entry';
+COMMENT ON COLUMN tdf_notes.tdf_uri IS 'tdf data uri';
+
+CREATE INDEX IF NOT EXISTS tdf_notes_parent_id_idx ON tdf_notes (parent_id);
+
+-- A partitioned tdf_objects can not be referenced by a foreign key on id alone, so the parent_id foreign key
+-- is enforced and cascaded by triggers
+CREATE OR REPLACE FUNCTION check_tdf_notes_parent_id()
+	RETURNS trigger AS $$
+BEGIN
+	IF NOT EXISTS (SELECT 1 FROM tdf_objects WHERE id = NEW.parent_id) THEN
+		RAISE foreign_key_violation USING MESSAGE = format('tdf_objects %s does not exist', NEW.parent_id);
+	END IF;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER check_tdf_notes_parent_id
+	BEFORE INSERT OR UPDATE OF parent_id ON tdf_notes
+	FOR EACH ROW
+	EXECUTE PROCEDURE check_tdf_notes_parent_id();
+
+CREATE OR REPLACE FUNCTION delete_tdf_objects_notes()
+	RETURNS trigger AS $$
+BEGIN
+	-- an update moving a row to another partition is a delete and an insert, keep the notes of moved rows
+	DELETE FROM tdf_notes
+	WHERE parent_id = OLD.id
+	  AND NOT EXISTS (SELECT 1 FROM tdf_objects WHERE id = OLD.id);
+	RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER delete_tdf_objects_notes
+	AFTER DELETE ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE delete_tdf_objects_notes();
+
+-- Create notification function
+CREATE OR REPLACE FUNCTION notify_tdf_note_objects_inserted()
+	RETURNS trigger AS $$
+DECLARE
+BEGIN
+	PERFORM pg_notify(
+		CAST('tdf_note_object_inserted' AS text),
+		row_to_json(NEW)::TEXT
+	);
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Add trigger to notify on insert
+CREATE OR REPLACE TRIGGER notify_tdf_note_objects_inserted
+	AFTER INSERT ON tdf_notes
+	FOR EACH ROW
+	EXECUTE PROCEDURE notify_tdf_note_objects_inserted();
+
+/*
+	#############################################################################
+	### geofences TABLE
+	#############################################################################
+*/
+
+CREATE TABLE IF NOT EXISTS geofences (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  name TEXT NOT NULL,
+  geo GEOMETRY NOT NULL,
+  src_type TEXT NULL,
+  search JSONB NULL,
+  dwell_seconds INTEGER NOT NULL DEFAULT 0,
+  _created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  _created_by TEXT DEFAULT 'anonymous'
+);
+
+COMMENT ON TABLE geofences IS 'named areas evaluated against new and updated tdf_objects';
+COMMENT ON COLUMN geofences.id IS 'uuid primary key generated by the database';
+COMMENT ON COLUMN geofences.name IS 'display name of the geofence';
+COMMENT ON COLUMN geofences.geo IS 'polygon of the geofence';
+COMMENT ON COLUMN geofences.src_type IS 'only tdf_objects of this source type are evaluated, NULL evaluates all source types';
+COMMENT ON COLUMN geofences.search IS 'only tdf_objects whose search index contains this json are evaluated';
+COMMENT ON COLUMN geofences.dwell_seconds IS 'seconds an entity must stay inside before a dwell event is emitted, 0 disables dwell events';
+
+CREATE INDEX IF NOT EXISTS geofences_geo_idx ON geofences USING GIST (geo);
+
+/*
+	#############################################################################
+	### geofence_occupants TABLE
+	#############################################################################
+*/
+
+CREATE TABLE IF NOT EXISTS geofence_occupants (
+  geofence_id UUID NOT NULL,
+  src_type TEXT NOT NULL,
+  entity_key TEXT NOT NULL,
+  entered_at TIMESTAMP NOT NULL,
+  last_seen TIMESTAMP NOT NULL,
+  dwell_notified BOOLEAN NOT NULL DEFAULT FALSE,
+  PRIMARY KEY (geofence_id, src_type, entity_key),
+  CONSTRAINT geofence_id FOREIGN KEY (geofence_id) REFERENCES geofences(id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE geofence_occupants IS 'entities currently inside a geofence';
+COMMENT ON COLUMN geofence_occupants.geofence_id IS 'foreign key, corresponds to primary key id of geofences entry';
+COMMENT ON COLUMN geofence_occupants.src_type IS 'source type of the entity';
+COMMENT ON COLUMN geofence_occupants.entity_key IS 'entity_key of the tdf_object, or its id when it has no entity_key';
+COMMENT ON COLUMN geofence_occupants.entered_at IS 'timestamp of the first tdf_object inside the geofence';
+COMMENT ON COLUMN geofence_occupants.last_seen IS 'timestamp of the latest tdf_object inside the geofence';
+COMMENT ON COLUMN geofence_occupants.dwell_notified IS 'whether a dwell event was emitted for this visit';
+
+/*
+	#############################################################################
+	### schema_migrations TABLE
+	#############################################################################
+*/
+
+CREATE TABLE IF NOT EXISTS schema_migrations (
+  version INT PRIMARY KEY,
+  name TEXT NOT NULL,
+  applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE schema_migrations IS 'applied schema migrations, see db/migrations';
+COMMENT ON COLUMN schema_migrations.version IS 'version of the migration, from its file name';
+COMMENT ON COLUMN schema_migrations.name IS 'name of the migration, from its file name';
+COMMENT ON COLUMN schema_migrations.applied_at IS 'timestamp the migration was applied';
+
+-- This file is migration 1, applying it by hand records it like `dsp-cop db migrate up` does
+INSERT INTO schema_migrations (version, name) VALUES (1, 'schema') ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,59 @@
+/*
+	#############################################################################
+	### webhooks TABLE
+	#############################################################################
+*/
+
+CREATE TABLE IF NOT EXISTS webhooks (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  name TEXT NOT NULL,
+  url TEXT NOT NULL,
+  src_type TEXT NULL,
+  geo GEOMETRY NULL,
+  search JSONB NULL,
+  secret TEXT NOT NULL,
+  client_id TEXT NOT NULL,
+  client_secret TEXT NOT NULL,
+  _created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  _created_by TEXT DEFAULT 'anonymous'
+);
+
+COMMENT ON TABLE webhooks IS 'subscriptions of URLs new tdf_objects are delivered to';
+COMMENT ON COLUMN webhooks.id IS 'uuid primary key generated by the database';
+COMMENT ON COLUMN webhooks.name IS 'display name of the webhook';
+COMMENT ON COLUMN webhooks.url IS 'URL new tdf_objects are POSTed to';
+COMMENT ON COLUMN webhooks.src_type IS 'only tdf_objects of this source type are delivered, NULL delivers all source types';
+COMMENT ON COLUMN webhooks.geo IS 'only tdf_objects intersecting this geometry are delivered, NULL delivers tdf_objects anywhere';
+COMMENT ON COLUMN webhooks.search IS 'only tdf_objects whose search index contains this json are delivered';
+COMMENT ON COLUMN webhooks.secret IS 'HMAC-SHA256 secret signing the deliveries';
+COMMENT ON COLUMN webhooks.client_id IS 'OIDC client of the service identity whose entitlements limit the tdf_objects delivered';
+COMMENT ON COLUMN webhooks.client_secret IS 'OIDC client secret of the service identity';
+
+CREATE INDEX IF NOT EXISTS webhooks_geo_idx ON webhooks USING GIST (geo);
+
+/*
+	#############################################################################
+	### webhook_dead_letters TABLE
+	#############################################################################
+*/
+
+CREATE TABLE IF NOT EXISTS webhook_dead_letters (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  webhook_id UUID NOT NULL,
+  tdf_object_id UUID NOT NULL,
+  payload JSONB NOT NULL,
+  attempts INTEGER NOT NULL,
+  error TEXT NOT NULL,
+  _created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT webhook_id FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE webhook_dead_letters IS 'deliveries of webhooks that failed after all their attempts';
+COMMENT ON COLUMN webhook_dead_letters.id IS 'uuid primary key generated by the database';
+COMMENT ON COLUMN webhook_dead_letters.webhook_id IS 'foreign key, corresponds to primary key id of webhooks entry';
+COMMENT ON COLUMN webhook_dead_letters.tdf_object_id IS 'id of the delivered tdf_object';
+COMMENT ON COLUMN webhook_dead_letters.payload IS 'json body of the delivery';
+COMMENT ON COLUMN webhook_dead_letters.attempts IS 'number of attempts of the delivery';
+COMMENT ON COLUMN webhook_dead_letters.error IS 'error of the last attempt';
+
+CREATE INDEX IF NOT EXISTS webhook_dead_letters_webhook_id_idx ON webhook_dead_letters (webhook_id, _created_at);
@@ -0,0 +1,14 @@
+DROP TRIGGER IF EXISTS notify_tdf_objects_deleted ON tdf_objects;
+DROP FUNCTION IF EXISTS notify_tdf_objects_deleted();
+
+CREATE OR REPLACE FUNCTION notify_tdf_objects_inserted()
+	RETURNS trigger AS $$
+DECLARE
+BEGIN
+	PERFORM pg_notify(
+		CAST('tdf_objects_inserted' AS text),
+		row_to_json(NEW)::TEXT
+	);
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
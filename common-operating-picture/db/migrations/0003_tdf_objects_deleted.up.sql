@@ -0,0 +1,57 @@
+/*
+	#############################################################################
+	### tdf_objects delete notifications
+	#############################################################################
+*/
+
+-- Create delete notification function, used to publish deletes to the Kafka sink. The payload leaves out the
+-- tdf_blob, keeping it under the 8000 byte limit of notifications. A row moved to another partition (see
+-- delete_tdf_objects_notes) is notified as an update instead, and marked for notify_tdf_objects_inserted to skip
+-- the insert of the move.
+CREATE OR REPLACE FUNCTION notify_tdf_objects_deleted()
+	RETURNS trigger AS $$
+DECLARE
+BEGIN
+	IF EXISTS (SELECT 1 FROM tdf_objects WHERE id = OLD.id) THEN
+		PERFORM set_config('dsp_cop.moved_tdf_object', OLD.id::TEXT, true);
+		PERFORM pg_notify(
+			CAST('tdf_objects_updated' AS text),
+			json_build_object('id', OLD.id)::TEXT
+		);
+		RETURN OLD;
+	END IF;
+	PERFORM pg_notify(
+		CAST('tdf_objects_deleted' AS text),
+		json_build_object(
+			'id', OLD.id,
+			'ts', OLD.ts,
+			'src_type', OLD.src_type,
+			'entity_key', OLD.entity_key
+		)::TEXT
+	);
+	RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Add trigger to notify on delete
+CREATE OR REPLACE TRIGGER notify_tdf_objects_deleted
+	AFTER DELETE ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE notify_tdf_objects_deleted();
+
+-- Skip the insert of a row moved to another partition, notified as an update by notify_tdf_objects_deleted
+CREATE OR REPLACE FUNCTION notify_tdf_objects_inserted()
+	RETURNS trigger AS $$
+DECLARE
+BEGIN
+	IF current_setting('dsp_cop.moved_tdf_object', true) = NEW.id::TEXT THEN
+		PERFORM set_config('dsp_cop.moved_tdf_object', '', true);
+		RETURN NEW;
+	END IF;
+	PERFORM pg_notify(
+		CAST('tdf_objects_inserted' AS text),
+		row_to_json(NEW)::TEXT
+	);
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,34 @@
+DROP TRIGGER IF EXISTS delete_tdf_notes_attachments ON tdf_notes;
+DROP FUNCTION IF EXISTS delete_tdf_notes_attachments();
+DROP TRIGGER IF EXISTS delete_tdf_objects_attachments ON tdf_objects;
+DROP FUNCTION IF EXISTS delete_tdf_objects_attachments();
+DROP TRIGGER IF EXISTS check_tdf_attachments_parent_id ON tdf_attachments;
+DROP FUNCTION IF EXISTS check_tdf_attachments_parent_id();
+DROP TABLE IF EXISTS tdf_attachments;
+
+-- Detach and drop the monthly partitions that end before the given timestamp and their notes, returns the
+-- dropped partitions. With dry_run the partitions are only returned.
+CREATE OR REPLACE FUNCTION drop_tdf_objects_partitions(before_ts TIMESTAMP, dry_run BOOLEAN)
+	RETURNS SETOF TEXT AS $$
+DECLARE
+	partition_name TEXT;
+BEGIN
+	FOR partition_name IN
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'tdf_objects'::regclass
+		  AND c.relname ~ '^tdf_objects_\d{4}_\d{2}$'
+		  AND to_timestamp(substring(c.relname FROM '\d{4}_\d{2}$'), 'YYYY_MM')::TIMESTAMP + INTERVAL '1 month' <= before_ts
+		ORDER BY c.relname
+	LOOP
+		IF NOT dry_run THEN
+			-- notes are removed by a row trigger, which does not fire when a partition is dropped
+			EXECUTE format('DELETE FROM tdf_notes WHERE parent_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format('ALTER TABLE tdf_objects DETACH PARTITION %I', partition_name);
+			EXECUTE format('DROP TABLE %I', partition_name);
+		END IF;
+		RETURN NEXT partition_name;
+	END LOOP;
+END;
+$$ LANGUAGE plpgsql;
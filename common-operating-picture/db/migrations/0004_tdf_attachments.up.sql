@@ -0,0 +1,118 @@
+/*
+	#############################################################################
+	### tdf_attachments TABLE
+	#############################################################################
+*/
+CREATE TABLE IF NOT EXISTS tdf_attachments (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  ts TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  parent_id UUID NOT NULL,
+  parent_type TEXT NOT NULL CHECK (parent_type IN ('tdf_object', 'tdf_note')),
+  name TEXT NOT NULL DEFAULT '',
+  mime_type TEXT NOT NULL,
+  size BIGINT NOT NULL DEFAULT 0,
+  search JSONB NULL,
+  tdf_blob BYTEA NULL,
+  tdf_uri TEXT NULL,
+  _created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  _created_by TEXT DEFAULT 'anonymous'
+);
+
+COMMENT ON TABLE tdf_attachments IS 'files attached to tdf_objects and tdf_notes, each with its own classification';
+COMMENT ON COLUMN tdf_attachments.id IS 'uuid primary key generated by the database';
+COMMENT ON COLUMN tdf_attachments.ts IS 'timestamp generated by the database';
+COMMENT ON COLUMN tdf_attachments.parent_id IS 'corresponds to primary key id of the tdf_objects or tdf_notes entry of parent_type';
+COMMENT ON COLUMN tdf_attachments.parent_type IS 'table of the parent, tdf_object or tdf_note';
+COMMENT ON COLUMN tdf_attachments.name IS 'file name of the attachment';
+COMMENT ON COLUMN tdf_attachments.mime_type IS 'MIME type of the attached file';
+COMMENT ON COLUMN tdf_attachments.size IS 'size in bytes of the tdf of the attachment';
+COMMENT ON COLUMN tdf_attachments.search IS 'plaintext json search index';
+COMMENT ON COLUMN tdf_attachments.tdf_blob IS 'tdf data blob';
+COMMENT ON COLUMN tdf_attachments.tdf_uri IS 'tdf data uri';
+
+CREATE INDEX IF NOT EXISTS tdf_attachments_parent_id_idx ON tdf_attachments (parent_id);
+
+-- A partitioned tdf_objects can not be referenced by a foreign key on id alone and a parent is of either table, so
+-- the parent_id foreign key is enforced and cascaded by triggers like the one of tdf_notes
+CREATE OR REPLACE FUNCTION check_tdf_attachments_parent_id()
+	RETURNS trigger AS $$
+BEGIN
+	IF NEW.parent_type = 'tdf_object' AND NOT EXISTS (SELECT 1 FROM tdf_objects WHERE id = NEW.parent_id) THEN
+		RAISE foreign_key_violation USING MESSAGE = format('tdf_objects %s does not exist', NEW.parent_id);
+	END IF;
+	IF NEW.parent_type = 'tdf_note' AND NOT EXISTS (SELECT 1 FROM tdf_notes WHERE id = NEW.parent_id) THEN
+		RAISE foreign_key_violation USING MESSAGE = format('tdf_notes %s does not exist', NEW.parent_id);
+	END IF;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER check_tdf_attachments_parent_id
+	BEFORE INSERT OR UPDATE OF parent_id, parent_type ON tdf_attachments
+	FOR EACH ROW
+	EXECUTE PROCEDURE check_tdf_attachments_parent_id();
+
+CREATE OR REPLACE FUNCTION delete_tdf_objects_attachments()
+	RETURNS trigger AS $$
+BEGIN
+	-- keep the attachments of moved rows, see delete_tdf_objects_notes
+	DELETE FROM tdf_attachments
+	WHERE parent_type = 'tdf_object'
+	  AND parent_id = OLD.id
+	  AND NOT EXISTS (SELECT 1 FROM tdf_objects WHERE id = OLD.id);
+	RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER delete_tdf_objects_attachments
+	AFTER DELETE ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE delete_tdf_objects_attachments();
+
+-- the notes of a deleted tdf_object are deleted by a trigger as well, which cascades to their attachments
+CREATE OR REPLACE FUNCTION delete_tdf_notes_attachments()
+	RETURNS trigger AS $$
+BEGIN
+	DELETE FROM tdf_attachments
+	WHERE parent_type = 'tdf_note'
+	  AND parent_id = OLD.id;
+	RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER delete_tdf_notes_attachments
+	AFTER DELETE ON tdf_notes
+	FOR EACH ROW
+	EXECUTE PROCEDURE delete_tdf_notes_attachments();
+
+-- Detach and drop the monthly partitions that end before the given timestamp, their notes and attachments, returns
+-- the dropped partitions. With dry_run the partitions are only returned.
+CREATE OR REPLACE FUNCTION drop_tdf_objects_partitions(before_ts TIMESTAMP, dry_run BOOLEAN)
+	RETURNS SETOF TEXT AS $$
+DECLARE
+	partition_name TEXT;
+BEGIN
+	FOR partition_name IN
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'tdf_objects'::regclass
+		  AND c.relname ~ '^tdf_objects_\d{4}_\d{2}$'
+		  AND to_timestamp(substring(c.relname FROM '\d{4}_\d{2}$'), 'YYYY_MM')::TIMESTAMP + INTERVAL '1 month' <= before_ts
+		ORDER BY c.relname
+	LOOP
+		IF NOT dry_run THEN
+			-- notes and attachments are removed by row triggers, which do not fire when a partition is dropped. The
+			-- attachments of the notes are removed by the tdf_notes delete trigger.
+			EXECUTE format('DELETE FROM tdf_notes WHERE parent_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format(
+				'DELETE FROM tdf_attachments WHERE parent_type = %L AND parent_id IN (SELECT id FROM %I)',
+				'tdf_object', partition_name
+			);
+			EXECUTE format('ALTER TABLE tdf_objects DETACH PARTITION %I', partition_name);
+			EXECUTE format('DROP TABLE %I', partition_name);
+		END IF;
+		RETURN NEXT partition_name;
+	END LOOP;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,131 @@
+/*
+	#############################################################################
+	### tdf_object_revisions TABLE
+	#############################################################################
+*/
+CREATE TABLE IF NOT EXISTS tdf_object_revisions (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  tdf_object_id UUID NOT NULL,
+  revision INTEGER NOT NULL,
+  ts TIMESTAMP NOT NULL,
+  src_type TEXT NOT NULL,
+  geo GEOMETRY NULL,
+  search JSONB NULL,
+  metadata JSONB NULL,
+  tdf_blob BYTEA NULL,
+  tdf_uri TEXT NULL,
+  entity_key TEXT NULL,
+  operation TEXT NOT NULL DEFAULT 'update' CHECK (operation IN ('update', 'revert')),
+  changed_fields TEXT[] NOT NULL DEFAULT '{}',
+  changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  changed_by TEXT NOT NULL DEFAULT 'anonymous',
+  UNIQUE (tdf_object_id, revision)
+);
+
+COMMENT ON TABLE tdf_object_revisions IS 'versions of tdf_objects before each update, recorded by a trigger';
+COMMENT ON COLUMN tdf_object_revisions.id IS 'uuid primary key generated by the database';
+COMMENT ON COLUMN tdf_object_revisions.tdf_object_id IS 'corresponds to primary key id of the tdf_objects entry';
+COMMENT ON COLUMN tdf_object_revisions.revision IS 'number of the change of the tdf_object, counting from 1';
+COMMENT ON COLUMN tdf_object_revisions.ts IS 'ts of the tdf_object before the change';
+COMMENT ON COLUMN tdf_object_revisions.src_type IS 'src_type of the tdf_object before the change';
+COMMENT ON COLUMN tdf_object_revisions.geo IS 'geo of the tdf_object before the change';
+COMMENT ON COLUMN tdf_object_revisions.search IS 'search of the tdf_object before the change, its previous classification';
+COMMENT ON COLUMN tdf_object_revisions.metadata IS 'metadata of the tdf_object before the change';
+COMMENT ON COLUMN tdf_object_revisions.tdf_blob IS 'tdf_blob of the tdf_object before the change';
+COMMENT ON COLUMN tdf_object_revisions.tdf_uri IS 'tdf_uri of the tdf_object before the change';
+COMMENT ON COLUMN tdf_object_revisions.entity_key IS 'entity_key of the tdf_object before the change';
+COMMENT ON COLUMN tdf_object_revisions.operation IS 'update or revert';
+COMMENT ON COLUMN tdf_object_revisions.changed_fields IS 'columns the change modified';
+COMMENT ON COLUMN tdf_object_revisions.changed_at IS 'timestamp of the change';
+COMMENT ON COLUMN tdf_object_revisions.changed_by IS 'user who made the change';
+
+-- Every update of a tdf_object keeps the previous version. The user and the operation are set for the transaction
+-- of the update with set_config('dsp_cop.changed_by', ...) and set_config('dsp_cop.operation', ...). Unlike the AFTER
+-- UPDATE triggers, a BEFORE UPDATE trigger also fires for updates moving a row to another partition.
+CREATE OR REPLACE FUNCTION record_tdf_object_revision()
+	RETURNS trigger AS $$
+DECLARE
+	changed TEXT[];
+BEGIN
+	changed := array_remove(ARRAY[
+		CASE WHEN NEW.ts IS DISTINCT FROM OLD.ts THEN 'ts' END,
+		CASE WHEN NEW.src_type IS DISTINCT FROM OLD.src_type THEN 'src_type' END,
+		CASE WHEN NEW.geo IS DISTINCT FROM OLD.geo THEN 'geo' END,
+		CASE WHEN NEW.search IS DISTINCT FROM OLD.search THEN 'search' END,
+		CASE WHEN NEW.metadata IS DISTINCT FROM OLD.metadata THEN 'metadata' END,
+		CASE WHEN NEW.tdf_blob IS DISTINCT FROM OLD.tdf_blob THEN 'tdf_blob' END,
+		CASE WHEN NEW.tdf_uri IS DISTINCT FROM OLD.tdf_uri THEN 'tdf_uri' END,
+		CASE WHEN NEW.entity_key IS DISTINCT FROM OLD.entity_key THEN 'entity_key' END
+	], NULL);
+	IF cardinality(changed) = 0 THEN
+		RETURN NEW;
+	END IF;
+
+	INSERT INTO tdf_object_revisions (
+		tdf_object_id, revision, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, entity_key,
+		operation, changed_fields, changed_by
+	)
+	SELECT OLD.id, COALESCE(MAX(r.revision), 0) + 1, OLD.ts, OLD.src_type, OLD.geo, OLD.search, OLD.metadata,
+		OLD.tdf_blob, OLD.tdf_uri, OLD.entity_key,
+		COALESCE(NULLIF(current_setting('dsp_cop.operation', true), ''), 'update'),
+		changed,
+		COALESCE(NULLIF(current_setting('dsp_cop.changed_by', true), ''), 'anonymous')
+	FROM tdf_object_revisions r
+	WHERE r.tdf_object_id = OLD.id;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER record_tdf_object_revision
+	BEFORE UPDATE ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE record_tdf_object_revision();
+
+CREATE OR REPLACE FUNCTION delete_tdf_objects_revisions()
+	RETURNS trigger AS $$
+BEGIN
+	-- keep the revisions of moved rows, see delete_tdf_objects_notes
+	DELETE FROM tdf_object_revisions
+	WHERE tdf_object_id = OLD.id
+	  AND NOT EXISTS (SELECT 1 FROM tdf_objects WHERE id = OLD.id);
+	RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER delete_tdf_objects_revisions
+	AFTER DELETE ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE delete_tdf_objects_revisions();
+
+-- Detach and drop the monthly partitions that end before the given timestamp, their notes, attachments and
+-- revisions, returns the dropped partitions. With dry_run the partitions are only returned.
+CREATE OR REPLACE FUNCTION drop_tdf_objects_partitions(before_ts TIMESTAMP, dry_run BOOLEAN)
+	RETURNS SETOF TEXT AS $$
+DECLARE
+	partition_name TEXT;
+BEGIN
+	FOR partition_name IN
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'tdf_objects'::regclass
+		  AND c.relname ~ '^tdf_objects_\d{4}_\d{2}$'
+		  AND to_timestamp(substring(c.relname FROM '\d{4}_\d{2}$'), 'YYYY_MM')::TIMESTAMP + INTERVAL '1 month' <= before_ts
+		ORDER BY c.relname
+	LOOP
+		IF NOT dry_run THEN
+			-- notes, attachments and revisions are removed by row triggers, which do not fire when a partition is
+			-- dropped. The attachments of the notes are removed by the tdf_notes delete trigger.
+			EXECUTE format('DELETE FROM tdf_notes WHERE parent_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format(
+				'DELETE FROM tdf_attachments WHERE parent_type = %L AND parent_id IN (SELECT id FROM %I)',
+				'tdf_object', partition_name
+			);
+			EXECUTE format('DELETE FROM tdf_object_revisions WHERE tdf_object_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format('ALTER TABLE tdf_objects DETACH PARTITION %I', partition_name);
+			EXECUTE format('DROP TABLE %I', partition_name);
+		END IF;
+		RETURN NEXT partition_name;
+	END LOOP;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS increment_tdf_objects_version ON tdf_objects;
+DROP FUNCTION IF EXISTS increment_tdf_objects_version();
+ALTER TABLE tdf_objects DROP COLUMN IF EXISTS version;
@@ -0,0 +1,18 @@
+ALTER TABLE tdf_objects ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN tdf_objects.version IS 'incremented by every update, checked by updates expecting a version';
+
+-- The version is incremented by a trigger so every update counts, including reverts, uploads and moves to another
+-- partition (see record_tdf_object_revision).
+CREATE OR REPLACE FUNCTION increment_tdf_objects_version()
+	RETURNS trigger AS $$
+BEGIN
+	NEW.version := OLD.version + 1;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER increment_tdf_objects_version
+	BEFORE UPDATE ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE increment_tdf_objects_version();
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS refuse_audit_log_truncate ON audit_log;
+DROP TRIGGER IF EXISTS refuse_audit_log_change ON audit_log;
+DROP FUNCTION IF EXISTS refuse_audit_log_change();
+DROP TABLE IF EXISTS audit_log;
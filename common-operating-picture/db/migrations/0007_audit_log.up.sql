@@ -0,0 +1,49 @@
+/*
+	#############################################################################
+	### audit_log TABLE
+	#############################################################################
+*/
+CREATE TABLE IF NOT EXISTS audit_log (
+  seq BIGINT PRIMARY KEY,
+  ts TIMESTAMP NOT NULL,
+  subject TEXT NOT NULL,
+  action TEXT NOT NULL,
+  procedure TEXT NOT NULL DEFAULT '',
+  object_ids TEXT[] NOT NULL DEFAULT '{}',
+  src_type TEXT NOT NULL DEFAULT '',
+  classification TEXT[] NOT NULL DEFAULT '{}',
+  decision TEXT NOT NULL,
+  prev_hash TEXT NOT NULL,
+  hash TEXT NOT NULL
+);
+
+COMMENT ON TABLE audit_log IS 'audit records of the access to the data, hash chained in seq order';
+COMMENT ON COLUMN audit_log.seq IS 'position of the record in the chain, counting from 1';
+COMMENT ON COLUMN audit_log.ts IS 'timestamp of the access';
+COMMENT ON COLUMN audit_log.subject IS 'user of the access token of the caller';
+COMMENT ON COLUMN audit_log.action IS 'query, view, create, update, revert or download';
+COMMENT ON COLUMN audit_log.procedure IS 'RPC procedure or HTTP path of the access';
+COMMENT ON COLUMN audit_log.object_ids IS 'ids of the records, notes or attachments accessed';
+COMMENT ON COLUMN audit_log.src_type IS 'source type of the records accessed';
+COMMENT ON COLUMN audit_log.classification IS 'classification of the search attributes of the objects accessed';
+COMMENT ON COLUMN audit_log.decision IS 'allowed, or filtered when the entitlements of the caller withheld the objects';
+COMMENT ON COLUMN audit_log.prev_hash IS 'hash of the previous record, empty for the first record';
+COMMENT ON COLUMN audit_log.hash IS 'hex SHA-256 of the record and prev_hash';
+
+-- Records are only appended. Changes are detected by the hash chain regardless, this refuses them outright.
+CREATE OR REPLACE FUNCTION refuse_audit_log_change()
+	RETURNS trigger AS $$
+BEGIN
+	RAISE insufficient_privilege USING MESSAGE = 'audit_log records can not be changed or deleted';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER refuse_audit_log_change
+	BEFORE UPDATE OR DELETE ON audit_log
+	FOR EACH ROW
+	EXECUTE PROCEDURE refuse_audit_log_change();
+
+CREATE OR REPLACE TRIGGER refuse_audit_log_truncate
+	BEFORE TRUNCATE ON audit_log
+	FOR EACH STATEMENT
+	EXECUTE PROCEDURE refuse_audit_log_change();
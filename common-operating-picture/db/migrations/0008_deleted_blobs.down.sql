@@ -0,0 +1,54 @@
+DROP TRIGGER IF EXISTS queue_deleted_tdf_attachments_blob ON tdf_attachments;
+DROP TRIGGER IF EXISTS queue_deleted_tdf_notes_blob ON tdf_notes;
+DROP TRIGGER IF EXISTS queue_deleted_tdf_objects_blob ON tdf_objects;
+DROP FUNCTION IF EXISTS queue_deleted_blob();
+DROP TABLE IF EXISTS deleted_blobs;
+
+-- Detach and drop the monthly partitions that end before the given timestamp, their notes, attachments and
+-- revisions, returns the dropped partitions. With dry_run the partitions are only returned.
+CREATE OR REPLACE FUNCTION drop_tdf_objects_partitions(before_ts TIMESTAMP, dry_run BOOLEAN)
+	RETURNS SETOF TEXT AS $$
+DECLARE
+	partition_name TEXT;
+BEGIN
+	FOR partition_name IN
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'tdf_objects'::regclass
+		  AND c.relname ~ '^tdf_objects_\d{4}_\d{2}$'
+		  AND to_timestamp(substring(c.relname FROM '\d{4}_\d{2}$'), 'YYYY_MM')::TIMESTAMP + INTERVAL '1 month' <= before_ts
+		ORDER BY c.relname
+	LOOP
+		IF NOT dry_run THEN
+			-- notes, attachments and revisions are removed by row triggers, which do not fire when a partition is
+			-- dropped. The attachments of the notes are removed by the tdf_notes delete trigger.
+			EXECUTE format('DELETE FROM tdf_notes WHERE parent_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format(
+				'DELETE FROM tdf_attachments WHERE parent_type = %L AND parent_id IN (SELECT id FROM %I)',
+				'tdf_object', partition_name
+			);
+			EXECUTE format('DELETE FROM tdf_object_revisions WHERE tdf_object_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format('ALTER TABLE tdf_objects DETACH PARTITION %I', partition_name);
+			EXECUTE format('DROP TABLE %I', partition_name);
+		END IF;
+		RETURN NEXT partition_name;
+	END LOOP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION notify_tdf_objects_inserted()
+	RETURNS trigger AS $$
+DECLARE
+BEGIN
+	IF current_setting('dsp_cop.moved_tdf_object', true) = NEW.id::TEXT THEN
+		PERFORM set_config('dsp_cop.moved_tdf_object', '', true);
+		RETURN NEW;
+	END IF;
+	PERFORM pg_notify(
+		CAST('tdf_objects_inserted' AS text),
+		row_to_json(NEW)::TEXT
+	);
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
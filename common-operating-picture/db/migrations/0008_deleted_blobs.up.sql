@@ -0,0 +1,95 @@
+/*
+	#############################################################################
+	### deleted_blobs TABLE
+	#############################################################################
+*/
+CREATE TABLE IF NOT EXISTS deleted_blobs (
+  tdf_uri TEXT PRIMARY KEY,
+  _created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE deleted_blobs IS 'tdf_uris of deleted tdf_objects, tdf_notes and tdf_attachments whose payloads are yet to be deleted from the blob store';
+COMMENT ON COLUMN deleted_blobs.tdf_uri IS 'tdf data uri of the deleted row';
+
+-- The blob store is not reachable from the database, so deletes queue the tdf_uris of their rows for the server to
+-- delete the payloads. Rows moved to another partition keep their payload.
+CREATE OR REPLACE FUNCTION queue_deleted_blob()
+	RETURNS trigger AS $$
+BEGIN
+	IF OLD.tdf_uri IS NOT NULL AND OLD.tdf_uri <> ''
+	  AND (TG_ARGV[0] IS NULL OR NOT EXISTS (SELECT 1 FROM tdf_objects WHERE id = OLD.id)) THEN
+		INSERT INTO deleted_blobs (tdf_uri) VALUES (OLD.tdf_uri) ON CONFLICT DO NOTHING;
+	END IF;
+	RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER queue_deleted_tdf_objects_blob
+	AFTER DELETE ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE queue_deleted_blob('tdf_objects');
+
+CREATE OR REPLACE TRIGGER queue_deleted_tdf_notes_blob
+	AFTER DELETE ON tdf_notes
+	FOR EACH ROW
+	EXECUTE PROCEDURE queue_deleted_blob();
+
+CREATE OR REPLACE TRIGGER queue_deleted_tdf_attachments_blob
+	AFTER DELETE ON tdf_attachments
+	FOR EACH ROW
+	EXECUTE PROCEDURE queue_deleted_blob();
+
+-- Detach and drop the monthly partitions that end before the given timestamp, their notes, attachments, revisions and
+-- payloads, returns the dropped partitions. With dry_run the partitions are only returned.
+CREATE OR REPLACE FUNCTION drop_tdf_objects_partitions(before_ts TIMESTAMP, dry_run BOOLEAN)
+	RETURNS SETOF TEXT AS $$
+DECLARE
+	partition_name TEXT;
+BEGIN
+	FOR partition_name IN
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'tdf_objects'::regclass
+		  AND c.relname ~ '^tdf_objects_\d{4}_\d{2}$'
+		  AND to_timestamp(substring(c.relname FROM '\d{4}_\d{2}$'), 'YYYY_MM')::TIMESTAMP + INTERVAL '1 month' <= before_ts
+		ORDER BY c.relname
+	LOOP
+		IF NOT dry_run THEN
+			-- notes, attachments, revisions and payloads are removed by row triggers, which do not fire when a
+			-- partition is dropped. The attachments of the notes are removed by the tdf_notes delete trigger.
+			EXECUTE format('DELETE FROM tdf_notes WHERE parent_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format(
+				'DELETE FROM tdf_attachments WHERE parent_type = %L AND parent_id IN (SELECT id FROM %I)',
+				'tdf_object', partition_name
+			);
+			EXECUTE format('DELETE FROM tdf_object_revisions WHERE tdf_object_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format(
+				'INSERT INTO deleted_blobs (tdf_uri) SELECT tdf_uri FROM %I WHERE tdf_uri <> %L ON CONFLICT DO NOTHING',
+				partition_name, ''
+			);
+			EXECUTE format('ALTER TABLE tdf_objects DETACH PARTITION %I', partition_name);
+			EXECUTE format('DROP TABLE %I', partition_name);
+		END IF;
+		RETURN NEXT partition_name;
+	END LOOP;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Notify inserts with the id of the row, read by the listener, as a row with its tdf_blob may exceed the 8000 byte
+-- limit of notifications. The insert of a row moved to another partition is still skipped.
+CREATE OR REPLACE FUNCTION notify_tdf_objects_inserted()
+	RETURNS trigger AS $$
+DECLARE
+BEGIN
+	IF current_setting('dsp_cop.moved_tdf_object', true) = NEW.id::TEXT THEN
+		PERFORM set_config('dsp_cop.moved_tdf_object', '', true);
+		RETURN NEW;
+	END IF;
+	PERFORM pg_notify(
+		CAST('tdf_objects_inserted' AS text),
+		json_build_object('id', NEW.id)::TEXT
+	);
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
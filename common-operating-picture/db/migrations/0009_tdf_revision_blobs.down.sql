@@ -0,0 +1,51 @@
+DROP TRIGGER IF EXISTS delete_tdf_object_revision_blobs ON tdf_object_revisions;
+DROP FUNCTION IF EXISTS delete_tdf_object_revision_blobs();
+
+ALTER TABLE tdf_object_revisions ADD COLUMN IF NOT EXISTS tdf_blob BYTEA NULL;
+
+COMMENT ON COLUMN tdf_object_revisions.tdf_blob IS 'tdf_blob of the tdf_object before the change';
+
+UPDATE tdf_object_revisions r
+SET tdf_blob = b.tdf_blob
+FROM tdf_revision_blobs b
+WHERE b.hash = r.tdf_blob_hash;
+
+DROP INDEX IF EXISTS tdf_object_revisions_changed_at_idx;
+DROP INDEX IF EXISTS tdf_object_revisions_tdf_blob_hash_idx;
+ALTER TABLE tdf_object_revisions DROP COLUMN IF EXISTS tdf_blob_hash;
+DROP TABLE IF EXISTS tdf_revision_blobs;
+
+-- Record the previous version with a copy of its tdf_blob, as migration 5
+CREATE OR REPLACE FUNCTION record_tdf_object_revision()
+	RETURNS trigger AS $$
+DECLARE
+	changed TEXT[];
+BEGIN
+	changed := array_remove(ARRAY[
+		CASE WHEN NEW.ts IS DISTINCT FROM OLD.ts THEN 'ts' END,
+		CASE WHEN NEW.src_type IS DISTINCT FROM OLD.src_type THEN 'src_type' END,
+		CASE WHEN NEW.geo IS DISTINCT FROM OLD.geo THEN 'geo' END,
+		CASE WHEN NEW.search IS DISTINCT FROM OLD.search THEN 'search' END,
+		CASE WHEN NEW.metadata IS DISTINCT FROM OLD.metadata THEN 'metadata' END,
+		CASE WHEN NEW.tdf_blob IS DISTINCT FROM OLD.tdf_blob THEN 'tdf_blob' END,
+		CASE WHEN NEW.tdf_uri IS DISTINCT FROM OLD.tdf_uri THEN 'tdf_uri' END,
+		CASE WHEN NEW.entity_key IS DISTINCT FROM OLD.entity_key THEN 'entity_key' END
+	], NULL);
+	IF cardinality(changed) = 0 THEN
+		RETURN NEW;
+	END IF;
+
+	INSERT INTO tdf_object_revisions (
+		tdf_object_id, revision, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, entity_key,
+		operation, changed_fields, changed_by
+	)
+	SELECT OLD.id, COALESCE(MAX(r.revision), 0) + 1, OLD.ts, OLD.src_type, OLD.geo, OLD.search, OLD.metadata,
+		OLD.tdf_blob, OLD.tdf_uri, OLD.entity_key,
+		COALESCE(NULLIF(current_setting('dsp_cop.operation', true), ''), 'update'),
+		changed,
+		COALESCE(NULLIF(current_setting('dsp_cop.changed_by', true), ''), 'anonymous')
+	FROM tdf_object_revisions r
+	WHERE r.tdf_object_id = OLD.id;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,101 @@
+/*
+	#############################################################################
+	### tdf_revision_blobs TABLE
+	#############################################################################
+*/
+CREATE TABLE IF NOT EXISTS tdf_revision_blobs (
+  hash TEXT PRIMARY KEY,
+  tdf_blob BYTEA NOT NULL
+);
+
+COMMENT ON TABLE tdf_revision_blobs IS 'tdf_blobs of tdf_object_revisions, stored once however many revisions have them';
+COMMENT ON COLUMN tdf_revision_blobs.hash IS 'hex encoded sha256 of the tdf_blob';
+COMMENT ON COLUMN tdf_revision_blobs.tdf_blob IS 'tdf_blob of the revisions referencing the hash';
+
+-- Revisions reference their tdf_blob by its hash, so the revisions of updates leaving the tdf_blob unchanged do not
+-- copy it again
+ALTER TABLE tdf_object_revisions ADD COLUMN IF NOT EXISTS tdf_blob_hash TEXT NULL REFERENCES tdf_revision_blobs (hash);
+
+COMMENT ON COLUMN tdf_object_revisions.tdf_blob_hash IS 'hash of the tdf_blob of the tdf_object before the change in tdf_revision_blobs';
+
+CREATE INDEX IF NOT EXISTS tdf_object_revisions_tdf_blob_hash_idx ON tdf_object_revisions (tdf_blob_hash);
+CREATE INDEX IF NOT EXISTS tdf_object_revisions_changed_at_idx ON tdf_object_revisions (changed_at);
+
+INSERT INTO tdf_revision_blobs (hash, tdf_blob)
+SELECT DISTINCT encode(sha256(tdf_blob), 'hex'), tdf_blob
+FROM tdf_object_revisions
+WHERE tdf_blob IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+UPDATE tdf_object_revisions
+SET tdf_blob_hash = encode(sha256(tdf_blob), 'hex')
+WHERE tdf_blob IS NOT NULL;
+
+ALTER TABLE tdf_object_revisions DROP COLUMN IF EXISTS tdf_blob;
+
+-- Record the previous version as before, with its tdf_blob in tdf_revision_blobs
+CREATE OR REPLACE FUNCTION record_tdf_object_revision()
+	RETURNS trigger AS $$
+DECLARE
+	changed TEXT[];
+	blob_hash TEXT;
+BEGIN
+	changed := array_remove(ARRAY[
+		CASE WHEN NEW.ts IS DISTINCT FROM OLD.ts THEN 'ts' END,
+		CASE WHEN NEW.src_type IS DISTINCT FROM OLD.src_type THEN 'src_type' END,
+		CASE WHEN NEW.geo IS DISTINCT FROM OLD.geo THEN 'geo' END,
+		CASE WHEN NEW.search IS DISTINCT FROM OLD.search THEN 'search' END,
+		CASE WHEN NEW.metadata IS DISTINCT FROM OLD.metadata THEN 'metadata' END,
+		CASE WHEN NEW.tdf_blob IS DISTINCT FROM OLD.tdf_blob THEN 'tdf_blob' END,
+		CASE WHEN NEW.tdf_uri IS DISTINCT FROM OLD.tdf_uri THEN 'tdf_uri' END,
+		CASE WHEN NEW.entity_key IS DISTINCT FROM OLD.entity_key THEN 'entity_key' END
+	], NULL);
+	IF cardinality(changed) = 0 THEN
+		RETURN NEW;
+	END IF;
+
+	IF OLD.tdf_blob IS NOT NULL THEN
+		blob_hash := encode(sha256(OLD.tdf_blob), 'hex');
+		INSERT INTO tdf_revision_blobs (hash, tdf_blob) VALUES (blob_hash, OLD.tdf_blob) ON CONFLICT DO NOTHING;
+	END IF;
+
+	INSERT INTO tdf_object_revisions (
+		tdf_object_id, revision, ts, src_type, geo, search, metadata, tdf_blob_hash, tdf_uri, entity_key,
+		operation, changed_fields, changed_by
+	)
+	SELECT OLD.id, COALESCE(MAX(r.revision), 0) + 1, OLD.ts, OLD.src_type, OLD.geo, OLD.search, OLD.metadata,
+		blob_hash, OLD.tdf_uri, OLD.entity_key,
+		COALESCE(NULLIF(current_setting('dsp_cop.operation', true), ''), 'update'),
+		changed,
+		COALESCE(NULLIF(current_setting('dsp_cop.changed_by', true), ''), 'anonymous')
+	FROM tdf_object_revisions r
+	WHERE r.tdf_object_id = OLD.id;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Remove the tdf_blob of a deleted revision when no other revision has it, and queue its tdf_uri for the server to
+-- delete the payload (see queue_deleted_blob) when neither the tdf_object nor its other revisions have it. AFTER
+-- triggers fire once the statement deleted all of its rows, so the revisions deleted with it do not count.
+CREATE OR REPLACE FUNCTION delete_tdf_object_revision_blobs()
+	RETURNS trigger AS $$
+BEGIN
+	IF OLD.tdf_blob_hash IS NOT NULL
+	  AND NOT EXISTS (SELECT 1 FROM tdf_object_revisions WHERE tdf_blob_hash = OLD.tdf_blob_hash) THEN
+		DELETE FROM tdf_revision_blobs WHERE hash = OLD.tdf_blob_hash;
+	END IF;
+	IF OLD.tdf_uri IS NOT NULL AND OLD.tdf_uri <> ''
+	  AND NOT EXISTS (SELECT 1 FROM tdf_objects WHERE id = OLD.tdf_object_id AND tdf_uri = OLD.tdf_uri)
+	  AND NOT EXISTS (
+		SELECT 1 FROM tdf_object_revisions WHERE tdf_object_id = OLD.tdf_object_id AND tdf_uri = OLD.tdf_uri
+	  ) THEN
+		INSERT INTO deleted_blobs (tdf_uri) VALUES (OLD.tdf_uri) ON CONFLICT DO NOTHING;
+	END IF;
+	RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER delete_tdf_object_revision_blobs
+	AFTER DELETE ON tdf_object_revisions
+	FOR EACH ROW
+	EXECUTE PROCEDURE delete_tdf_object_revision_blobs();
@@ -0,0 +1,35 @@
+DROP TRIGGER IF EXISTS record_tdf_object_deletion ON tdf_objects;
+DROP FUNCTION IF EXISTS record_tdf_object_deletion();
+
+-- Revisions are deleted with their tdf_object again, the history of the deleted tdf_objects goes and their payloads
+-- are queued by delete_tdf_object_revision_blobs
+DELETE FROM tdf_object_revisions r
+WHERE NOT EXISTS (SELECT 1 FROM tdf_objects o WHERE o.id = r.tdf_object_id);
+
+ALTER TABLE tdf_object_revisions DROP CONSTRAINT IF EXISTS tdf_object_revisions_operation_check;
+ALTER TABLE tdf_object_revisions ADD CONSTRAINT tdf_object_revisions_operation_check
+	CHECK (operation IN ('update', 'revert'));
+
+COMMENT ON COLUMN tdf_object_revisions.operation IS 'update or revert';
+
+CREATE OR REPLACE TRIGGER queue_deleted_tdf_objects_blob
+	AFTER DELETE ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE queue_deleted_blob('tdf_objects');
+
+-- as migration 5
+CREATE OR REPLACE FUNCTION delete_tdf_objects_revisions()
+	RETURNS trigger AS $$
+BEGIN
+	-- keep the revisions of moved rows, see delete_tdf_objects_notes
+	DELETE FROM tdf_object_revisions
+	WHERE tdf_object_id = OLD.id
+	  AND NOT EXISTS (SELECT 1 FROM tdf_objects WHERE id = OLD.id);
+	RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER delete_tdf_objects_revisions
+	AFTER DELETE ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE delete_tdf_objects_revisions();
@@ -0,0 +1,60 @@
+-- Deleting a tdf_object records its last version as a revision of the delete operation, rather than deleting its
+-- revisions, so the history of deleted tdf_objects is kept until the retention purge of revisions removes it
+ALTER TABLE tdf_object_revisions DROP CONSTRAINT IF EXISTS tdf_object_revisions_operation_check;
+ALTER TABLE tdf_object_revisions ADD CONSTRAINT tdf_object_revisions_operation_check
+	CHECK (operation IN ('update', 'revert', 'delete'));
+
+COMMENT ON COLUMN tdf_object_revisions.operation IS 'update, revert or delete';
+
+DROP TRIGGER IF EXISTS delete_tdf_objects_revisions ON tdf_objects;
+DROP FUNCTION IF EXISTS delete_tdf_objects_revisions();
+
+-- The payload of a deleted tdf_object is kept for its delete revision, and queued by delete_tdf_object_revision_blobs
+-- once the revision is purged
+DROP TRIGGER IF EXISTS queue_deleted_tdf_objects_blob ON tdf_objects;
+
+-- Record the version of a deleted tdf_object, with its user as set by set_config('dsp_cop.changed_by', ...). Rows
+-- moved to another partition are not deleted and record nothing, see delete_tdf_objects_notes. The retention purge
+-- sets set_config('dsp_cop.operation', 'purge', ...), its deletes remove the revisions of the tdf_objects and queue
+-- their payloads instead.
+CREATE OR REPLACE FUNCTION record_tdf_object_deletion()
+	RETURNS trigger AS $$
+DECLARE
+	blob_hash TEXT;
+BEGIN
+	IF EXISTS (SELECT 1 FROM tdf_objects WHERE id = OLD.id) THEN
+		RETURN OLD;
+	END IF;
+
+	IF current_setting('dsp_cop.operation', true) = 'purge' THEN
+		DELETE FROM tdf_object_revisions WHERE tdf_object_id = OLD.id;
+		IF OLD.tdf_uri IS NOT NULL AND OLD.tdf_uri <> '' THEN
+			INSERT INTO deleted_blobs (tdf_uri) VALUES (OLD.tdf_uri) ON CONFLICT DO NOTHING;
+		END IF;
+		RETURN OLD;
+	END IF;
+
+	IF OLD.tdf_blob IS NOT NULL THEN
+		blob_hash := encode(sha256(OLD.tdf_blob), 'hex');
+		INSERT INTO tdf_revision_blobs (hash, tdf_blob) VALUES (blob_hash, OLD.tdf_blob) ON CONFLICT DO NOTHING;
+	END IF;
+
+	INSERT INTO tdf_object_revisions (
+		tdf_object_id, revision, ts, src_type, geo, search, metadata, tdf_blob_hash, tdf_uri, entity_key,
+		operation, changed_fields, changed_by
+	)
+	SELECT OLD.id, COALESCE(MAX(r.revision), 0) + 1, OLD.ts, OLD.src_type, OLD.geo, OLD.search, OLD.metadata,
+		blob_hash, OLD.tdf_uri, OLD.entity_key,
+		'delete',
+		'{}',
+		COALESCE(NULLIF(current_setting('dsp_cop.changed_by', true), ''), 'anonymous')
+	FROM tdf_object_revisions r
+	WHERE r.tdf_object_id = OLD.id;
+	RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER record_tdf_object_deletion
+	AFTER DELETE ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE record_tdf_object_deletion();
@@ -0,0 +1,2 @@
+DROP FUNCTION IF EXISTS tdf_object_visible(JSONB, TEXT[]);
+DROP FUNCTION IF EXISTS tdf_object_search_values(JSONB);
@@ -0,0 +1,30 @@
+-- The values of a search attribute, a string or an array of strings as read by util.StringOrArray. NULL when the
+-- value is neither, which tdf_object_visible treats as not visible.
+CREATE OR REPLACE FUNCTION tdf_object_search_values(value JSONB)
+	RETURNS TEXT[] AS $$
+	SELECT CASE
+		WHEN value IS NULL OR jsonb_typeof(value) = 'null' THEN '{}'::TEXT[]
+		WHEN jsonb_typeof(value) = 'string' AND value #>> '{}' = '' THEN '{}'::TEXT[]
+		WHEN jsonb_typeof(value) = 'string' THEN ARRAY[value #>> '{}']
+		WHEN jsonb_typeof(value) = 'array'
+			AND NOT EXISTS (SELECT 1 FROM jsonb_array_elements(value) e WHERE jsonb_typeof(e) NOT IN ('string', 'null'))
+			THEN ARRAY(SELECT jsonb_array_elements_text(value))
+	END;
+$$ LANGUAGE sql IMMUTABLE;
+
+-- Whether a caller with the entitlements can see a tdf_object of the search index, the check of
+-- util.TrimTDFVisibility: every classification and need-to-know and, when there are any, one of the rel-tos.
+-- Queries use it to pick the latest visible row of an entity, the server still filters the rows it returns.
+CREATE OR REPLACE FUNCTION tdf_object_visible(search JSONB, entitlements TEXT[])
+	RETURNS BOOLEAN AS $$
+	SELECT COALESCE(
+		search IS NULL OR jsonb_typeof(search) = 'null' OR (
+			jsonb_typeof(search) = 'object'
+			AND tdf_object_search_values(search -> 'attrClassification') <@ COALESCE(entitlements, '{}')
+			AND tdf_object_search_values(search -> 'attrNeedToKnow') <@ COALESCE(entitlements, '{}')
+			AND (cardinality(tdf_object_search_values(search -> 'attrRelTo')) = 0
+				OR tdf_object_search_values(search -> 'attrRelTo') && COALESCE(entitlements, '{}'))
+		),
+		false
+	);
+$$ LANGUAGE sql IMMUTABLE;
@@ -0,0 +1,44 @@
+DROP TRIGGER IF EXISTS keep_tdf_object_id ON tdf_objects;
+DROP FUNCTION IF EXISTS keep_tdf_object_id();
+DROP TRIGGER IF EXISTS release_tdf_object_id ON tdf_objects;
+DROP FUNCTION IF EXISTS release_tdf_object_id();
+DROP TRIGGER IF EXISTS claim_tdf_object_id ON tdf_objects;
+DROP FUNCTION IF EXISTS claim_tdf_object_id();
+DROP TABLE IF EXISTS tdf_object_ids;
+
+-- Detach and drop the monthly partitions that end before the given timestamp, their notes, attachments, revisions and
+-- payloads, returns the dropped partitions. With dry_run the partitions are only returned.
+CREATE OR REPLACE FUNCTION drop_tdf_objects_partitions(before_ts TIMESTAMP, dry_run BOOLEAN)
+	RETURNS SETOF TEXT AS $$
+DECLARE
+	partition_name TEXT;
+BEGIN
+	FOR partition_name IN
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'tdf_objects'::regclass
+		  AND c.relname ~ '^tdf_objects_\d{4}_\d{2}$'
+		  AND to_timestamp(substring(c.relname FROM '\d{4}_\d{2}$'), 'YYYY_MM')::TIMESTAMP + INTERVAL '1 month' <= before_ts
+		ORDER BY c.relname
+	LOOP
+		IF NOT dry_run THEN
+			-- notes, attachments, revisions and payloads are removed by row triggers, which do not fire when a
+			-- partition is dropped. The attachments of the notes are removed by the tdf_notes delete trigger.
+			EXECUTE format('DELETE FROM tdf_notes WHERE parent_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format(
+				'DELETE FROM tdf_attachments WHERE parent_type = %L AND parent_id IN (SELECT id FROM %I)',
+				'tdf_object', partition_name
+			);
+			EXECUTE format('DELETE FROM tdf_object_revisions WHERE tdf_object_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format(
+				'INSERT INTO deleted_blobs (tdf_uri) SELECT tdf_uri FROM %I WHERE tdf_uri <> %L ON CONFLICT DO NOTHING',
+				partition_name, ''
+			);
+			EXECUTE format('ALTER TABLE tdf_objects DETACH PARTITION %I', partition_name);
+			EXECUTE format('DROP TABLE %I', partition_name);
+		END IF;
+		RETURN NEXT partition_name;
+	END LOOP;
+END;
+$$ LANGUAGE plpgsql;
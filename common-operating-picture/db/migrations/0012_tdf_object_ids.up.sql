@@ -0,0 +1,109 @@
+/*
+	#############################################################################
+	### tdf_object_ids TABLE
+	#############################################################################
+*/
+-- The primary key of the partitioned tdf_objects is (id, ts), the ids are kept unique across the partitions by
+-- claiming them in tdf_object_ids
+CREATE TABLE IF NOT EXISTS tdf_object_ids (
+  id UUID PRIMARY KEY
+);
+
+COMMENT ON TABLE tdf_object_ids IS 'ids of the tdf_objects, unique across partitions';
+
+DO $$
+BEGIN
+	IF EXISTS (SELECT 1 FROM tdf_objects GROUP BY id HAVING count(*) > 1) THEN
+		RAISE EXCEPTION 'tdf_objects has rows sharing an id, remove the duplicates before migrating';
+	END IF;
+END;
+$$;
+
+INSERT INTO tdf_object_ids (id)
+SELECT id FROM tdf_objects
+ON CONFLICT DO NOTHING;
+
+-- Claim the id of an inserted row, an id of another row fails the insert with a unique violation. An id left
+-- claimed without a row, e.g. by a TRUNCATE, is claimed again. A row moved to another partition is deleted then
+-- inserted, its id is released by release_tdf_object_id before it is claimed again.
+CREATE OR REPLACE FUNCTION claim_tdf_object_id()
+	RETURNS trigger AS $$
+BEGIN
+	INSERT INTO tdf_object_ids (id) VALUES (NEW.id) ON CONFLICT (id) DO NOTHING;
+	IF NOT FOUND AND EXISTS (SELECT 1 FROM tdf_objects WHERE id = NEW.id) THEN
+		RAISE EXCEPTION 'duplicate tdf_objects id %', NEW.id
+			USING ERRCODE = 'unique_violation', CONSTRAINT = 'tdf_object_ids_pkey';
+	END IF;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER claim_tdf_object_id
+	BEFORE INSERT ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE claim_tdf_object_id();
+
+CREATE OR REPLACE FUNCTION release_tdf_object_id()
+	RETURNS trigger AS $$
+BEGIN
+	DELETE FROM tdf_object_ids WHERE id = OLD.id;
+	RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER release_tdf_object_id
+	BEFORE DELETE ON tdf_objects
+	FOR EACH ROW
+	EXECUTE PROCEDURE release_tdf_object_id();
+
+-- The id of a row never changes, so the claimed ids stay those of the rows
+CREATE OR REPLACE FUNCTION keep_tdf_object_id()
+	RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'the id of tdf_object % can not change', OLD.id;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER keep_tdf_object_id
+	BEFORE UPDATE OF id ON tdf_objects
+	FOR EACH ROW
+	WHEN (NEW.id IS DISTINCT FROM OLD.id)
+	EXECUTE PROCEDURE keep_tdf_object_id();
+
+-- Detach and drop the monthly partitions that end before the given timestamp, their notes, attachments, revisions,
+-- payloads and ids, returns the dropped partitions. With dry_run the partitions are only returned.
+CREATE OR REPLACE FUNCTION drop_tdf_objects_partitions(before_ts TIMESTAMP, dry_run BOOLEAN)
+	RETURNS SETOF TEXT AS $$
+DECLARE
+	partition_name TEXT;
+BEGIN
+	FOR partition_name IN
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'tdf_objects'::regclass
+		  AND c.relname ~ '^tdf_objects_\d{4}_\d{2}$'
+		  AND to_timestamp(substring(c.relname FROM '\d{4}_\d{2}$'), 'YYYY_MM')::TIMESTAMP + INTERVAL '1 month' <= before_ts
+		ORDER BY c.relname
+	LOOP
+		IF NOT dry_run THEN
+			-- notes, attachments, revisions, payloads and ids are removed by row triggers, which do not fire when a
+			-- partition is dropped. The attachments of the notes are removed by the tdf_notes delete trigger.
+			EXECUTE format('DELETE FROM tdf_notes WHERE parent_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format(
+				'DELETE FROM tdf_attachments WHERE parent_type = %L AND parent_id IN (SELECT id FROM %I)',
+				'tdf_object', partition_name
+			);
+			EXECUTE format('DELETE FROM tdf_object_revisions WHERE tdf_object_id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format(
+				'INSERT INTO deleted_blobs (tdf_uri) SELECT tdf_uri FROM %I WHERE tdf_uri <> %L ON CONFLICT DO NOTHING',
+				partition_name, ''
+			);
+			EXECUTE format('DELETE FROM tdf_object_ids WHERE id IN (SELECT id FROM %I)', partition_name);
+			EXECUTE format('ALTER TABLE tdf_objects DETACH PARTITION %I', partition_name);
+			EXECUTE format('DROP TABLE %I', partition_name);
+		END IF;
+		RETURN NEXT partition_name;
+	END LOOP;
+END;
+$$ LANGUAGE plpgsql;
@@ -10,6 +10,73 @@ import (
 	geos "github.com/twpayne/go-geos"
 )
 
+// audit records of the access to the data, hash chained in seq order
+type AuditLog struct {
+	// position of the record in the chain, counting from 1
+	Seq int64 `json:"seq"`
+	// timestamp of the access
+	Ts pgtype.Timestamp `json:"ts"`
+	// user of the access token of the caller
+	Subject string `json:"subject"`
+	// query, view, create, update, revert or download
+	Action string `json:"action"`
+	// RPC procedure or HTTP path of the access
+	Procedure string `json:"procedure"`
+	// ids of the records, notes or attachments accessed
+	ObjectIds []string `json:"object_ids"`
+	// source type of the records accessed
+	SrcType string `json:"src_type"`
+	// classification of the search attributes of the objects accessed
+	Classification []string `json:"classification"`
+	// allowed, or filtered when the entitlements of the caller withheld the objects
+	Decision string `json:"decision"`
+	// hash of the previous record, empty for the first record
+	PrevHash string `json:"prev_hash"`
+	// hex SHA-256 of the record and prev_hash
+	Hash string `json:"hash"`
+}
+
+// tdf_uris of deleted tdf_objects, tdf_notes and tdf_attachments whose payloads are yet to be deleted from the blob store
+type DeletedBlob struct {
+	// tdf data uri of the deleted row
+	TdfUri    string           `json:"tdf_uri"`
+	CreatedAt pgtype.Timestamp `json:"_created_at"`
+}
+
+// named areas evaluated against new and updated tdf_objects
+type Geofence struct {
+	// uuid primary key generated by the database
+	ID uuid.UUID `json:"id"`
+	// display name of the geofence
+	Name string `json:"name"`
+	// polygon of the geofence
+	Geo interface{} `json:"geo"`
+	// only tdf_objects of this source type are evaluated, NULL evaluates all source types
+	SrcType pgtype.Text `json:"src_type"`
+	// only tdf_objects whose search index contains this json are evaluated
+	Search []byte `json:"search"`
+	// seconds an entity must stay inside before a dwell event is emitted, 0 disables dwell events
+	DwellSeconds int32            `json:"dwell_seconds"`
+	CreatedAt    pgtype.Timestamp `json:"_created_at"`
+	CreatedBy    pgtype.Text      `json:"_created_by"`
+}
+
+// entities currently inside a geofence
+type GeofenceOccupant struct {
+	// foreign key, corresponds to primary key id of geofences entry
+	GeofenceID uuid.UUID `json:"geofence_id"`
+	// source type of the entity
+	SrcType string `json:"src_type"`
+	// entity_key of the tdf_object, or its id when it has no entity_key
+	EntityKey string `json:"entity_key"`
+	// timestamp of the first tdf_object inside the geofence
+	EnteredAt pgtype.Timestamp `json:"entered_at"`
+	// timestamp of the latest tdf_object inside the geofence
+	LastSeen pgtype.Timestamp `json:"last_seen"`
+	// whether a dwell event was emitted for this visit
+	DwellNotified bool `json:"dwell_notified"`
+}
+
 type SrcType struct {
 	ID         string `json:"id"`
 	FormSchema []byte `json:"form_schema"`
@@ -17,6 +84,32 @@ type SrcType struct {
 	Metadata   []byte `json:"metadata"`
 }
 
+// files attached to tdf_objects and tdf_notes, each with its own classification
+type TdfAttachment struct {
+	// uuid primary key generated by the database
+	ID uuid.UUID `json:"id"`
+	// timestamp generated by the database
+	Ts pgtype.Timestamp `json:"ts"`
+	// corresponds to primary key id of the tdf_objects or tdf_notes entry of parent_type
+	ParentID uuid.UUID `json:"parent_id"`
+	// table of the parent, tdf_object or tdf_note
+	ParentType string `json:"parent_type"`
+	// file name of the attachment
+	Name string `json:"name"`
+	// MIME type of the attached file
+	MimeType string `json:"mime_type"`
+	// size in bytes of the tdf of the attachment
+	Size int64 `json:"size"`
+	// plaintext json search index
+	Search []byte `json:"search"`
+	// tdf data blob
+	TdfBlob []byte `json:"tdf_blob"`
+	// tdf data uri
+	TdfUri    pgtype.Text      `json:"tdf_uri"`
+	CreatedAt pgtype.Timestamp `json:"_created_at"`
+	CreatedBy pgtype.Text      `json:"_created_by"`
+}
+
 // stream of tdf data
 type TdfNote struct {
 	// uuid primary key generated by the database
@@ -37,7 +130,7 @@ type TdfNote struct {
 
 // stream of tdf data
 type TdfObject struct {
-	// uuid primary key generated by the database
+	// uuid generated by the database, unique across partitions although the primary key is (id, ts)
 	ID uuid.UUID `json:"id"`
 	// timestamp generated by the database
 	Ts pgtype.Timestamp `json:"ts"`
@@ -55,4 +148,96 @@ type TdfObject struct {
 	TdfUri    pgtype.Text      `json:"tdf_uri"`
 	CreatedAt pgtype.Timestamp `json:"_created_at"`
 	CreatedBy pgtype.Text      `json:"_created_by"`
+	// identifies the entity (e.g. aircraft, vessel) the data is about, used to build tracks
+	EntityKey pgtype.Text `json:"entity_key"`
+	// incremented by every update, checked by updates expecting a version
+	Version int32 `json:"version"`
+}
+
+// ids of the tdf_objects, unique across partitions
+type TdfObjectID struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// versions of tdf_objects before each update, recorded by a trigger
+type TdfObjectRevision struct {
+	// uuid primary key generated by the database
+	ID uuid.UUID `json:"id"`
+	// corresponds to primary key id of the tdf_objects entry
+	TdfObjectID uuid.UUID `json:"tdf_object_id"`
+	// number of the change of the tdf_object, counting from 1
+	Revision int32 `json:"revision"`
+	// ts of the tdf_object before the change
+	Ts pgtype.Timestamp `json:"ts"`
+	// src_type of the tdf_object before the change
+	SrcType string `json:"src_type"`
+	// geo of the tdf_object before the change
+	Geo *geos.Geom `json:"geo"`
+	// search of the tdf_object before the change, its previous classification
+	Search []byte `json:"search"`
+	// metadata of the tdf_object before the change
+	Metadata []byte `json:"metadata"`
+	// tdf_uri of the tdf_object before the change
+	TdfUri pgtype.Text `json:"tdf_uri"`
+	// entity_key of the tdf_object before the change
+	EntityKey pgtype.Text `json:"entity_key"`
+	// update, revert or delete
+	Operation string `json:"operation"`
+	// columns the change modified
+	ChangedFields []string `json:"changed_fields"`
+	// timestamp of the change
+	ChangedAt pgtype.Timestamp `json:"changed_at"`
+	// user who made the change
+	ChangedBy string `json:"changed_by"`
+	// hash of the tdf_blob of the tdf_object before the change in tdf_revision_blobs
+	TdfBlobHash pgtype.Text `json:"tdf_blob_hash"`
+}
+
+// tdf_blobs of tdf_object_revisions, stored once however many revisions have them
+type TdfRevisionBlob struct {
+	// hex encoded sha256 of the tdf_blob
+	Hash string `json:"hash"`
+	// tdf_blob of the revisions referencing the hash
+	TdfBlob []byte `json:"tdf_blob"`
+}
+
+// subscriptions of URLs new tdf_objects are delivered to
+type Webhook struct {
+	// uuid primary key generated by the database
+	ID uuid.UUID `json:"id"`
+	// display name of the webhook
+	Name string `json:"name"`
+	// URL new tdf_objects are POSTed to
+	Url string `json:"url"`
+	// only tdf_objects of this source type are delivered, NULL delivers all source types
+	SrcType pgtype.Text `json:"src_type"`
+	// only tdf_objects intersecting this geometry are delivered, NULL delivers tdf_objects anywhere
+	Geo *geos.Geom `json:"geo"`
+	// only tdf_objects whose search index contains this json are delivered
+	Search []byte `json:"search"`
+	// HMAC-SHA256 secret signing the deliveries
+	Secret string `json:"secret"`
+	// OIDC client of the service identity whose entitlements limit the tdf_objects delivered
+	ClientID string `json:"client_id"`
+	// OIDC client secret of the service identity
+	ClientSecret string           `json:"client_secret"`
+	CreatedAt    pgtype.Timestamp `json:"_created_at"`
+	CreatedBy    pgtype.Text      `json:"_created_by"`
+}
+
+// deliveries of webhooks that failed after all their attempts
+type WebhookDeadLetter struct {
+	// uuid primary key generated by the database
+	ID uuid.UUID `json:"id"`
+	// foreign key, corresponds to primary key id of webhooks entry
+	WebhookID uuid.UUID `json:"webhook_id"`
+	// id of the delivered tdf_object
+	TdfObjectID uuid.UUID `json:"tdf_object_id"`
+	// json body of the delivery
+	Payload []byte `json:"payload"`
+	// number of attempts of the delivery
+	Attempts int32 `json:"attempts"`
+	// error of the last attempt
+	Error     string           `json:"error"`
+	CreatedAt pgtype.Timestamp `json:"_created_at"`
 }
@@ -53,7 +53,7 @@ func StatusifyError(err error, fallbackErr error, log ...any) *connect.Error {
 		return nil
 	}
 	if strings.Contains(err.Error(), pgerrcode.UndefinedTable) {
-		l = append(l, "check README steps to set up database schema")
+		l = append(l, "run `dsp-cop db migrate up` to set up database schema")
 		slog.Error(err.Error(), l...)
 		return connect.NewError(
 			connect.CodeInternal,
@@ -1,6 +1,6 @@
 -- name: CreateTdfObjects :batchone
-INSERT INTO tdf_objects (ts, src_type, geo, search, metadata, tdf_blob, tdf_uri)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO tdf_objects (ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, entity_key)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING id;
 
 -- name: UpdateTdfObject :one
@@ -11,9 +11,10 @@ SET ts = COALESCE(sqlc.narg('ts'), ts),
     search = COALESCE(sqlc.narg('search'), search),
     metadata = COALESCE(sqlc.narg('metadata'), metadata),
     tdf_blob = COALESCE(sqlc.narg('tdf_blob'), tdf_blob),
-    tdf_uri = COALESCE(sqlc.narg('tdf_uri'), tdf_uri)
-WHERE id = $1
-RETURNING id, src_type, ts;
+    tdf_uri = COALESCE(sqlc.narg('tdf_uri'), tdf_uri),
+    entity_key = COALESCE(sqlc.narg('entity_key'), entity_key)
+WHERE id = $1 AND (sqlc.narg('expected_version')::INTEGER IS NULL OR version = sqlc.narg('expected_version')::INTEGER)
+RETURNING id, src_type, ts, version, search;
 
 -- name: DeleteTdfObject :one
 DELETE FROM tdf_objects
@@ -21,47 +22,88 @@ WHERE id = $1
 RETURNING *;
 
 -- name: GetTdfObject :one
-SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 FROM tdf_objects
 WHERE
   id = $1
 LIMIT 1;
 
+-- name: GetNotifiedTdfObject :one
+SELECT *
+FROM tdf_objects
+WHERE id = $1;
+
+-- name: GetTdfObjectMetadata :one
+SELECT metadata
+FROM tdf_objects
+WHERE id = $1;
+
+-- name: GetTdfObjectSrcType :one
+SELECT src_type
+FROM tdf_objects
+WHERE id = $1;
+
 -- name: ListTdfObjects :many
-SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 FROM tdf_objects
-WHERE src_type = sqlc.arg('SourceType')::TEXT AND ts >= sqlc.arg('StartTime')::TIMESTAMP AND ts <= sqlc.arg('EndTime')::TIMESTAMP
+WHERE (COALESCE(cardinality(sqlc.arg('SourceTypes')::TEXT[]), 0) = 0 OR src_type = ANY(sqlc.arg('SourceTypes')::TEXT[])) AND ts >= sqlc.arg('StartTime')::TIMESTAMP AND ts <= sqlc.arg('EndTime')::TIMESTAMP
 ORDER BY ts DESC;
 
--- name: ListTdfObjectsWithGeo :many
-SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+-- name: ListTdfObjectsPage :many
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_uri, entity_key, version, tdf_blob IS NOT NULL AS has_tdf_blob
 FROM tdf_objects
 WHERE src_type = sqlc.arg('SourceType')::TEXT AND ts >= sqlc.arg('StartTime')::TIMESTAMP AND ts <= sqlc.arg('EndTime')::TIMESTAMP
+  AND (ts, id) < (sqlc.arg('BeforeTs')::TIMESTAMP, sqlc.arg('BeforeID')::UUID)
+  AND (sqlc.narg('Search')::JSONB IS NULL OR search @> sqlc.narg('Search')::JSONB)
+  AND (sqlc.narg('Geometry')::GEOMETRY IS NULL OR ST_Within(geo, sqlc.narg('Geometry')::GEOMETRY))
+ORDER BY ts DESC, id DESC
+LIMIT sqlc.arg('Limit');
+
+-- name: ListTdfObjectsWithGeo :many
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
+FROM tdf_objects
+WHERE (COALESCE(cardinality(sqlc.arg('SourceTypes')::TEXT[]), 0) = 0 OR src_type = ANY(sqlc.arg('SourceTypes')::TEXT[])) AND ts >= sqlc.arg('StartTime')::TIMESTAMP AND ts <= sqlc.arg('EndTime')::TIMESTAMP
   AND ST_Within(geo, sqlc.arg('Geometry')::GEOMETRY)
 ORDER BY ts DESC;
 
 -- name: ListTdfObjectsWithSearch :many
-SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 FROM tdf_objects
-WHERE src_type = sqlc.arg('SourceType')::TEXT AND ts >= sqlc.arg('StartTime')::TIMESTAMP AND ts <= sqlc.arg('EndTime')::TIMESTAMP
+WHERE (COALESCE(cardinality(sqlc.arg('SourceTypes')::TEXT[]), 0) = 0 OR src_type = ANY(sqlc.arg('SourceTypes')::TEXT[])) AND ts >= sqlc.arg('StartTime')::TIMESTAMP AND ts <= sqlc.arg('EndTime')::TIMESTAMP
   AND search @> sqlc.arg('Search')::JSONB
 ORDER BY ts DESC;
 
 -- name: ListTdfObjectsWithMetadata :many
-SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 FROM tdf_objects
-WHERE src_type = sqlc.arg('SourceType')::TEXT AND ts >= sqlc.arg('StartTime')::TIMESTAMP AND ts <= sqlc.arg('EndTime')::TIMESTAMP
+WHERE (COALESCE(cardinality(sqlc.arg('SourceTypes')::TEXT[]), 0) = 0 OR src_type = ANY(sqlc.arg('SourceTypes')::TEXT[])) AND ts >= sqlc.arg('StartTime')::TIMESTAMP AND ts <= sqlc.arg('EndTime')::TIMESTAMP
   AND metadata @> sqlc.arg('Metadata')::JSONB
 ORDER BY ts DESC;
 
 -- name: ListTdfObjectsWithSearchAndGeo :many
-SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 FROM tdf_objects
-WHERE src_type = sqlc.arg('SourceType')::TEXT AND ts >= sqlc.arg('StartTime')::TIMESTAMP AND ts <= sqlc.arg('EndTime')::TIMESTAMP
+WHERE (COALESCE(cardinality(sqlc.arg('SourceTypes')::TEXT[]), 0) = 0 OR src_type = ANY(sqlc.arg('SourceTypes')::TEXT[])) AND ts >= sqlc.arg('StartTime')::TIMESTAMP AND ts <= sqlc.arg('EndTime')::TIMESTAMP
   AND search @> sqlc.arg('Search')::JSONB
   AND ST_Within(geo, sqlc.arg('Geometry')::GEOMETRY)
 ORDER BY ts DESC;
 
+-- name: GetLatestPositions :many
+SELECT DISTINCT ON (src_type, entity_key) id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
+FROM tdf_objects
+WHERE entity_key IS NOT NULL
+  AND (COALESCE(cardinality(sqlc.arg('SourceTypes')::TEXT[]), 0) = 0 OR src_type = ANY(sqlc.arg('SourceTypes')::TEXT[])) AND ts >= sqlc.arg('StartTime')::TIMESTAMP AND ts <= sqlc.arg('EndTime')::TIMESTAMP
+  AND tdf_object_visible(search, sqlc.arg('Entitlements')::TEXT[])
+ORDER BY src_type, entity_key, ts DESC, id DESC;
+
+-- name: GetTrack :many
+SELECT id, ts, ST_Centroid(geo)::GEOMETRY AS geo, search
+FROM tdf_objects
+WHERE src_type = sqlc.arg('SourceType')::TEXT AND entity_key = sqlc.arg('EntityKey')::TEXT
+  AND ts >= sqlc.arg('StartTime')::TIMESTAMP AND ts <= sqlc.arg('EndTime')::TIMESTAMP
+  AND geo IS NOT NULL
+ORDER BY ts ASC;
+
 -- name: GetSrcType :one
 SELECT id, form_schema, ui_schema, metadata
 FROM src_types
@@ -91,3 +133,294 @@ where id = $1;
 INSERT INTO tdf_notes (ts, parent_id, search, tdf_blob, tdf_uri)
 VALUES ($1, $2, $3, $4, $5)
 RETURNING id;
+
+-- name: CreateTdfAttachment :one
+INSERT INTO tdf_attachments (parent_id, parent_type, name, mime_type, size, search, tdf_blob, tdf_uri)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id;
+
+-- name: GetTdfAttachment :one
+SELECT id, ts, parent_id, parent_type, name, mime_type, size, search, tdf_blob, tdf_uri
+FROM tdf_attachments
+WHERE id = $1;
+
+-- name: ListTdfAttachments :many
+SELECT id, ts, parent_id, parent_type, name, mime_type, size, search
+FROM tdf_attachments
+WHERE parent_id = $1
+ORDER BY ts;
+
+-- name: SetTdfObjectChange :exec
+SELECT set_config('dsp_cop.changed_by', sqlc.arg('changed_by')::TEXT, true),
+       set_config('dsp_cop.operation', sqlc.arg('operation')::TEXT, true);
+
+-- name: ListTdfObjectRevisions :many
+SELECT r.id, r.tdf_object_id, r.revision, r.ts, r.src_type, r.geo, r.search, r.metadata, b.tdf_blob, r.tdf_uri, r.entity_key,
+  r.operation, r.changed_fields, r.changed_at, r.changed_by
+FROM tdf_object_revisions r
+LEFT JOIN tdf_revision_blobs b ON b.hash = r.tdf_blob_hash
+WHERE r.tdf_object_id = $1
+ORDER BY r.revision DESC;
+
+-- name: GetTdfObjectRevision :one
+SELECT r.id, r.tdf_object_id, r.revision, r.ts, r.src_type, r.geo, r.search, r.metadata, b.tdf_blob, r.tdf_uri, r.entity_key,
+  r.operation, r.changed_fields, r.changed_at, r.changed_by
+FROM tdf_object_revisions r
+LEFT JOIN tdf_revision_blobs b ON b.hash = r.tdf_blob_hash
+WHERE r.tdf_object_id = $1 AND r.revision = $2;
+
+-- name: GetLatestTdfObjectRevision :one
+SELECT COALESCE(MAX(revision), 0)::INTEGER AS revision
+FROM tdf_object_revisions
+WHERE tdf_object_id = $1;
+
+-- name: RevertTdfObject :one
+UPDATE tdf_objects o
+SET ts = sqlc.arg('ts'),
+    src_type = r.src_type,
+    geo = r.geo,
+    search = r.search,
+    metadata = r.metadata,
+    tdf_blob = (SELECT b.tdf_blob FROM tdf_revision_blobs b WHERE b.hash = r.tdf_blob_hash),
+    tdf_uri = r.tdf_uri,
+    entity_key = r.entity_key
+FROM tdf_object_revisions r
+WHERE o.id = sqlc.arg('id') AND r.tdf_object_id = o.id AND r.revision = sqlc.arg('revision')
+RETURNING o.id;
+
+-- name: CreateGeofence :one
+INSERT INTO geofences (name, geo, src_type, search, dwell_seconds)
+VALUES ($1, $2, NULLIF(sqlc.arg('src_type')::TEXT, ''), $3, $4)
+RETURNING id;
+
+-- name: UpdateGeofence :one
+UPDATE geofences
+SET name = COALESCE(sqlc.narg('name'), name),
+    geo = COALESCE(sqlc.narg('geo'), geo),
+    src_type = CASE WHEN sqlc.narg('src_type')::TEXT IS NULL THEN src_type ELSE NULLIF(sqlc.narg('src_type')::TEXT, '') END,
+    search = COALESCE(sqlc.narg('search'), search),
+    dwell_seconds = COALESCE(sqlc.narg('dwell_seconds'), dwell_seconds)
+WHERE id = $1
+RETURNING id;
+
+-- name: DeleteGeofence :one
+DELETE FROM geofences
+WHERE id = $1
+RETURNING id;
+
+-- name: GetGeofence :one
+SELECT id, name, geo, src_type, search, dwell_seconds
+FROM geofences
+WHERE id = $1;
+
+-- name: ListGeofences :many
+SELECT id, name, geo, src_type, search, dwell_seconds
+FROM geofences
+ORDER BY name;
+
+-- name: ListGeofencesContaining :many
+SELECT id, name, dwell_seconds
+FROM geofences
+WHERE (src_type IS NULL OR src_type = sqlc.arg('SourceType')::TEXT)
+  AND (COALESCE(search, '{}'::JSONB) = '{}'::JSONB OR sqlc.arg('Search')::JSONB @> search)
+  AND ST_Intersects(geo, ST_SetSRID(sqlc.arg('Geometry')::GEOMETRY, ST_SRID(geo)));
+
+-- name: EnterGeofence :one
+INSERT INTO geofence_occupants (geofence_id, src_type, entity_key, entered_at, last_seen)
+VALUES ($1, $2, $3, sqlc.arg('ts')::TIMESTAMP, sqlc.arg('ts')::TIMESTAMP)
+ON CONFLICT (geofence_id, src_type, entity_key) DO UPDATE SET last_seen = EXCLUDED.last_seen
+RETURNING entered_at, dwell_notified, (xmax = 0)::BOOLEAN AS entered;
+
+-- name: MarkGeofenceDwellNotified :exec
+UPDATE geofence_occupants
+SET dwell_notified = TRUE
+WHERE geofence_id = $1 AND src_type = $2 AND entity_key = $3;
+
+-- name: ExitGeofences :many
+DELETE FROM geofence_occupants o
+USING geofences g
+WHERE o.geofence_id = g.id AND o.src_type = sqlc.arg('SourceType')::TEXT AND o.entity_key = sqlc.arg('EntityKey')::TEXT
+  AND NOT (o.geofence_id = ANY(sqlc.arg('Inside')::UUID[]))
+RETURNING g.id, g.name, o.entered_at;
+
+-- name: ListTdfObjectSrcTypes :many
+SELECT DISTINCT src_type
+FROM tdf_objects;
+
+-- name: CountTdfObjectsForRetention :one
+SELECT count(*) AS total, count(*) FILTER (WHERE ts < sqlc.arg('Before')::TIMESTAMP) AS expired
+FROM tdf_objects
+WHERE src_type = sqlc.arg('SourceType')::TEXT;
+
+-- name: DeleteExpiredTdfObjects :execrows
+DELETE FROM tdf_objects
+WHERE src_type = sqlc.arg('SourceType')::TEXT AND ts < sqlc.arg('Before')::TIMESTAMP;
+
+-- name: DeleteExcessTdfObjects :execrows
+DELETE FROM tdf_objects
+WHERE id IN (
+  SELECT id
+  FROM tdf_objects
+  WHERE src_type = sqlc.arg('SourceType')::TEXT
+  ORDER BY ts DESC NULLS LAST
+  OFFSET sqlc.arg('MaxRows')::BIGINT
+);
+
+-- name: CountTdfObjectRevisionsForRetention :one
+SELECT count(*) FILTER (WHERE changed_at < sqlc.arg('Before')::TIMESTAMP AND (n > 1 OR operation = 'delete')) AS expired,
+  count(*) FILTER (
+    WHERE changed_at >= sqlc.arg('Before')::TIMESTAMP AND sqlc.arg('MaxCount')::INTEGER > 0 AND n > sqlc.arg('MaxCount')::INTEGER
+  ) AS excess
+FROM (
+  SELECT changed_at, operation, row_number() OVER (PARTITION BY tdf_object_id ORDER BY revision DESC) AS n
+  FROM tdf_object_revisions
+) r;
+
+-- name: DeleteExpiredTdfObjectRevisions :execrows
+DELETE FROM tdf_object_revisions r
+WHERE r.changed_at < sqlc.arg('Before')::TIMESTAMP
+  AND (r.operation = 'delete'
+    OR r.revision < (SELECT max(l.revision) FROM tdf_object_revisions l WHERE l.tdf_object_id = r.tdf_object_id));
+
+-- name: DeleteExcessTdfObjectRevisions :execrows
+DELETE FROM tdf_object_revisions
+WHERE id IN (
+  SELECT id
+  FROM (
+    SELECT id, row_number() OVER (PARTITION BY tdf_object_id ORDER BY revision DESC) AS n
+    FROM tdf_object_revisions
+  ) r
+  WHERE n > sqlc.arg('MaxCount')::INTEGER
+);
+
+-- name: CreateTdfObjectPartitions :many
+SELECT create_tdf_objects_partitions(sqlc.arg('Through')::TIMESTAMP)::TEXT AS name;
+
+-- name: DropTdfObjectPartitions :many
+SELECT drop_tdf_objects_partitions(sqlc.arg('Before')::TIMESTAMP, sqlc.arg('DryRun')::BOOLEAN)::TEXT AS name;
+
+-- name: ExportTdfObjects :many
+SELECT id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by, entity_key, version
+FROM tdf_objects
+WHERE (COALESCE(cardinality(sqlc.arg('SourceTypes')::TEXT[]), 0) = 0 OR src_type = ANY(sqlc.arg('SourceTypes')::TEXT[]))
+  AND (ts, id) > (sqlc.arg('AfterTs')::TIMESTAMP, sqlc.arg('AfterID')::UUID) AND ts <= sqlc.arg('EndTime')::TIMESTAMP
+  AND (sqlc.narg('Search')::JSONB IS NULL OR search @> sqlc.narg('Search')::JSONB)
+  AND (sqlc.narg('Geometry')::GEOMETRY IS NULL OR ST_Within(geo, sqlc.narg('Geometry')::GEOMETRY))
+ORDER BY ts, id
+LIMIT sqlc.arg('Limit');
+
+-- name: ListNotesByParents :many
+SELECT id, ts, parent_id, search, tdf_blob, tdf_uri, _created_at, _created_by
+FROM tdf_notes
+WHERE parent_id = ANY(sqlc.arg('ParentIDs')::UUID[])
+ORDER BY parent_id, ts;
+
+-- name: ImportTdfObjects :batchone
+INSERT INTO tdf_objects (id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by, entity_key)
+SELECT sqlc.arg('ID')::UUID, sqlc.arg('Ts')::TIMESTAMP, sqlc.arg('SrcType')::TEXT, sqlc.narg('Geo')::GEOMETRY,
+  sqlc.narg('Search')::JSONB, sqlc.narg('Metadata')::JSONB, sqlc.narg('TdfBlob')::BYTEA, sqlc.narg('TdfUri')::TEXT,
+  COALESCE(sqlc.narg('CreatedAt')::TIMESTAMP, CURRENT_TIMESTAMP), COALESCE(sqlc.narg('CreatedBy')::TEXT, 'anonymous'),
+  sqlc.narg('EntityKey')::TEXT
+WHERE NOT EXISTS (SELECT 1 FROM tdf_objects WHERE id = sqlc.arg('ID')::UUID)
+ON CONFLICT DO NOTHING
+RETURNING id;
+
+-- name: ImportTdfNotes :batchone
+INSERT INTO tdf_notes (id, ts, parent_id, search, tdf_blob, tdf_uri, _created_at, _created_by)
+VALUES (sqlc.arg('ID')::UUID, sqlc.narg('Ts')::TIMESTAMP, sqlc.arg('ParentID')::UUID, sqlc.narg('Search')::JSONB,
+  sqlc.narg('TdfBlob')::BYTEA, sqlc.narg('TdfUri')::TEXT, COALESCE(sqlc.narg('CreatedAt')::TIMESTAMP, CURRENT_TIMESTAMP),
+  COALESCE(sqlc.narg('CreatedBy')::TEXT, 'anonymous'))
+ON CONFLICT (id) DO NOTHING
+RETURNING id;
+
+-- name: CreateWebhook :one
+INSERT INTO webhooks (name, url, src_type, geo, search, secret, client_id, client_secret, _created_by)
+VALUES ($1, $2, NULLIF(sqlc.arg('src_type')::TEXT, ''), $3, $4, $5, $6, $7, sqlc.arg('created_by')::TEXT)
+RETURNING id;
+
+-- name: UpdateWebhook :one
+UPDATE webhooks
+SET name = COALESCE(sqlc.narg('name'), name),
+    url = COALESCE(sqlc.narg('url'), url),
+    src_type = CASE WHEN sqlc.narg('src_type')::TEXT IS NULL THEN src_type ELSE NULLIF(sqlc.narg('src_type')::TEXT, '') END,
+    geo = CASE WHEN sqlc.arg('clear_geo')::BOOLEAN THEN NULL ELSE COALESCE(sqlc.narg('geo'), geo) END,
+    search = COALESCE(sqlc.narg('search'), search),
+    secret = COALESCE(sqlc.narg('secret'), secret),
+    client_id = COALESCE(sqlc.narg('client_id'), client_id),
+    client_secret = COALESCE(sqlc.narg('client_secret'), client_secret)
+WHERE id = $1
+RETURNING id;
+
+-- name: DeleteWebhook :one
+DELETE FROM webhooks
+WHERE id = $1
+RETURNING id;
+
+-- name: GetWebhook :one
+SELECT id, name, url, src_type, geo, search, client_id
+FROM webhooks
+WHERE id = $1;
+
+-- name: GetWebhookOwner :one
+SELECT _created_by
+FROM webhooks
+WHERE id = $1;
+
+-- name: ListWebhooks :many
+SELECT id, name, url, src_type, geo, search, client_id
+FROM webhooks
+WHERE sqlc.arg('Admin')::BOOLEAN OR _created_by = sqlc.arg('Owner')::TEXT
+ORDER BY name;
+
+-- name: ListWebhookSecrets :many
+SELECT id, secret, client_secret
+FROM webhooks;
+
+-- name: ListWebhooksMatching :many
+SELECT id, name, url, secret, client_id, client_secret
+FROM webhooks
+WHERE (src_type IS NULL OR src_type = sqlc.arg('SourceType')::TEXT)
+  AND (COALESCE(search, '{}'::JSONB) = '{}'::JSONB OR sqlc.arg('Search')::JSONB @> search)
+  AND (geo IS NULL OR ST_Intersects(geo, ST_SetSRID(sqlc.narg('Geometry')::GEOMETRY, ST_SRID(geo))));
+
+-- name: CreateWebhookDeadLetter :exec
+INSERT INTO webhook_dead_letters (webhook_id, tdf_object_id, payload, attempts, error)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListWebhookDeadLetters :many
+SELECT id, webhook_id, tdf_object_id, payload, attempts, error, _created_at
+FROM webhook_dead_letters
+WHERE webhook_id = $1
+ORDER BY _created_at DESC
+LIMIT sqlc.arg('Limit')::INTEGER;
+
+-- name: CreateAuditRecord :exec
+INSERT INTO audit_log (seq, ts, subject, action, procedure, object_ids, src_type, classification, decision, prev_hash, hash)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: LockAuditLog :exec
+SELECT pg_advisory_xact_lock(4871320926);
+
+-- name: GetLatestAuditRecord :one
+SELECT seq, ts, subject, action, procedure, object_ids, src_type, classification, decision, prev_hash, hash
+FROM audit_log
+ORDER BY seq DESC
+LIMIT 1;
+
+-- name: ListAuditRecords :many
+SELECT seq, ts, subject, action, procedure, object_ids, src_type, classification, decision, prev_hash, hash
+FROM audit_log
+WHERE seq > sqlc.arg('AfterSeq')::BIGINT
+ORDER BY seq
+LIMIT sqlc.arg('Limit')::INTEGER;
+
+-- name: ListDeletedBlobs :many
+SELECT tdf_uri
+FROM deleted_blobs
+WHERE tdf_uri > sqlc.arg('After')::TEXT
+ORDER BY tdf_uri
+LIMIT sqlc.arg('Limit')::INTEGER;
+
+-- name: DeleteDeletedBlob :exec
+DELETE FROM deleted_blobs
+WHERE tdf_uri = $1;
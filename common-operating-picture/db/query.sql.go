@@ -13,17 +13,427 @@ import (
 	geos "github.com/twpayne/go-geos"
 )
 
+const countTdfObjectRevisionsForRetention = `-- name: CountTdfObjectRevisionsForRetention :one
+SELECT count(*) FILTER (WHERE changed_at < $1::TIMESTAMP AND (n > 1 OR operation = 'delete')) AS expired,
+  count(*) FILTER (
+    WHERE changed_at >= $1::TIMESTAMP AND $2::INTEGER > 0 AND n > $2::INTEGER
+  ) AS excess
+FROM (
+  SELECT changed_at, operation, row_number() OVER (PARTITION BY tdf_object_id ORDER BY revision DESC) AS n
+  FROM tdf_object_revisions
+) r
+`
+
+type CountTdfObjectRevisionsForRetentionParams struct {
+	Before   pgtype.Timestamp `json:"before"`
+	MaxCount int32            `json:"max_count"`
+}
+
+type CountTdfObjectRevisionsForRetentionRow struct {
+	Expired int64 `json:"expired"`
+	Excess  int64 `json:"excess"`
+}
+
+// CountTdfObjectRevisionsForRetention
+//
+//	SELECT count(*) FILTER (WHERE changed_at < $1::TIMESTAMP AND (n > 1 OR operation = 'delete')) AS expired,
+//	  count(*) FILTER (
+//	    WHERE changed_at >= $1::TIMESTAMP AND $2::INTEGER > 0 AND n > $2::INTEGER
+//	  ) AS excess
+//	FROM (
+//	  SELECT changed_at, operation, row_number() OVER (PARTITION BY tdf_object_id ORDER BY revision DESC) AS n
+//	  FROM tdf_object_revisions
+//	) r
+func (q *Queries) CountTdfObjectRevisionsForRetention(ctx context.Context, arg CountTdfObjectRevisionsForRetentionParams) (CountTdfObjectRevisionsForRetentionRow, error) {
+	row := q.db.QueryRow(ctx, countTdfObjectRevisionsForRetention, arg.Before, arg.MaxCount)
+	var i CountTdfObjectRevisionsForRetentionRow
+	err := row.Scan(&i.Expired, &i.Excess)
+	return i, err
+}
+
+const countTdfObjectsForRetention = `-- name: CountTdfObjectsForRetention :one
+SELECT count(*) AS total, count(*) FILTER (WHERE ts < $1::TIMESTAMP) AS expired
+FROM tdf_objects
+WHERE src_type = $2::TEXT
+`
+
+type CountTdfObjectsForRetentionParams struct {
+	Before     pgtype.Timestamp `json:"before"`
+	SourceType string           `json:"source_type"`
+}
+
+type CountTdfObjectsForRetentionRow struct {
+	Total   int64 `json:"total"`
+	Expired int64 `json:"expired"`
+}
+
+// CountTdfObjectsForRetention
+//
+//	SELECT count(*) AS total, count(*) FILTER (WHERE ts < $1::TIMESTAMP) AS expired
+//	FROM tdf_objects
+//	WHERE src_type = $2::TEXT
+func (q *Queries) CountTdfObjectsForRetention(ctx context.Context, arg CountTdfObjectsForRetentionParams) (CountTdfObjectsForRetentionRow, error) {
+	row := q.db.QueryRow(ctx, countTdfObjectsForRetention, arg.Before, arg.SourceType)
+	var i CountTdfObjectsForRetentionRow
+	err := row.Scan(&i.Total, &i.Expired)
+	return i, err
+}
+
+const createAuditRecord = `-- name: CreateAuditRecord :exec
+INSERT INTO audit_log (seq, ts, subject, action, procedure, object_ids, src_type, classification, decision, prev_hash, hash)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+`
+
+type CreateAuditRecordParams struct {
+	Seq            int64            `json:"seq"`
+	Ts             pgtype.Timestamp `json:"ts"`
+	Subject        string           `json:"subject"`
+	Action         string           `json:"action"`
+	Procedure      string           `json:"procedure"`
+	ObjectIds      []string         `json:"object_ids"`
+	SrcType        string           `json:"src_type"`
+	Classification []string         `json:"classification"`
+	Decision       string           `json:"decision"`
+	PrevHash       string           `json:"prev_hash"`
+	Hash           string           `json:"hash"`
+}
+
+// CreateAuditRecord
+//
+//	INSERT INTO audit_log (seq, ts, subject, action, procedure, object_ids, src_type, classification, decision, prev_hash, hash)
+//	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+func (q *Queries) CreateAuditRecord(ctx context.Context, arg CreateAuditRecordParams) error {
+	_, err := q.db.Exec(ctx, createAuditRecord,
+		arg.Seq,
+		arg.Ts,
+		arg.Subject,
+		arg.Action,
+		arg.Procedure,
+		arg.ObjectIds,
+		arg.SrcType,
+		arg.Classification,
+		arg.Decision,
+		arg.PrevHash,
+		arg.Hash,
+	)
+	return err
+}
+
+const createGeofence = `-- name: CreateGeofence :one
+INSERT INTO geofences (name, geo, src_type, search, dwell_seconds)
+VALUES ($1, $2, NULLIF($5::TEXT, ''), $3, $4)
+RETURNING id
+`
+
+type CreateGeofenceParams struct {
+	Name         string      `json:"name"`
+	Geo          interface{} `json:"geo"`
+	Search       []byte      `json:"search"`
+	DwellSeconds int32       `json:"dwell_seconds"`
+	SrcType      string      `json:"src_type"`
+}
+
+// CreateGeofence
+//
+//	INSERT INTO geofences (name, geo, src_type, search, dwell_seconds)
+//	VALUES ($1, $2, NULLIF($5::TEXT, ''), $3, $4)
+//	RETURNING id
+func (q *Queries) CreateGeofence(ctx context.Context, arg CreateGeofenceParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createGeofence,
+		arg.Name,
+		arg.Geo,
+		arg.Search,
+		arg.DwellSeconds,
+		arg.SrcType,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTdfAttachment = `-- name: CreateTdfAttachment :one
+INSERT INTO tdf_attachments (parent_id, parent_type, name, mime_type, size, search, tdf_blob, tdf_uri)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id
+`
+
+type CreateTdfAttachmentParams struct {
+	ParentID   uuid.UUID   `json:"parent_id"`
+	ParentType string      `json:"parent_type"`
+	Name       string      `json:"name"`
+	MimeType   string      `json:"mime_type"`
+	Size       int64       `json:"size"`
+	Search     []byte      `json:"search"`
+	TdfBlob    []byte      `json:"tdf_blob"`
+	TdfUri     pgtype.Text `json:"tdf_uri"`
+}
+
+// CreateTdfAttachment
+//
+//	INSERT INTO tdf_attachments (parent_id, parent_type, name, mime_type, size, search, tdf_blob, tdf_uri)
+//	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+//	RETURNING id
+func (q *Queries) CreateTdfAttachment(ctx context.Context, arg CreateTdfAttachmentParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createTdfAttachment,
+		arg.ParentID,
+		arg.ParentType,
+		arg.Name,
+		arg.MimeType,
+		arg.Size,
+		arg.Search,
+		arg.TdfBlob,
+		arg.TdfUri,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTdfObjectPartitions = `-- name: CreateTdfObjectPartitions :many
+SELECT create_tdf_objects_partitions($1::TIMESTAMP)::TEXT AS name
+`
+
+// CreateTdfObjectPartitions
+//
+//	SELECT create_tdf_objects_partitions($1::TIMESTAMP)::TEXT AS name
+func (q *Queries) CreateTdfObjectPartitions(ctx context.Context, through pgtype.Timestamp) ([]string, error) {
+	rows, err := q.db.Query(ctx, createTdfObjectPartitions, through)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createWebhook = `-- name: CreateWebhook :one
+INSERT INTO webhooks (name, url, src_type, geo, search, secret, client_id, client_secret, _created_by)
+VALUES ($1, $2, NULLIF($8::TEXT, ''), $3, $4, $5, $6, $7, $9::TEXT)
+RETURNING id
+`
+
+type CreateWebhookParams struct {
+	Name         string     `json:"name"`
+	Url          string     `json:"url"`
+	Geo          *geos.Geom `json:"geo"`
+	Search       []byte     `json:"search"`
+	Secret       string     `json:"secret"`
+	ClientID     string     `json:"client_id"`
+	ClientSecret string     `json:"client_secret"`
+	SrcType      string     `json:"src_type"`
+	CreatedBy    string     `json:"created_by"`
+}
+
+// CreateWebhook
+//
+//	INSERT INTO webhooks (name, url, src_type, geo, search, secret, client_id, client_secret, _created_by)
+//	VALUES ($1, $2, NULLIF($8::TEXT, ''), $3, $4, $5, $6, $7, $9::TEXT)
+//	RETURNING id
+func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createWebhook,
+		arg.Name,
+		arg.Url,
+		arg.Geo,
+		arg.Search,
+		arg.Secret,
+		arg.ClientID,
+		arg.ClientSecret,
+		arg.SrcType,
+		arg.CreatedBy,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createWebhookDeadLetter = `-- name: CreateWebhookDeadLetter :exec
+INSERT INTO webhook_dead_letters (webhook_id, tdf_object_id, payload, attempts, error)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type CreateWebhookDeadLetterParams struct {
+	WebhookID   uuid.UUID `json:"webhook_id"`
+	TdfObjectID uuid.UUID `json:"tdf_object_id"`
+	Payload     []byte    `json:"payload"`
+	Attempts    int32     `json:"attempts"`
+	Error       string    `json:"error"`
+}
+
+// CreateWebhookDeadLetter
+//
+//	INSERT INTO webhook_dead_letters (webhook_id, tdf_object_id, payload, attempts, error)
+//	VALUES ($1, $2, $3, $4, $5)
+func (q *Queries) CreateWebhookDeadLetter(ctx context.Context, arg CreateWebhookDeadLetterParams) error {
+	_, err := q.db.Exec(ctx, createWebhookDeadLetter,
+		arg.WebhookID,
+		arg.TdfObjectID,
+		arg.Payload,
+		arg.Attempts,
+		arg.Error,
+	)
+	return err
+}
+
+const deleteDeletedBlob = `-- name: DeleteDeletedBlob :exec
+DELETE FROM deleted_blobs
+WHERE tdf_uri = $1
+`
+
+// DeleteDeletedBlob
+//
+//	DELETE FROM deleted_blobs
+//	WHERE tdf_uri = $1
+func (q *Queries) DeleteDeletedBlob(ctx context.Context, tdfUri string) error {
+	_, err := q.db.Exec(ctx, deleteDeletedBlob, tdfUri)
+	return err
+}
+
+const deleteExcessTdfObjectRevisions = `-- name: DeleteExcessTdfObjectRevisions :execrows
+DELETE FROM tdf_object_revisions
+WHERE id IN (
+  SELECT id
+  FROM (
+    SELECT id, row_number() OVER (PARTITION BY tdf_object_id ORDER BY revision DESC) AS n
+    FROM tdf_object_revisions
+  ) r
+  WHERE n > $1::INTEGER
+)
+`
+
+// DeleteExcessTdfObjectRevisions
+//
+//	DELETE FROM tdf_object_revisions
+//	WHERE id IN (
+//	  SELECT id
+//	  FROM (
+//	    SELECT id, row_number() OVER (PARTITION BY tdf_object_id ORDER BY revision DESC) AS n
+//	    FROM tdf_object_revisions
+//	  ) r
+//	  WHERE n > $1::INTEGER
+//	)
+func (q *Queries) DeleteExcessTdfObjectRevisions(ctx context.Context, maxCount int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExcessTdfObjectRevisions, maxCount)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteExcessTdfObjects = `-- name: DeleteExcessTdfObjects :execrows
+DELETE FROM tdf_objects
+WHERE id IN (
+  SELECT id
+  FROM tdf_objects
+  WHERE src_type = $1::TEXT
+  ORDER BY ts DESC NULLS LAST
+  OFFSET $2::BIGINT
+)
+`
+
+type DeleteExcessTdfObjectsParams struct {
+	SourceType string `json:"source_type"`
+	MaxRows    int64  `json:"max_rows"`
+}
+
+// DeleteExcessTdfObjects
+//
+//	DELETE FROM tdf_objects
+//	WHERE id IN (
+//	  SELECT id
+//	  FROM tdf_objects
+//	  WHERE src_type = $1::TEXT
+//	  ORDER BY ts DESC NULLS LAST
+//	  OFFSET $2::BIGINT
+//	)
+func (q *Queries) DeleteExcessTdfObjects(ctx context.Context, arg DeleteExcessTdfObjectsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExcessTdfObjects, arg.SourceType, arg.MaxRows)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteExpiredTdfObjectRevisions = `-- name: DeleteExpiredTdfObjectRevisions :execrows
+DELETE FROM tdf_object_revisions r
+WHERE r.changed_at < $1::TIMESTAMP
+  AND (r.operation = 'delete'
+    OR r.revision < (SELECT max(l.revision) FROM tdf_object_revisions l WHERE l.tdf_object_id = r.tdf_object_id))
+`
+
+// DeleteExpiredTdfObjectRevisions
+//
+//	DELETE FROM tdf_object_revisions r
+//	WHERE r.changed_at < $1::TIMESTAMP
+//	  AND (r.operation = 'delete'
+//	    OR r.revision < (SELECT max(l.revision) FROM tdf_object_revisions l WHERE l.tdf_object_id = r.tdf_object_id))
+func (q *Queries) DeleteExpiredTdfObjectRevisions(ctx context.Context, before pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredTdfObjectRevisions, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteExpiredTdfObjects = `-- name: DeleteExpiredTdfObjects :execrows
+DELETE FROM tdf_objects
+WHERE src_type = $1::TEXT AND ts < $2::TIMESTAMP
+`
+
+type DeleteExpiredTdfObjectsParams struct {
+	SourceType string           `json:"source_type"`
+	Before     pgtype.Timestamp `json:"before"`
+}
+
+// DeleteExpiredTdfObjects
+//
+//	DELETE FROM tdf_objects
+//	WHERE src_type = $1::TEXT AND ts < $2::TIMESTAMP
+func (q *Queries) DeleteExpiredTdfObjects(ctx context.Context, arg DeleteExpiredTdfObjectsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredTdfObjects, arg.SourceType, arg.Before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteGeofence = `-- name: DeleteGeofence :one
+DELETE FROM geofences
+WHERE id = $1
+RETURNING id
+`
+
+// DeleteGeofence
+//
+//	DELETE FROM geofences
+//	WHERE id = $1
+//	RETURNING id
+func (q *Queries) DeleteGeofence(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, deleteGeofence, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
 const deleteTdfObject = `-- name: DeleteTdfObject :one
 DELETE FROM tdf_objects
 WHERE id = $1
-RETURNING id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by
+RETURNING id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by, entity_key, version
 `
 
 // DeleteTdfObject
 //
 //	DELETE FROM tdf_objects
 //	WHERE id = $1
-//	RETURNING id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by
+//	RETURNING id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by, entity_key, version
 func (q *Queries) DeleteTdfObject(ctx context.Context, id uuid.UUID) (TdfObject, error) {
 	row := q.db.QueryRow(ctx, deleteTdfObject, id)
 	var i TdfObject
@@ -38,80 +448,207 @@ func (q *Queries) DeleteTdfObject(ctx context.Context, id uuid.UUID) (TdfObject,
 		&i.TdfUri,
 		&i.CreatedAt,
 		&i.CreatedBy,
+		&i.EntityKey,
+		&i.Version,
 	)
 	return i, err
 }
 
-const getNoteByID = `-- name: GetNoteByID :one
-SELECT id, ts, parent_id, tdf_blob, search, tdf_uri
-FROM tdf_notes
-where id = $1
+const deleteWebhook = `-- name: DeleteWebhook :one
+DELETE FROM webhooks
+WHERE id = $1
+RETURNING id
 `
 
-type GetNoteByIDRow struct {
-	ID       uuid.UUID        `json:"id"`
-	Ts       pgtype.Timestamp `json:"ts"`
-	ParentID uuid.UUID        `json:"parent_id"`
-	TdfBlob  []byte           `json:"tdf_blob"`
-	Search   []byte           `json:"search"`
-	TdfUri   pgtype.Text      `json:"tdf_uri"`
+// DeleteWebhook
+//
+//	DELETE FROM webhooks
+//	WHERE id = $1
+//	RETURNING id
+func (q *Queries) DeleteWebhook(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, deleteWebhook, id)
+	err := row.Scan(&id)
+	return id, err
 }
 
-// GetNoteByID
+const dropTdfObjectPartitions = `-- name: DropTdfObjectPartitions :many
+SELECT drop_tdf_objects_partitions($1::TIMESTAMP, $2::BOOLEAN)::TEXT AS name
+`
+
+type DropTdfObjectPartitionsParams struct {
+	Before pgtype.Timestamp `json:"before"`
+	DryRun bool             `json:"dry_run"`
+}
+
+// DropTdfObjectPartitions
 //
-//	SELECT id, ts, parent_id, tdf_blob, search, tdf_uri
-//	FROM tdf_notes
-//	where id = $1
-func (q *Queries) GetNoteByID(ctx context.Context, id uuid.UUID) (GetNoteByIDRow, error) {
-	row := q.db.QueryRow(ctx, getNoteByID, id)
-	var i GetNoteByIDRow
-	err := row.Scan(
-		&i.ID,
-		&i.Ts,
-		&i.ParentID,
-		&i.TdfBlob,
-		&i.Search,
-		&i.TdfUri,
+//	SELECT drop_tdf_objects_partitions($1::TIMESTAMP, $2::BOOLEAN)::TEXT AS name
+func (q *Queries) DropTdfObjectPartitions(ctx context.Context, arg DropTdfObjectPartitionsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, dropTdfObjectPartitions, arg.Before, arg.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const enterGeofence = `-- name: EnterGeofence :one
+INSERT INTO geofence_occupants (geofence_id, src_type, entity_key, entered_at, last_seen)
+VALUES ($1, $2, $3, $4::TIMESTAMP, $4::TIMESTAMP)
+ON CONFLICT (geofence_id, src_type, entity_key) DO UPDATE SET last_seen = EXCLUDED.last_seen
+RETURNING entered_at, dwell_notified, (xmax = 0)::BOOLEAN AS entered
+`
+
+type EnterGeofenceParams struct {
+	GeofenceID uuid.UUID        `json:"geofence_id"`
+	SrcType    string           `json:"src_type"`
+	EntityKey  string           `json:"entity_key"`
+	Ts         pgtype.Timestamp `json:"ts"`
+}
+
+type EnterGeofenceRow struct {
+	EnteredAt     pgtype.Timestamp `json:"entered_at"`
+	DwellNotified bool             `json:"dwell_notified"`
+	Entered       bool             `json:"entered"`
+}
+
+// EnterGeofence
+//
+//	INSERT INTO geofence_occupants (geofence_id, src_type, entity_key, entered_at, last_seen)
+//	VALUES ($1, $2, $3, $4::TIMESTAMP, $4::TIMESTAMP)
+//	ON CONFLICT (geofence_id, src_type, entity_key) DO UPDATE SET last_seen = EXCLUDED.last_seen
+//	RETURNING entered_at, dwell_notified, (xmax = 0)::BOOLEAN AS entered
+func (q *Queries) EnterGeofence(ctx context.Context, arg EnterGeofenceParams) (EnterGeofenceRow, error) {
+	row := q.db.QueryRow(ctx, enterGeofence,
+		arg.GeofenceID,
+		arg.SrcType,
+		arg.EntityKey,
+		arg.Ts,
 	)
+	var i EnterGeofenceRow
+	err := row.Scan(&i.EnteredAt, &i.DwellNotified, &i.Entered)
 	return i, err
 }
 
-const getNotesFromPar = `-- name: GetNotesFromPar :many
-SELECT id, ts, parent_id, search, tdf_blob, tdf_uri
-FROM tdf_notes
-WHERE parent_id = $1
+const exitGeofences = `-- name: ExitGeofences :many
+DELETE FROM geofence_occupants o
+USING geofences g
+WHERE o.geofence_id = g.id AND o.src_type = $1::TEXT AND o.entity_key = $2::TEXT
+  AND NOT (o.geofence_id = ANY($3::UUID[]))
+RETURNING g.id, g.name, o.entered_at
 `
 
-type GetNotesFromParRow struct {
-	ID       uuid.UUID        `json:"id"`
-	Ts       pgtype.Timestamp `json:"ts"`
-	ParentID uuid.UUID        `json:"parent_id"`
-	Search   []byte           `json:"search"`
-	TdfBlob  []byte           `json:"tdf_blob"`
-	TdfUri   pgtype.Text      `json:"tdf_uri"`
+type ExitGeofencesParams struct {
+	SourceType string      `json:"source_type"`
+	EntityKey  string      `json:"entity_key"`
+	Inside     []uuid.UUID `json:"inside"`
 }
 
-// GetNotesFromPar
+type ExitGeofencesRow struct {
+	ID        uuid.UUID        `json:"id"`
+	Name      string           `json:"name"`
+	EnteredAt pgtype.Timestamp `json:"entered_at"`
+}
+
+// ExitGeofences
 //
-//	SELECT id, ts, parent_id, search, tdf_blob, tdf_uri
-//	FROM tdf_notes
-//	WHERE parent_id = $1
-func (q *Queries) GetNotesFromPar(ctx context.Context, parentID uuid.UUID) ([]GetNotesFromParRow, error) {
-	rows, err := q.db.Query(ctx, getNotesFromPar, parentID)
+//	DELETE FROM geofence_occupants o
+//	USING geofences g
+//	WHERE o.geofence_id = g.id AND o.src_type = $1::TEXT AND o.entity_key = $2::TEXT
+//	  AND NOT (o.geofence_id = ANY($3::UUID[]))
+//	RETURNING g.id, g.name, o.entered_at
+func (q *Queries) ExitGeofences(ctx context.Context, arg ExitGeofencesParams) ([]ExitGeofencesRow, error) {
+	rows, err := q.db.Query(ctx, exitGeofences, arg.SourceType, arg.EntityKey, arg.Inside)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetNotesFromParRow
+	var items []ExitGeofencesRow
 	for rows.Next() {
-		var i GetNotesFromParRow
+		var i ExitGeofencesRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.EnteredAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportTdfObjects = `-- name: ExportTdfObjects :many
+SELECT id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by, entity_key, version
+FROM tdf_objects
+WHERE (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[]))
+  AND (ts, id) > ($2::TIMESTAMP, $3::UUID) AND ts <= $4::TIMESTAMP
+  AND ($5::JSONB IS NULL OR search @> $5::JSONB)
+  AND ($6::GEOMETRY IS NULL OR ST_Within(geo, $6::GEOMETRY))
+ORDER BY ts, id
+LIMIT $7
+`
+
+type ExportTdfObjectsParams struct {
+	SourceTypes []string         `json:"source_types"`
+	AfterTs     pgtype.Timestamp `json:"after_ts"`
+	AfterID     uuid.UUID        `json:"after_id"`
+	EndTime     pgtype.Timestamp `json:"end_time"`
+	Search      []byte           `json:"search"`
+	Geometry    interface{}      `json:"geometry"`
+	Limit       int32            `json:"limit"`
+}
+
+// ExportTdfObjects
+//
+//	SELECT id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by, entity_key, version
+//	FROM tdf_objects
+//	WHERE (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[]))
+//	  AND (ts, id) > ($2::TIMESTAMP, $3::UUID) AND ts <= $4::TIMESTAMP
+//	  AND ($5::JSONB IS NULL OR search @> $5::JSONB)
+//	  AND ($6::GEOMETRY IS NULL OR ST_Within(geo, $6::GEOMETRY))
+//	ORDER BY ts, id
+//	LIMIT $7
+func (q *Queries) ExportTdfObjects(ctx context.Context, arg ExportTdfObjectsParams) ([]TdfObject, error) {
+	rows, err := q.db.Query(ctx, exportTdfObjects,
+		arg.SourceTypes,
+		arg.AfterTs,
+		arg.AfterID,
+		arg.EndTime,
+		arg.Search,
+		arg.Geometry,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TdfObject
+	for rows.Next() {
+		var i TdfObject
 		if err := rows.Scan(
 			&i.ID,
 			&i.Ts,
-			&i.ParentID,
+			&i.SrcType,
+			&i.Geo,
 			&i.Search,
+			&i.Metadata,
 			&i.TdfBlob,
 			&i.TdfUri,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.EntityKey,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -123,13 +660,282 @@ func (q *Queries) GetNotesFromPar(ctx context.Context, parentID uuid.UUID) ([]Ge
 	return items, nil
 }
 
-const getSrcType = `-- name: GetSrcType :one
-SELECT id, form_schema, ui_schema, metadata
-FROM src_types
+const getGeofence = `-- name: GetGeofence :one
+SELECT id, name, geo, src_type, search, dwell_seconds
+FROM geofences
 WHERE id = $1
 `
 
-// GetSrcType
+type GetGeofenceRow struct {
+	ID           uuid.UUID   `json:"id"`
+	Name         string      `json:"name"`
+	Geo          interface{} `json:"geo"`
+	SrcType      pgtype.Text `json:"src_type"`
+	Search       []byte      `json:"search"`
+	DwellSeconds int32       `json:"dwell_seconds"`
+}
+
+// GetGeofence
+//
+//	SELECT id, name, geo, src_type, search, dwell_seconds
+//	FROM geofences
+//	WHERE id = $1
+func (q *Queries) GetGeofence(ctx context.Context, id uuid.UUID) (GetGeofenceRow, error) {
+	row := q.db.QueryRow(ctx, getGeofence, id)
+	var i GetGeofenceRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Geo,
+		&i.SrcType,
+		&i.Search,
+		&i.DwellSeconds,
+	)
+	return i, err
+}
+
+const getLatestAuditRecord = `-- name: GetLatestAuditRecord :one
+SELECT seq, ts, subject, action, procedure, object_ids, src_type, classification, decision, prev_hash, hash
+FROM audit_log
+ORDER BY seq DESC
+LIMIT 1
+`
+
+// GetLatestAuditRecord
+//
+//	SELECT seq, ts, subject, action, procedure, object_ids, src_type, classification, decision, prev_hash, hash
+//	FROM audit_log
+//	ORDER BY seq DESC
+//	LIMIT 1
+func (q *Queries) GetLatestAuditRecord(ctx context.Context) (AuditLog, error) {
+	row := q.db.QueryRow(ctx, getLatestAuditRecord)
+	var i AuditLog
+	err := row.Scan(
+		&i.Seq,
+		&i.Ts,
+		&i.Subject,
+		&i.Action,
+		&i.Procedure,
+		&i.ObjectIds,
+		&i.SrcType,
+		&i.Classification,
+		&i.Decision,
+		&i.PrevHash,
+		&i.Hash,
+	)
+	return i, err
+}
+
+const getLatestPositions = `-- name: GetLatestPositions :many
+SELECT DISTINCT ON (src_type, entity_key) id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
+FROM tdf_objects
+WHERE entity_key IS NOT NULL
+  AND (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[])) AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
+  AND tdf_object_visible(search, $4::TEXT[])
+ORDER BY src_type, entity_key, ts DESC, id DESC
+`
+
+type GetLatestPositionsParams struct {
+	SourceTypes  []string         `json:"source_types"`
+	StartTime    pgtype.Timestamp `json:"start_time"`
+	EndTime      pgtype.Timestamp `json:"end_time"`
+	Entitlements []string         `json:"entitlements"`
+}
+
+type GetLatestPositionsRow struct {
+	ID        uuid.UUID        `json:"id"`
+	Ts        pgtype.Timestamp `json:"ts"`
+	SrcType   string           `json:"src_type"`
+	Geo       interface{}      `json:"geo"`
+	Search    []byte           `json:"search"`
+	Metadata  []byte           `json:"metadata"`
+	TdfBlob   []byte           `json:"tdf_blob"`
+	TdfUri    pgtype.Text      `json:"tdf_uri"`
+	EntityKey pgtype.Text      `json:"entity_key"`
+	Version   int32            `json:"version"`
+}
+
+// GetLatestPositions
+//
+//	SELECT DISTINCT ON (src_type, entity_key) id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
+//	FROM tdf_objects
+//	WHERE entity_key IS NOT NULL
+//	  AND (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[])) AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
+//	  AND tdf_object_visible(search, $4::TEXT[])
+//	ORDER BY src_type, entity_key, ts DESC, id DESC
+func (q *Queries) GetLatestPositions(ctx context.Context, arg GetLatestPositionsParams) ([]GetLatestPositionsRow, error) {
+	rows, err := q.db.Query(ctx, getLatestPositions,
+		arg.SourceTypes,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Entitlements,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetLatestPositionsRow
+	for rows.Next() {
+		var i GetLatestPositionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ts,
+			&i.SrcType,
+			&i.Geo,
+			&i.Search,
+			&i.Metadata,
+			&i.TdfBlob,
+			&i.TdfUri,
+			&i.EntityKey,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLatestTdfObjectRevision = `-- name: GetLatestTdfObjectRevision :one
+SELECT COALESCE(MAX(revision), 0)::INTEGER AS revision
+FROM tdf_object_revisions
+WHERE tdf_object_id = $1
+`
+
+// GetLatestTdfObjectRevision
+//
+//	SELECT COALESCE(MAX(revision), 0)::INTEGER AS revision
+//	FROM tdf_object_revisions
+//	WHERE tdf_object_id = $1
+func (q *Queries) GetLatestTdfObjectRevision(ctx context.Context, tdfObjectID uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, getLatestTdfObjectRevision, tdfObjectID)
+	var revision int32
+	err := row.Scan(&revision)
+	return revision, err
+}
+
+const getNoteByID = `-- name: GetNoteByID :one
+SELECT id, ts, parent_id, tdf_blob, search, tdf_uri
+FROM tdf_notes
+where id = $1
+`
+
+type GetNoteByIDRow struct {
+	ID       uuid.UUID        `json:"id"`
+	Ts       pgtype.Timestamp `json:"ts"`
+	ParentID uuid.UUID        `json:"parent_id"`
+	TdfBlob  []byte           `json:"tdf_blob"`
+	Search   []byte           `json:"search"`
+	TdfUri   pgtype.Text      `json:"tdf_uri"`
+}
+
+// GetNoteByID
+//
+//	SELECT id, ts, parent_id, tdf_blob, search, tdf_uri
+//	FROM tdf_notes
+//	where id = $1
+func (q *Queries) GetNoteByID(ctx context.Context, id uuid.UUID) (GetNoteByIDRow, error) {
+	row := q.db.QueryRow(ctx, getNoteByID, id)
+	var i GetNoteByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Ts,
+		&i.ParentID,
+		&i.TdfBlob,
+		&i.Search,
+		&i.TdfUri,
+	)
+	return i, err
+}
+
+const getNotesFromPar = `-- name: GetNotesFromPar :many
+SELECT id, ts, parent_id, search, tdf_blob, tdf_uri
+FROM tdf_notes
+WHERE parent_id = $1
+`
+
+type GetNotesFromParRow struct {
+	ID       uuid.UUID        `json:"id"`
+	Ts       pgtype.Timestamp `json:"ts"`
+	ParentID uuid.UUID        `json:"parent_id"`
+	Search   []byte           `json:"search"`
+	TdfBlob  []byte           `json:"tdf_blob"`
+	TdfUri   pgtype.Text      `json:"tdf_uri"`
+}
+
+// GetNotesFromPar
+//
+//	SELECT id, ts, parent_id, search, tdf_blob, tdf_uri
+//	FROM tdf_notes
+//	WHERE parent_id = $1
+func (q *Queries) GetNotesFromPar(ctx context.Context, parentID uuid.UUID) ([]GetNotesFromParRow, error) {
+	rows, err := q.db.Query(ctx, getNotesFromPar, parentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetNotesFromParRow
+	for rows.Next() {
+		var i GetNotesFromParRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ts,
+			&i.ParentID,
+			&i.Search,
+			&i.TdfBlob,
+			&i.TdfUri,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getNotifiedTdfObject = `-- name: GetNotifiedTdfObject :one
+SELECT id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by, entity_key, version
+FROM tdf_objects
+WHERE id = $1
+`
+
+// GetNotifiedTdfObject
+//
+//	SELECT id, ts, src_type, geo, search, metadata, tdf_blob, tdf_uri, _created_at, _created_by, entity_key, version
+//	FROM tdf_objects
+//	WHERE id = $1
+func (q *Queries) GetNotifiedTdfObject(ctx context.Context, id uuid.UUID) (TdfObject, error) {
+	row := q.db.QueryRow(ctx, getNotifiedTdfObject, id)
+	var i TdfObject
+	err := row.Scan(
+		&i.ID,
+		&i.Ts,
+		&i.SrcType,
+		&i.Geo,
+		&i.Search,
+		&i.Metadata,
+		&i.TdfBlob,
+		&i.TdfUri,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.EntityKey,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getSrcType = `-- name: GetSrcType :one
+SELECT id, form_schema, ui_schema, metadata
+FROM src_types
+WHERE id = $1
+`
+
+// GetSrcType
 //
 //	SELECT id, form_schema, ui_schema, metadata
 //	FROM src_types
@@ -146,8 +952,50 @@ func (q *Queries) GetSrcType(ctx context.Context, id string) (SrcType, error) {
 	return i, err
 }
 
+const getTdfAttachment = `-- name: GetTdfAttachment :one
+SELECT id, ts, parent_id, parent_type, name, mime_type, size, search, tdf_blob, tdf_uri
+FROM tdf_attachments
+WHERE id = $1
+`
+
+type GetTdfAttachmentRow struct {
+	ID         uuid.UUID        `json:"id"`
+	Ts         pgtype.Timestamp `json:"ts"`
+	ParentID   uuid.UUID        `json:"parent_id"`
+	ParentType string           `json:"parent_type"`
+	Name       string           `json:"name"`
+	MimeType   string           `json:"mime_type"`
+	Size       int64            `json:"size"`
+	Search     []byte           `json:"search"`
+	TdfBlob    []byte           `json:"tdf_blob"`
+	TdfUri     pgtype.Text      `json:"tdf_uri"`
+}
+
+// GetTdfAttachment
+//
+//	SELECT id, ts, parent_id, parent_type, name, mime_type, size, search, tdf_blob, tdf_uri
+//	FROM tdf_attachments
+//	WHERE id = $1
+func (q *Queries) GetTdfAttachment(ctx context.Context, id uuid.UUID) (GetTdfAttachmentRow, error) {
+	row := q.db.QueryRow(ctx, getTdfAttachment, id)
+	var i GetTdfAttachmentRow
+	err := row.Scan(
+		&i.ID,
+		&i.Ts,
+		&i.ParentID,
+		&i.ParentType,
+		&i.Name,
+		&i.MimeType,
+		&i.Size,
+		&i.Search,
+		&i.TdfBlob,
+		&i.TdfUri,
+	)
+	return i, err
+}
+
 const getTdfObject = `-- name: GetTdfObject :one
-SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 FROM tdf_objects
 WHERE
   id = $1
@@ -155,19 +1003,21 @@ LIMIT 1
 `
 
 type GetTdfObjectRow struct {
-	ID       uuid.UUID        `json:"id"`
-	Ts       pgtype.Timestamp `json:"ts"`
-	SrcType  string           `json:"src_type"`
-	Geo      interface{}      `json:"geo"`
-	Search   []byte           `json:"search"`
-	Metadata []byte           `json:"metadata"`
-	TdfBlob  []byte           `json:"tdf_blob"`
-	TdfUri   pgtype.Text      `json:"tdf_uri"`
+	ID        uuid.UUID        `json:"id"`
+	Ts        pgtype.Timestamp `json:"ts"`
+	SrcType   string           `json:"src_type"`
+	Geo       interface{}      `json:"geo"`
+	Search    []byte           `json:"search"`
+	Metadata  []byte           `json:"metadata"`
+	TdfBlob   []byte           `json:"tdf_blob"`
+	TdfUri    pgtype.Text      `json:"tdf_uri"`
+	EntityKey pgtype.Text      `json:"entity_key"`
+	Version   int32            `json:"version"`
 }
 
 // GetTdfObject
 //
-//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 //	FROM tdf_objects
 //	WHERE
 //	  id = $1
@@ -184,32 +1034,690 @@ func (q *Queries) GetTdfObject(ctx context.Context, id uuid.UUID) (GetTdfObjectR
 		&i.Metadata,
 		&i.TdfBlob,
 		&i.TdfUri,
+		&i.EntityKey,
+		&i.Version,
 	)
 	return i, err
 }
 
-const listSrcTypes = `-- name: ListSrcTypes :many
-SELECT id
-FROM src_types
+const getTdfObjectMetadata = `-- name: GetTdfObjectMetadata :one
+SELECT metadata
+FROM tdf_objects
+WHERE id = $1
 `
 
-// ListSrcTypes
+// GetTdfObjectMetadata
 //
-//	SELECT id
-//	FROM src_types
-func (q *Queries) ListSrcTypes(ctx context.Context) ([]string, error) {
-	rows, err := q.db.Query(ctx, listSrcTypes)
+//	SELECT metadata
+//	FROM tdf_objects
+//	WHERE id = $1
+func (q *Queries) GetTdfObjectMetadata(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getTdfObjectMetadata, id)
+	var metadata []byte
+	err := row.Scan(&metadata)
+	return metadata, err
+}
+
+const getTdfObjectRevision = `-- name: GetTdfObjectRevision :one
+SELECT r.id, r.tdf_object_id, r.revision, r.ts, r.src_type, r.geo, r.search, r.metadata, b.tdf_blob, r.tdf_uri, r.entity_key,
+  r.operation, r.changed_fields, r.changed_at, r.changed_by
+FROM tdf_object_revisions r
+LEFT JOIN tdf_revision_blobs b ON b.hash = r.tdf_blob_hash
+WHERE r.tdf_object_id = $1 AND r.revision = $2
+`
+
+type GetTdfObjectRevisionParams struct {
+	TdfObjectID uuid.UUID `json:"tdf_object_id"`
+	Revision    int32     `json:"revision"`
+}
+
+type GetTdfObjectRevisionRow struct {
+	ID            uuid.UUID        `json:"id"`
+	TdfObjectID   uuid.UUID        `json:"tdf_object_id"`
+	Revision      int32            `json:"revision"`
+	Ts            pgtype.Timestamp `json:"ts"`
+	SrcType       string           `json:"src_type"`
+	Geo           *geos.Geom       `json:"geo"`
+	Search        []byte           `json:"search"`
+	Metadata      []byte           `json:"metadata"`
+	TdfBlob       []byte           `json:"tdf_blob"`
+	TdfUri        pgtype.Text      `json:"tdf_uri"`
+	EntityKey     pgtype.Text      `json:"entity_key"`
+	Operation     string           `json:"operation"`
+	ChangedFields []string         `json:"changed_fields"`
+	ChangedAt     pgtype.Timestamp `json:"changed_at"`
+	ChangedBy     string           `json:"changed_by"`
+}
+
+// GetTdfObjectRevision
+//
+//	SELECT r.id, r.tdf_object_id, r.revision, r.ts, r.src_type, r.geo, r.search, r.metadata, b.tdf_blob, r.tdf_uri, r.entity_key,
+//	  r.operation, r.changed_fields, r.changed_at, r.changed_by
+//	FROM tdf_object_revisions r
+//	LEFT JOIN tdf_revision_blobs b ON b.hash = r.tdf_blob_hash
+//	WHERE r.tdf_object_id = $1 AND r.revision = $2
+func (q *Queries) GetTdfObjectRevision(ctx context.Context, arg GetTdfObjectRevisionParams) (GetTdfObjectRevisionRow, error) {
+	row := q.db.QueryRow(ctx, getTdfObjectRevision, arg.TdfObjectID, arg.Revision)
+	var i GetTdfObjectRevisionRow
+	err := row.Scan(
+		&i.ID,
+		&i.TdfObjectID,
+		&i.Revision,
+		&i.Ts,
+		&i.SrcType,
+		&i.Geo,
+		&i.Search,
+		&i.Metadata,
+		&i.TdfBlob,
+		&i.TdfUri,
+		&i.EntityKey,
+		&i.Operation,
+		&i.ChangedFields,
+		&i.ChangedAt,
+		&i.ChangedBy,
+	)
+	return i, err
+}
+
+const getTdfObjectSrcType = `-- name: GetTdfObjectSrcType :one
+SELECT src_type
+FROM tdf_objects
+WHERE id = $1
+`
+
+// GetTdfObjectSrcType
+//
+//	SELECT src_type
+//	FROM tdf_objects
+//	WHERE id = $1
+func (q *Queries) GetTdfObjectSrcType(ctx context.Context, id uuid.UUID) (string, error) {
+	row := q.db.QueryRow(ctx, getTdfObjectSrcType, id)
+	var src_type string
+	err := row.Scan(&src_type)
+	return src_type, err
+}
+
+const getTrack = `-- name: GetTrack :many
+SELECT id, ts, ST_Centroid(geo)::GEOMETRY AS geo, search
+FROM tdf_objects
+WHERE src_type = $1::TEXT AND entity_key = $2::TEXT
+  AND ts >= $3::TIMESTAMP AND ts <= $4::TIMESTAMP
+  AND geo IS NOT NULL
+ORDER BY ts ASC
+`
+
+type GetTrackParams struct {
+	SourceType string           `json:"source_type"`
+	EntityKey  string           `json:"entity_key"`
+	StartTime  pgtype.Timestamp `json:"start_time"`
+	EndTime    pgtype.Timestamp `json:"end_time"`
+}
+
+type GetTrackRow struct {
+	ID     uuid.UUID        `json:"id"`
+	Ts     pgtype.Timestamp `json:"ts"`
+	Geo    interface{}      `json:"geo"`
+	Search []byte           `json:"search"`
+}
+
+// GetTrack
+//
+//	SELECT id, ts, ST_Centroid(geo)::GEOMETRY AS geo, search
+//	FROM tdf_objects
+//	WHERE src_type = $1::TEXT AND entity_key = $2::TEXT
+//	  AND ts >= $3::TIMESTAMP AND ts <= $4::TIMESTAMP
+//	  AND geo IS NOT NULL
+//	ORDER BY ts ASC
+func (q *Queries) GetTrack(ctx context.Context, arg GetTrackParams) ([]GetTrackRow, error) {
+	rows, err := q.db.Query(ctx, getTrack,
+		arg.SourceType,
+		arg.EntityKey,
+		arg.StartTime,
+		arg.EndTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTrackRow
+	for rows.Next() {
+		var i GetTrackRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ts,
+			&i.Geo,
+			&i.Search,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhook = `-- name: GetWebhook :one
+SELECT id, name, url, src_type, geo, search, client_id
+FROM webhooks
+WHERE id = $1
+`
+
+type GetWebhookRow struct {
+	ID       uuid.UUID   `json:"id"`
+	Name     string      `json:"name"`
+	Url      string      `json:"url"`
+	SrcType  pgtype.Text `json:"src_type"`
+	Geo      *geos.Geom  `json:"geo"`
+	Search   []byte      `json:"search"`
+	ClientID string      `json:"client_id"`
+}
+
+// GetWebhook
+//
+//	SELECT id, name, url, src_type, geo, search, client_id
+//	FROM webhooks
+//	WHERE id = $1
+func (q *Queries) GetWebhook(ctx context.Context, id uuid.UUID) (GetWebhookRow, error) {
+	row := q.db.QueryRow(ctx, getWebhook, id)
+	var i GetWebhookRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Url,
+		&i.SrcType,
+		&i.Geo,
+		&i.Search,
+		&i.ClientID,
+	)
+	return i, err
+}
+
+const getWebhookOwner = `-- name: GetWebhookOwner :one
+SELECT _created_by
+FROM webhooks
+WHERE id = $1
+`
+
+// GetWebhookOwner
+//
+//	SELECT _created_by
+//	FROM webhooks
+//	WHERE id = $1
+func (q *Queries) GetWebhookOwner(ctx context.Context, id uuid.UUID) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getWebhookOwner, id)
+	var createdBy pgtype.Text
+	err := row.Scan(&createdBy)
+	return createdBy, err
+}
+
+const listAuditRecords = `-- name: ListAuditRecords :many
+SELECT seq, ts, subject, action, procedure, object_ids, src_type, classification, decision, prev_hash, hash
+FROM audit_log
+WHERE seq > $1::BIGINT
+ORDER BY seq
+LIMIT $2::INTEGER
+`
+
+type ListAuditRecordsParams struct {
+	AfterSeq int64 `json:"after_seq"`
+	Limit    int32 `json:"limit"`
+}
+
+// ListAuditRecords
+//
+//	SELECT seq, ts, subject, action, procedure, object_ids, src_type, classification, decision, prev_hash, hash
+//	FROM audit_log
+//	WHERE seq > $1::BIGINT
+//	ORDER BY seq
+//	LIMIT $2::INTEGER
+func (q *Queries) ListAuditRecords(ctx context.Context, arg ListAuditRecordsParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, listAuditRecords, arg.AfterSeq, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.Seq,
+			&i.Ts,
+			&i.Subject,
+			&i.Action,
+			&i.Procedure,
+			&i.ObjectIds,
+			&i.SrcType,
+			&i.Classification,
+			&i.Decision,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeletedBlobs = `-- name: ListDeletedBlobs :many
+SELECT tdf_uri
+FROM deleted_blobs
+WHERE tdf_uri > $1::TEXT
+ORDER BY tdf_uri
+LIMIT $2::INTEGER
+`
+
+type ListDeletedBlobsParams struct {
+	After string `json:"after"`
+	Limit int32  `json:"limit"`
+}
+
+// ListDeletedBlobs
+//
+//	SELECT tdf_uri
+//	FROM deleted_blobs
+//	WHERE tdf_uri > $1::TEXT
+//	ORDER BY tdf_uri
+//	LIMIT $2::INTEGER
+func (q *Queries) ListDeletedBlobs(ctx context.Context, arg ListDeletedBlobsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listDeletedBlobs, arg.After, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var tdf_uri string
+		if err := rows.Scan(&tdf_uri); err != nil {
+			return nil, err
+		}
+		items = append(items, tdf_uri)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGeofences = `-- name: ListGeofences :many
+SELECT id, name, geo, src_type, search, dwell_seconds
+FROM geofences
+ORDER BY name
+`
+
+type ListGeofencesRow struct {
+	ID           uuid.UUID   `json:"id"`
+	Name         string      `json:"name"`
+	Geo          interface{} `json:"geo"`
+	SrcType      pgtype.Text `json:"src_type"`
+	Search       []byte      `json:"search"`
+	DwellSeconds int32       `json:"dwell_seconds"`
+}
+
+// ListGeofences
+//
+//	SELECT id, name, geo, src_type, search, dwell_seconds
+//	FROM geofences
+//	ORDER BY name
+func (q *Queries) ListGeofences(ctx context.Context) ([]ListGeofencesRow, error) {
+	rows, err := q.db.Query(ctx, listGeofences)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListGeofencesRow
+	for rows.Next() {
+		var i ListGeofencesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Geo,
+			&i.SrcType,
+			&i.Search,
+			&i.DwellSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGeofencesContaining = `-- name: ListGeofencesContaining :many
+SELECT id, name, dwell_seconds
+FROM geofences
+WHERE (src_type IS NULL OR src_type = $1::TEXT)
+  AND (COALESCE(search, '{}'::JSONB) = '{}'::JSONB OR $2::JSONB @> search)
+  AND ST_Intersects(geo, ST_SetSRID($3::GEOMETRY, ST_SRID(geo)))
+`
+
+type ListGeofencesContainingParams struct {
+	SourceType string      `json:"source_type"`
+	Search     []byte      `json:"search"`
+	Geometry   interface{} `json:"geometry"`
+}
+
+type ListGeofencesContainingRow struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	DwellSeconds int32     `json:"dwell_seconds"`
+}
+
+// ListGeofencesContaining
+//
+//	SELECT id, name, dwell_seconds
+//	FROM geofences
+//	WHERE (src_type IS NULL OR src_type = $1::TEXT)
+//	  AND (COALESCE(search, '{}'::JSONB) = '{}'::JSONB OR $2::JSONB @> search)
+//	  AND ST_Intersects(geo, ST_SetSRID($3::GEOMETRY, ST_SRID(geo)))
+func (q *Queries) ListGeofencesContaining(ctx context.Context, arg ListGeofencesContainingParams) ([]ListGeofencesContainingRow, error) {
+	rows, err := q.db.Query(ctx, listGeofencesContaining, arg.SourceType, arg.Search, arg.Geometry)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListGeofencesContainingRow
+	for rows.Next() {
+		var i ListGeofencesContainingRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.DwellSeconds); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotesByParents = `-- name: ListNotesByParents :many
+SELECT id, ts, parent_id, search, tdf_blob, tdf_uri, _created_at, _created_by
+FROM tdf_notes
+WHERE parent_id = ANY($1::UUID[])
+ORDER BY parent_id, ts
+`
+
+// ListNotesByParents
+//
+//	SELECT id, ts, parent_id, search, tdf_blob, tdf_uri, _created_at, _created_by
+//	FROM tdf_notes
+//	WHERE parent_id = ANY($1::UUID[])
+//	ORDER BY parent_id, ts
+func (q *Queries) ListNotesByParents(ctx context.Context, parentids []uuid.UUID) ([]TdfNote, error) {
+	rows, err := q.db.Query(ctx, listNotesByParents, parentids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TdfNote
+	for rows.Next() {
+		var i TdfNote
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ts,
+			&i.ParentID,
+			&i.Search,
+			&i.TdfBlob,
+			&i.TdfUri,
+			&i.CreatedAt,
+			&i.CreatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSrcTypes = `-- name: ListSrcTypes :many
+SELECT id
+FROM src_types
+`
+
+// ListSrcTypes
+//
+//	SELECT id
+//	FROM src_types
+func (q *Queries) ListSrcTypes(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listSrcTypes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTdfAttachments = `-- name: ListTdfAttachments :many
+SELECT id, ts, parent_id, parent_type, name, mime_type, size, search
+FROM tdf_attachments
+WHERE parent_id = $1
+ORDER BY ts
+`
+
+type ListTdfAttachmentsRow struct {
+	ID         uuid.UUID        `json:"id"`
+	Ts         pgtype.Timestamp `json:"ts"`
+	ParentID   uuid.UUID        `json:"parent_id"`
+	ParentType string           `json:"parent_type"`
+	Name       string           `json:"name"`
+	MimeType   string           `json:"mime_type"`
+	Size       int64            `json:"size"`
+	Search     []byte           `json:"search"`
+}
+
+// ListTdfAttachments
+//
+//	SELECT id, ts, parent_id, parent_type, name, mime_type, size, search
+//	FROM tdf_attachments
+//	WHERE parent_id = $1
+//	ORDER BY ts
+func (q *Queries) ListTdfAttachments(ctx context.Context, parentID uuid.UUID) ([]ListTdfAttachmentsRow, error) {
+	rows, err := q.db.Query(ctx, listTdfAttachments, parentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTdfAttachmentsRow
+	for rows.Next() {
+		var i ListTdfAttachmentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ts,
+			&i.ParentID,
+			&i.ParentType,
+			&i.Name,
+			&i.MimeType,
+			&i.Size,
+			&i.Search,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTdfObjectRevisions = `-- name: ListTdfObjectRevisions :many
+SELECT r.id, r.tdf_object_id, r.revision, r.ts, r.src_type, r.geo, r.search, r.metadata, b.tdf_blob, r.tdf_uri, r.entity_key,
+  r.operation, r.changed_fields, r.changed_at, r.changed_by
+FROM tdf_object_revisions r
+LEFT JOIN tdf_revision_blobs b ON b.hash = r.tdf_blob_hash
+WHERE r.tdf_object_id = $1
+ORDER BY r.revision DESC
+`
+
+type ListTdfObjectRevisionsRow struct {
+	ID            uuid.UUID        `json:"id"`
+	TdfObjectID   uuid.UUID        `json:"tdf_object_id"`
+	Revision      int32            `json:"revision"`
+	Ts            pgtype.Timestamp `json:"ts"`
+	SrcType       string           `json:"src_type"`
+	Geo           *geos.Geom       `json:"geo"`
+	Search        []byte           `json:"search"`
+	Metadata      []byte           `json:"metadata"`
+	TdfBlob       []byte           `json:"tdf_blob"`
+	TdfUri        pgtype.Text      `json:"tdf_uri"`
+	EntityKey     pgtype.Text      `json:"entity_key"`
+	Operation     string           `json:"operation"`
+	ChangedFields []string         `json:"changed_fields"`
+	ChangedAt     pgtype.Timestamp `json:"changed_at"`
+	ChangedBy     string           `json:"changed_by"`
+}
+
+// ListTdfObjectRevisions
+//
+//	SELECT r.id, r.tdf_object_id, r.revision, r.ts, r.src_type, r.geo, r.search, r.metadata, b.tdf_blob, r.tdf_uri, r.entity_key,
+//	  r.operation, r.changed_fields, r.changed_at, r.changed_by
+//	FROM tdf_object_revisions r
+//	LEFT JOIN tdf_revision_blobs b ON b.hash = r.tdf_blob_hash
+//	WHERE r.tdf_object_id = $1
+//	ORDER BY r.revision DESC
+func (q *Queries) ListTdfObjectRevisions(ctx context.Context, tdfObjectID uuid.UUID) ([]ListTdfObjectRevisionsRow, error) {
+	rows, err := q.db.Query(ctx, listTdfObjectRevisions, tdfObjectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTdfObjectRevisionsRow
+	for rows.Next() {
+		var i ListTdfObjectRevisionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TdfObjectID,
+			&i.Revision,
+			&i.Ts,
+			&i.SrcType,
+			&i.Geo,
+			&i.Search,
+			&i.Metadata,
+			&i.TdfBlob,
+			&i.TdfUri,
+			&i.EntityKey,
+			&i.Operation,
+			&i.ChangedFields,
+			&i.ChangedAt,
+			&i.ChangedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTdfObjectSrcTypes = `-- name: ListTdfObjectSrcTypes :many
+SELECT DISTINCT src_type
+FROM tdf_objects
+`
+
+// ListTdfObjectSrcTypes
+//
+//	SELECT DISTINCT src_type
+//	FROM tdf_objects
+func (q *Queries) ListTdfObjectSrcTypes(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listTdfObjectSrcTypes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var src_type string
+		if err := rows.Scan(&src_type); err != nil {
+			return nil, err
+		}
+		items = append(items, src_type)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTdfObjects = `-- name: ListTdfObjects :many
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
+FROM tdf_objects
+WHERE (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[])) AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
+ORDER BY ts DESC
+`
+
+type ListTdfObjectsParams struct {
+	SourceTypes []string         `json:"source_types"`
+	StartTime   pgtype.Timestamp `json:"start_time"`
+	EndTime     pgtype.Timestamp `json:"end_time"`
+}
+
+type ListTdfObjectsRow struct {
+	ID        uuid.UUID        `json:"id"`
+	Ts        pgtype.Timestamp `json:"ts"`
+	SrcType   string           `json:"src_type"`
+	Geo       interface{}      `json:"geo"`
+	Search    []byte           `json:"search"`
+	Metadata  []byte           `json:"metadata"`
+	TdfBlob   []byte           `json:"tdf_blob"`
+	TdfUri    pgtype.Text      `json:"tdf_uri"`
+	EntityKey pgtype.Text      `json:"entity_key"`
+	Version   int32            `json:"version"`
+}
+
+// ListTdfObjects
+//
+//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
+//	FROM tdf_objects
+//	WHERE (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[])) AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
+//	ORDER BY ts DESC
+func (q *Queries) ListTdfObjects(ctx context.Context, arg ListTdfObjectsParams) ([]ListTdfObjectsRow, error) {
+	rows, err := q.db.Query(ctx, listTdfObjects, arg.SourceTypes, arg.StartTime, arg.EndTime)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []ListTdfObjectsRow
 	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
+		var i ListTdfObjectsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ts,
+			&i.SrcType,
+			&i.Geo,
+			&i.Search,
+			&i.Metadata,
+			&i.TdfBlob,
+			&i.TdfUri,
+			&i.EntityKey,
+			&i.Version,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, id)
+		items = append(items, i)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -217,45 +1725,69 @@ func (q *Queries) ListSrcTypes(ctx context.Context) ([]string, error) {
 	return items, nil
 }
 
-const listTdfObjects = `-- name: ListTdfObjects :many
-SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+const listTdfObjectsPage = `-- name: ListTdfObjectsPage :many
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_uri, entity_key, version, tdf_blob IS NOT NULL AS has_tdf_blob
 FROM tdf_objects
 WHERE src_type = $1::TEXT AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
-ORDER BY ts DESC
+  AND (ts, id) < ($4::TIMESTAMP, $5::UUID)
+  AND ($6::JSONB IS NULL OR search @> $6::JSONB)
+  AND ($7::GEOMETRY IS NULL OR ST_Within(geo, $7::GEOMETRY))
+ORDER BY ts DESC, id DESC
+LIMIT $8
 `
 
-type ListTdfObjectsParams struct {
+type ListTdfObjectsPageParams struct {
 	SourceType string           `json:"source_type"`
 	StartTime  pgtype.Timestamp `json:"start_time"`
 	EndTime    pgtype.Timestamp `json:"end_time"`
+	BeforeTs   pgtype.Timestamp `json:"before_ts"`
+	BeforeID   uuid.UUID        `json:"before_id"`
+	Search     []byte           `json:"search"`
+	Geometry   interface{}      `json:"geometry"`
+	Limit      int32            `json:"limit"`
 }
 
-type ListTdfObjectsRow struct {
-	ID       uuid.UUID        `json:"id"`
-	Ts       pgtype.Timestamp `json:"ts"`
-	SrcType  string           `json:"src_type"`
-	Geo      interface{}      `json:"geo"`
-	Search   []byte           `json:"search"`
-	Metadata []byte           `json:"metadata"`
-	TdfBlob  []byte           `json:"tdf_blob"`
-	TdfUri   pgtype.Text      `json:"tdf_uri"`
+type ListTdfObjectsPageRow struct {
+	ID         uuid.UUID        `json:"id"`
+	Ts         pgtype.Timestamp `json:"ts"`
+	SrcType    string           `json:"src_type"`
+	Geo        interface{}      `json:"geo"`
+	Search     []byte           `json:"search"`
+	Metadata   []byte           `json:"metadata"`
+	TdfUri     pgtype.Text      `json:"tdf_uri"`
+	EntityKey  pgtype.Text      `json:"entity_key"`
+	Version    int32            `json:"version"`
+	HasTdfBlob bool             `json:"has_tdf_blob"`
 }
 
-// ListTdfObjects
+// ListTdfObjectsPage
 //
-//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_uri, entity_key, version, tdf_blob IS NOT NULL AS has_tdf_blob
 //	FROM tdf_objects
 //	WHERE src_type = $1::TEXT AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
-//	ORDER BY ts DESC
-func (q *Queries) ListTdfObjects(ctx context.Context, arg ListTdfObjectsParams) ([]ListTdfObjectsRow, error) {
-	rows, err := q.db.Query(ctx, listTdfObjects, arg.SourceType, arg.StartTime, arg.EndTime)
+//	  AND (ts, id) < ($4::TIMESTAMP, $5::UUID)
+//	  AND ($6::JSONB IS NULL OR search @> $6::JSONB)
+//	  AND ($7::GEOMETRY IS NULL OR ST_Within(geo, $7::GEOMETRY))
+//	ORDER BY ts DESC, id DESC
+//	LIMIT $8
+func (q *Queries) ListTdfObjectsPage(ctx context.Context, arg ListTdfObjectsPageParams) ([]ListTdfObjectsPageRow, error) {
+	rows, err := q.db.Query(ctx, listTdfObjectsPage,
+		arg.SourceType,
+		arg.StartTime,
+		arg.EndTime,
+		arg.BeforeTs,
+		arg.BeforeID,
+		arg.Search,
+		arg.Geometry,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListTdfObjectsRow
+	var items []ListTdfObjectsPageRow
 	for rows.Next() {
-		var i ListTdfObjectsRow
+		var i ListTdfObjectsPageRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Ts,
@@ -263,8 +1795,10 @@ func (q *Queries) ListTdfObjects(ctx context.Context, arg ListTdfObjectsParams)
 			&i.Geo,
 			&i.Search,
 			&i.Metadata,
-			&i.TdfBlob,
 			&i.TdfUri,
+			&i.EntityKey,
+			&i.Version,
+			&i.HasTdfBlob,
 		); err != nil {
 			return nil, err
 		}
@@ -277,41 +1811,43 @@ func (q *Queries) ListTdfObjects(ctx context.Context, arg ListTdfObjectsParams)
 }
 
 const listTdfObjectsWithGeo = `-- name: ListTdfObjectsWithGeo :many
-SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 FROM tdf_objects
-WHERE src_type = $1::TEXT AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
+WHERE (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[])) AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
   AND ST_Within(geo, $4::GEOMETRY)
 ORDER BY ts DESC
 `
 
 type ListTdfObjectsWithGeoParams struct {
-	SourceType string           `json:"source_type"`
-	StartTime  pgtype.Timestamp `json:"start_time"`
-	EndTime    pgtype.Timestamp `json:"end_time"`
-	Geometry   interface{}      `json:"geometry"`
+	SourceTypes []string         `json:"source_types"`
+	StartTime   pgtype.Timestamp `json:"start_time"`
+	EndTime     pgtype.Timestamp `json:"end_time"`
+	Geometry    interface{}      `json:"geometry"`
 }
 
 type ListTdfObjectsWithGeoRow struct {
-	ID       uuid.UUID        `json:"id"`
-	Ts       pgtype.Timestamp `json:"ts"`
-	SrcType  string           `json:"src_type"`
-	Geo      interface{}      `json:"geo"`
-	Search   []byte           `json:"search"`
-	Metadata []byte           `json:"metadata"`
-	TdfBlob  []byte           `json:"tdf_blob"`
-	TdfUri   pgtype.Text      `json:"tdf_uri"`
+	ID        uuid.UUID        `json:"id"`
+	Ts        pgtype.Timestamp `json:"ts"`
+	SrcType   string           `json:"src_type"`
+	Geo       interface{}      `json:"geo"`
+	Search    []byte           `json:"search"`
+	Metadata  []byte           `json:"metadata"`
+	TdfBlob   []byte           `json:"tdf_blob"`
+	TdfUri    pgtype.Text      `json:"tdf_uri"`
+	EntityKey pgtype.Text      `json:"entity_key"`
+	Version   int32            `json:"version"`
 }
 
 // ListTdfObjectsWithGeo
 //
-//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 //	FROM tdf_objects
-//	WHERE src_type = $1::TEXT AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
+//	WHERE (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[])) AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
 //	  AND ST_Within(geo, $4::GEOMETRY)
 //	ORDER BY ts DESC
 func (q *Queries) ListTdfObjectsWithGeo(ctx context.Context, arg ListTdfObjectsWithGeoParams) ([]ListTdfObjectsWithGeoRow, error) {
 	rows, err := q.db.Query(ctx, listTdfObjectsWithGeo,
-		arg.SourceType,
+		arg.SourceTypes,
 		arg.StartTime,
 		arg.EndTime,
 		arg.Geometry,
@@ -332,6 +1868,8 @@ func (q *Queries) ListTdfObjectsWithGeo(ctx context.Context, arg ListTdfObjectsW
 			&i.Metadata,
 			&i.TdfBlob,
 			&i.TdfUri,
+			&i.EntityKey,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -344,41 +1882,43 @@ func (q *Queries) ListTdfObjectsWithGeo(ctx context.Context, arg ListTdfObjectsW
 }
 
 const listTdfObjectsWithMetadata = `-- name: ListTdfObjectsWithMetadata :many
-SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 FROM tdf_objects
-WHERE src_type = $1::TEXT AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
+WHERE (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[])) AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
   AND metadata @> $4::JSONB
 ORDER BY ts DESC
 `
 
 type ListTdfObjectsWithMetadataParams struct {
-	SourceType string           `json:"source_type"`
-	StartTime  pgtype.Timestamp `json:"start_time"`
-	EndTime    pgtype.Timestamp `json:"end_time"`
-	Metadata   []byte           `json:"metadata"`
+	SourceTypes []string         `json:"source_types"`
+	StartTime   pgtype.Timestamp `json:"start_time"`
+	EndTime     pgtype.Timestamp `json:"end_time"`
+	Metadata    []byte           `json:"metadata"`
 }
 
 type ListTdfObjectsWithMetadataRow struct {
-	ID       uuid.UUID        `json:"id"`
-	Ts       pgtype.Timestamp `json:"ts"`
-	SrcType  string           `json:"src_type"`
-	Geo      interface{}      `json:"geo"`
-	Search   []byte           `json:"search"`
-	Metadata []byte           `json:"metadata"`
-	TdfBlob  []byte           `json:"tdf_blob"`
-	TdfUri   pgtype.Text      `json:"tdf_uri"`
+	ID        uuid.UUID        `json:"id"`
+	Ts        pgtype.Timestamp `json:"ts"`
+	SrcType   string           `json:"src_type"`
+	Geo       interface{}      `json:"geo"`
+	Search    []byte           `json:"search"`
+	Metadata  []byte           `json:"metadata"`
+	TdfBlob   []byte           `json:"tdf_blob"`
+	TdfUri    pgtype.Text      `json:"tdf_uri"`
+	EntityKey pgtype.Text      `json:"entity_key"`
+	Version   int32            `json:"version"`
 }
 
 // ListTdfObjectsWithMetadata
 //
-//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 //	FROM tdf_objects
-//	WHERE src_type = $1::TEXT AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
+//	WHERE (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[])) AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
 //	  AND metadata @> $4::JSONB
 //	ORDER BY ts DESC
 func (q *Queries) ListTdfObjectsWithMetadata(ctx context.Context, arg ListTdfObjectsWithMetadataParams) ([]ListTdfObjectsWithMetadataRow, error) {
 	rows, err := q.db.Query(ctx, listTdfObjectsWithMetadata,
-		arg.SourceType,
+		arg.SourceTypes,
 		arg.StartTime,
 		arg.EndTime,
 		arg.Metadata,
@@ -399,6 +1939,8 @@ func (q *Queries) ListTdfObjectsWithMetadata(ctx context.Context, arg ListTdfObj
 			&i.Metadata,
 			&i.TdfBlob,
 			&i.TdfUri,
+			&i.EntityKey,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -411,41 +1953,43 @@ func (q *Queries) ListTdfObjectsWithMetadata(ctx context.Context, arg ListTdfObj
 }
 
 const listTdfObjectsWithSearch = `-- name: ListTdfObjectsWithSearch :many
-SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 FROM tdf_objects
-WHERE src_type = $1::TEXT AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
+WHERE (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[])) AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
   AND search @> $4::JSONB
 ORDER BY ts DESC
 `
 
 type ListTdfObjectsWithSearchParams struct {
-	SourceType string           `json:"source_type"`
-	StartTime  pgtype.Timestamp `json:"start_time"`
-	EndTime    pgtype.Timestamp `json:"end_time"`
-	Search     []byte           `json:"search"`
+	SourceTypes []string         `json:"source_types"`
+	StartTime   pgtype.Timestamp `json:"start_time"`
+	EndTime     pgtype.Timestamp `json:"end_time"`
+	Search      []byte           `json:"search"`
 }
 
 type ListTdfObjectsWithSearchRow struct {
-	ID       uuid.UUID        `json:"id"`
-	Ts       pgtype.Timestamp `json:"ts"`
-	SrcType  string           `json:"src_type"`
-	Geo      interface{}      `json:"geo"`
-	Search   []byte           `json:"search"`
-	Metadata []byte           `json:"metadata"`
-	TdfBlob  []byte           `json:"tdf_blob"`
-	TdfUri   pgtype.Text      `json:"tdf_uri"`
+	ID        uuid.UUID        `json:"id"`
+	Ts        pgtype.Timestamp `json:"ts"`
+	SrcType   string           `json:"src_type"`
+	Geo       interface{}      `json:"geo"`
+	Search    []byte           `json:"search"`
+	Metadata  []byte           `json:"metadata"`
+	TdfBlob   []byte           `json:"tdf_blob"`
+	TdfUri    pgtype.Text      `json:"tdf_uri"`
+	EntityKey pgtype.Text      `json:"entity_key"`
+	Version   int32            `json:"version"`
 }
 
 // ListTdfObjectsWithSearch
 //
-//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 //	FROM tdf_objects
-//	WHERE src_type = $1::TEXT AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
+//	WHERE (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[])) AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
 //	  AND search @> $4::JSONB
 //	ORDER BY ts DESC
 func (q *Queries) ListTdfObjectsWithSearch(ctx context.Context, arg ListTdfObjectsWithSearchParams) ([]ListTdfObjectsWithSearchRow, error) {
 	rows, err := q.db.Query(ctx, listTdfObjectsWithSearch,
-		arg.SourceType,
+		arg.SourceTypes,
 		arg.StartTime,
 		arg.EndTime,
 		arg.Search,
@@ -466,6 +2010,8 @@ func (q *Queries) ListTdfObjectsWithSearch(ctx context.Context, arg ListTdfObjec
 			&i.Metadata,
 			&i.TdfBlob,
 			&i.TdfUri,
+			&i.EntityKey,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -478,44 +2024,46 @@ func (q *Queries) ListTdfObjectsWithSearch(ctx context.Context, arg ListTdfObjec
 }
 
 const listTdfObjectsWithSearchAndGeo = `-- name: ListTdfObjectsWithSearchAndGeo :many
-SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 FROM tdf_objects
-WHERE src_type = $1::TEXT AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
+WHERE (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[])) AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
   AND search @> $4::JSONB
   AND ST_Within(geo, $5::GEOMETRY)
 ORDER BY ts DESC
 `
 
 type ListTdfObjectsWithSearchAndGeoParams struct {
-	SourceType string           `json:"source_type"`
-	StartTime  pgtype.Timestamp `json:"start_time"`
-	EndTime    pgtype.Timestamp `json:"end_time"`
-	Search     []byte           `json:"search"`
-	Geometry   interface{}      `json:"geometry"`
+	SourceTypes []string         `json:"source_types"`
+	StartTime   pgtype.Timestamp `json:"start_time"`
+	EndTime     pgtype.Timestamp `json:"end_time"`
+	Search      []byte           `json:"search"`
+	Geometry    interface{}      `json:"geometry"`
 }
 
 type ListTdfObjectsWithSearchAndGeoRow struct {
-	ID       uuid.UUID        `json:"id"`
-	Ts       pgtype.Timestamp `json:"ts"`
-	SrcType  string           `json:"src_type"`
-	Geo      interface{}      `json:"geo"`
-	Search   []byte           `json:"search"`
-	Metadata []byte           `json:"metadata"`
-	TdfBlob  []byte           `json:"tdf_blob"`
-	TdfUri   pgtype.Text      `json:"tdf_uri"`
+	ID        uuid.UUID        `json:"id"`
+	Ts        pgtype.Timestamp `json:"ts"`
+	SrcType   string           `json:"src_type"`
+	Geo       interface{}      `json:"geo"`
+	Search    []byte           `json:"search"`
+	Metadata  []byte           `json:"metadata"`
+	TdfBlob   []byte           `json:"tdf_blob"`
+	TdfUri    pgtype.Text      `json:"tdf_uri"`
+	EntityKey pgtype.Text      `json:"entity_key"`
+	Version   int32            `json:"version"`
 }
 
 // ListTdfObjectsWithSearchAndGeo
 //
-//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri
+//	SELECT id, ts, src_type, ST_Centroid(geo)::GEOMETRY AS geo, search, metadata, tdf_blob, tdf_uri, entity_key, version
 //	FROM tdf_objects
-//	WHERE src_type = $1::TEXT AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
+//	WHERE (COALESCE(cardinality($1::TEXT[]), 0) = 0 OR src_type = ANY($1::TEXT[])) AND ts >= $2::TIMESTAMP AND ts <= $3::TIMESTAMP
 //	  AND search @> $4::JSONB
 //	  AND ST_Within(geo, $5::GEOMETRY)
 //	ORDER BY ts DESC
 func (q *Queries) ListTdfObjectsWithSearchAndGeo(ctx context.Context, arg ListTdfObjectsWithSearchAndGeoParams) ([]ListTdfObjectsWithSearchAndGeoRow, error) {
 	rows, err := q.db.Query(ctx, listTdfObjectsWithSearchAndGeo,
-		arg.SourceType,
+		arg.SourceTypes,
 		arg.StartTime,
 		arg.EndTime,
 		arg.Search,
@@ -537,6 +2085,208 @@ func (q *Queries) ListTdfObjectsWithSearchAndGeo(ctx context.Context, arg ListTd
 			&i.Metadata,
 			&i.TdfBlob,
 			&i.TdfUri,
+			&i.EntityKey,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeadLetters = `-- name: ListWebhookDeadLetters :many
+SELECT id, webhook_id, tdf_object_id, payload, attempts, error, _created_at
+FROM webhook_dead_letters
+WHERE webhook_id = $1
+ORDER BY _created_at DESC
+LIMIT $2::INTEGER
+`
+
+type ListWebhookDeadLettersParams struct {
+	WebhookID uuid.UUID `json:"webhook_id"`
+	Limit     int32     `json:"limit"`
+}
+
+// ListWebhookDeadLetters
+//
+//	SELECT id, webhook_id, tdf_object_id, payload, attempts, error, _created_at
+//	FROM webhook_dead_letters
+//	WHERE webhook_id = $1
+//	ORDER BY _created_at DESC
+//	LIMIT $2::INTEGER
+func (q *Queries) ListWebhookDeadLetters(ctx context.Context, arg ListWebhookDeadLettersParams) ([]WebhookDeadLetter, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeadLetters, arg.WebhookID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDeadLetter
+	for rows.Next() {
+		var i WebhookDeadLetter
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.TdfObjectID,
+			&i.Payload,
+			&i.Attempts,
+			&i.Error,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSecrets = `-- name: ListWebhookSecrets :many
+SELECT id, secret, client_secret
+FROM webhooks
+`
+
+type ListWebhookSecretsRow struct {
+	ID           uuid.UUID `json:"id"`
+	Secret       string    `json:"secret"`
+	ClientSecret string    `json:"client_secret"`
+}
+
+// ListWebhookSecrets
+//
+//	SELECT id, secret, client_secret
+//	FROM webhooks
+func (q *Queries) ListWebhookSecrets(ctx context.Context) ([]ListWebhookSecretsRow, error) {
+	rows, err := q.db.Query(ctx, listWebhookSecrets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookSecretsRow
+	for rows.Next() {
+		var i ListWebhookSecretsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Secret,
+			&i.ClientSecret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhooks = `-- name: ListWebhooks :many
+SELECT id, name, url, src_type, geo, search, client_id
+FROM webhooks
+WHERE $1::BOOLEAN OR _created_by = $2::TEXT
+ORDER BY name
+`
+
+type ListWebhooksParams struct {
+	Admin bool   `json:"admin"`
+	Owner string `json:"owner"`
+}
+
+type ListWebhooksRow struct {
+	ID       uuid.UUID   `json:"id"`
+	Name     string      `json:"name"`
+	Url      string      `json:"url"`
+	SrcType  pgtype.Text `json:"src_type"`
+	Geo      *geos.Geom  `json:"geo"`
+	Search   []byte      `json:"search"`
+	ClientID string      `json:"client_id"`
+}
+
+// ListWebhooks
+//
+//	SELECT id, name, url, src_type, geo, search, client_id
+//	FROM webhooks
+//	WHERE $1::BOOLEAN OR _created_by = $2::TEXT
+//	ORDER BY name
+func (q *Queries) ListWebhooks(ctx context.Context, arg ListWebhooksParams) ([]ListWebhooksRow, error) {
+	rows, err := q.db.Query(ctx, listWebhooks, arg.Admin, arg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhooksRow
+	for rows.Next() {
+		var i ListWebhooksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Url,
+			&i.SrcType,
+			&i.Geo,
+			&i.Search,
+			&i.ClientID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhooksMatching = `-- name: ListWebhooksMatching :many
+SELECT id, name, url, secret, client_id, client_secret
+FROM webhooks
+WHERE (src_type IS NULL OR src_type = $1::TEXT)
+  AND (COALESCE(search, '{}'::JSONB) = '{}'::JSONB OR $2::JSONB @> search)
+  AND (geo IS NULL OR ST_Intersects(geo, ST_SetSRID($3::GEOMETRY, ST_SRID(geo))))
+`
+
+type ListWebhooksMatchingParams struct {
+	SourceType string      `json:"source_type"`
+	Search     []byte      `json:"search"`
+	Geometry   interface{} `json:"geometry"`
+}
+
+type ListWebhooksMatchingRow struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	Url          string    `json:"url"`
+	Secret       string    `json:"secret"`
+	ClientID     string    `json:"client_id"`
+	ClientSecret string    `json:"client_secret"`
+}
+
+// ListWebhooksMatching
+//
+//	SELECT id, name, url, secret, client_id, client_secret
+//	FROM webhooks
+//	WHERE (src_type IS NULL OR src_type = $1::TEXT)
+//	  AND (COALESCE(search, '{}'::JSONB) = '{}'::JSONB OR $2::JSONB @> search)
+//	  AND (geo IS NULL OR ST_Intersects(geo, ST_SetSRID($3::GEOMETRY, ST_SRID(geo))))
+func (q *Queries) ListWebhooksMatching(ctx context.Context, arg ListWebhooksMatchingParams) ([]ListWebhooksMatchingRow, error) {
+	rows, err := q.db.Query(ctx, listWebhooksMatching, arg.SourceType, arg.Search, arg.Geometry)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhooksMatchingRow
+	for rows.Next() {
+		var i ListWebhooksMatchingRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Url,
+			&i.Secret,
+			&i.ClientID,
+			&i.ClientSecret,
 		); err != nil {
 			return nil, err
 		}
@@ -548,6 +2298,145 @@ func (q *Queries) ListTdfObjectsWithSearchAndGeo(ctx context.Context, arg ListTd
 	return items, nil
 }
 
+const lockAuditLog = `-- name: LockAuditLog :exec
+SELECT pg_advisory_xact_lock(4871320926)
+`
+
+// LockAuditLog
+//
+//	SELECT pg_advisory_xact_lock(4871320926)
+func (q *Queries) LockAuditLog(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockAuditLog)
+	return err
+}
+
+const markGeofenceDwellNotified = `-- name: MarkGeofenceDwellNotified :exec
+UPDATE geofence_occupants
+SET dwell_notified = TRUE
+WHERE geofence_id = $1 AND src_type = $2 AND entity_key = $3
+`
+
+type MarkGeofenceDwellNotifiedParams struct {
+	GeofenceID uuid.UUID `json:"geofence_id"`
+	SrcType    string    `json:"src_type"`
+	EntityKey  string    `json:"entity_key"`
+}
+
+// MarkGeofenceDwellNotified
+//
+//	UPDATE geofence_occupants
+//	SET dwell_notified = TRUE
+//	WHERE geofence_id = $1 AND src_type = $2 AND entity_key = $3
+func (q *Queries) MarkGeofenceDwellNotified(ctx context.Context, arg MarkGeofenceDwellNotifiedParams) error {
+	_, err := q.db.Exec(ctx, markGeofenceDwellNotified, arg.GeofenceID, arg.SrcType, arg.EntityKey)
+	return err
+}
+
+const revertTdfObject = `-- name: RevertTdfObject :one
+UPDATE tdf_objects o
+SET ts = $1,
+    src_type = r.src_type,
+    geo = r.geo,
+    search = r.search,
+    metadata = r.metadata,
+    tdf_blob = (SELECT b.tdf_blob FROM tdf_revision_blobs b WHERE b.hash = r.tdf_blob_hash),
+    tdf_uri = r.tdf_uri,
+    entity_key = r.entity_key
+FROM tdf_object_revisions r
+WHERE o.id = $2 AND r.tdf_object_id = o.id AND r.revision = $3
+RETURNING o.id
+`
+
+type RevertTdfObjectParams struct {
+	Ts       pgtype.Timestamp `json:"ts"`
+	ID       uuid.UUID        `json:"id"`
+	Revision int32            `json:"revision"`
+}
+
+// RevertTdfObject
+//
+//	UPDATE tdf_objects o
+//	SET ts = $1,
+//	    src_type = r.src_type,
+//	    geo = r.geo,
+//	    search = r.search,
+//	    metadata = r.metadata,
+//	    tdf_blob = (SELECT b.tdf_blob FROM tdf_revision_blobs b WHERE b.hash = r.tdf_blob_hash),
+//	    tdf_uri = r.tdf_uri,
+//	    entity_key = r.entity_key
+//	FROM tdf_object_revisions r
+//	WHERE o.id = $2 AND r.tdf_object_id = o.id AND r.revision = $3
+//	RETURNING o.id
+func (q *Queries) RevertTdfObject(ctx context.Context, arg RevertTdfObjectParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, revertTdfObject, arg.Ts, arg.ID, arg.Revision)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const setTdfObjectChange = `-- name: SetTdfObjectChange :exec
+SELECT set_config('dsp_cop.changed_by', $1::TEXT, true),
+       set_config('dsp_cop.operation', $2::TEXT, true)
+`
+
+type SetTdfObjectChangeParams struct {
+	ChangedBy string `json:"changed_by"`
+	Operation string `json:"operation"`
+}
+
+// SetTdfObjectChange
+//
+//	SELECT set_config('dsp_cop.changed_by', $1::TEXT, true),
+//	       set_config('dsp_cop.operation', $2::TEXT, true)
+func (q *Queries) SetTdfObjectChange(ctx context.Context, arg SetTdfObjectChangeParams) error {
+	_, err := q.db.Exec(ctx, setTdfObjectChange, arg.ChangedBy, arg.Operation)
+	return err
+}
+
+const updateGeofence = `-- name: UpdateGeofence :one
+UPDATE geofences
+SET name = COALESCE($2, name),
+    geo = COALESCE($3, geo),
+    src_type = CASE WHEN $4::TEXT IS NULL THEN src_type ELSE NULLIF($4::TEXT, '') END,
+    search = COALESCE($5, search),
+    dwell_seconds = COALESCE($6, dwell_seconds)
+WHERE id = $1
+RETURNING id
+`
+
+type UpdateGeofenceParams struct {
+	ID           uuid.UUID   `json:"id"`
+	Name         pgtype.Text `json:"name"`
+	Geo          *geos.Geom  `json:"geo"`
+	SrcType      pgtype.Text `json:"src_type"`
+	Search       []byte      `json:"search"`
+	DwellSeconds pgtype.Int4 `json:"dwell_seconds"`
+}
+
+// UpdateGeofence
+//
+//	UPDATE geofences
+//	SET name = COALESCE($2, name),
+//	    geo = COALESCE($3, geo),
+//	    src_type = CASE WHEN $4::TEXT IS NULL THEN src_type ELSE NULLIF($4::TEXT, '') END,
+//	    search = COALESCE($5, search),
+//	    dwell_seconds = COALESCE($6, dwell_seconds)
+//	WHERE id = $1
+//	RETURNING id
+func (q *Queries) UpdateGeofence(ctx context.Context, arg UpdateGeofenceParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, updateGeofence,
+		arg.ID,
+		arg.Name,
+		arg.Geo,
+		arg.SrcType,
+		arg.Search,
+		arg.DwellSeconds,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const updateTdfObject = `-- name: UpdateTdfObject :one
 UPDATE tdf_objects
 SET ts = COALESCE($2, ts),
@@ -556,26 +2445,31 @@ SET ts = COALESCE($2, ts),
     search = COALESCE($5, search),
     metadata = COALESCE($6, metadata),
     tdf_blob = COALESCE($7, tdf_blob),
-    tdf_uri = COALESCE($8, tdf_uri)
-WHERE id = $1
-RETURNING id, src_type, ts
+    tdf_uri = COALESCE($8, tdf_uri),
+    entity_key = COALESCE($9, entity_key)
+WHERE id = $1 AND ($10::INTEGER IS NULL OR version = $10::INTEGER)
+RETURNING id, src_type, ts, version, search
 `
 
 type UpdateTdfObjectParams struct {
-	ID       uuid.UUID        `json:"id"`
-	Ts       pgtype.Timestamp `json:"ts"`
-	SrcType  pgtype.Text      `json:"src_type"`
-	Geo      *geos.Geom       `json:"geo"`
-	Search   []byte           `json:"search"`
-	Metadata []byte           `json:"metadata"`
-	TdfBlob  []byte           `json:"tdf_blob"`
-	TdfUri   pgtype.Text      `json:"tdf_uri"`
+	ID              uuid.UUID        `json:"id"`
+	Ts              pgtype.Timestamp `json:"ts"`
+	SrcType         pgtype.Text      `json:"src_type"`
+	Geo             *geos.Geom       `json:"geo"`
+	Search          []byte           `json:"search"`
+	Metadata        []byte           `json:"metadata"`
+	TdfBlob         []byte           `json:"tdf_blob"`
+	TdfUri          pgtype.Text      `json:"tdf_uri"`
+	EntityKey       pgtype.Text      `json:"entity_key"`
+	ExpectedVersion pgtype.Int4      `json:"expected_version"`
 }
 
 type UpdateTdfObjectRow struct {
 	ID      uuid.UUID        `json:"id"`
 	SrcType string           `json:"src_type"`
 	Ts      pgtype.Timestamp `json:"ts"`
+	Version int32            `json:"version"`
+	Search  []byte           `json:"search"`
 }
 
 // UpdateTdfObject
@@ -587,9 +2481,10 @@ type UpdateTdfObjectRow struct {
 //	    search = COALESCE($5, search),
 //	    metadata = COALESCE($6, metadata),
 //	    tdf_blob = COALESCE($7, tdf_blob),
-//	    tdf_uri = COALESCE($8, tdf_uri)
-//	WHERE id = $1
-//	RETURNING id, src_type, ts
+//	    tdf_uri = COALESCE($8, tdf_uri),
+//	    entity_key = COALESCE($9, entity_key)
+//	WHERE id = $1 AND ($10::INTEGER IS NULL OR version = $10::INTEGER)
+//	RETURNING id, src_type, ts, version, search
 func (q *Queries) UpdateTdfObject(ctx context.Context, arg UpdateTdfObjectParams) (UpdateTdfObjectRow, error) {
 	row := q.db.QueryRow(ctx, updateTdfObject,
 		arg.ID,
@@ -600,12 +2495,72 @@ func (q *Queries) UpdateTdfObject(ctx context.Context, arg UpdateTdfObjectParams
 		arg.Metadata,
 		arg.TdfBlob,
 		arg.TdfUri,
+		arg.EntityKey,
+		arg.ExpectedVersion,
 	)
 	var i UpdateTdfObjectRow
-	err := row.Scan(&i.ID, &i.SrcType, &i.Ts)
+	err := row.Scan(&i.ID, &i.SrcType, &i.Ts, &i.Version, &i.Search)
 	return i, err
 }
 
+const updateWebhook = `-- name: UpdateWebhook :one
+UPDATE webhooks
+SET name = COALESCE($2, name),
+    url = COALESCE($3, url),
+    src_type = CASE WHEN $4::TEXT IS NULL THEN src_type ELSE NULLIF($4::TEXT, '') END,
+    geo = CASE WHEN $5::BOOLEAN THEN NULL ELSE COALESCE($6, geo) END,
+    search = COALESCE($7, search),
+    secret = COALESCE($8, secret),
+    client_id = COALESCE($9, client_id),
+    client_secret = COALESCE($10, client_secret)
+WHERE id = $1
+RETURNING id
+`
+
+type UpdateWebhookParams struct {
+	ID           uuid.UUID   `json:"id"`
+	Name         pgtype.Text `json:"name"`
+	Url          pgtype.Text `json:"url"`
+	SrcType      pgtype.Text `json:"src_type"`
+	ClearGeo     bool        `json:"clear_geo"`
+	Geo          *geos.Geom  `json:"geo"`
+	Search       []byte      `json:"search"`
+	Secret       pgtype.Text `json:"secret"`
+	ClientID     pgtype.Text `json:"client_id"`
+	ClientSecret pgtype.Text `json:"client_secret"`
+}
+
+// UpdateWebhook
+//
+//	UPDATE webhooks
+//	SET name = COALESCE($2, name),
+//	    url = COALESCE($3, url),
+//	    src_type = CASE WHEN $4::TEXT IS NULL THEN src_type ELSE NULLIF($4::TEXT, '') END,
+//	    geo = CASE WHEN $5::BOOLEAN THEN NULL ELSE COALESCE($6, geo) END,
+//	    search = COALESCE($7, search),
+//	    secret = COALESCE($8, secret),
+//	    client_id = COALESCE($9, client_id),
+//	    client_secret = COALESCE($10, client_secret)
+//	WHERE id = $1
+//	RETURNING id
+func (q *Queries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, updateWebhook,
+		arg.ID,
+		arg.Name,
+		arg.Url,
+		arg.SrcType,
+		arg.ClearGeo,
+		arg.Geo,
+		arg.Search,
+		arg.Secret,
+		arg.ClientID,
+		arg.ClientSecret,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getNotesByParent = `-- name: getNotesByParent :many
 SELECT id, ts, parent_id, tdf_blob, search, tdf_uri
 FROM tdf_notes
@@ -466,6 +466,155 @@ VALUES (
         "details":["attrClassification","attrNeedToKnow","attrRelTo","vehicleName","callsign","origin","destination","aircraft_type","speed","altitude","heading"]
       }
     }'
+  ),
+  (
+    'cot',
+    '{
+      "type":"object",
+      "required":["uid","type"],
+      "properties": {
+        "callsign": {
+          "title":"Callsign",
+          "type":"string"
+        },
+        "uid": {
+          "title":"UID",
+          "type":"string",
+          "minLength":1
+        },
+        "type": {
+          "title":"CoT Type",
+          "type":"string",
+          "minLength":1
+        }
+      }
+    }',
+    '{
+      "order": ["callsign","uid","type"]
+    }',
+    '{
+      "searchFields":["uid","type","callsign","attrClassification","attrNeedToKnow","attrRelTo"],
+      "attrFields":["attrClassification","attrNeedToKnow","attrRelTo"],
+      "entityField":"uid",
+      "displayFields": {
+        "header":"callsign",
+        "details":["type","uid","how","stale","speed","course"]
+      }
+    }'
+  ),
+  (
+    'ais',
+    '{
+      "type":"object",
+      "required":["attrClassification","mmsi"],
+      "default":{},
+      "definitions": {
+        "attrClassification": {
+          "oneOf": [
+              {"type":"string","title":"TOPSECRET","enum":["https://demo.com/attr/classification/value/topsecret"]},{"type":"string","title":"SECRET","enum":["https://demo.com/attr/classification/value/secret"]},{"type":"string","title":"CONFIDENTIAL","enum":["https://demo.com/attr/classification/value/confidential"]},{"type":"string","title":"UNCLASSIFIED","enum":["https://demo.com/attr/classification/value/unclassified"]}
+            ]
+        },
+        "attrNeedToKnow": {
+          "anyOf": [
+              {"type":"string","title":"AAA","enum":["https://demo.com/attr/needtoknow/value/aaa"]},{"type":"string","title":"BBB","enum":["https://demo.com/attr/needtoknow/value/bbb"]},{"type":"string","title":"INT","enum":["https://demo.com/attr/needtoknow/value/int"]},{"type":"string","title":"OPS","enum":["https://demo.com/attr/needtoknow/value/ops"]}
+            ]
+        },
+        "attributeRelTo": {
+          "anyOf": [
+            {"type":"string","title":"AUSTRALIA","enum":["https://demo.com/attr/relto/value/aus"]},{"type":"string","title":"FRANCE","enum":["https://demo.com/attr/relto/value/fra"]},{"type":"string","title":"FVEY","enum":["https://demo.com/attr/relto/value/fvey"]},{"type":"string","title":"UNITED KINGDOM","enum":["https://demo.com/attr/relto/value/gbr"]},{"type":"string","title":"NATO","enum":["https://demo.com/attr/relto/value/nato"]},{"type":"string","title":"PINK","enum":["https://demo.com/attr/relto/value/pink"]},{"type":"string","title":"UNITED STATES","enum":["https://demo.com/attr/relto/value/usa"]},{"type":"string","title":"ARUBA","enum":["https://demo.com/attr/relto/value/abw"]},{"type":"string","title":"AFGHANISTAN","enum":["https://demo.com/attr/relto/value/afg"]},{"type":"string","title":"ANGOLA","enum":["https://demo.com/attr/relto/value/ago"]},{"type":"string","title":"ANGUILLA","enum":["https://demo.com/attr/relto/value/aia"]},{"type":"string","title":"ALAND ISLANDS","enum":["https://demo.com/attr/relto/value/ala"]},{"type":"string","title":"ALBANIA","enum":["https://demo.com/attr/relto/value/alb"]},{"type":"string","title":"ANDORRA","enum":["https://demo.com/attr/relto/value/and"]},{"type":"string","title":"UNITED ARAB EMIRATES","enum":["https://demo.com/attr/relto/value/are"]},{"type":"string","title":"ARGENTINA","enum":["https://demo.com/attr/relto/value/arg"]},{"type":"string","title":"ARMENIA","enum":["https://demo.com/attr/relto/value/arm"]},{"type":"string","title":"AMERICAN SAMOA","enum":["https://demo.com/attr/relto/value/asm"]},{"type":"string","title":"ANTARCTICA","enum":["https://demo.com/attr/relto/value/ata"]},{"type":"string","title":"FRENCH SOUTHERN AND ANTARCTIC LANDS","enum":["https://demo.com/attr/relto/value/atf"]},{"type":"string","title":"ANTIGUA AND BARBUDA","enum":["https://demo.com/attr/relto/value/atg"]},{"type":"string","title":"AUSTRIA","enum":["https://demo.com/attr/relto/value/aut"]},{"type":"string","title":"AZERBAIJAN","enum":["https://demo.com/attr/relto/value/aze"]},{"type":"string","title":"BURUNDI","enum":["https://demo.com/attr/relto/value/bdi"]},{"type":"string","title":"BELGIUM","enum":["https://demo.com/attr/relto/value/bel"]},{"type":"string","title":"BENIN","enum":["https://demo.com/attr/relto/value/ben"]},{"type":"string","title":"BONAIRE, SINT EUSTATIUS AND SABA","enum":["https://demo.com/attr/relto/value/bes"]},{"type":"string","title":"BURKINA FASO","enum":["https://demo.com/attr/relto/value/bfa"]},{"type":"string","title":"BANGLADESH","enum":["https://demo.com/attr/relto/value/bgd"]},{"type":"string","title":"BULGARIA","enum":["https://demo.com/attr/relto/value/bgr"]},{"type":"string","title":"BAHRAIN","enum":["https://demo.com/attr/relto/value/bhr"]},{"type":"string","title":"BAHAMAS, THE","enum":["https://demo.com/attr/relto/value/bhs"]},{"type":"string","title":"BOSNIA AND HERZEGOVINA","enum":["https://demo.com/attr/relto/value/bih"]},{"type":"string","title":"SAINT BARTHELEMY","enum":["https://demo.com/attr/relto/value/blm"]},{"type":"string","title":"BELARUS","enum":["https://demo.com/attr/relto/value/blr"]},{"type":"string","title":"BELIZE","enum":["https://demo.com/attr/relto/value/blz"]},{"type":"string","title":"BERMUDA","enum":["https://demo.com/attr/relto/value/bmu"]},{"type":"string","title":"BOLIVIA","enum":["https://demo.com/attr/relto/value/bol"]},{"type":"string","title":"BRAZIL","enum":["https://demo.com/attr/relto/value/bra"]},{"type":"string","title":"BARBADOS","enum":["https://demo.com/attr/relto/value/brb"]},{"type":"string","title":"BRUNEI","enum":["https://demo.com/attr/relto/value/brn"]},{"type":"string","title":"BHUTAN","enum":["https://demo.com/attr/relto/value/btn"]},{"type":"string","title":"BOUVET ISLAND","enum":["https://demo.com/attr/relto/value/bvt"]},{"type":"string","title":"BOTSWANA","enum":["https://demo.com/attr/relto/value/bwa"]},{"type":"string","title":"CENTRAL AFRICAN REPUBLIC","enum":["https://demo.com/attr/relto/value/caf"]},{"type":"string","title":"CANADA","enum":["https://demo.com/attr/relto/value/can"]},{"type":"string","title":"COCOS (KEELING) ISLANDS","enum":["https://demo.com/attr/relto/value/cck"]},{"type":"string","title":"SWITZERLAND","enum":["https://demo.com/attr/relto/value/che"]},{"type":"string","title":"CHILE","enum":["https://demo.com/attr/relto/value/chl"]},{"type":"string","title":"CHINA","enum":["https://demo.com/attr/relto/value/chn"]},{"type":"string","title":"CÔTE D''IVOIRE","enum":["https://demo.com/attr/relto/value/civ"]},{"type":"string","title":"CAMEROON","enum":["https://demo.com/attr/relto/value/cmr"]},{"type":"string","title":"CONGO(KINSHASA)","enum":["https://demo.com/attr/relto/value/cod"]},{"type":"string","title":"CONGO (BRAZZAVILLE)","enum":["https://demo.com/attr/relto/value/cog"]},{"type":"string","title":"COOK ISLANDS","enum":["https://demo.com/attr/relto/value/cok"]},{"type":"string","title":"COLOMBIA","enum":["https://demo.com/attr/relto/value/col"]},{"type":"string","title":"COMOROS","enum":["https://demo.com/attr/relto/value/com"]},{"type":"string","title":"CABO VERDE","enum":["https://demo.com/attr/relto/value/cpv"]},{"type":"string","title":"COSTA RICA","enum":["https://demo.com/attr/relto/value/cri"]},{"type":"string","title":"CUBA","enum":["https://demo.com/attr/relto/value/cub"]},{"type":"string","title":"CURAÇAO","enum":["https://demo.com/attr/relto/value/cuw"]},{"type":"string","title":"CHRISTMAS ISLAND","enum":["https://demo.com/attr/relto/value/cxr"]},{"type":"string","title":"CAYMAN ISLANDS","enum":["https://demo.com/attr/relto/value/cym"]},{"type":"string","title":"CYPRUS","enum":["https://demo.com/attr/relto/value/cyp"]},{"type":"string","title":"CZECH REPUBLIC","enum":["https://demo.com/attr/relto/value/cze"]},{"type":"string","title":"GERMANY","enum":["https://demo.com/attr/relto/value/deu"]},{"type":"string","title":"DJIBOUTI","enum":["https://demo.com/attr/relto/value/dji"]},{"type":"string","title":"DOMINICA","enum":["https://demo.com/attr/relto/value/dma"]},{"type":"string","title":"DENMARK","enum":["https://demo.com/attr/relto/value/dnk"]},{"type":"string","title":"DOMINICAN REPUBLIC","enum":["https://demo.com/attr/relto/value/dom"]},{"type":"string","title":"ALGERIA","enum":["https://demo.com/attr/relto/value/dza"]},{"type":"string","title":"ECUADOR","enum":["https://demo.com/attr/relto/value/ecu"]},{"type":"string","title":"EGYPT","enum":["https://demo.com/attr/relto/value/egy"]},{"type":"string","title":"ERITREA","enum":["https://demo.com/attr/relto/value/eri"]},{"type":"string","title":"WESTERN SAHARA","enum":["https://demo.com/attr/relto/value/esh"]},{"type":"string","title":"SPAIN","enum":["https://demo.com/attr/relto/value/esp"]},{"type":"string","title":"ESTONIA","enum":["https://demo.com/attr/relto/value/est"]},{"type":"string","title":"ETHIOPIA","enum":["https://demo.com/attr/relto/value/eth"]},{"type":"string","title":"FINLAND","enum":["https://demo.com/attr/relto/value/fin"]},{"type":"string","title":"FIJI","enum":["https://demo.com/attr/relto/value/fji"]},{"type":"string","title":"FALKLAND ISLANDS (ISLAS MALVINAS)","enum":["https://demo.com/attr/relto/value/flk"]},{"type":"string","title":"FAROE ISLANDS","enum":["https://demo.com/attr/relto/value/fro"]},{"type":"string","title":"MICRONESIA, FEDERATED STATES OF","enum":["https://demo.com/attr/relto/value/fsm"]},{"type":"string","title":"GABON","enum":["https://demo.com/attr/relto/value/gab"]},{"type":"string","title":"GEORGIA","enum":["https://demo.com/attr/relto/value/geo"]},{"type":"string","title":"GUERNSEY","enum":["https://demo.com/attr/relto/value/ggy"]},{"type":"string","title":"GHANA","enum":["https://demo.com/attr/relto/value/gha"]},{"type":"string","title":"GIBRALTAR","enum":["https://demo.com/attr/relto/value/gib"]},{"type":"string","title":"GUINEA","enum":["https://demo.com/attr/relto/value/gin"]},{"type":"string","title":"GUADELOUPE","enum":["https://demo.com/attr/relto/value/glp"]},{"type":"string","title":"GAMBIA, THE","enum":["https://demo.com/attr/relto/value/gmb"]},{"type":"string","title":"GUINEA-BISSAU","enum":["https://demo.com/attr/relto/value/gnb"]},{"type":"string","title":"EQUATORIAL GUINEA","enum":["https://demo.com/attr/relto/value/gnq"]},{"type":"string","title":"GREECE","enum":["https://demo.com/attr/relto/value/grc"]},{"type":"string","title":"GRENADA","enum":["https://demo.com/attr/relto/value/grd"]},{"type":"string","title":"GREENLAND","enum":["https://demo.com/attr/relto/value/grl"]},{"type":"string","title":"GUATEMALA","enum":["https://demo.com/attr/relto/value/gtm"]},{"type":"string","title":"FRENCH GUIANA","enum":["https://demo.com/attr/relto/value/guf"]},{"type":"string","title":"GUAM","enum":["https://demo.com/attr/relto/value/gum"]},{"type":"string","title":"GUYANA","enum":["https://demo.com/attr/relto/value/guy"]},{"type":"string","title":"HONG KONG","enum":["https://demo.com/attr/relto/value/hkg"]},{"type":"string","title":"HEARD ISLAND AND MCDONALD ISLANDS","enum":["https://demo.com/attr/relto/value/hmd"]},{"type":"string","title":"HONDURAS","enum":["https://demo.com/attr/relto/value/hnd"]},{"type":"string","title":"CROATIA","enum":["https://demo.com/attr/relto/value/hrv"]},{"type":"string","title":"HAITI","enum":["https://demo.com/attr/relto/value/hti"]},{"type":"string","title":"HUNGARY","enum":["https://demo.com/attr/relto/value/hun"]},{"type":"string","title":"INDONESIA","enum":["https://demo.com/attr/relto/value/idn"]},{"type":"string","title":"ISLE OF MAN","enum":["https://demo.com/attr/relto/value/imn"]},{"type":"string","title":"INDIA","enum":["https://demo.com/attr/relto/value/ind"]},{"type":"string","title":"BRITISH INDIAN OCEAN TERRITORY","enum":["https://demo.com/attr/relto/value/iot"]},{"type":"string","title":"IRELAND","enum":["https://demo.com/attr/relto/value/irl"]},{"type":"string","title":"IRAN","enum":["https://demo.com/attr/relto/value/irn"]},{"type":"string","title":"IRAQ","enum":["https://demo.com/attr/relto/value/irq"]},{"type":"string","title":"ICELAND","enum":["https://demo.com/attr/relto/value/isl"]},{"type":"string","title":"ISRAEL","enum":["https://demo.com/attr/relto/value/isr"]},{"type":"string","title":"ITALY","enum":["https://demo.com/attr/relto/value/ita"]},{"type":"string","title":"JAMAICA","enum":["https://demo.com/attr/relto/value/jam"]},{"type":"string","title":"JERSEY","enum":["https://demo.com/attr/relto/value/jey"]},{"type":"string","title":"JORDAN","enum":["https://demo.com/attr/relto/value/jor"]},{"type":"string","title":"JAPAN","enum":["https://demo.com/attr/relto/value/jpn"]},{"type":"string","title":"KAZAKHSTAN","enum":["https://demo.com/attr/relto/value/kaz"]},{"type":"string","title":"KENYA","enum":["https://demo.com/attr/relto/value/ken"]},{"type":"string","title":"KYRGYZSTAN","enum":["https://demo.com/attr/relto/value/kgz"]},{"type":"string","title":"CAMBODIA","enum":["https://demo.com/attr/relto/value/khm"]},{"type":"string","title":"KIRIBATI","enum":["https://demo.com/attr/relto/value/kir"]},{"type":"string","title":"SAINT KITTS AND NEVIS","enum":["https://demo.com/attr/relto/value/kna"]},{"type":"string","title":"KOREA, SOUTH","enum":["https://demo.com/attr/relto/value/kor"]},{"type":"string","title":"KUWAIT","enum":["https://demo.com/attr/relto/value/kwt"]},{"type":"string","title":"LAOS","enum":["https://demo.com/attr/relto/value/lao"]},{"type":"string","title":"LEBANON","enum":["https://demo.com/attr/relto/value/lbn"]},{"type":"string","title":"LIBERIA","enum":["https://demo.com/attr/relto/value/lbr"]},{"type":"string","title":"LIBYA","enum":["https://demo.com/attr/relto/value/lby"]},{"type":"string","title":"SAINT LUCIA","enum":["https://demo.com/attr/relto/value/lca"]},{"type":"string","title":"LIECHTENSTEIN","enum":["https://demo.com/attr/relto/value/lie"]},{"type":"string","title":"SRI LANKA","enum":["https://demo.com/attr/relto/value/lka"]},{"type":"string","title":"LESOTHO","enum":["https://demo.com/attr/relto/value/lso"]},{"type":"string","title":"LITHUANIA","enum":["https://demo.com/attr/relto/value/ltu"]},{"type":"string","title":"LUXEMBOURG","enum":["https://demo.com/attr/relto/value/lux"]},{"type":"string","title":"LATVIA","enum":["https://demo.com/attr/relto/value/lva"]},{"type":"string","title":"MACAU","enum":["https://demo.com/attr/relto/value/mac"]},{"type":"string","title":"SAINT MARTIN","enum":["https://demo.com/attr/relto/value/maf"]},{"type":"string","title":"MOROCCO","enum":["https://demo.com/attr/relto/value/mar"]},{"type":"string","title":"MONACO","enum":["https://demo.com/attr/relto/value/mco"]},{"type":"string","title":"MOLDOVA","enum":["https://demo.com/attr/relto/value/mda"]},{"type":"string","title":"MADAGASCAR","enum":["https://demo.com/attr/relto/value/mdg"]},{"type":"string","title":"MALDIVES","enum":["https://demo.com/attr/relto/value/mdv"]},{"type":"string","title":"MEXICO","enum":["https://demo.com/attr/relto/value/mex"]},{"type":"string","title":"MARSHALL ISLANDS","enum":["https://demo.com/attr/relto/value/mhl"]},{"type":"string","title":"MACEDONIA","enum":["https://demo.com/attr/relto/value/mkd"]},{"type":"string","title":"MALI","enum":["https://demo.com/attr/relto/value/mli"]},{"type":"string","title":"MALTA","enum":["https://demo.com/attr/relto/value/mlt"]},{"type":"string","title":"BURMA","enum":["https://demo.com/attr/relto/value/mmr"]},{"type":"string","title":"MONTENEGRO","enum":["https://demo.com/attr/relto/value/mne"]},{"type":"string","title":"MONGOLIA","enum":["https://demo.com/attr/relto/value/mng"]},{"type":"string","title":"NORTHERN MARIANA ISLANDS","enum":["https://demo.com/attr/relto/value/mnp"]},{"type":"string","title":"MOZAMBIQUE","enum":["https://demo.com/attr/relto/value/moz"]},{"type":"string","title":"MAURITANIA","enum":["https://demo.com/attr/relto/value/mrt"]},{"type":"string","title":"MONTSERRAT","enum":["https://demo.com/attr/relto/value/msr"]},{"type":"string","title":"MARTINIQUE","enum":["https://demo.com/attr/relto/value/mtq"]},{"type":"string","title":"MAURITIUS","enum":["https://demo.com/attr/relto/value/mus"]},{"type":"string","title":"MALAWI","enum":["https://demo.com/attr/relto/value/mwi"]},{"type":"string","title":"MALAYSIA","enum":["https://demo.com/attr/relto/value/mys"]},{"type":"string","title":"MAYOTTE","enum":["https://demo.com/attr/relto/value/myt"]},{"type":"string","title":"NAMIBIA","enum":["https://demo.com/attr/relto/value/nam"]},{"type":"string","title":"NEW CALEDONIA","enum":["https://demo.com/attr/relto/value/ncl"]},{"type":"string","title":"NIGER","enum":["https://demo.com/attr/relto/value/ner"]},{"type":"string","title":"NORFOLK ISLAND","enum":["https://demo.com/attr/relto/value/nfk"]},{"type":"string","title":"NIGERIA","enum":["https://demo.com/attr/relto/value/nga"]},{"type":"string","title":"NICARAGUA","enum":["https://demo.com/attr/relto/value/nic"]},{"type":"string","title":"NIUE","enum":["https://demo.com/attr/relto/value/niu"]},{"type":"string","title":"NETHERLANDS","enum":["https://demo.com/attr/relto/value/nld"]},{"type":"string","title":"NORWAY","enum":["https://demo.com/attr/relto/value/nor"]},{"type":"string","title":"NEPAL","enum":["https://demo.com/attr/relto/value/npl"]},{"type":"string","title":"NAURU","enum":["https://demo.com/attr/relto/value/nru"]},{"type":"string","title":"NEW ZEALAND","enum":["https://demo.com/attr/relto/value/nzl"]},{"type":"string","title":"OMAN","enum":["https://demo.com/attr/relto/value/omn"]},{"type":"string","title":"PAKISTAN","enum":["https://demo.com/attr/relto/value/pak"]},{"type":"string","title":"PANAMA","enum":["https://demo.com/attr/relto/value/pan"]},{"type":"string","title":"PITCAIRN ISLANDS","enum":["https://demo.com/attr/relto/value/pcn"]},{"type":"string","title":"PERU","enum":["https://demo.com/attr/relto/value/per"]},{"type":"string","title":"PHILIPPINES","enum":["https://demo.com/attr/relto/value/phl"]},{"type":"string","title":"PALAU","enum":["https://demo.com/attr/relto/value/plw"]},{"type":"string","title":"PAPUA NEW GUINEA","enum":["https://demo.com/attr/relto/value/png"]},{"type":"string","title":"POLAND","enum":["https://demo.com/attr/relto/value/pol"]},{"type":"string","title":"PUERTO RICO","enum":["https://demo.com/attr/relto/value/pri"]},{"type":"string","title":"KOREA, NORTH","enum":["https://demo.com/attr/relto/value/prk"]},{"type":"string","title":"PORTUGAL","enum":["https://demo.com/attr/relto/value/prt"]},{"type":"string","title":"PARAGUAY","enum":["https://demo.com/attr/relto/value/pry"]},{"type":"string","title":"PALESTINE","enum":["https://demo.com/attr/relto/value/pse"]},{"type":"string","title":"FRENCH POLYNESIA","enum":["https://demo.com/attr/relto/value/pyf"]},{"type":"string","title":"QATAR","enum":["https://demo.com/attr/relto/value/qat"]},{"type":"string","title":"REUNION","enum":["https://demo.com/attr/relto/value/reu"]},{"type":"string","title":"ROMANIA","enum":["https://demo.com/attr/relto/value/rou"]},{"type":"string","title":"RUSSIA","enum":["https://demo.com/attr/relto/value/rus"]},{"type":"string","title":"RWANDA","enum":["https://demo.com/attr/relto/value/rwa"]},{"type":"string","title":"SAUDI ARABIA","enum":["https://demo.com/attr/relto/value/sau"]},{"type":"string","title":"SUDAN","enum":["https://demo.com/attr/relto/value/sdn"]},{"type":"string","title":"SENEGAL","enum":["https://demo.com/attr/relto/value/sen"]},{"type":"string","title":"SINGAPORE","enum":["https://demo.com/attr/relto/value/sgp"]},{"type":"string","title":"SOUTH GEORGIA AND SOUTH SANDWICH ISLANDS","enum":["https://demo.com/attr/relto/value/sgs"]},{"type":"string","title":"SAINT HELENA, ASCENSION AND TRISTAN DA CUNHA","enum":["https://demo.com/attr/relto/value/shn"]},{"type":"string","title":"SVALBARD","enum":["https://demo.com/attr/relto/value/sjm"]},{"type":"string","title":"SOLOMON ISLANDS","enum":["https://demo.com/attr/relto/value/slb"]},{"type":"string","title":"SIERRA LEONE","enum":["https://demo.com/attr/relto/value/sle"]},{"type":"string","title":"EL SALVADOR","enum":["https://demo.com/attr/relto/value/slv"]},{"type":"string","title":"SAN MARINO","enum":["https://demo.com/attr/relto/value/smr"]},{"type":"string","title":"SOMALIA","enum":["https://demo.com/attr/relto/value/som"]},{"type":"string","title":"SAINT PIERRE AND MIQUELON","enum":["https://demo.com/attr/relto/value/spm"]},{"type":"string","title":"SERBIA","enum":["https://demo.com/attr/relto/value/srb"]},{"type":"string","title":"SOUTH SUDAN","enum":["https://demo.com/attr/relto/value/ssd"]},{"type":"string","title":"SAO TOME AND PRINCIPE","enum":["https://demo.com/attr/relto/value/stp"]},{"type":"string","title":"SURINAME","enum":["https://demo.com/attr/relto/value/sur"]},{"type":"string","title":"SLOVAKIA","enum":["https://demo.com/attr/relto/value/svk"]},{"type":"string","title":"SLOVENIA","enum":["https://demo.com/attr/relto/value/svn"]},{"type":"string","title":"SWEDEN","enum":["https://demo.com/attr/relto/value/swe"]},{"type":"string","title":"SWAZILAND","enum":["https://demo.com/attr/relto/value/swz"]},{"type":"string","title":"SINT MAARTEN","enum":["https://demo.com/attr/relto/value/sxm"]},{"type":"string","title":"SEYCHELLES","enum":["https://demo.com/attr/relto/value/syc"]},{"type":"string","title":"SYRIA","enum":["https://demo.com/attr/relto/value/syr"]},{"type":"string","title":"TURKS AND CAICOS ISLANDS","enum":["https://demo.com/attr/relto/value/tca"]},{"type":"string","title":"CHAD","enum":["https://demo.com/attr/relto/value/tcd"]},{"type":"string","title":"TOGO","enum":["https://demo.com/attr/relto/value/tgo"]},{"type":"string","title":"THAILAND","enum":["https://demo.com/attr/relto/value/tha"]},{"type":"string","title":"TAJIKISTAN","enum":["https://demo.com/attr/relto/value/tjk"]},{"type":"string","title":"TOKELAU","enum":["https://demo.com/attr/relto/value/tkl"]},{"type":"string","title":"TURKMENISTAN","enum":["https://demo.com/attr/relto/value/tkm"]},{"type":"string","title":"TIMOR-LESTE","enum":["https://demo.com/attr/relto/value/tls"]},{"type":"string","title":"TONGA","enum":["https://demo.com/attr/relto/value/ton"]},{"type":"string","title":"TRINIDAD AND TOBAGO","enum":["https://demo.com/attr/relto/value/tto"]},{"type":"string","title":"TUNISIA","enum":["https://demo.com/attr/relto/value/tun"]},{"type":"string","title":"TURKEY","enum":["https://demo.com/attr/relto/value/tur"]},{"type":"string","title":"TUVALU","enum":["https://demo.com/attr/relto/value/tuv"]},{"type":"string","title":"TAIWAN","enum":["https://demo.com/attr/relto/value/twn"]},{"type":"string","title":"TANZANIA","enum":["https://demo.com/attr/relto/value/tza"]},{"type":"string","title":"UGANDA","enum":["https://demo.com/attr/relto/value/uga"]},{"type":"string","title":"UKRAINE","enum":["https://demo.com/attr/relto/value/ukr"]},{"type":"string","title":"US MINOR OUTLYING ISLANDS","enum":["https://demo.com/attr/relto/value/umi"]},{"type":"string","title":"URUGUAY","enum":["https://demo.com/attr/relto/value/ury"]},{"type":"string","title":"UZBEKISTAN","enum":["https://demo.com/attr/relto/value/uzb"]},{"type":"string","title":"VATICAN CITY","enum":["https://demo.com/attr/relto/value/vat"]},{"type":"string","title":"SAINT VINCENT AND THE GRENADINES","enum":["https://demo.com/attr/relto/value/vct"]},{"type":"string","title":"VENEZUELA","enum":["https://demo.com/attr/relto/value/ven"]},{"type":"string","title":"VIRGIN ISLANDS, BRITISH","enum":["https://demo.com/attr/relto/value/vgb"]},{"type":"string","title":"VIRGIN ISLANDS, U.S.","enum":["https://demo.com/attr/relto/value/vir"]},{"type":"string","title":"VIETNAM","enum":["https://demo.com/attr/relto/value/vnm"]}
+            ]
+        }
+      },
+      "properties": {
+        "attrClassification": {
+          "title":"Classification",
+          "type":"string",
+          "$ref":"#/definitions/attrClassification"
+        },
+        "attrNeedToKnow": {
+          "title":"Need To Know",
+          "type":"array",
+          "uniqueItems":true,
+          "items":{"$ref":"#/definitions/attrNeedToKnow"}
+        },
+        "attrRelTo": {
+          "title":"Rel To",
+          "type":"array",
+          "uniqueItems":true,
+          "items":{"$ref":"#/definitions/attributeRelTo"}
+        },
+        "mmsi": {
+          "title":"MMSI",
+          "type":"string",
+          "pattern":"^[0-9]{9}$"
+        },
+        "name": {
+          "title":"Ship Name",
+          "type":"string"
+        },
+        "callsign": {
+          "title":"Callsign",
+          "type":"string"
+        },
+        "shipCategory": {
+          "title":"Ship Category",
+          "type":"string",
+          "enum":["cargo","tanker","passenger","fishing","tug","military","pleasure","high speed","law enforcement","other","unknown"]
+        },
+        "destination": {
+          "title":"Destination",
+          "type":"string"
+        },
+        "speed": {
+          "title":"Speed (knots)",
+          "type":"number",
+          "minimum":0
+        },
+        "course": {
+          "title":"Course",
+          "type":"number",
+          "minimum":0,
+          "maximum":360
+        },
+        "heading": {
+          "title":"Heading",
+          "type":"integer",
+          "minimum":0,
+          "maximum":359
+        },
+        "navStatus": {
+          "title":"Navigational Status",
+          "type":"string"
+        }
+      }
+    }',
+    '{
+      "order": ["attrClassification","attrNeedToKnow","attrRelTo","mmsi","name","callsign","shipCategory","destination","speed","course","heading","navStatus"],
+      "attrClassification":{"widget":"AttributeAutocomplete"},
+      "attrNeedToKnow":{"widget":"AttributeAutocomplete","multiple":true},
+      "attrRelTo":{"widget":"AttributeAutocomplete","multiple":true},
+      "mmsi":{"ui:placeholder":"Enter the 9 digit MMSI"},
+      "name":{"ui:placeholder":"Enter ship name"},
+      "callsign":{"ui:placeholder":"Enter callsign"},
+      "destination":{"ui:placeholder":"Enter destination"}
+    }',
+    '{
+      "searchFields":["mmsi","name","callsign","attrClassification","attrNeedToKnow","attrRelTo"],
+      "attrFields":["attrClassification","attrNeedToKnow","attrRelTo"],
+      "entityField":"mmsi",
+      "displayFields": {
+        "header":"name",
+        "details":["mmsi","callsign","shipCategory","destination","speed","course","heading","navStatus"]
+      },
+      "mapFields": {
+        "iconDefault":"default",
+        "colorDefault":"default",
+        "colorConfig": [{
+          "field":"shipCategory",
+          "valueMap": {}
+        }]
+      }
+    }'
   )
   -- TODO add information for vehicles above, should both allow vehicle level filtering &
   -- control its display in the search results box (at least I think.)
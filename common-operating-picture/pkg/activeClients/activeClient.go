@@ -38,6 +38,37 @@ func (s *TdfNoteStream) Send(message interface{}) error {
 	return fmt.Errorf("invalid message type for TdfNoteStream")
 }
 
+// TdfObjectFilter returns a tdf_object as a client may see it, false when the client can not see it
+type TdfObjectFilter func(obj *tdf_objectv1.TdfObject) (*tdf_objectv1.TdfObject, bool)
+
+// Wrapper for geofence event stream, subscribed to a set of geofences
+type GeofenceEventStream struct {
+	stream      *connect.ServerStream[tdf_objectv1.StreamGeofenceEventsResponse]
+	geofenceIds map[string]bool
+	filter      TdfObjectFilter
+}
+
+func (s *GeofenceEventStream) Send(message interface{}) error {
+	switch m := message.(type) {
+	case *tdf_objectv1.StreamGeofenceEventsResponse:
+		return s.stream.Send(m)
+	case *tdf_objectv1.StreamTdfObjectsResponse:
+		// forward system events (connected, heartbeat, shutdown) but not tdf_objects
+		if len(m.TdfObjects) == 0 {
+			return s.stream.Send(&tdf_objectv1.StreamGeofenceEventsResponse{
+				EventType:   m.EventType,
+				EventDetail: m.EventDetail,
+			})
+		}
+	}
+	return fmt.Errorf("invalid message type for GeofenceEventStream")
+}
+
+// subscribed reports whether the stream receives events of a geofence, no geofence ids subscribes to all geofences
+func (s *GeofenceEventStream) subscribed(geofenceId string) bool {
+	return len(s.geofenceIds) == 0 || s.geofenceIds[geofenceId]
+}
+
 // ActiveClient represents a client that can either stream tdf_objects or tdf_notes
 type ActiveClient struct {
 	id     string
@@ -65,6 +96,25 @@ func (ac *ActiveClients) AddNote(id string, peer connect.Peer, stream *connect.S
 	ac.clients = append(ac.clients, ActiveClient{id, peer, &TdfNoteStream{stream}})
 }
 
+// AddGeofence adds a new geofence event client subscribed to geofenceIds, or all geofences if empty. The events of
+// the tdf_objects filter does not return are not sent to the client.
+func (ac *ActiveClients) AddGeofence(id string, peer connect.Peer, stream *connect.ServerStream[tdf_objectv1.StreamGeofenceEventsResponse], geofenceIds []string, filter TdfObjectFilter) {
+	ac.lock.Lock()
+	defer ac.lock.Unlock()
+	ids := make(map[string]bool, len(geofenceIds))
+	for _, g := range geofenceIds {
+		ids[g] = true
+	}
+	ac.clients = append(ac.clients, ActiveClient{id, peer, &GeofenceEventStream{stream, ids, filter}})
+}
+
+// AddStream adds an internal client, such as an output to another system, receiving the broadcasts on its own stream
+func (ac *ActiveClients) AddStream(id string, stream Stream) {
+	ac.lock.Lock()
+	defer ac.lock.Unlock()
+	ac.clients = append(ac.clients, ActiveClient{id: id, stream: stream})
+}
+
 // Remove a client by ID
 func (ac *ActiveClients) Remove(id string) {
 	ac.lock.Lock()
@@ -129,6 +179,14 @@ func (ac *ActiveClients) BroadcastTdfObjects(objs []*tdf_objectv1.TdfObject) {
 	})
 }
 
+// BroadcastUpdatedTdfObjects sends updated TDF objects, with their new version, to all clients
+func (ac *ActiveClients) BroadcastUpdatedTdfObjects(objs []*tdf_objectv1.TdfObject) {
+	ac.broadcast(&tdf_objectv1.StreamTdfObjectsResponse{
+		EventType:  tdf_objectv1.StreamEventType_STREAM_EVENT_TYPE_TDF_OBJECTS_UPDATED,
+		TdfObjects: objs,
+	})
+}
+
 // BroadcastTdfNotes sends new TDF notes to all clients
 func (ac *ActiveClients) BroadcastTdfNotes(notes []*tdf_notev1.TdfNote) {
 	ac.broadcast(&tdf_notev1.StreamTdfNotesResponse{
@@ -136,3 +194,30 @@ func (ac *ActiveClients) BroadcastTdfNotes(notes []*tdf_notev1.TdfNote) {
 		TdfNotes:  notes,
 	})
 }
+
+// BroadcastGeofenceEvent sends a geofence event to the clients subscribed to the geofence, each with the tdf_object
+// of the event as filtered for the client
+func (ac *ActiveClients) BroadcastGeofenceEvent(event tdf_objectv1.StreamEventType, geofenceEvent *tdf_objectv1.GeofenceEvent) {
+	ac.lock.Lock()
+	defer ac.lock.Unlock()
+	for _, c := range ac.clients {
+		s, ok := c.stream.(*GeofenceEventStream)
+		if !ok || !s.subscribed(geofenceEvent.GeofenceId) {
+			continue
+		}
+		tdfObject, visible := s.filter(geofenceEvent.TdfObject)
+		if !visible {
+			continue
+		}
+		s.Send(&tdf_objectv1.StreamGeofenceEventsResponse{
+			EventType: event,
+			GeofenceEvent: &tdf_objectv1.GeofenceEvent{
+				GeofenceId:   geofenceEvent.GeofenceId,
+				GeofenceName: geofenceEvent.GeofenceName,
+				EntityKey:    geofenceEvent.EntityKey,
+				EnteredAt:    geofenceEvent.EnteredAt,
+				TdfObject:    tdfObject,
+			},
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package adsb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/pkg/feed"
+	"github.com/virtru-corp/dsp-cop/pkg/tdf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// stateTTL is how long the state of an aircraft is kept after its last message
+const stateTTL = time.Hour
+
+// Store creates and updates tdf_objects, implemented by the TdfObjectServer of the API and by its clients
+type Store interface {
+	CreateTdfObject(context.Context, *connect.Request[tdf_objectv1.CreateTdfObjectRequest]) (*connect.Response[tdf_objectv1.CreateTdfObjectResponse], error)
+	UpdateTdfObject(context.Context, *connect.Request[tdf_objectv1.UpdateTdfObjectRequest]) (*connect.Response[tdf_objectv1.UpdateTdfObjectResponse], error)
+}
+
+// Ingester keeps the state of every aircraft of SBS messages and stores the aircraft with a position as a tdf_object
+// of SrcType keyed by ICAO address, at most once per Interval of message time. The first store of an aircraft
+// creates its tdf_object and the later stores update it, unless History creates a tdf_object every time. The
+// aircraft is wrapped in a TDF of the attribute values. An Ingester is not safe for concurrent use.
+type Ingester struct {
+	Store      Store
+	Encrypter  tdf.Encrypter
+	SrcType    string
+	AttrValues []string
+	TDFType    int
+	Interval   time.Duration
+	History    bool
+	// Location of the message times, the local time of the receiver
+	Location *time.Location
+
+	// Created and Updated are the number of tdf_objects created and updated
+	Created int
+	Updated int
+
+	aircraft map[string]*tracked
+	pruned   time.Time
+}
+
+type tracked struct {
+	Aircraft
+	// id of the tdf_object of the aircraft, empty until created
+	id     string
+	stored time.Time
+	seen   time.Time
+}
+
+// HandleLine parses a message and stores its aircraft when due. Invalid messages are logged and dropped.
+func (in *Ingester) HandleLine(ctx context.Context, line string) {
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	loc := in.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	m, err := Parse(line, loc)
+	if errors.Is(err, ErrNotTransmission) {
+		return
+	} else if err != nil {
+		slog.WarnContext(ctx, "dropping SBS message", slog.String("message", line), slog.String("error", err.Error()))
+		return
+	}
+
+	ts := m.Time
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	if in.aircraft == nil {
+		in.aircraft = make(map[string]*tracked)
+	}
+	in.prune(ts)
+
+	t, ok := in.aircraft[m.ICAO]
+	if !ok {
+		t = &tracked{}
+		in.aircraft[m.ICAO] = t
+	}
+	t.Update(m)
+	t.seen = ts
+
+	if !t.HasPosition() || (!t.stored.IsZero() && ts.Sub(t.stored) < in.Interval) {
+		return
+	}
+	if err := in.store(ctx, t, ts); err != nil {
+		slog.ErrorContext(ctx, "failed to store aircraft", slog.String("icao", m.ICAO), slog.String("error", err.Error()))
+		return
+	}
+	t.stored = ts
+}
+
+// prune drops the state of the aircraft without messages for stateTTL, at most once a minute
+func (in *Ingester) prune(now time.Time) {
+	if now.Sub(in.pruned) < time.Minute {
+		return
+	}
+	in.pruned = now
+	for icao, t := range in.aircraft {
+		if now.Sub(t.seen) > stateTTL {
+			delete(in.aircraft, icao)
+		}
+	}
+}
+
+// aircraftDisplay is the plaintext metadata of an aircraft, the fields naming it. Its altitude, speed, heading,
+// squawk and other details are only in the TDF.
+type aircraftDisplay struct {
+	ICAO        string `json:"icao"`
+	Callsign    string `json:"callsign,omitempty"`
+	VehicleName string `json:"vehicleName"`
+}
+
+func (in *Ingester) store(ctx context.Context, t *tracked, ts time.Time) error {
+	payload, err := json.Marshal(t.Aircraft)
+	if err != nil {
+		return err
+	}
+	metadata, err := json.Marshal(aircraftDisplay{
+		ICAO:        t.ICAO,
+		Callsign:    t.Callsign,
+		VehicleName: t.VehicleName,
+	})
+	if err != nil {
+		return err
+	}
+	blob, err := in.Encrypter.EncryptBytes(payload, in.AttrValues, in.TDFType)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt aircraft: %w", err)
+	}
+
+	search := map[string]interface{}{
+		"icao": t.ICAO,
+	}
+	if t.Callsign != "" {
+		search["callsign"] = t.Callsign
+	}
+	for k, attrs := range tdf.SearchAttributes(in.AttrValues) {
+		search[k] = attrs
+	}
+	searchJSON, err := json.Marshal(search)
+	if err != nil {
+		return err
+	}
+	geoJSON, err := json.Marshal(map[string]interface{}{
+		"type":        "Point",
+		"coordinates": []float64{*t.Lon, *t.Lat},
+	})
+	if err != nil {
+		return err
+	}
+
+	if t.id != "" && !in.History {
+		_, err := in.Store.UpdateTdfObject(ctx, connect.NewRequest(&tdf_objectv1.UpdateTdfObjectRequest{
+			Id:       t.id,
+			Ts:       timestamppb.New(ts),
+			Geo:      wrapperspb.String(string(geoJSON)),
+			Search:   wrapperspb.String(string(searchJSON)),
+			Metadata: wrapperspb.String(string(metadata)),
+			TdfBlob:  wrapperspb.Bytes(blob.Bytes()),
+		}))
+		if err != nil {
+			// the tdf_object may have been purged, create a new one next time
+			t.id = ""
+			return err
+		}
+		in.Updated++
+		return nil
+	}
+
+	res, err := in.Store.CreateTdfObject(ctx, connect.NewRequest(&tdf_objectv1.CreateTdfObjectRequest{
+		SrcType:   in.SrcType,
+		Ts:        timestamppb.New(ts),
+		Geo:       string(geoJSON),
+		Search:    string(searchJSON),
+		Metadata:  string(metadata),
+		TdfBlob:   blob.Bytes(),
+		EntityKey: t.ICAO,
+	}))
+	if err != nil {
+		return err
+	}
+	t.id = res.Msg.Id
+	in.Created++
+	slog.DebugContext(ctx, "created aircraft", slog.String("icao", t.ICAO), slog.String("id", t.id))
+	return nil
+}
+
+// Serve reads messages from a source until the context is done or a file ends, see feed.Serve
+func (in *Ingester) Serve(ctx context.Context, source string) error {
+	return feed.Serve(ctx, source, in.HandleLine)
+}
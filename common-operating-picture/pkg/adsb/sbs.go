@@ -0,0 +1,175 @@
+package adsb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNotTransmission is returned when parsing an SBS message other than a MSG transmission, such as SEL, ID, AIR,
+// STA and CLK messages
+var ErrNotTransmission = errors.New("not an SBS transmission message")
+
+// sbsTimeLayout is the date and time of SBS messages, in the local time of the receiver
+const sbsTimeLayout = "2006/01/02 15:04:05.000"
+
+// Message is an SBS-1 BaseStation transmission message, as served on port 30003 by dump1090 and other receivers, e.g.
+//
+//	MSG,3,1,1,4CA2D6,1,2024/06/01,12:00:00.000,2024/06/01,12:00:00.000,,37000,,,51.47,-0.45,,,0,0,0,0
+//
+// Every transmission type carries some of the fields, which are nil or empty when not carried.
+type Message struct {
+	TransmissionType int
+	// 24-bit ICAO address of the aircraft as 6 hex digits
+	ICAO string
+	// Time the message was generated
+	Time time.Time
+
+	Callsign string
+	// Altitude in feet, ground speed in knots, track in degrees and vertical rate in feet per minute
+	Altitude     *int
+	GroundSpeed  *float64
+	Track        *float64
+	Lat          *float64
+	Lon          *float64
+	VerticalRate *int
+	Squawk       string
+	OnGround     *bool
+}
+
+// Parse parses an SBS message, the time is parsed in the location of the receiver
+func Parse(line string, loc *time.Location) (Message, error) {
+	var m Message
+	fields := strings.Split(strings.TrimSpace(line), ",")
+	if fields[0] != "MSG" {
+		return m, ErrNotTransmission
+	}
+	if len(fields) < 22 {
+		return m, fmt.Errorf("invalid SBS message: %d fields, want 22", len(fields))
+	}
+
+	var err error
+	if m.TransmissionType, err = strconv.Atoi(fields[1]); err != nil || m.TransmissionType < 1 || m.TransmissionType > 8 {
+		return m, fmt.Errorf("invalid SBS message: transmission type %q", fields[1])
+	}
+	m.ICAO = strings.ToUpper(fields[4])
+	if _, err := strconv.ParseUint(m.ICAO, 16, 24); err != nil || len(m.ICAO) != 6 {
+		return m, fmt.Errorf("invalid SBS message: hex ident %q", fields[4])
+	}
+	if fields[6] != "" && fields[7] != "" {
+		if m.Time, err = time.ParseInLocation(sbsTimeLayout, fields[6]+" "+fields[7], loc); err != nil {
+			return m, fmt.Errorf("invalid SBS message: time %q", fields[6]+" "+fields[7])
+		}
+	}
+
+	m.Callsign = strings.TrimSpace(fields[10])
+	m.Squawk = strings.TrimSpace(fields[17])
+	if m.Altitude, err = intField(fields[11]); err != nil {
+		return m, fmt.Errorf("invalid SBS message: altitude %q", fields[11])
+	}
+	if m.GroundSpeed, err = floatField(fields[12]); err != nil {
+		return m, fmt.Errorf("invalid SBS message: ground speed %q", fields[12])
+	}
+	if m.Track, err = floatField(fields[13]); err != nil {
+		return m, fmt.Errorf("invalid SBS message: track %q", fields[13])
+	}
+	if m.Lat, err = floatField(fields[14]); err != nil || (m.Lat != nil && (*m.Lat < -90 || *m.Lat > 90)) {
+		return m, fmt.Errorf("invalid SBS message: latitude %q", fields[14])
+	}
+	if m.Lon, err = floatField(fields[15]); err != nil || (m.Lon != nil && (*m.Lon < -180 || *m.Lon > 180)) {
+		return m, fmt.Errorf("invalid SBS message: longitude %q", fields[15])
+	}
+	if (m.Lat == nil) != (m.Lon == nil) {
+		return m, errors.New("invalid SBS message: latitude without longitude")
+	}
+	if m.VerticalRate, err = intField(fields[16]); err != nil {
+		return m, fmt.Errorf("invalid SBS message: vertical rate %q", fields[16])
+	}
+	// flags are 0 or -1, some receivers send 1 for true
+	if f := strings.TrimSpace(fields[21]); f != "" {
+		onGround := f != "0"
+		m.OnGround = &onGround
+	}
+	return m, nil
+}
+
+func intField(s string) (*int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func floatField(s string) (*float64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// Aircraft is the state of an aircraft built from its messages. It is the TDF payload of the tdf_object, and only
+// the fields naming it are also in plaintext, in search and metadata.
+type Aircraft struct {
+	ICAO string `json:"icao"`
+	// The callsign is also the vehicle name of the vehicles source type
+	Callsign     string   `json:"callsign,omitempty"`
+	VehicleName  string   `json:"vehicleName"`
+	Altitude     *int     `json:"altitude,omitempty"`
+	Speed        *float64 `json:"speed,omitempty"`
+	Heading      *float64 `json:"heading,omitempty"`
+	VerticalRate *int     `json:"verticalRate,omitempty"`
+	Squawk       string   `json:"squawk,omitempty"`
+	OnGround     *bool    `json:"onGround,omitempty"`
+	Lat          *float64 `json:"lat,omitempty"`
+	Lon          *float64 `json:"lon,omitempty"`
+}
+
+// Update sets the fields of the aircraft carried by a message
+func (a *Aircraft) Update(m Message) {
+	a.ICAO = m.ICAO
+	if a.VehicleName == "" {
+		a.VehicleName = m.ICAO
+	}
+	if m.Callsign != "" {
+		a.Callsign = m.Callsign
+		a.VehicleName = m.Callsign
+	}
+	if m.Altitude != nil {
+		a.Altitude = m.Altitude
+	}
+	if m.GroundSpeed != nil {
+		a.Speed = m.GroundSpeed
+	}
+	if m.Track != nil {
+		a.Heading = m.Track
+	}
+	if m.Lat != nil {
+		a.Lat, a.Lon = m.Lat, m.Lon
+	}
+	if m.VerticalRate != nil {
+		a.VerticalRate = m.VerticalRate
+	}
+	if m.Squawk != "" {
+		a.Squawk = m.Squawk
+	}
+	if m.OnGround != nil {
+		a.OnGround = m.OnGround
+	}
+}
+
+// HasPosition reports whether a position of the aircraft has been received
+func (a *Aircraft) HasPosition() bool {
+	return a.Lat != nil && a.Lon != nil
+}
@@ -0,0 +1,147 @@
+package adsb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+)
+
+var Test_ParseTests = []struct {
+	test string
+
+	input   string
+	wantErr error
+	invalid bool
+}{
+	{
+		test:  "identification",
+		input: "MSG,1,1,1,4ca2d6,1,2024/06/01,12:00:00.000,2024/06/01,12:00:00.000,RYR1234 ,,,,,,,,,,,0",
+	},
+	{
+		test:  "airborne position",
+		input: "MSG,3,1,1,4CA2D6,1,2024/06/01,12:00:01.000,2024/06/01,12:00:01.000,,37000,,,51.47,-0.45,,,0,0,0,0",
+	},
+	{
+		test:    "status message",
+		input:   "STA,,1,1,4CA2D6,1,2024/06/01,12:00:00.000,2024/06/01,12:00:00.000,RM",
+		wantErr: ErrNotTransmission,
+	},
+	{
+		test:    "invalid hex ident",
+		input:   "MSG,3,1,1,XYZ,1,2024/06/01,12:00:01.000,2024/06/01,12:00:01.000,,37000,,,51.47,-0.45,,,0,0,0,0",
+		invalid: true,
+	},
+	{
+		test:    "latitude out of range",
+		input:   "MSG,3,1,1,4CA2D6,1,2024/06/01,12:00:01.000,2024/06/01,12:00:01.000,,37000,,,95,-0.45,,,0,0,0,0",
+		invalid: true,
+	},
+	{
+		test:    "too few fields",
+		input:   "MSG,3,1,1,4CA2D6",
+		invalid: true,
+	},
+}
+
+func Test_Parse(t *testing.T) {
+	for _, tt := range Test_ParseTests {
+		t.Run(tt.test, func(t *testing.T) {
+			_, err := Parse(tt.input, time.UTC)
+			switch {
+			case tt.wantErr != nil && err != tt.wantErr:
+				t.Fatalf("Parse() error = %v; want %v", err, tt.wantErr)
+			case tt.wantErr == nil && (err != nil) != tt.invalid:
+				t.Fatalf("Parse() error = %v; invalid %v", err, tt.invalid)
+			}
+		})
+	}
+}
+
+type testEncrypter struct{}
+
+func (testEncrypter) EncryptBytes(b []byte, _ []string, _ int) (*bytes.Buffer, error) {
+	return bytes.NewBuffer(b), nil
+}
+
+type testStore struct {
+	creates []*tdf_objectv1.CreateTdfObjectRequest
+	updates []*tdf_objectv1.UpdateTdfObjectRequest
+}
+
+func (s *testStore) CreateTdfObject(_ context.Context, req *connect.Request[tdf_objectv1.CreateTdfObjectRequest]) (*connect.Response[tdf_objectv1.CreateTdfObjectResponse], error) {
+	s.creates = append(s.creates, req.Msg)
+	return connect.NewResponse(&tdf_objectv1.CreateTdfObjectResponse{Id: "00000000-0000-0000-0000-000000000001"}), nil
+}
+
+func (s *testStore) UpdateTdfObject(_ context.Context, req *connect.Request[tdf_objectv1.UpdateTdfObjectRequest]) (*connect.Response[tdf_objectv1.UpdateTdfObjectResponse], error) {
+	s.updates = append(s.updates, req.Msg)
+	return connect.NewResponse(&tdf_objectv1.UpdateTdfObjectResponse{Id: req.Msg.Id}), nil
+}
+
+func Test_Ingester(t *testing.T) {
+	messages := []string{
+		// no position yet
+		"MSG,1,1,1,4CA2D6,1,2024/06/01,12:00:00.000,2024/06/01,12:00:00.000,RYR1234,,,,,,,,,,,0",
+		// created
+		"MSG,3,1,1,4CA2D6,1,2024/06/01,12:00:01.000,2024/06/01,12:00:01.000,,37000,,,51.47,-0.45,,,0,0,0,0",
+		"MSG,4,1,1,4CA2D6,1,2024/06/01,12:00:02.000,2024/06/01,12:00:02.000,,,420,270,,,0,,0,0,0,0",
+		// throttled
+		"MSG,3,1,1,4CA2D6,1,2024/06/01,12:00:03.000,2024/06/01,12:00:03.000,,37000,,,51.47,-0.46,,,0,0,0,0",
+		// updated
+		"MSG,3,1,1,4CA2D6,1,2024/06/01,12:00:07.000,2024/06/01,12:00:07.000,,37025,,,51.47,-0.48,,,0,0,0,0",
+	}
+
+	store := &testStore{}
+	in := &Ingester{
+		Store:     store,
+		Encrypter: testEncrypter{},
+		SrcType:   "vehicles",
+		Interval:  5 * time.Second,
+		Location:  time.UTC,
+	}
+	for _, m := range messages {
+		in.HandleLine(context.Background(), m)
+	}
+
+	if len(store.creates) != 1 || len(store.updates) != 1 || in.Created != 1 || in.Updated != 1 {
+		t.Fatalf("stored %d creates and %d updates; want 1 and 1", len(store.creates), len(store.updates))
+	}
+	create := store.creates[0]
+	if create.EntityKey != "4CA2D6" || create.SrcType != "vehicles" || !create.Ts.AsTime().Equal(time.Date(2024, 6, 1, 12, 0, 1, 0, time.UTC)) {
+		t.Errorf("CreateTdfObject() request = %v", create)
+	}
+
+	update := store.updates[0]
+	var a Aircraft
+	if err := json.Unmarshal(update.TdfBlob.GetValue(), &a); err != nil {
+		t.Fatal(err)
+	}
+	if a.Callsign != "RYR1234" || a.VehicleName != "RYR1234" || *a.Altitude != 37025 || *a.Speed != 420 || *a.Lon != -0.48 {
+		t.Errorf("UpdateTdfObject() aircraft = %+v", a)
+	}
+	if update.Id != "00000000-0000-0000-0000-000000000001" {
+		t.Errorf("UpdateTdfObject() id = %s", update.Id)
+	}
+	var metadata map[string]interface{}
+	if err := json.Unmarshal([]byte(update.Metadata.GetValue()), &metadata); err != nil {
+		t.Fatal(err)
+	}
+	if metadata["callsign"] != "RYR1234" || metadata["altitude"] != nil || metadata["lat"] != nil {
+		t.Errorf("UpdateTdfObject() metadata = %s", update.Metadata.GetValue())
+	}
+
+	// with history every store creates a tdf_object
+	store = &testStore{}
+	in = &Ingester{Store: store, Encrypter: testEncrypter{}, SrcType: "vehicles", Interval: 5 * time.Second, History: true, Location: time.UTC}
+	for _, m := range messages {
+		in.HandleLine(context.Background(), m)
+	}
+	if len(store.creates) != 2 || len(store.updates) != 0 {
+		t.Errorf("stored %d creates and %d updates with history; want 2 and 0", len(store.creates), len(store.updates))
+	}
+}
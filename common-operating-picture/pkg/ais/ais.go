@@ -0,0 +1,371 @@
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupported is returned when decoding a message of a type other than 1, 2, 3, 5, 18 and 24
+var ErrUnsupported = errors.New("unsupported AIS message type")
+
+// sixbitText is the AIS 6-bit character set
+const sixbitText = "@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_ !\"#$%&'()*+,-./0123456789:;<=>?"
+
+// Sentence is an NMEA 0183 AIVDM (or AIVDO, own vessel) sentence, e.g.
+//
+//	!AIVDM,1,1,,B,15M67FC000G?ufbE`FepT@3n00Sa,0*5C
+//
+// A message longer than one sentence is split in Count sentences sharing a SeqID.
+type Sentence struct {
+	Count   int
+	Num     int
+	SeqID   string
+	Channel string
+	Payload string
+	Fill    int
+	// Time of the "c" field of a tag block, e.g. \c:1717243200*55\!AIVDM,..., zero without a tag block
+	Time time.Time
+}
+
+// ParseSentence parses an AIVDM or AIVDO sentence and checks its checksum. Anything before the sentence other than
+// a tag block, such as the timestamp of a log line, is ignored.
+func ParseSentence(line string) (Sentence, error) {
+	var s Sentence
+	line = strings.TrimSpace(line)
+
+	i := strings.Index(line, "!AIVD")
+	if i < 0 {
+		return s, errors.New("invalid AIS sentence: not an AIVDM sentence")
+	}
+	if tag, ok := tagBlock(line[:i]); ok {
+		s.Time = tagTime(tag)
+	}
+	line = line[i:]
+
+	body, checksum, ok := strings.Cut(line[1:], "*")
+	if !ok || len(checksum) < 2 {
+		return s, errors.New("invalid AIS sentence: missing checksum")
+	}
+	want, err := strconv.ParseUint(checksum[:2], 16, 8)
+	if err != nil {
+		return s, fmt.Errorf("invalid AIS sentence: checksum %q", checksum)
+	}
+	if sum := nmeaChecksum(body); sum != byte(want) {
+		return s, fmt.Errorf("invalid AIS sentence: checksum %02X, want %02X", sum, want)
+	}
+
+	fields := strings.Split(body, ",")
+	if len(fields) != 7 || (fields[0] != "AIVDM" && fields[0] != "AIVDO") {
+		return s, errors.New("invalid AIS sentence: expected 7 fields")
+	}
+	if s.Count, err = strconv.Atoi(fields[1]); err != nil || s.Count < 1 {
+		return s, fmt.Errorf("invalid AIS sentence: fragment count %q", fields[1])
+	}
+	if s.Num, err = strconv.Atoi(fields[2]); err != nil || s.Num < 1 || s.Num > s.Count {
+		return s, fmt.Errorf("invalid AIS sentence: fragment number %q", fields[2])
+	}
+	if s.Fill, err = strconv.Atoi(fields[6]); err != nil || s.Fill < 0 || s.Fill > 5 {
+		return s, fmt.Errorf("invalid AIS sentence: fill bits %q", fields[6])
+	}
+	s.SeqID = fields[3]
+	s.Channel = fields[4]
+	s.Payload = fields[5]
+	return s, nil
+}
+
+func nmeaChecksum(body string) byte {
+	var sum byte
+	for i := 0; i < len(body); i++ {
+		sum ^= body[i]
+	}
+	return sum
+}
+
+// tagBlock returns the NMEA 4.0 tag block of the prefix of a sentence, e.g. \s:r003669945,c:1717243200*1D\
+func tagBlock(prefix string) (string, bool) {
+	prefix = strings.TrimSpace(prefix)
+	if len(prefix) < 2 || prefix[0] != '\\' || prefix[len(prefix)-1] != '\\' {
+		return "", false
+	}
+	tag, _, _ := strings.Cut(prefix[1:len(prefix)-1], "*")
+	return tag, true
+}
+
+// tagTime returns the unix time, in seconds or milliseconds, of the "c" field of a tag block
+func tagTime(tag string) time.Time {
+	for _, field := range strings.Split(tag, ",") {
+		v, ok := strings.CutPrefix(field, "c:")
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n <= 0 {
+			return time.Time{}
+		}
+		if n > 1e12 {
+			return time.UnixMilli(n).UTC()
+		}
+		return time.Unix(n, 0).UTC()
+	}
+	return time.Time{}
+}
+
+// Assembler joins the payloads of multi-sentence messages. Sentences of a message are expected in order, and a
+// message is dropped when a sentence is missing.
+type Assembler struct {
+	parts map[string]*fragments
+}
+
+type fragments struct {
+	next    int
+	payload strings.Builder
+}
+
+// Add adds a sentence and returns the payload and fill bits of the message once its last sentence is added
+func (a *Assembler) Add(s Sentence) (string, int, bool) {
+	if s.Count == 1 {
+		return s.Payload, s.Fill, true
+	}
+	if a.parts == nil {
+		a.parts = make(map[string]*fragments)
+	}
+
+	key := s.SeqID + "," + s.Channel
+	f, ok := a.parts[key]
+	if s.Num == 1 {
+		f = &fragments{}
+		a.parts[key] = f
+	} else if !ok || f.next != s.Num {
+		delete(a.parts, key)
+		return "", 0, false
+	}
+	f.payload.WriteString(s.Payload)
+	f.next = s.Num + 1
+
+	if s.Num < s.Count {
+		return "", 0, false
+	}
+	delete(a.parts, key)
+	return f.payload.String(), s.Fill, true
+}
+
+// Message is a decoded AIS message of a vessel, either a position report or static data
+type Message struct {
+	Type int
+	// 9 digit Maritime Mobile Service Identity of the vessel
+	MMSI string
+
+	// Position reports, message types 1, 2, 3 (class A) and 18 (class B)
+	Position *Position
+
+	// Static and voyage data, message types 5 (class A) and 24 (class B, in two parts each with some of the fields)
+	Static *Static
+}
+
+type Position struct {
+	Lat float64
+	Lon float64
+	// Speed over ground in knots, course over ground and true heading in degrees, nil when not available
+	Speed   *float64
+	Course  *float64
+	Heading *int
+	// Navigational status of class A reports, nil for class B
+	NavStatus *int
+}
+
+// Static fields are empty or zero when not reported
+type Static struct {
+	Name        string
+	Callsign    string
+	ShipType    int
+	IMO         int
+	Destination string
+}
+
+// Merge sets the fields of s reported in other
+func (s *Static) Merge(other Static) {
+	if other.Name != "" {
+		s.Name = other.Name
+	}
+	if other.Callsign != "" {
+		s.Callsign = other.Callsign
+	}
+	if other.ShipType != 0 {
+		s.ShipType = other.ShipType
+	}
+	if other.IMO != 0 {
+		s.IMO = other.IMO
+	}
+	if other.Destination != "" {
+		s.Destination = other.Destination
+	}
+}
+
+// Decode decodes the armored payload of a message. Position reports without a valid position are an error.
+func Decode(payload string, fill int) (Message, error) {
+	var m Message
+	b, err := unarmor(payload, fill)
+	if err != nil {
+		return m, err
+	}
+	if b.len() < 38 {
+		return m, errors.New("invalid AIS message: too short")
+	}
+	m.Type = int(b.uint(0, 6))
+	m.MMSI = fmt.Sprintf("%09d", b.uint(8, 30))
+
+	switch m.Type {
+	case 1, 2, 3:
+		if b.len() < 137 {
+			return m, fmt.Errorf("invalid AIS message type %d: too short", m.Type)
+		}
+		navStatus := int(b.uint(38, 4))
+		m.Position = &Position{
+			Speed:     speed(b.uint(50, 10)),
+			Course:    course(b.uint(116, 12)),
+			Heading:   heading(b.uint(128, 9)),
+			NavStatus: &navStatus,
+		}
+		m.Position.Lon, m.Position.Lat = position(b.int(61, 28), b.int(89, 27))
+	case 18:
+		if b.len() < 133 {
+			return m, fmt.Errorf("invalid AIS message type %d: too short", m.Type)
+		}
+		m.Position = &Position{
+			Speed:   speed(b.uint(46, 10)),
+			Course:  course(b.uint(112, 12)),
+			Heading: heading(b.uint(124, 9)),
+		}
+		m.Position.Lon, m.Position.Lat = position(b.int(57, 28), b.int(85, 27))
+	case 5:
+		if b.len() < 420 {
+			return m, fmt.Errorf("invalid AIS message type %d: too short", m.Type)
+		}
+		m.Static = &Static{
+			IMO:         int(b.uint(40, 30)),
+			Callsign:    b.text(70, 7),
+			Name:        b.text(112, 20),
+			ShipType:    int(b.uint(232, 8)),
+			Destination: b.text(302, 20),
+		}
+	case 24:
+		if b.len() < 40 {
+			return m, fmt.Errorf("invalid AIS message type %d: too short", m.Type)
+		}
+		switch b.uint(38, 2) {
+		case 0:
+			if b.len() < 160 {
+				return m, fmt.Errorf("invalid AIS message type %d part A: too short", m.Type)
+			}
+			m.Static = &Static{Name: b.text(40, 20)}
+		case 1:
+			if b.len() < 132 {
+				return m, fmt.Errorf("invalid AIS message type %d part B: too short", m.Type)
+			}
+			m.Static = &Static{ShipType: int(b.uint(40, 8)), Callsign: b.text(90, 7)}
+		default:
+			return m, fmt.Errorf("invalid AIS message type %d: part number %d", m.Type, b.uint(38, 2))
+		}
+	default:
+		return m, fmt.Errorf("%w %d", ErrUnsupported, m.Type)
+	}
+
+	if p := m.Position; p != nil && (p.Lat < -90 || p.Lat > 90 || p.Lon < -180 || p.Lon > 180) {
+		return m, fmt.Errorf("invalid AIS message type %d: position not available", m.Type)
+	}
+	return m, nil
+}
+
+// position returns the longitude and latitude of 1/10000 minutes, 181 and 91 are not available
+func position(lon, lat int64) (float64, float64) {
+	return float64(lon) / 600000, float64(lat) / 600000
+}
+
+// speed returns the knots of 1/10 knots, 1023 is not available
+func speed(v uint64) *float64 {
+	if v == 1023 {
+		return nil
+	}
+	knots := float64(v) / 10
+	return &knots
+}
+
+// course returns the degrees of 1/10 degrees, 3600 is not available
+func course(v uint64) *float64 {
+	if v >= 3600 {
+		return nil
+	}
+	degrees := float64(v) / 10
+	return &degrees
+}
+
+// heading returns the degrees, 511 is not available
+func heading(v uint64) *int {
+	if v >= 360 {
+		return nil
+	}
+	degrees := int(v)
+	return &degrees
+}
+
+// bitstring is a payload of one bit per byte
+type bitstring []byte
+
+func unarmor(payload string, fill int) (bitstring, error) {
+	b := make(bitstring, 0, len(payload)*6)
+	for i := 0; i < len(payload); i++ {
+		c := payload[i]
+		if c < '0' || c > 'w' || (c > 'W' && c < '`') {
+			return nil, fmt.Errorf("invalid AIS payload: character %q", c)
+		}
+		v := c - '0'
+		if v > 40 {
+			v -= 8
+		}
+		for bit := 5; bit >= 0; bit-- {
+			b = append(b, (v>>bit)&1)
+		}
+	}
+	if fill > 0 && fill <= len(b) {
+		b = b[:len(b)-fill]
+	}
+	return b, nil
+}
+
+func (b bitstring) len() int {
+	return len(b)
+}
+
+// uint returns the unsigned integer of n bits from start, bits past the end are 0
+func (b bitstring) uint(start, n int) uint64 {
+	var v uint64
+	for i := start; i < start+n; i++ {
+		v <<= 1
+		if i < len(b) {
+			v |= uint64(b[i])
+		}
+	}
+	return v
+}
+
+// int returns the two's complement integer of n bits from start
+func (b bitstring) int(start, n int) int64 {
+	v := int64(b.uint(start, n))
+	if v&(1<<(n-1)) != 0 {
+		v -= 1 << n
+	}
+	return v
+}
+
+// text returns the 6-bit text of n characters from start, without the trailing "@" padding and spaces
+func (b bitstring) text(start, n int) string {
+	var s strings.Builder
+	for i := 0; i < n && start+i*6+6 <= len(b); i++ {
+		s.WriteByte(sixbitText[b.uint(start+i*6, 6)])
+	}
+	text, _, _ := strings.Cut(s.String(), "@")
+	return strings.TrimSpace(text)
+}
@@ -0,0 +1,161 @@
+package ais
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var Test_ParseSentenceTests = []struct {
+	test string
+
+	input    string
+	wantTime time.Time
+	wantErr  bool
+}{
+	{
+		test:  "valid sentence",
+		input: "!AIVDM,1,1,,A,15RTgt0PAso;90TKcjM8h6g208CQ,0*4A",
+	},
+	{
+		test:     "tag block",
+		input:    `\s:r003669945,c:1717243200*1D\!AIVDM,1,1,,A,15RTgt0PAso;90TKcjM8h6g208CQ,0*4A`,
+		wantTime: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+	},
+	{
+		test:  "log line prefix",
+		input: "2024-06-01 12:00:00 !AIVDM,1,1,,A,15RTgt0PAso;90TKcjM8h6g208CQ,0*4A",
+	},
+	{
+		test:    "bad checksum",
+		input:   "!AIVDM,1,1,,A,15RTgt0PAso;90TKcjM8h6g208CQ,0*4B",
+		wantErr: true,
+	},
+	{
+		test:    "not AIVDM",
+		input:   "$GPGGA,123519,4807.038,N,01131.000,E,1,08,0.9,545.4,M,46.9,M,,*47",
+		wantErr: true,
+	},
+}
+
+func Test_ParseSentence(t *testing.T) {
+	for _, tt := range Test_ParseSentenceTests {
+		t.Run(tt.test, func(t *testing.T) {
+			s, err := ParseSentence(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSentence() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if !s.Time.Equal(tt.wantTime) {
+				t.Errorf("ParseSentence() time = %v; want %v", s.Time, tt.wantTime)
+			}
+		})
+	}
+}
+
+// decode assembles and decodes the sentences of one message
+func decode(t *testing.T, sentences ...string) Message {
+	t.Helper()
+	var a Assembler
+	for i, line := range sentences {
+		s, err := ParseSentence(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		payload, fill, ok := a.Add(s)
+		if ok != (i == len(sentences)-1) {
+			t.Fatalf("Add() of sentence %d complete = %v", i+1, ok)
+		}
+		if ok {
+			m, err := Decode(payload, fill)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return m
+		}
+	}
+	return Message{}
+}
+
+func Test_DecodePosition(t *testing.T) {
+	m := decode(t, "!AIVDM,1,1,,A,15RTgt0PAso;90TKcjM8h6g208CQ,0*4A")
+	if m.Type != 1 || m.MMSI != "371798000" || m.Position == nil {
+		t.Fatalf("Decode() = %+v", m)
+	}
+	p := m.Position
+	if p.Lat < 48.3816 || p.Lat > 48.3817 || p.Lon < -123.3954 || p.Lon > -123.3953 {
+		t.Errorf("Decode() position = %f,%f", p.Lat, p.Lon)
+	}
+	if p.Speed == nil || *p.Speed != 12.3 || p.Course == nil || *p.Course != 224 || p.Heading == nil || *p.Heading != 215 {
+		t.Errorf("Decode() speed, course and heading = %v %v %v", p.Speed, p.Course, p.Heading)
+	}
+
+	m = decode(t, "!AIVDM,1,1,,B,B5NJ;PP005l4ot5Isbl03wsUkP06,0*75")
+	if m.Type != 18 || m.MMSI != "367430530" || m.Position == nil || m.Position.NavStatus != nil || m.Position.Heading != nil {
+		t.Errorf("Decode() = %+v", m)
+	}
+}
+
+func Test_DecodeStatic(t *testing.T) {
+	m := decode(t,
+		"!AIVDM,2,1,1,A,55?MbV02;H;s<HtKR20EHE:0@T4@Dn2222222216L961O5Gf0NSQEp6ClRp8,0*1C",
+		"!AIVDM,2,2,1,A,88888888880,2*25",
+	)
+	want := Static{Name: "EVER DIADEM", Callsign: "3FOF8", ShipType: 70, IMO: 9134270, Destination: "NEW YORK"}
+	if m.Type != 5 || m.MMSI != "351759000" || m.Static == nil || *m.Static != want {
+		t.Errorf("Decode() = %+v, static %+v", m, m.Static)
+	}
+
+	var s Static
+	s.Merge(*decode(t, "!AIVDM,1,1,,A,H42O55i18tMET00000000000000,2*6D").Static)
+	s.Merge(*decode(t, "!AIVDM,1,1,,A,H42O55lti4hhhilD3nink000?050,0*40").Static)
+	if s.Name != "PROGUY" || s.Callsign != "TC6163" || s.ShipType != 60 {
+		t.Errorf("Merge() of type 24 parts = %+v", s)
+	}
+}
+
+func Test_Assembler(t *testing.T) {
+	var a Assembler
+	second, err := ParseSentence("!AIVDM,2,2,1,A,88888888880,2*25")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := a.Add(second); ok {
+		t.Error("Add() of a second sentence without the first is complete")
+	}
+}
+
+func Test_VesselParams(t *testing.T) {
+	m := decode(t, "!AIVDM,1,1,,A,15RTgt0PAso;90TKcjM8h6g208CQ,0*4A")
+	v := NewVessel(m.MMSI, *m.Position, Static{Name: "EVER DIADEM", ShipType: 70})
+	if v.ShipCategory != "cargo" || v.NavStatus != "under way using engine" {
+		t.Errorf("NewVessel() = %+v", v)
+	}
+
+	ts := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	p, err := v.Params("ais", ts, []string{"https://demo.com/attr/classification/value/unclassified"}, []byte("tdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.SrcType != "ais" || p.EntityKey.String != "371798000" || !p.Ts.Time.Equal(ts) || string(p.TdfBlob) != "tdf" {
+		t.Errorf("Params() = %+v", p)
+	}
+
+	var search map[string]interface{}
+	if err := json.Unmarshal(p.Search, &search); err != nil {
+		t.Fatal(err)
+	}
+	if search["mmsi"] != "371798000" || search["name"] != "EVER DIADEM" {
+		t.Errorf("Params() search = %s", p.Search)
+	}
+	if c, _ := search["attrClassification"].([]interface{}); len(c) != 1 {
+		t.Errorf("Params() search attrClassification = %v", search["attrClassification"])
+	}
+
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(p.Metadata, &metadata); err != nil {
+		t.Fatal(err)
+	}
+	if metadata["shipCategory"] != "cargo" || metadata["speed"] != nil || metadata["lat"] != nil {
+		t.Errorf("Params() metadata = %s", p.Metadata)
+	}
+}
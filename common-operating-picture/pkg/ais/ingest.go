@@ -0,0 +1,244 @@
+package ais
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	geos "github.com/twpayne/go-geos"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/feed"
+	"github.com/virtru-corp/dsp-cop/pkg/geo"
+	"github.com/virtru-corp/dsp-cop/pkg/tdf"
+)
+
+// navStatuses are the navigational statuses of class A position reports
+var navStatuses = map[int]string{
+	0:  "under way using engine",
+	1:  "at anchor",
+	2:  "not under command",
+	3:  "restricted manoeuvrability",
+	4:  "constrained by draught",
+	5:  "moored",
+	6:  "aground",
+	7:  "engaged in fishing",
+	8:  "under way sailing",
+	14: "AIS-SART active",
+}
+
+// Vessel is a position report of a vessel with the latest static data received for its MMSI. It is the TDF payload
+// of the tdf_object, and its plaintext fields are indexed in search and metadata.
+type Vessel struct {
+	MMSI         string   `json:"mmsi"`
+	Name         string   `json:"name,omitempty"`
+	Callsign     string   `json:"callsign,omitempty"`
+	IMO          int      `json:"imo,omitempty"`
+	ShipType     int      `json:"shipType,omitempty"`
+	ShipCategory string   `json:"shipCategory"`
+	Destination  string   `json:"destination,omitempty"`
+	Speed        *float64 `json:"speed,omitempty"`
+	Course       *float64 `json:"course,omitempty"`
+	Heading      *int     `json:"heading,omitempty"`
+	NavStatus    string   `json:"navStatus,omitempty"`
+	Lat          float64  `json:"lat"`
+	Lon          float64  `json:"lon"`
+}
+
+// NewVessel returns the vessel of a position report and the static data of its MMSI
+func NewVessel(mmsi string, p Position, s Static) Vessel {
+	v := Vessel{
+		MMSI:         mmsi,
+		Name:         s.Name,
+		Callsign:     s.Callsign,
+		IMO:          s.IMO,
+		ShipType:     s.ShipType,
+		ShipCategory: ShipCategory(s.ShipType),
+		Destination:  s.Destination,
+		Speed:        p.Speed,
+		Course:       p.Course,
+		Heading:      p.Heading,
+		Lat:          p.Lat,
+		Lon:          p.Lon,
+	}
+	if p.NavStatus != nil {
+		v.NavStatus = navStatuses[*p.NavStatus]
+	}
+	return v
+}
+
+// ShipCategory returns the category of an AIS ship type, used to color vessels on the map
+func ShipCategory(shipType int) string {
+	switch {
+	case shipType == 30:
+		return "fishing"
+	case shipType == 31 || shipType == 32 || shipType == 52:
+		return "tug"
+	case shipType == 35:
+		return "military"
+	case shipType == 36 || shipType == 37:
+		return "pleasure"
+	case shipType >= 40 && shipType < 50:
+		return "high speed"
+	case shipType == 51 || shipType == 55:
+		return "law enforcement"
+	case shipType >= 60 && shipType < 70:
+		return "passenger"
+	case shipType >= 70 && shipType < 80:
+		return "cargo"
+	case shipType >= 80 && shipType < 90:
+		return "tanker"
+	case shipType == 0:
+		return "unknown"
+	}
+	return "other"
+}
+
+// vesselDisplay is the plaintext metadata of a vessel: the fields naming it and the ship category the map colors it
+// by. Its course, speed, destination and other details are only in the TDF.
+type vesselDisplay struct {
+	MMSI         string `json:"mmsi"`
+	Name         string `json:"name,omitempty"`
+	Callsign     string `json:"callsign,omitempty"`
+	ShipCategory string `json:"shipCategory"`
+}
+
+// Params returns the CreateTdfObjects parameters of the vessel, keyed by MMSI. MMSI, name and callsign are indexed
+// in search along with the attribute values of the TDF, and metadata holds the vesselDisplay fields.
+func (v Vessel) Params(srcType string, ts time.Time, attrValues []string, tdfBlob []byte) (db.CreateTdfObjectsParams, error) {
+	search := map[string]interface{}{
+		"mmsi": v.MMSI,
+	}
+	if v.Name != "" {
+		search["name"] = v.Name
+	}
+	if v.Callsign != "" {
+		search["callsign"] = v.Callsign
+	}
+	for k, attrs := range tdf.SearchAttributes(attrValues) {
+		search[k] = attrs
+	}
+
+	searchJSON, err := json.Marshal(search)
+	if err != nil {
+		return db.CreateTdfObjectsParams{}, err
+	}
+	metadataJSON, err := json.Marshal(vesselDisplay{
+		MMSI:         v.MMSI,
+		Name:         v.Name,
+		Callsign:     v.Callsign,
+		ShipCategory: v.ShipCategory,
+	})
+	if err != nil {
+		return db.CreateTdfObjectsParams{}, err
+	}
+
+	return db.CreateTdfObjectsParams{
+		Ts:        pgtype.Timestamp{Time: ts.UTC(), Valid: true},
+		SrcType:   srcType,
+		Geo:       geos.NewPointFromXY(v.Lon, v.Lat).SetSRID(geo.DEFAULT_SRID),
+		Search:    searchJSON,
+		Metadata:  metadataJSON,
+		TdfBlob:   tdfBlob,
+		EntityKey: pgtype.Text{String: v.MMSI, Valid: true},
+	}, nil
+}
+
+// Ingester decodes AIVDM sentences and stores every position report as a tdf_object of SrcType, with the vessel
+// wrapped in a TDF of the attribute values. Static data is kept per MMSI and added to the later position reports.
+// An Ingester is not safe for concurrent use.
+type Ingester struct {
+	Queries    *db.Queries
+	Encrypter  tdf.Encrypter
+	SrcType    string
+	AttrValues []string
+	TDFType    int
+
+	// Stored is the number of position reports stored
+	Stored int
+
+	assembler Assembler
+	static    map[string]Static
+}
+
+// HandleLine decodes a sentence, storing the vessel once a position report is complete. Invalid sentences and
+// unsupported message types are logged and dropped.
+func (in *Ingester) HandleLine(ctx context.Context, line string) {
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	s, err := ParseSentence(line)
+	if err != nil {
+		slog.WarnContext(ctx, "dropping AIS sentence", slog.String("sentence", line), slog.String("error", err.Error()))
+		return
+	}
+	payload, fill, ok := in.assembler.Add(s)
+	if !ok {
+		return
+	}
+	m, err := Decode(payload, fill)
+	if errors.Is(err, ErrUnsupported) {
+		slog.DebugContext(ctx, "dropping AIS message", slog.Int("type", m.Type))
+		return
+	} else if err != nil {
+		slog.WarnContext(ctx, "dropping AIS message", slog.String("payload", payload), slog.String("error", err.Error()))
+		return
+	}
+
+	if in.static == nil {
+		in.static = make(map[string]Static)
+	}
+	if m.Static != nil {
+		static := in.static[m.MMSI]
+		static.Merge(*m.Static)
+		in.static[m.MMSI] = static
+		return
+	}
+
+	ts := s.Time
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	v := NewVessel(m.MMSI, *m.Position, in.static[m.MMSI])
+	if err := in.store(ctx, v, ts); err != nil {
+		slog.ErrorContext(ctx, "failed to store AIS position report", slog.String("mmsi", m.MMSI), slog.String("error", err.Error()))
+		return
+	}
+	in.Stored++
+}
+
+func (in *Ingester) store(ctx context.Context, v Vessel, ts time.Time) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	blob, err := in.Encrypter.EncryptBytes(payload, in.AttrValues, in.TDFType)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt vessel: %w", err)
+	}
+
+	params, err := v.Params(in.SrcType, ts, in.AttrValues, blob.Bytes())
+	if err != nil {
+		return err
+	}
+
+	var insertErr error
+	in.Queries.CreateTdfObjects(ctx, []db.CreateTdfObjectsParams{params}).QueryRow(func(_ int, id uuid.UUID, err error) {
+		if err != nil {
+			insertErr = err
+			return
+		}
+		slog.DebugContext(ctx, "stored AIS position report", slog.String("mmsi", v.MMSI), slog.String("id", id.String()))
+	})
+	return insertErr
+}
+
+// Serve reads sentences from a source until the context is done or a file ends, see feed.Serve
+func (in *Ingester) Serve(ctx context.Context, source string) error {
+	return feed.Serve(ctx, source, in.HandleLine)
+}
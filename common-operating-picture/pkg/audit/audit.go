@@ -0,0 +1,248 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+)
+
+// Actions of the records
+const (
+	ActionQuery    = "query"
+	ActionView     = "view"
+	ActionCreate   = "create"
+	ActionUpdate   = "update"
+	ActionRevert   = "revert"
+	ActionDownload = "download"
+)
+
+// Decisions of the records
+const (
+	// DecisionAllowed records objects returned to or changed by the caller
+	DecisionAllowed = "allowed"
+	// DecisionFiltered records objects the entitlements of the caller withheld
+	DecisionFiltered = "filtered"
+)
+
+// MaxObjectIDs is the largest number of object ids of a record, the ids of larger accesses are split over records
+const MaxObjectIDs = 500
+
+// ErrChain is returned by Verifier.Check for a record which does not follow the previous one
+var ErrChain = errors.New("audit chain broken")
+
+// Record is an access of a subject to objects. Seq, Time, PrevHash and Hash are set by the Logger writing it.
+type Record struct {
+	// position of the record in the chain, counting from 1
+	Seq  int64     `json:"seq"`
+	Time time.Time `json:"time"`
+
+	// user of the access token of the caller, see the changed_by of tdf_object_revisions
+	Subject string `json:"subject"`
+	Action  string `json:"action"`
+	// RPC procedure or HTTP path of the access
+	Procedure string `json:"procedure,omitempty"`
+
+	// ids of the records, notes or attachments accessed
+	ObjectIDs      []string `json:"object_ids"`
+	SrcType        string   `json:"src_type,omitempty"`
+	Classification []string `json:"classification,omitempty"`
+	Decision       string   `json:"decision"`
+
+	// hash of the previous record, empty for the first record
+	PrevHash string `json:"prev_hash"`
+	// hex SHA-256 of the other fields, which include PrevHash
+	Hash string `json:"hash"`
+}
+
+// Digest returns the hash of a record, of all its fields but Hash
+func (r Record) Digest() string {
+	// a JSON array of the fields is unambiguous, nil and empty slices hash alike
+	b, _ := json.Marshal([]any{
+		r.Seq,
+		r.Time.UTC().Format(time.RFC3339Nano),
+		r.Subject,
+		r.Action,
+		r.Procedure,
+		nonNil(r.ObjectIDs),
+		r.SrcType,
+		nonNil(r.Classification),
+		r.Decision,
+		r.PrevHash,
+	})
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// Sink keeps the records of a Logger in order
+type Sink interface {
+	// Write appends a record
+	Write(ctx context.Context, r Record) error
+
+	// Last returns the latest record, the zero Record when there is none, so the chain continues after a restart
+	Last(ctx context.Context) (Record, error)
+
+	Close() error
+}
+
+// Appender is a Sink written by several Loggers, e.g. the servers sharing a database. It chains each record to its
+// own latest record, holding a lock until the record is written.
+type Appender interface {
+	Append(ctx context.Context, chain func(last Record) Record) error
+}
+
+// Logger chains the records of the accesses and writes them to its sink. A nil *Logger records nothing.
+//
+// The chain of a sink which is not an Appender is kept in memory, so such a sink is written by a single Logger.
+type Logger struct {
+	sink Sink
+
+	mu   sync.Mutex
+	last Record
+
+	failures atomic.Int64
+}
+
+// New returns the logger of the configured sink, or nil when no sink is configured. The pool is the one of the
+// postgres sink.
+func New(ctx context.Context, cfg *config.Config, pool *pgxpool.Pool) (*Logger, error) {
+	var sink Sink
+	var err error
+	switch cfg.Audit.Sink {
+	case "":
+		return nil, nil
+	case "file":
+		sink, err = NewFile(cfg.Audit.File.Path, cfg.Audit.File.MaxSize, cfg.Audit.File.MaxBackups)
+	case "syslog":
+		sink, err = NewSyslog(cfg.Audit.Syslog.Network, cfg.Audit.Syslog.Addr, cfg.Audit.Syslog.Tag, cfg.Audit.Syslog.HeadPath)
+	case "postgres":
+		sink = NewPostgres(pool)
+	default:
+		return nil, fmt.Errorf("unsupported audit sink %s", cfg.Audit.Sink)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewLogger(ctx, sink)
+}
+
+// NewLogger returns a logger continuing the chain of the records of a sink
+func NewLogger(ctx context.Context, sink Sink) (*Logger, error) {
+	last, err := sink.Last(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the last audit record: %w", err)
+	}
+	return &Logger{sink: sink, last: last}, nil
+}
+
+// Log records an access, split into records of up to MaxObjectIDs object ids. Failures are counted and logged with
+// the count so far, the access is not refused.
+func (l *Logger) Log(ctx context.Context, r Record) {
+	if l == nil {
+		return
+	}
+	ids := r.ObjectIDs
+	for {
+		r.ObjectIDs = ids[:min(len(ids), MaxObjectIDs)]
+		if err := l.write(ctx, r); err != nil {
+			slog.ErrorContext(ctx, "failed to write audit record",
+				slog.String("subject", r.Subject),
+				slog.String("action", r.Action),
+				slog.Any("object_ids", r.ObjectIDs),
+				slog.Int64("failures", l.failures.Add(1)),
+				slog.String("error", err.Error()),
+			)
+		}
+		ids = ids[len(r.ObjectIDs):]
+		if len(ids) == 0 {
+			return
+		}
+	}
+}
+
+// Failures returns the number of records the logger failed to write
+func (l *Logger) Failures() int64 {
+	if l == nil {
+		return 0
+	}
+	return l.failures.Load()
+}
+
+func (l *Logger) write(ctx context.Context, r Record) error {
+	if a, ok := l.sink.(Appender); ok {
+		return a.Append(ctx, func(last Record) Record { return chain(last, r) })
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	r = chain(l.last, r)
+	if err := l.sink.Write(ctx, r); err != nil {
+		return err
+	}
+	l.last = r
+	return nil
+}
+
+// chain returns a record following the last one
+func chain(last, r Record) Record {
+	r.Seq = last.Seq + 1
+	// postgres keeps microseconds, records read back hash alike
+	r.Time = time.Now().UTC().Truncate(time.Microsecond)
+	r.PrevHash = last.Hash
+	r.Hash = r.Digest()
+	return r
+}
+
+// Close closes the sink of the logger
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	return l.sink.Close()
+}
+
+// Verifier checks that records follow each other in a chain
+type Verifier struct {
+	last    Record
+	Checked int
+}
+
+// Check returns ErrChain when a record does not follow the previous one checked or its hash does not match. The
+// first record checked is trusted to follow its PrevHash, as earlier records may be rotated away.
+func (v *Verifier) Check(r Record) error {
+	if r.Hash != r.Digest() {
+		return fmt.Errorf("%w: record %d does not match its hash", ErrChain, r.Seq)
+	}
+	if v.Checked == 0 {
+		if r.Seq == 1 && r.PrevHash != "" {
+			return fmt.Errorf("%w: first record has a previous hash", ErrChain)
+		}
+	} else {
+		if r.Seq != v.last.Seq+1 {
+			return fmt.Errorf("%w: record %d follows record %d", ErrChain, r.Seq, v.last.Seq)
+		}
+		if r.PrevHash != v.last.Hash {
+			return fmt.Errorf("%w: record %d does not follow the hash of record %d", ErrChain, r.Seq, v.last.Seq)
+		}
+	}
+	v.last = r
+	v.Checked++
+	return nil
+}
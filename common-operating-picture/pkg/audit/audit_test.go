@@ -0,0 +1,337 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// memory is a sink keeping the records written
+type memory struct {
+	records []Record
+}
+
+func (s *memory) Write(_ context.Context, r Record) error {
+	s.records = append(s.records, r)
+	return nil
+}
+
+func (s *memory) Last(_ context.Context) (Record, error) {
+	if len(s.records) == 0 {
+		return Record{}, nil
+	}
+	return s.records[len(s.records)-1], nil
+}
+
+func (s *memory) Close() error {
+	return nil
+}
+
+func verify(records []Record) error {
+	v := &Verifier{}
+	for _, r := range records {
+		if err := v.Check(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Test_Logger(t *testing.T) {
+	ctx := context.Background()
+	sink := &memory{}
+	l, err := NewLogger(ctx, sink)
+	if err != nil {
+		t.Fatalf("NewLogger() failed: %v", err)
+	}
+
+	ids := make([]string, MaxObjectIDs+1)
+	for i := range ids {
+		ids[i] = "id"
+	}
+	l.Log(ctx, Record{Subject: "alice", Action: ActionQuery, ObjectIDs: ids, Decision: DecisionAllowed})
+	l.Log(ctx, Record{Subject: "alice", Action: ActionQuery, ObjectIDs: []string{"id"}, Decision: DecisionFiltered})
+
+	if len(sink.records) != 3 {
+		t.Fatalf("Log() wrote %d records; want 3, the ids of the first access split over 2", len(sink.records))
+	}
+	if len(sink.records[0].ObjectIDs) != MaxObjectIDs || len(sink.records[1].ObjectIDs) != 1 {
+		t.Errorf("Log() split %d ids into %d and %d; want %d and 1", len(ids),
+			len(sink.records[0].ObjectIDs), len(sink.records[1].ObjectIDs), MaxObjectIDs)
+	}
+	if sink.records[0].Seq != 1 || sink.records[0].PrevHash != "" || sink.records[1].PrevHash != sink.records[0].Hash {
+		t.Errorf("Log() records are not chained: %+v", sink.records[:2])
+	}
+	if err := verify(sink.records); err != nil {
+		t.Errorf("Check() of the records written failed: %v", err)
+	}
+
+	// a restarted logger continues the chain
+	l, err = NewLogger(ctx, sink)
+	if err != nil {
+		t.Fatalf("NewLogger() failed: %v", err)
+	}
+	l.Log(ctx, Record{Subject: "bob", Action: ActionView, Decision: DecisionAllowed})
+	if last := sink.records[len(sink.records)-1]; last.Seq != 4 {
+		t.Errorf("Log() after a restart seq = %d; want 4", last.Seq)
+	}
+	if err := verify(sink.records); err != nil {
+		t.Errorf("Check() of the records written after a restart failed: %v", err)
+	}
+
+	var nilLogger *Logger
+	nilLogger.Log(ctx, Record{Subject: "alice"})
+}
+
+// failing is a sink failing every write
+type failing struct {
+	memory
+}
+
+func (s *failing) Write(_ context.Context, _ Record) error {
+	return errors.New("disk full")
+}
+
+func Test_LoggerFailures(t *testing.T) {
+	ctx := context.Background()
+	l, err := NewLogger(ctx, &failing{})
+	if err != nil {
+		t.Fatalf("NewLogger() failed: %v", err)
+	}
+	l.Log(ctx, Record{Subject: "alice", Action: ActionQuery, Decision: DecisionAllowed})
+	l.Log(ctx, Record{Subject: "alice", Action: ActionQuery, Decision: DecisionAllowed})
+	if got := l.Failures(); got != 2 {
+		t.Errorf("Failures() = %d; want 2", got)
+	}
+}
+
+// shared is an Appender of the records of several loggers
+type shared struct {
+	memory
+	mu sync.Mutex
+}
+
+func (s *shared) Append(_ context.Context, chain func(last Record) Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	last, _ := s.Last(context.Background())
+	s.records = append(s.records, chain(last))
+	return nil
+}
+
+func Test_LoggerAppender(t *testing.T) {
+	ctx := context.Background()
+	sink := &shared{}
+	// loggers started together all read the same last record, the appender chains their records regardless
+	var loggers []*Logger
+	for range 3 {
+		l, err := NewLogger(ctx, sink)
+		if err != nil {
+			t.Fatalf("NewLogger() failed: %v", err)
+		}
+		loggers = append(loggers, l)
+	}
+	var wg sync.WaitGroup
+	for _, l := range loggers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				l.Log(ctx, Record{Subject: "alice", Action: ActionQuery, Decision: DecisionAllowed})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(sink.records) != 30 {
+		t.Fatalf("Log() wrote %d records; want 30", len(sink.records))
+	}
+	if err := verify(sink.records); err != nil {
+		t.Errorf("Check() of the records of the loggers failed: %v", err)
+	}
+}
+
+var Test_VerifierTests = []struct {
+	name   string
+	tamper func([]Record) []Record
+}{
+	{
+		name: "changed subject",
+		tamper: func(r []Record) []Record {
+			r[1].Subject = "mallory"
+			return r
+		},
+	},
+	{
+		name: "changed and rehashed",
+		tamper: func(r []Record) []Record {
+			r[1].Decision = DecisionAllowed
+			r[1].Hash = r[1].Digest()
+			return r
+		},
+	},
+	{
+		name: "removed",
+		tamper: func(r []Record) []Record {
+			return append(r[:1], r[2:]...)
+		},
+	},
+	{
+		name: "reordered",
+		tamper: func(r []Record) []Record {
+			r[1], r[2] = r[2], r[1]
+			return r
+		},
+	},
+	{
+		name: "first removed",
+		tamper: func(r []Record) []Record {
+			r = r[1:]
+			r[0].Seq = 1
+			r[0].Hash = r[0].Digest()
+			return r
+		},
+	},
+}
+
+func Test_Verifier(t *testing.T) {
+	for _, tt := range Test_VerifierTests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			sink := &memory{}
+			l, err := NewLogger(ctx, sink)
+			if err != nil {
+				t.Fatalf("NewLogger() failed: %v", err)
+			}
+			for _, decision := range []string{DecisionAllowed, DecisionFiltered, DecisionAllowed} {
+				l.Log(ctx, Record{Subject: "alice", Action: ActionView, ObjectIDs: []string{"id"}, Decision: decision})
+			}
+
+			if err := verify(tt.tamper(sink.records)); !errors.Is(err, ErrChain) {
+				t.Errorf("Check() of tampered records error = %v; want ErrChain", err)
+			}
+		})
+	}
+}
+
+func Test_File(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	s, err := NewFile(path, 600, 0)
+	if err != nil {
+		t.Fatalf("NewFile() failed: %v", err)
+	}
+	l, err := NewLogger(ctx, s)
+	if err != nil {
+		t.Fatalf("NewLogger() failed: %v", err)
+	}
+	for range 5 {
+		l.Log(ctx, Record{Subject: "alice", Action: ActionQuery, ObjectIDs: []string{"id"}, Decision: DecisionAllowed})
+	}
+	if err := l.Close(); err != nil {
+		t.Fatalf("Close() failed: %v", err)
+	}
+
+	files, err := Files(path)
+	if err != nil {
+		t.Fatalf("Files() failed: %v", err)
+	}
+	if len(files) < 2 || files[len(files)-1] != path {
+		t.Fatalf("Files() = %v; want rotated files followed by %s", files, path)
+	}
+	v := &Verifier{}
+	if err := VerifyFiles(v, files...); err != nil || v.Checked != 5 {
+		t.Errorf("VerifyFiles() checked %d records, error %v; want 5 records", v.Checked, err)
+	}
+
+	// a reopened file continues the chain
+	s, err = NewFile(path, 600, 0)
+	if err != nil {
+		t.Fatalf("NewFile() failed: %v", err)
+	}
+	if last, err := s.Last(ctx); err != nil || last.Seq != 5 {
+		t.Errorf("Last() = %d, %v; want 5", last.Seq, err)
+	}
+	s.Close()
+
+	// a changed record breaks the chain
+	b, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatalf("ReadFile() failed: %v", err)
+	}
+	tampered := strings.Replace(string(b), `"subject":"alice"`, `"subject":"bob"`, 1)
+	if err := os.WriteFile(files[0], []byte(tampered), 0o640); err != nil {
+		t.Fatalf("WriteFile() failed: %v", err)
+	}
+	if err := VerifyFiles(&Verifier{}, files...); !errors.Is(err, ErrChain) {
+		t.Errorf("VerifyFiles() of a changed record error = %v; want ErrChain", err)
+	}
+}
+
+func Test_FileMaxBackups(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	s, err := NewFile(path, 1, 2)
+	if err != nil {
+		t.Fatalf("NewFile() failed: %v", err)
+	}
+	l, err := NewLogger(ctx, s)
+	if err != nil {
+		t.Fatalf("NewLogger() failed: %v", err)
+	}
+	for range 5 {
+		l.Log(ctx, Record{Subject: "alice", Action: ActionQuery, Decision: DecisionAllowed})
+	}
+	l.Close()
+
+	files, err := Files(path)
+	if err != nil {
+		t.Fatalf("Files() failed: %v", err)
+	}
+	if len(files) != 3 {
+		t.Fatalf("Files() = %v; want 2 rotated files and the file", files)
+	}
+	// the records left still verify, from the oldest one kept
+	v := &Verifier{}
+	if err := VerifyFiles(v, files...); err != nil || v.Checked != 3 {
+		t.Errorf("VerifyFiles() checked %d records, error %v; want 3 records", v.Checked, err)
+	}
+}
+
+func Test_SyslogHead(t *testing.T) {
+	ctx := context.Background()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket() failed: %v", err)
+	}
+	defer conn.Close()
+	head := filepath.Join(t.TempDir(), "audit", "syslog-head.json")
+
+	s, err := NewSyslog("udp", conn.LocalAddr().String(), "dsp-cop-audit", head)
+	if err != nil {
+		t.Fatalf("NewSyslog() failed: %v", err)
+	}
+	l, err := NewLogger(ctx, s)
+	if err != nil {
+		t.Fatalf("NewLogger() failed: %v", err)
+	}
+	for range 3 {
+		l.Log(ctx, Record{Subject: "alice", Action: ActionQuery, Decision: DecisionAllowed})
+	}
+	l.Close()
+
+	// a restarted sink continues the chain from its head file
+	s, err = NewSyslog("udp", conn.LocalAddr().String(), "dsp-cop-audit", head)
+	if err != nil {
+		t.Fatalf("NewSyslog() failed: %v", err)
+	}
+	defer s.Close()
+	if last, err := s.Last(ctx); err != nil || last.Seq != 3 || last.Hash != last.Digest() {
+		t.Errorf("Last() = %d, %v; want the record 3", last.Seq, err)
+	}
+}
@@ -0,0 +1,247 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// backupTimeFormat names the rotated files, fixed width so they sort by the time of the rotation
+const backupTimeFormat = "20060102T150405.000000000Z"
+
+// File is a sink of JSON lines, one record per line. The file is rotated when it reaches maxSize bytes, renamed
+// with the time of the rotation, audit.log to audit-20261019T120000.000000000Z.log.
+type File struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	f    *os.File
+	size int64
+}
+
+// NewFile returns a sink appending to a file, created with its directory when missing. The oldest rotated files
+// beyond maxBackups are removed, 0 keeps every rotated file.
+func NewFile(path string, maxSize int64, maxBackups int) (*File, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid audit file: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create audit directory: %w", err)
+	}
+	s := &File{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *File) Write(_ context.Context, r Record) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit record: %w", err)
+	}
+	line = append(line, '\n')
+
+	if s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := s.f.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+	return nil
+}
+
+func (s *File) Last(_ context.Context) (Record, error) {
+	files, err := Files(s.path)
+	if err != nil {
+		return Record{}, err
+	}
+	// the current file is empty right after a rotation, the last record is then the one of the latest rotated file
+	for i := len(files) - 1; i >= 0; i-- {
+		r, ok, err := lastRecord(files[i])
+		if err != nil || ok {
+			return r, err
+		}
+	}
+	return Record{}, nil
+}
+
+func (s *File) Close() error {
+	return s.f.Close()
+}
+
+func (s *File) open() error {
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	s.f = f
+	s.size = info.Size()
+	return nil
+}
+
+func (s *File) rotate() error {
+	if err := s.f.Close(); err != nil {
+		return fmt.Errorf("failed to rotate audit file: %w", err)
+	}
+	ext := filepath.Ext(s.path)
+	backup := strings.TrimSuffix(s.path, ext) + "-" + time.Now().UTC().Format(backupTimeFormat) + ext
+	if err := os.Rename(s.path, backup); err != nil {
+		return fmt.Errorf("failed to rotate audit file: %w", err)
+	}
+	if err := s.open(); err != nil {
+		return err
+	}
+
+	if s.maxBackups == 0 {
+		return nil
+	}
+	backups, err := backups(s.path)
+	if err != nil {
+		return err
+	}
+	for len(backups) > s.maxBackups {
+		if err := os.Remove(backups[0]); err != nil {
+			return fmt.Errorf("failed to remove rotated audit file: %w", err)
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
+
+// Files returns the rotated files of an audit file, oldest first, followed by the file itself when it exists
+func Files(path string) ([]string, error) {
+	files, err := backups(path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(path); err == nil {
+		files = append(files, path)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read audit file: %w", err)
+	}
+	return files, nil
+}
+
+func backups(path string) ([]string, error) {
+	ext := filepath.Ext(path)
+	files, err := filepath.Glob(glob(strings.TrimSuffix(path, ext)) + "-*" + glob(ext))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rotated audit files: %w", err)
+	}
+	backups := files[:0]
+	for _, f := range files {
+		ts := strings.TrimSuffix(strings.TrimPrefix(f, strings.TrimSuffix(path, ext)+"-"), ext)
+		if _, err := time.Parse(backupTimeFormat, ts); err == nil {
+			backups = append(backups, f)
+		}
+	}
+	sort.Strings(backups)
+	return backups, nil
+}
+
+// glob escapes the meta characters of a path in a glob pattern
+func glob(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		if strings.ContainsRune(`*?[\`, c) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// lastRecord returns the record of the last line of a file, reading back from its end until the line is whole
+func lastRecord(path string) (Record, bool, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Record{}, false, nil
+	} else if err != nil {
+		return Record{}, false, fmt.Errorf("failed to open audit file: %w", err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return Record{}, false, fmt.Errorf("failed to open audit file: %w", err)
+	}
+
+	for n := int64(64 << 10); ; n *= 2 {
+		off := max(info.Size()-n, 0)
+		buf := make([]byte, info.Size()-off)
+		if _, err := f.ReadAt(buf, off); err != nil && !errors.Is(err, io.EOF) {
+			return Record{}, false, fmt.Errorf("failed to read audit file: %w", err)
+		}
+		buf = bytes.TrimRight(buf, "\n")
+		if len(buf) == 0 {
+			return Record{}, false, nil
+		}
+		i := bytes.LastIndexByte(buf, '\n')
+		if i < 0 && off > 0 {
+			continue
+		}
+		var r Record
+		if err := json.Unmarshal(buf[i+1:], &r); err != nil {
+			return Record{}, false, fmt.Errorf("invalid last record of %s: %w", path, err)
+		}
+		return r, true, nil
+	}
+}
+
+// VerifyFiles checks the records of files in order with a verifier, the rotated files of an audit file are listed
+// by Files
+func VerifyFiles(v *Verifier, paths ...string) error {
+	for _, path := range paths {
+		if err := verifyFile(v, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func verifyFile(v *Verifier, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	for n := 1; ; n++ {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var r Record
+			if err := json.Unmarshal(line, &r); err != nil {
+				return fmt.Errorf("%s:%d: %w: invalid record: %w", path, n, ErrChain, err)
+			}
+			if err := v.Check(r); err != nil {
+				return fmt.Errorf("%s:%d: %w", path, n, err)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read audit file: %w", err)
+		}
+	}
+}
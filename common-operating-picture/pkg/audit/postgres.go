@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/virtru-corp/dsp-cop/db"
+)
+
+// Postgres is a sink of the audit_log table, which refuses changes and deletions of its records. The servers sharing
+// a database append to the same chain, see Append.
+type Postgres struct {
+	pool    *pgxpool.Pool
+	queries *db.Queries
+}
+
+// NewPostgres returns a sink of the audit_log table of a database
+func NewPostgres(pool *pgxpool.Pool) *Postgres {
+	return &Postgres{pool: pool, queries: db.New(pool)}
+}
+
+func (s *Postgres) Write(ctx context.Context, r Record) error {
+	return createRecord(ctx, s.queries, r)
+}
+
+// Append chains a record to the latest record of the table and inserts it in one transaction, holding an advisory
+// lock until it commits so the records of the servers sharing the database follow each other
+func (s *Postgres) Append(ctx context.Context, chain func(last Record) Record) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin audit transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.queries.WithTx(tx)
+	if err := q.LockAuditLog(ctx); err != nil {
+		return fmt.Errorf("failed to lock audit_log: %w", err)
+	}
+	last, err := latestRecord(ctx, q)
+	if err != nil {
+		return fmt.Errorf("failed to read the last audit record: %w", err)
+	}
+	if err := createRecord(ctx, q, chain(last)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func createRecord(ctx context.Context, q *db.Queries, r Record) error {
+	err := q.CreateAuditRecord(ctx, db.CreateAuditRecordParams{
+		Seq:            r.Seq,
+		Ts:             pgtype.Timestamp{Time: r.Time, Valid: true},
+		Subject:        r.Subject,
+		Action:         r.Action,
+		Procedure:      r.Procedure,
+		ObjectIds:      nonNil(r.ObjectIDs),
+		SrcType:        r.SrcType,
+		Classification: nonNil(r.Classification),
+		Decision:       r.Decision,
+		PrevHash:       r.PrevHash,
+		Hash:           r.Hash,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to insert audit record: %w", err)
+	}
+	return nil
+}
+
+func (s *Postgres) Last(ctx context.Context) (Record, error) {
+	return latestRecord(ctx, s.queries)
+}
+
+func latestRecord(ctx context.Context, q *db.Queries) (Record, error) {
+	row, err := q.GetLatestAuditRecord(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Record{}, nil
+	} else if err != nil {
+		return Record{}, err
+	}
+	return record(row), nil
+}
+
+// Records returns up to limit records following the record after, in order
+func (s *Postgres) Records(ctx context.Context, after int64, limit int32) ([]Record, error) {
+	rows, err := s.queries.ListAuditRecords(ctx, db.ListAuditRecordsParams{AfterSeq: after, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+	records := make([]Record, 0, len(rows))
+	for _, row := range rows {
+		records = append(records, record(row))
+	}
+	return records, nil
+}
+
+// Close leaves the pool, which is the one of the service
+func (s *Postgres) Close() error {
+	return nil
+}
+
+func record(row db.AuditLog) Record {
+	return Record{
+		Seq:            row.Seq,
+		Time:           row.Ts.Time.UTC(),
+		Subject:        row.Subject,
+		Action:         row.Action,
+		Procedure:      row.Procedure,
+		ObjectIDs:      row.ObjectIds,
+		SrcType:        row.SrcType,
+		Classification: row.Classification,
+		Decision:       row.Decision,
+		PrevHash:       row.PrevHash,
+		Hash:           row.Hash,
+	}
+}
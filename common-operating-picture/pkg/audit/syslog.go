@@ -0,0 +1,81 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/syslog"
+	"os"
+	"path/filepath"
+)
+
+// Syslog is a sink of JSON messages of the authpriv facility. The records sent can not be read back, so the last
+// record sent is kept in a head file for the chain to continue after a restart. Without a head file, or when it is
+// lost, the chain starts over at seq 1 with the next start of the service.
+type Syslog struct {
+	w        *syslog.Writer
+	headPath string
+}
+
+// NewSyslog returns a sink of a syslog server, the local syslog when network is empty. The last record sent is
+// kept in headPath, created with its directory when missing, unless headPath is empty.
+func NewSyslog(network, addr, tag, headPath string) (*Syslog, error) {
+	if headPath != "" {
+		var err error
+		if headPath, err = filepath.Abs(headPath); err != nil {
+			return nil, fmt.Errorf("invalid audit syslog head file: %w", err)
+		}
+		if err := os.MkdirAll(filepath.Dir(headPath), 0o750); err != nil {
+			return nil, fmt.Errorf("failed to create audit directory: %w", err)
+		}
+	}
+	w, err := syslog.Dial(network, addr, syslog.LOG_INFO|syslog.LOG_AUTHPRIV, tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+	return &Syslog{w: w, headPath: headPath}, nil
+}
+
+func (s *Syslog) Write(_ context.Context, r Record) error {
+	msg, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit record: %w", err)
+	}
+	if err := s.w.Info(string(msg)); err != nil {
+		return fmt.Errorf("failed to send audit record: %w", err)
+	}
+	if s.headPath == "" {
+		return nil
+	}
+	// the head is replaced whole, a crash leaves the previous head rather than a partial one
+	tmp := s.headPath + ".tmp"
+	if err := os.WriteFile(tmp, msg, 0o640); err != nil {
+		return fmt.Errorf("failed to write audit syslog head file: %w", err)
+	}
+	if err := os.Rename(tmp, s.headPath); err != nil {
+		return fmt.Errorf("failed to write audit syslog head file: %w", err)
+	}
+	return nil
+}
+
+func (s *Syslog) Last(_ context.Context) (Record, error) {
+	if s.headPath == "" {
+		return Record{}, nil
+	}
+	b, err := os.ReadFile(s.headPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return Record{}, nil
+	} else if err != nil {
+		return Record{}, fmt.Errorf("failed to read audit syslog head file: %w", err)
+	}
+	var r Record
+	if err := json.Unmarshal(b, &r); err != nil {
+		return Record{}, fmt.Errorf("invalid audit syslog head file: %w", err)
+	}
+	return r, nil
+}
+
+func (s *Syslog) Close() error {
+	return s.w.Close()
+}
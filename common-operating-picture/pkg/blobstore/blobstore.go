@@ -0,0 +1,123 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+)
+
+// Store keeps the TDF payloads of tdf_objects, which reference them by their tdf_uri
+type Store interface {
+	// Put stores a payload of size bytes under a key and returns its tdf_uri
+	Put(ctx context.Context, key string, r io.Reader, size int64) (string, error)
+
+	// Open returns a reader of the payload of a tdf_uri of the store and its size
+	Open(ctx context.Context, uri string) (io.ReadCloser, int64, error)
+
+	// Delete removes the payload of a tdf_uri of the store
+	Delete(ctx context.Context, uri string) error
+
+	// URL returns a download URL of the payload of a tdf_uri of the store, valid for ttl
+	URL(ctx context.Context, uri string, ttl time.Duration) (string, error)
+
+	// Owns reports whether a tdf_uri is of the store, other tdf_uris are set by clients and left alone
+	Owns(uri string) bool
+}
+
+// Blobs is the configured store with the threshold of the payloads it keeps. A nil *Blobs keeps every payload
+// inline.
+type Blobs struct {
+	Store
+	Threshold int
+	URLTTL    time.Duration
+}
+
+// New returns the configured blob store, or nil when no backend is configured
+func New(ctx context.Context, cfg *config.Config) (*Blobs, error) {
+	var store Store
+	var err error
+	switch cfg.BlobStore.Backend {
+	case "":
+		return nil, nil
+	case "fs":
+		store, err = newConfiguredFS(cfg)
+	case "s3":
+		store, err = NewS3(ctx, cfg)
+	default:
+		return nil, fmt.Errorf("unsupported blob store backend %s", cfg.BlobStore.Backend)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Blobs{Store: store, Threshold: cfg.BlobStore.Threshold, URLTTL: cfg.BlobStore.URLTTL}, nil
+}
+
+func newConfiguredFS(cfg *config.Config) (*FS, error) {
+	url := cfg.BlobStore.FS.URL
+	if url == "" {
+		scheme := "https"
+		if !cfg.Service.TLS.Enabled {
+			scheme = "http"
+		}
+		url = scheme + "://" + cfg.Service.PublicServerHost + "/blobs"
+	}
+
+	signingKey := []byte(cfg.BlobStore.FS.SigningKey)
+	if len(signingKey) == 0 {
+		slog.Warn("no blob_store.fs.signing_key configured, download URLs will not survive a restart")
+		signingKey = make([]byte, 32)
+		if _, err := rand.Read(signingKey); err != nil {
+			return nil, fmt.Errorf("failed to generate signing key: %w", err)
+		}
+	}
+	return NewFS(cfg.BlobStore.FS.Dir, url, signingKey)
+}
+
+// Offload puts a payload larger than the threshold in the store under a new key of its src_type, returning its
+// tdf_uri. An empty tdf_uri is returned for a payload kept inline.
+func (b *Blobs) Offload(ctx context.Context, srcType string, data []byte) (string, error) {
+	if b == nil || len(data) <= b.Threshold {
+		return "", nil
+	}
+	return b.Put(ctx, Key(srcType), bytes.NewReader(data), int64(len(data)))
+}
+
+// DownloadURL returns the download URL of a tdf_uri of the store, other tdf_uris are returned as they are
+func (b *Blobs) DownloadURL(ctx context.Context, uri string) (string, error) {
+	if b == nil || !b.Owns(uri) {
+		return uri, nil
+	}
+	return b.URL(ctx, uri, b.URLTTL)
+}
+
+// Discard deletes the payload of a tdf_uri of the store whose tdf_object was not stored, logging failures
+func (b *Blobs) Discard(ctx context.Context, uri string) {
+	if b == nil || !b.Owns(uri) {
+		return
+	}
+	if err := b.Delete(ctx, uri); err != nil {
+		slog.ErrorContext(ctx, "failed to delete blob", slog.String("tdf_uri", uri), slog.String("error", err.Error()))
+	}
+}
+
+// Key returns a new key of a payload of a src_type
+func Key(srcType string) string {
+	srcType = strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == '.' {
+			return '_'
+		}
+		return r
+	}, srcType)
+	if srcType == "" {
+		srcType = "_"
+	}
+	return srcType + "/" + uuid.NewString() + ".tdf"
+}
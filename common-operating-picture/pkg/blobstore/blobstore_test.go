@@ -0,0 +1,240 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+)
+
+func Test_FS(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewFS(t.TempDir(), "https://cop.example/blobs/", []byte("secret"))
+	if err != nil {
+		t.Fatalf("NewFS() failed: %v", err)
+	}
+
+	uri, err := s.Put(ctx, "vehicles/a1.tdf", strings.NewReader("payload"), 7)
+	if err != nil {
+		t.Fatalf("Put() failed: %v", err)
+	}
+	if !strings.HasPrefix(uri, "file:///") || !s.Owns(uri) {
+		t.Errorf("Put() = %s; want a file URI of the store", uri)
+	}
+	if got, err := read(ctx, s, uri); err != nil || got != "payload" {
+		t.Errorf("Open() = %s, %v; want payload", got, err)
+	}
+
+	if _, err := s.Put(ctx, "../escape.tdf", strings.NewReader("payload"), 7); err == nil {
+		t.Error("Put() of a key outside of the directory succeeded; want error")
+	}
+	if s.Owns("file:///etc/passwd") || s.Owns("https://cop.example/a1.tdf") {
+		t.Error("Owns() of a tdf_uri outside of the store = true; want false")
+	}
+
+	if err := s.Delete(ctx, uri); err != nil {
+		t.Fatalf("Delete() failed: %v", err)
+	}
+	if _, _, err := s.Open(ctx, uri); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Open() of a deleted blob error = %v; want ErrNotFound", err)
+	}
+}
+
+func Test_FSDownload(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewFS(t.TempDir(), "https://cop.example/blobs", []byte("secret"))
+	if err != nil {
+		t.Fatalf("NewFS() failed: %v", err)
+	}
+	uri, err := s.Put(ctx, "vehicles/a1.tdf", strings.NewReader("payload"), 7)
+	if err != nil {
+		t.Fatalf("Put() failed: %v", err)
+	}
+
+	download := func(target string) *httptest.ResponseRecorder {
+		u, err := url.Parse(target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u.Path = strings.TrimPrefix(u.Path, "/blobs")
+		res := httptest.NewRecorder()
+		s.ServeHTTP(res, httptest.NewRequest(http.MethodGet, u.String(), nil))
+		return res
+	}
+
+	valid, err := s.URL(ctx, uri, time.Minute)
+	if err != nil {
+		t.Fatalf("URL() failed: %v", err)
+	}
+	if !strings.HasPrefix(valid, "https://cop.example/blobs/vehicles/a1.tdf?") {
+		t.Errorf("URL() = %s; want a URL of the key under the base URL", valid)
+	}
+	if res := download(valid); res.Code != http.StatusOK || res.Body.String() != "payload" {
+		t.Errorf("download of a valid URL = %d %s; want 200 payload", res.Code, res.Body)
+	}
+
+	expired, _ := s.URL(ctx, uri, -time.Minute)
+	if res := download(expired); res.Code != http.StatusForbidden {
+		t.Errorf("download of an expired URL = %d; want 403", res.Code)
+	}
+
+	tampered := strings.Replace(valid, "a1.tdf", "b2.tdf", 1)
+	if res := download(tampered); res.Code != http.StatusForbidden {
+		t.Errorf("download of another key = %d; want 403", res.Code)
+	}
+}
+
+func Test_BlobsOffload(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewFS(t.TempDir(), "https://cop.example/blobs", []byte("secret"))
+	if err != nil {
+		t.Fatalf("NewFS() failed: %v", err)
+	}
+	b := &Blobs{Store: s, Threshold: 4, URLTTL: time.Minute}
+
+	if uri, err := b.Offload(ctx, "vehicles", []byte("tiny")); err != nil || uri != "" {
+		t.Errorf("Offload() of a payload at the threshold = %s, %v; want inline", uri, err)
+	}
+	uri, err := b.Offload(ctx, "vehicles", []byte("larger"))
+	if err != nil || !b.Owns(uri) || !strings.Contains(uri, "/vehicles/") {
+		t.Errorf("Offload() of a payload over the threshold = %s, %v; want a tdf_uri of vehicles", uri, err)
+	}
+
+	if got, err := b.DownloadURL(ctx, "https://partner.example/a1.tdf"); err != nil || got != "https://partner.example/a1.tdf" {
+		t.Errorf("DownloadURL() of a client tdf_uri = %s, %v; want it unchanged", got, err)
+	}
+	if got, err := b.DownloadURL(ctx, uri); err != nil || !strings.HasPrefix(got, "https://cop.example/blobs/vehicles/") {
+		t.Errorf("DownloadURL() = %s, %v; want a download URL", got, err)
+	}
+
+	var disabled *Blobs
+	if uri, err := disabled.Offload(ctx, "vehicles", []byte("larger")); err != nil || uri != "" {
+		t.Errorf("Offload() without a blob store = %s, %v; want inline", uri, err)
+	}
+}
+
+var Test_KeyTests = []struct {
+	test string
+
+	srcType    string
+	wantPrefix string
+}{
+	{
+		test:       "src_type",
+		srcType:    "vehicles",
+		wantPrefix: "vehicles/",
+	},
+	{
+		test:       "path separators",
+		srcType:    "../etc",
+		wantPrefix: "___etc/",
+	},
+	{
+		test:       "empty",
+		srcType:    "",
+		wantPrefix: "_/",
+	},
+}
+
+func Test_Key(t *testing.T) {
+	for _, tt := range Test_KeyTests {
+		t.Run(tt.test, func(t *testing.T) {
+			got := Key(tt.srcType)
+			if !strings.HasPrefix(got, tt.wantPrefix) || !strings.HasSuffix(got, ".tdf") {
+				t.Errorf("Key() = %s; want %s<uuid>.tdf", got, tt.wantPrefix)
+			}
+		})
+	}
+}
+
+func Test_S3Owns(t *testing.T) {
+	s := &S3{Bucket: "dsp-cop", Prefix: "cop/"}
+	for uri, want := range map[string]bool{
+		"s3://dsp-cop/cop/vehicles/a1.tdf":   true,
+		"s3://dsp-cop/other/vehicles/a1.tdf": false,
+		"s3://other/cop/vehicles/a1.tdf":     false,
+		"https://dsp-cop/cop/a1.tdf":         false,
+	} {
+		if got := s.Owns(uri); got != want {
+			t.Errorf("Owns(%s) = %v; want %v", uri, got, want)
+		}
+	}
+}
+
+// Test_S3 runs against the S3-compatible store of DSP_COP_TEST_S3_ENDPOINT, e.g. http://localhost:9000 of the minio
+// profile of docker-compose.dev.yaml, with its dsp-cop bucket and minioadmin credentials
+func Test_S3(t *testing.T) {
+	endpoint := os.Getenv("DSP_COP_TEST_S3_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("DSP_COP_TEST_S3_ENDPOINT is not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cfg := &config.Config{}
+	cfg.BlobStore.S3.Endpoint = endpoint
+	cfg.BlobStore.S3.Region = "us-east-1"
+	cfg.BlobStore.S3.Bucket = "dsp-cop"
+	cfg.BlobStore.S3.Prefix = "test/"
+	cfg.BlobStore.S3.AccessKeyID = "minioadmin"
+	cfg.BlobStore.S3.SecretAccessKey = "minioadmin"
+	cfg.BlobStore.S3.UsePathStyle = true
+
+	s, err := NewS3(ctx, cfg)
+	if err != nil {
+		t.Fatalf("NewS3() failed: %v", err)
+	}
+
+	uri, err := s.Put(ctx, Key("vehicles"), strings.NewReader("payload"), 7)
+	if err != nil {
+		t.Fatalf("Put() failed: %v", err)
+	}
+	if got, err := read(ctx, s, uri); err != nil || got != "payload" {
+		t.Errorf("Open() = %s, %v; want payload", got, err)
+	}
+
+	download, err := s.URL(ctx, uri, time.Minute)
+	if err != nil {
+		t.Fatalf("URL() failed: %v", err)
+	}
+	res, err := http.Get(download)
+	if err != nil {
+		t.Fatalf("download failed: %v", err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK || string(body) != "payload" {
+		t.Errorf("download = %d %s; want 200 payload", res.StatusCode, body)
+	}
+
+	if err := s.Delete(ctx, uri); err != nil {
+		t.Fatalf("Delete() failed: %v", err)
+	}
+	if _, _, err := s.Open(ctx, uri); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Open() of a deleted blob error = %v; want ErrNotFound", err)
+	}
+}
+
+// read returns the payload of a tdf_uri of a store
+func read(ctx context.Context, s Store, uri string) (string, error) {
+	r, size, err := s.Open(ctx, uri)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err == nil && int64(len(data)) != size {
+		err = fmt.Errorf("read %d bytes of %d", len(data), size)
+	}
+	return string(data), err
+}
@@ -0,0 +1,189 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is the error of a tdf_uri that is not of the store or has no payload
+var ErrNotFound = errors.New("blob not found")
+
+// FS is a store of payloads in the files of a directory. Its tdf_uris are file URIs, and its download URLs are
+// signed with an expiry and served by the store as an http.Handler of the path of the keys.
+type FS struct {
+	dir        string
+	url        string
+	signingKey []byte
+}
+
+// NewFS returns a store of a directory, created when missing, downloaded from a base URL
+func NewFS(dir, baseURL string, signingKey []byte) (*FS, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blob store directory: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create blob store directory: %w", err)
+	}
+	return &FS{dir: dir, url: strings.TrimSuffix(baseURL, "/"), signingKey: signingKey}, nil
+}
+
+func (s *FS) Put(_ context.Context, key string, r io.Reader, size int64) (string, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return "", fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	// write to a temporary file first so a payload is never read half written
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if n, err := io.Copy(tmp, r); err != nil || n != size {
+		tmp.Close()
+		if err == nil {
+			err = fmt.Errorf("%d bytes read of %d", n, size)
+		}
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String(), nil
+}
+
+func (s *FS) Open(_ context.Context, uri string) (io.ReadCloser, int64, error) {
+	key, ok := s.key(uri)
+	if !ok {
+		return nil, 0, ErrNotFound
+	}
+	path, err := s.path(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, 0, ErrNotFound
+	} else if err != nil {
+		return nil, 0, fmt.Errorf("failed to open blob: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, fmt.Errorf("failed to open blob: %w", err)
+	}
+	return f, info.Size(), nil
+}
+
+func (s *FS) Delete(_ context.Context, uri string) error {
+	key, ok := s.key(uri)
+	if !ok {
+		return ErrNotFound
+	}
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+	return nil
+}
+
+func (s *FS) URL(_ context.Context, uri string, ttl time.Duration) (string, error) {
+	key, ok := s.key(uri)
+	if !ok {
+		return "", ErrNotFound
+	}
+	expires := strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)
+	query := url.Values{"expires": {expires}, "signature": {s.signature(key, expires)}}
+	return s.url + "/" + key + "?" + query.Encode(), nil
+}
+
+func (s *FS) Owns(uri string) bool {
+	_, ok := s.key(uri)
+	return ok
+}
+
+// ServeHTTP serves the payload of a download URL, the path of the request being the key
+func (s *FS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	expires := r.URL.Query().Get("expires")
+	signature := r.URL.Query().Get("signature")
+
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || !hmac.Equal([]byte(signature), []byte(s.signature(key, expires))) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	if time.Now().Unix() > unix {
+		http.Error(w, "download URL expired", http.StatusForbidden)
+		return
+	}
+
+	path, err := s.path(key)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to stat blob", slog.String("key", key), slog.String("error", err.Error()))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filepath.Base(path)))
+	http.ServeContent(w, r, "", info.ModTime(), f)
+}
+
+// path returns the file of a key, refusing keys outside of the directory
+func (s *FS) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid blob key %s", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+// key returns the key of a tdf_uri of the store
+func (s *FS) key(uri string) (string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return "", false
+	}
+	key, ok := strings.CutPrefix(u.Path, filepath.ToSlash(s.dir)+"/")
+	return key, ok && key != ""
+}
+
+func (s *FS) signature(key, expires string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,128 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+)
+
+// S3 is a store of payloads in the objects of an S3 or S3-compatible bucket. Its tdf_uris are s3://bucket/key URIs,
+// and its download URLs are presigned GetObject requests.
+type S3 struct {
+	Client  *s3.Client
+	Presign *s3.PresignClient
+	Bucket  string
+	Prefix  string
+}
+
+// NewS3 returns a store of the configured bucket
+func NewS3(ctx context.Context, cfg *config.Config) (*S3, error) {
+	c := cfg.BlobStore.S3
+	if c.Bucket == "" {
+		return nil, errors.New("no blob_store.s3.bucket configured")
+	}
+
+	opts := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(c.Region)}
+	if c.AccessKeyID != "" {
+		opts = append(opts, awsconfig.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(c.AccessKeyID, c.SecretAccessKey, ""),
+		))
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if c.Endpoint != "" {
+			o.BaseEndpoint = aws.String(c.Endpoint)
+		}
+		o.UsePathStyle = c.UsePathStyle
+		// S3-compatible stores do not all support the checksums AWS S3 defaults to
+		o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+		o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+	})
+	return &S3{Client: client, Presign: s3.NewPresignClient(client), Bucket: c.Bucket, Prefix: c.Prefix}, nil
+}
+
+func (s *S3) Put(ctx context.Context, key string, r io.Reader, size int64) (string, error) {
+	key = s.Prefix + key
+	_, err := s.Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.Bucket),
+		Key:           aws.String(key),
+		Body:          r,
+		ContentLength: aws.Int64(size),
+		ContentType:   aws.String("application/octet-stream"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to put blob: %w", err)
+	}
+	return (&url.URL{Scheme: "s3", Host: s.Bucket, Path: "/" + key}).String(), nil
+}
+
+func (s *S3) Open(ctx context.Context, uri string) (io.ReadCloser, int64, error) {
+	key, ok := s.key(uri)
+	if !ok {
+		return nil, 0, ErrNotFound
+	}
+	out, err := s.Client.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String(s.Bucket), Key: aws.String(key)})
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return nil, 0, ErrNotFound
+	} else if err != nil {
+		return nil, 0, fmt.Errorf("failed to get blob: %w", err)
+	}
+	return out.Body, aws.ToInt64(out.ContentLength), nil
+}
+
+func (s *S3) Delete(ctx context.Context, uri string) error {
+	key, ok := s.key(uri)
+	if !ok {
+		return ErrNotFound
+	}
+	if _, err := s.Client.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: aws.String(s.Bucket), Key: aws.String(key)}); err != nil {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+	return nil
+}
+
+func (s *S3) URL(ctx context.Context, uri string, ttl time.Duration) (string, error) {
+	key, ok := s.key(uri)
+	if !ok {
+		return "", ErrNotFound
+	}
+	req, err := s.Presign.PresignGetObject(ctx,
+		&s3.GetObjectInput{Bucket: aws.String(s.Bucket), Key: aws.String(key)},
+		s3.WithPresignExpires(ttl),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to presign blob URL: %w", err)
+	}
+	return req.URL, nil
+}
+
+func (s *S3) Owns(uri string) bool {
+	_, ok := s.key(uri)
+	return ok
+}
+
+// key returns the object key of a tdf_uri of the bucket
+func (s *S3) key(uri string) (string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "s3" || u.Host != s.Bucket {
+		return "", false
+	}
+	key := strings.TrimPrefix(u.Path, "/")
+	return key, key != "" && strings.HasPrefix(key, s.Prefix)
+}
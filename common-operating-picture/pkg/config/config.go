@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/creasty/defaults"
 	validator "github.com/go-playground/validator/v10"
@@ -36,6 +37,10 @@ type Config struct {
 	// Pool settings https://pkg.go.dev/github.com/jackc/pgx/v5@v5.5.5/pgxpool#ParseConfig
 	DBUrl string `mapstructure:"db_url" validate:"required"`
 
+	// Schema migrations when the server starts: "off" does nothing, "check" refuses to start when the schema is
+	// older than the binary expects and "up" applies the pending migrations
+	DBMigrate string `mapstructure:"db_migrate" default:"up" validate:"oneof=off check up"`
+
 	// DSP platform endpoint
 	PlatformEndpoint string `mapstructure:"platform_endpoint" validate:"required"`
 
@@ -57,6 +62,29 @@ type Config struct {
 	////////////////////////
 	LogLevel string `mapstructure:"log_level" default:"DEBUG"`
 
+	// Logging of the RPC requests. Credentials and TDF payloads are never logged: the Authorization, Cookie and
+	// Proxy-Authorization headers are redacted, bytes fields such as tdf_blob and metadata fields are logged as their
+	// length and search fields are pruned to their attrClassification, attrNeedToKnow and attrRelTo attributes.
+	RequestLog struct {
+		// Level of the requests of procedures without a level of their own, OFF logs none
+		Level string `mapstructure:"level" default:"INFO" validate:"oneof=DEBUG INFO WARN ERROR OFF debug info warn error off"`
+
+		// Levels of procedures, e.g. DEBUG for the frequent GetLatestPositions
+		Procedures []RequestLogProcedure `mapstructure:"procedures" validate:"dive"`
+
+		// Headers redacted besides Authorization, Cookie and Proxy-Authorization
+		RedactHeaders []string `mapstructure:"redact_headers"`
+
+		// Message fields logged as their length besides bytes fields and metadata
+		SummarizeFields []string `mapstructure:"summarize_fields"`
+
+		// Message fields pruned like search, JSON of search attributes
+		PruneFields []string `mapstructure:"prune_fields"`
+
+		// Message fields left out
+		OmitFields []string `mapstructure:"omit_fields"`
+	} `mapstructure:"request_log"`
+
 	Service struct {
 		// The public host and port is used by the web interface to connect to the server. In many
 		// environments this will not be the same as the hostname and port the server is listening on.
@@ -93,6 +121,340 @@ type Config struct {
 		// Override TDF3/ZTDF default and encrypt forms as NanoTDFs
 		FormSubmitNanoTDF bool `mapstructure:"form_submit_nano_tdf" default:"true"`
 	}
+
+	// Retention of tdf_objects, enforced by the server and the `db purge` command. A retention rule in
+	// the src_types metadata takes precedence over the rules configured here.
+	Retention struct {
+		// Interval in seconds between purges in the server (0 disables the purge job)
+		Interval int `mapstructure:"interval" default:"3600" validate:"gte=0"`
+
+		// Rule for source types without a rule of their own
+		Default RetentionRule `mapstructure:"default"`
+
+		// Rules per source type
+		SrcTypes map[string]RetentionRule `mapstructure:"src_types" validate:"dive"`
+
+		// Retention of the revisions of tdf_objects, the latest revision of each tdf_object is always kept
+		Revisions struct {
+			// Maximum age of revisions (e.g. "2160h"), 0 keeps revisions of any age
+			MaxAge time.Duration `mapstructure:"max_age" default:"2160h" validate:"gte=0"`
+
+			// Maximum number of revisions of each tdf_object, 0 keeps any number of revisions
+			MaxCount int32 `mapstructure:"max_count" default:"100" validate:"gte=0"`
+		} `mapstructure:"revisions"`
+	} `mapstructure:"retention"`
+
+	// Monthly partitions of tdf_objects, created ahead of time by the server
+	Partitions struct {
+		// Number of months of partitions to create ahead of the current month
+		Premake int `mapstructure:"premake" default:"3" validate:"gte=1"`
+
+		// Interval in seconds between partition maintenance runs in the server
+		Interval int `mapstructure:"interval" default:"86400" validate:"gt=0"`
+	} `mapstructure:"partitions"`
+
+	// KML feed of recent tdf_objects for Google Earth style viewers
+	KML struct {
+		// Seconds of recent tdf_objects in the feed, unless the request sets a window
+		Window int `mapstructure:"window" default:"3600" validate:"gt=0"`
+
+		// Seconds between refreshes of the feed by a NetworkLink
+		RefreshInterval int `mapstructure:"refresh_interval" default:"30" validate:"gt=0"`
+
+		// URL of placemark icons where {icon} is replaced by the icon name of the src_type map fields, e.g.
+		// "https://icons.example.com/{icon}.png" (empty uses the viewer's default icon)
+		IconURL string `mapstructure:"icon_url"`
+	} `mapstructure:"kml"`
+
+	// Cursor-on-Target listener storing the CoT XML events of TAK devices as tdf_objects
+	CoT struct {
+		Enabled bool `mapstructure:"enabled" default:"false"`
+
+		// UDP address events are received on, one event per datagram (empty disables UDP)
+		UDPAddr string `mapstructure:"udp_addr" default:":8087"`
+
+		// TCP address events are streamed to (empty disables TCP)
+		TCPAddr string `mapstructure:"tcp_addr" default:":8087"`
+
+		// Source type of the stored tdf_objects
+		SrcType string `mapstructure:"src_type" default:"cot" validate:"required"`
+
+		// Attribute values (FQNs) of the TDF wrapping the event detail, also indexed as search attributes
+		Attributes []string `mapstructure:"attributes"`
+
+		// Wrap the event detail as a NanoTDF instead of a ZTDF
+		NanoTDF bool `mapstructure:"nano_tdf" default:"true"`
+
+		// Destinations new tdf_objects are sent to as CoT events, independent of the listener
+		Outputs []CoTOutput `mapstructure:"outputs" validate:"dive"`
+	} `mapstructure:"cot"`
+
+	// AIS ingest of the `ingest ais` command storing vessel position reports as tdf_objects
+	AIS struct {
+		// Source type of the stored tdf_objects
+		SrcType string `mapstructure:"src_type" default:"ais" validate:"required"`
+
+		// Attribute values (FQNs) of the TDF wrapping the vessel, also indexed as search attributes
+		Attributes []string `mapstructure:"attributes"`
+
+		// Wrap the vessel as a NanoTDF instead of a ZTDF
+		NanoTDF bool `mapstructure:"nano_tdf" default:"true"`
+	} `mapstructure:"ais"`
+
+	// ADS-B ingest of the `ingest adsb` command storing aircraft as tdf_objects
+	ADSB struct {
+		// Source type of the stored tdf_objects
+		SrcType string `mapstructure:"src_type" default:"vehicles" validate:"required"`
+
+		// Attribute values (FQNs) of the TDF wrapping the aircraft, also indexed as search attributes
+		Attributes []string `mapstructure:"attributes"`
+
+		// Wrap the aircraft as a NanoTDF instead of a ZTDF
+		NanoTDF bool `mapstructure:"nano_tdf" default:"true"`
+
+		// Minimum seconds between stores of an aircraft
+		Interval int `mapstructure:"interval" default:"5" validate:"gt=0"`
+
+		// Create a tdf_object at every store, keeping the track of the aircraft, instead of updating the
+		// tdf_object of the aircraft
+		History bool `mapstructure:"history" default:"false"`
+	} `mapstructure:"adsb"`
+
+	// MQTT bridge of the server storing the JSON payloads of sensor topics as tdf_objects
+	MQTT struct {
+		Enabled bool `mapstructure:"enabled" default:"false"`
+
+		// URL of the broker, e.g. "tcp://localhost:1883", "ssl://broker:8883" or "ws://broker:8080/mqtt"
+		Broker   string `mapstructure:"broker" default:"tcp://localhost:1883" validate:"required"`
+		ClientID string `mapstructure:"client_id" default:"dsp-cop" validate:"required"`
+		Username string `mapstructure:"username"`
+		Password string `mapstructure:"password"`
+
+		// QoS of the subscriptions. Messages are acknowledged once stored, so with QoS 1 or 2 and a persistent
+		// session the broker redelivers the messages not stored before a disconnect.
+		QoS int `mapstructure:"qos" default:"1" validate:"gte=0,lte=2"`
+
+		// Start a new session at every connection instead of resuming the session of the client ID
+		CleanSession bool `mapstructure:"clean_session" default:"false"`
+
+		// Maximum number of payloads inserted per batch, and time a payload waits for its batch to fill
+		BatchSize int           `mapstructure:"batch_size" default:"100" validate:"gt=0"`
+		BatchWait time.Duration `mapstructure:"batch_wait" default:"1s" validate:"gt=0"`
+
+		// Wrap the payloads as NanoTDFs instead of ZTDFs
+		NanoTDF bool `mapstructure:"nano_tdf" default:"true"`
+
+		// Topics stored, a message is stored as the src_type of the first topic matching its topic
+		Topics []MQTTTopic `mapstructure:"topics" validate:"dive"`
+	} `mapstructure:"mqtt"`
+
+	// Delivery of new tdf_objects to the webhooks registered through the API
+	Webhooks struct {
+		// Number of deliveries made concurrently
+		Workers int `mapstructure:"workers" default:"4" validate:"gt=0"`
+
+		// Number of deliveries waiting for a worker, deliveries past a full queue are dead-lettered
+		QueueSize int `mapstructure:"queue_size" default:"1000" validate:"gt=0"`
+
+		// Attempts of a delivery before it is dead-lettered
+		MaxAttempts int `mapstructure:"max_attempts" default:"6" validate:"gt=0"`
+
+		// Wait after the first failed attempt, doubled after every failed attempt up to max_backoff
+		InitialBackoff time.Duration `mapstructure:"initial_backoff" default:"1s" validate:"gt=0"`
+		MaxBackoff     time.Duration `mapstructure:"max_backoff" default:"5m" validate:"gt=0"`
+
+		// Timeout of a delivery request
+		Timeout time.Duration `mapstructure:"timeout" default:"10s" validate:"gt=0"`
+
+		// Token URL of the webhooks' service identities, defaults to the token endpoint of deprecated_idp_url
+		TokenURL string `mapstructure:"token_url"`
+
+		// Key sealing the signing secrets and client secrets of the webhooks in the database, webhooks can not be
+		// created without it
+		SecretKey string `mapstructure:"secret_key"`
+
+		// Realm role of the users allowed to read and change the webhooks of other users, empty allows none
+		AdminRole string `mapstructure:"admin_role"`
+
+		// Allow webhook URLs of loopback, link-local and private addresses, e.g. receivers on the same host
+		AllowPrivateURLs bool `mapstructure:"allow_private_urls"`
+	} `mapstructure:"webhooks"`
+
+	// Kafka sink publishing the changes of tdf_objects and source consuming new tdf_objects
+	Kafka struct {
+		// Seed brokers of the cluster, e.g. "localhost:9092"
+		Brokers  []string `mapstructure:"brokers"`
+		ClientID string   `mapstructure:"client_id" default:"dsp-cop" validate:"required"`
+
+		// Connect to the brokers with TLS
+		TLS bool `mapstructure:"tls" default:"false"`
+
+		// SASL mechanism of the username and password, no SASL authentication when empty
+		SASLMechanism string `mapstructure:"sasl_mechanism" validate:"omitempty,oneof=PLAIN SCRAM-SHA-256 SCRAM-SHA-512"`
+		Username      string `mapstructure:"username"`
+		Password      string `mapstructure:"password"`
+
+		// Sink publishing every inserted, updated or deleted tdf_object as a protobuf TdfObject keyed by its id, to
+		// the topic of its src_type
+		Sink struct {
+			Enabled bool `mapstructure:"enabled" default:"false"`
+
+			// Prefix of the topics, the topic of a src_type is the prefix followed by the src_type
+			TopicPrefix string `mapstructure:"topic_prefix" default:"dsp-cop.tdf_objects."`
+		} `mapstructure:"sink"`
+
+		// Source consuming protobuf CreateTdfObjectRequests into tdf_objects. Offsets are committed once the
+		// tdf_objects are stored, so messages are redelivered when the server stops before storing them.
+		Source struct {
+			Enabled bool     `mapstructure:"enabled" default:"false"`
+			Topics  []string `mapstructure:"topics"`
+
+			// Consumer group of the servers sharing the partitions of the topics
+			Group string `mapstructure:"group" default:"dsp-cop" validate:"required"`
+
+			// Maximum number of messages inserted per batch
+			BatchSize int `mapstructure:"batch_size" default:"100" validate:"gt=0"`
+		} `mapstructure:"source"`
+	} `mapstructure:"kafka"`
+
+	// Blob store of the TDF payloads too large to be stored inline in tdf_objects, which keep the tdf_uri of the
+	// payload instead
+	BlobStore struct {
+		// "fs" or "s3", every payload is stored inline when empty
+		Backend string `mapstructure:"backend" validate:"omitempty,oneof=fs s3"`
+
+		// Payloads larger than threshold bytes go to the blob store. Inline payloads travel hex encoded in the
+		// notifications of new tdf_objects, which are limited to 8000 bytes.
+		Threshold int `mapstructure:"threshold" default:"3072" validate:"gte=0"`
+
+		// Lifetime of the download URLs of the payloads returned by the API
+		URLTTL time.Duration `mapstructure:"url_ttl" default:"5m" validate:"gt=0"`
+
+		// Filesystem store, its payloads are downloaded from the /blobs path of the server
+		FS struct {
+			Dir string `mapstructure:"dir" default:"blobs"`
+
+			// Base URL of the download URLs, defaults to the /blobs path of public_server_host
+			URL string `mapstructure:"url"`
+
+			// Key signing the download URLs. A random key is used when empty, so URLs do not survive a restart and
+			// can not be shared between servers.
+			SigningKey string `mapstructure:"signing_key"`
+		} `mapstructure:"fs"`
+
+		// S3 or S3-compatible (e.g. MinIO) store, its payloads are downloaded with presigned URLs
+		S3 struct {
+			// Endpoint of an S3-compatible store, e.g. "http://localhost:9000" (empty is AWS S3)
+			Endpoint string `mapstructure:"endpoint"`
+			Region   string `mapstructure:"region" default:"us-east-1"`
+			Bucket   string `mapstructure:"bucket"`
+
+			// Prefix of the object keys, e.g. "dsp-cop/"
+			Prefix string `mapstructure:"prefix"`
+
+			// Static credentials, the AWS default credential chain is used when empty
+			AccessKeyID     string `mapstructure:"access_key_id"`
+			SecretAccessKey string `mapstructure:"secret_access_key"`
+
+			// Address buckets in the path instead of the host name, as MinIO requires
+			UsePathStyle bool `mapstructure:"use_path_style" default:"false"`
+		} `mapstructure:"s3"`
+	} `mapstructure:"blob_store"`
+
+	// Chunked uploads of the UploadTdfBlob RPC, kept on disk until complete so they can be resumed
+	Uploads struct {
+		// Directory of the partial uploads
+		Dir string `mapstructure:"dir" default:"uploads"`
+
+		// Largest payload in bytes of an upload
+		MaxSize int64 `mapstructure:"max_size" default:"2147483648" validate:"gt=0"`
+
+		// Partial uploads not written to for expiry are removed
+		Expiry time.Duration `mapstructure:"expiry" default:"24h" validate:"gt=0"`
+
+		// Longest call of UploadTdfBlob and DownloadTdfBlob, which are not bound by grpc_read_timeout and
+		// grpc_write_timeout
+		Timeout time.Duration `mapstructure:"timeout" default:"1h" validate:"gt=0"`
+	} `mapstructure:"uploads"`
+
+	// Audit log of who queried, viewed, created or updated which data. Its records are hash chained, so a changed
+	// or removed record breaks the chain `dsp-cop audit verify` checks.
+	Audit struct {
+		// "file", "syslog" or "postgres", no audit log when empty
+		Sink string `mapstructure:"sink" validate:"omitempty,oneof=file syslog postgres"`
+
+		// JSON lines file, rotated to a file named with the time of the rotation when it reaches max_size bytes
+		File struct {
+			Path    string `mapstructure:"path" default:"audit/audit.log" validate:"required"`
+			MaxSize int64  `mapstructure:"max_size" default:"104857600" validate:"gt=0"`
+
+			// Rotated files kept, the oldest are removed beyond it. 0 keeps every rotated file.
+			MaxBackups int `mapstructure:"max_backups" default:"0" validate:"gte=0"`
+		} `mapstructure:"file"`
+
+		// Syslog server receiving the records as JSON messages of the authpriv facility
+		Syslog struct {
+			// "udp", "tcp" or "unix", the local syslog when empty
+			Network string `mapstructure:"network" validate:"omitempty,oneof=udp tcp unix unixgram"`
+			Addr    string `mapstructure:"addr"`
+			Tag     string `mapstructure:"tag" default:"dsp-cop-audit"`
+
+			// File keeping the last record sent, so the chain continues after a restart. The chain starts over at
+			// seq 1 with every start of the service when empty.
+			HeadPath string `mapstructure:"head_path" default:"audit/syslog-head.json"`
+		} `mapstructure:"syslog"`
+	} `mapstructure:"audit"`
+}
+
+// RequestLogProcedure is the level of the requests of a procedure, named in full
+// ("/tdf_object.v1.TdfObjectService/GetLatestPositions") or by its method ("GetLatestPositions")
+type RequestLogProcedure struct {
+	Procedure string `mapstructure:"procedure" validate:"required"`
+	Level     string `mapstructure:"level" validate:"oneof=DEBUG INFO WARN ERROR OFF debug info warn error off"`
+}
+
+// MQTTTopic maps a topic filter to the src_type of its JSON payloads. The payloads are indexed by the geo, ts,
+// entity, search and attr fields of the src_type metadata, as the create form of the web interface does.
+type MQTTTopic struct {
+	// Topic filter with + and # wildcards, e.g. "sensors/+/position"
+	Filter  string `mapstructure:"filter" validate:"required"`
+	SrcType string `mapstructure:"src_type" validate:"required"`
+
+	// Attribute values (FQNs) of the TDF of payloads without values in the attr fields of the src_type
+	Attributes []string `mapstructure:"attributes"`
+}
+
+// CoTOutput is a TAK destination of CoT events. What is sent is decided by the entitlements of the output's own
+// service identity, an OIDC client authenticated with client credentials.
+type CoTOutput struct {
+	Name string `mapstructure:"name" validate:"required"`
+
+	// "tcp" for a TAK server input, or "udp" for a unicast or multicast (e.g. 239.2.3.1:6969) address
+	Network string `mapstructure:"network" validate:"oneof=tcp udp"`
+	Addr    string `mapstructure:"addr" validate:"required"`
+
+	// Source types sent, empty sends all source types but the CoT listener's own
+	SrcTypes []string `mapstructure:"src_types"`
+
+	// CoT type of the events (empty is "a-u-G", an unknown ground unit)
+	Type string `mapstructure:"type"`
+
+	// Seconds after ts an event is stale (0 is 300 seconds)
+	Stale int `mapstructure:"stale" validate:"gte=0"`
+
+	// OIDC client credentials of the service identity, the token URL defaults to the token endpoint of
+	// deprecated_idp_url
+	ClientID     string `mapstructure:"client_id" validate:"required"`
+	ClientSecret string `mapstructure:"client_secret" validate:"required"`
+	TokenURL     string `mapstructure:"token_url"`
+}
+
+type RetentionRule struct {
+	// Maximum age of tdf_objects (e.g. "720h"), 0 keeps tdf_objects of any age
+	MaxAge time.Duration `mapstructure:"max_age" validate:"gte=0"`
+
+	// Maximum number of tdf_objects, 0 keeps any number of tdf_objects
+	MaxRows int64 `mapstructure:"max_rows" validate:"gte=0"`
 }
 
 func New() (*Config, error) {
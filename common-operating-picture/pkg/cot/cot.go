@@ -0,0 +1,161 @@
+package cot
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	geos "github.com/twpayne/go-geos"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/geo"
+	"github.com/virtru-corp/dsp-cop/pkg/tdf"
+)
+
+// unknownHae is the CoT height above ellipsoid of an unknown altitude
+const unknownHae = 9999999.0
+
+// Event is a Cursor-on-Target event, e.g.
+//
+//	<event version="2.0" uid="ANDROID-1234" type="a-f-G-U-C" how="m-g" time="2024-06-01T12:00:00Z"
+//	 start="2024-06-01T12:00:00Z" stale="2024-06-01T12:05:00Z">
+//	  <point lat="38.89" lon="-77.03" hae="10" ce="9.9" le="9999999"/>
+//	  <detail><contact callsign="ALPHA-1"/></detail>
+//	</event>
+type Event struct {
+	XMLName xml.Name  `xml:"event"`
+	Version string    `xml:"version,attr"`
+	UID     string    `xml:"uid,attr"`
+	Type    string    `xml:"type,attr"`
+	How     string    `xml:"how,attr"`
+	Time    time.Time `xml:"time,attr"`
+	Start   time.Time `xml:"start,attr"`
+	Stale   time.Time `xml:"stale,attr"`
+	Point   Point     `xml:"point"`
+	Detail  *Detail   `xml:"detail"`
+}
+
+type Point struct {
+	Lat float64 `xml:"lat,attr"`
+	Lon float64 `xml:"lon,attr"`
+	// height above ellipsoid in meters, 9999999 when unknown
+	Hae float64 `xml:"hae,attr"`
+	// circular and linear error in meters
+	Ce float64 `xml:"ce,attr"`
+	Le float64 `xml:"le,attr"`
+}
+
+// Detail is the free-form detail of an event, of which only the well-known contact and track are parsed
+type Detail struct {
+	Inner   []byte   `xml:",innerxml"`
+	Contact *Contact `xml:"contact"`
+	Track   *Track   `xml:"track"`
+}
+
+type Contact struct {
+	Callsign string `xml:"callsign,attr"`
+}
+
+type Track struct {
+	Course float64 `xml:"course,attr"`
+	Speed  float64 `xml:"speed,attr"`
+}
+
+// Parse parses a CoT event
+func Parse(data []byte) (Event, error) {
+	var e Event
+	if err := xml.Unmarshal(data, &e); err != nil {
+		return e, fmt.Errorf("invalid CoT event: %w", err)
+	}
+	if err := e.validate(); err != nil {
+		return e, err
+	}
+	return e, nil
+}
+
+func (e Event) validate() error {
+	switch {
+	case e.UID == "":
+		return errors.New("invalid CoT event: uid is required")
+	case e.Type == "":
+		return errors.New("invalid CoT event: type is required")
+	case e.Time.IsZero():
+		return errors.New("invalid CoT event: time is required")
+	case e.Point.Lat < -90 || e.Point.Lat > 90 || e.Point.Lon < -180 || e.Point.Lon > 180:
+		return fmt.Errorf("invalid CoT event: point %f,%f out of range", e.Point.Lat, e.Point.Lon)
+	}
+	return nil
+}
+
+// Callsign returns the contact callsign of the event, or an empty string
+func (e Event) Callsign() string {
+	if e.Detail == nil || e.Detail.Contact == nil {
+		return ""
+	}
+	return e.Detail.Contact.Callsign
+}
+
+// DetailXML returns the detail element of the event, the part of the event wrapped in a TDF
+func (e Event) DetailXML() []byte {
+	var b bytes.Buffer
+	b.WriteString("<detail>")
+	if e.Detail != nil {
+		b.Write(e.Detail.Inner)
+	}
+	b.WriteString("</detail>")
+	return b.Bytes()
+}
+
+// Params returns the CreateTdfObjects parameters of the event. The point becomes the geometry, time the ts and the
+// uid the entity_key; uid, type and callsign are indexed in search along with the attribute values of the TDF, and
+// metadata holds the plaintext the viewers display.
+func (e Event) Params(srcType string, attrValues []string, tdfBlob []byte) (db.CreateTdfObjectsParams, error) {
+	search := map[string]interface{}{
+		"uid":  e.UID,
+		"type": e.Type,
+	}
+	metadata := map[string]interface{}{
+		"uid":   e.UID,
+		"type":  e.Type,
+		"how":   e.How,
+		"stale": e.Stale.UTC().Format(time.RFC3339),
+		"ce":    e.Point.Ce,
+		"le":    e.Point.Le,
+	}
+	if callsign := e.Callsign(); callsign != "" {
+		search["callsign"] = callsign
+		metadata["callsign"] = callsign
+	}
+	if e.Point.Hae != unknownHae {
+		metadata["hae"] = e.Point.Hae
+	}
+	if e.Detail != nil && e.Detail.Track != nil {
+		metadata["course"] = e.Detail.Track.Course
+		metadata["speed"] = e.Detail.Track.Speed
+	}
+	for k, v := range tdf.SearchAttributes(attrValues) {
+		search[k] = v
+	}
+
+	searchJSON, err := json.Marshal(search)
+	if err != nil {
+		return db.CreateTdfObjectsParams{}, err
+	}
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return db.CreateTdfObjectsParams{}, err
+	}
+
+	return db.CreateTdfObjectsParams{
+		Ts:        pgtype.Timestamp{Time: e.Time.UTC(), Valid: true},
+		SrcType:   srcType,
+		Geo:       geos.NewPointFromXY(e.Point.Lon, e.Point.Lat).SetSRID(geo.DEFAULT_SRID),
+		Search:    searchJSON,
+		Metadata:  metadataJSON,
+		TdfBlob:   tdfBlob,
+		EntityKey: pgtype.Text{String: e.UID, Valid: true},
+	}, nil
+}
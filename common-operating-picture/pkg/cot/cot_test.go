@@ -0,0 +1,185 @@
+package cot
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testEvent = `<?xml version="1.0" encoding="UTF-8"?>
+<event version="2.0" uid="ANDROID-1234" type="a-f-G-U-C" how="m-g" time="2024-06-01T12:00:00.000Z"
+ start="2024-06-01T12:00:00.000Z" stale="2024-06-01T12:05:00.000Z">
+  <point lat="38.89" lon="-77.03" hae="9999999" ce="9.9" le="9999999"/>
+  <detail><contact callsign="ALPHA-1"/><track course="90" speed="4.2"/><__group name="Cyan"/></detail>
+</event>`
+
+var Test_ParseTests = []struct {
+	test string
+
+	input   string
+	wantErr bool
+}{
+	{
+		test:  "valid event",
+		input: testEvent,
+	},
+	{
+		test:    "missing uid",
+		input:   `<event type="a-f-G" time="2024-06-01T12:00:00Z"><point lat="1" lon="2"/></event>`,
+		wantErr: true,
+	},
+	{
+		test:    "point out of range",
+		input:   `<event uid="a" type="a-f-G" time="2024-06-01T12:00:00Z"><point lat="91" lon="2"/></event>`,
+		wantErr: true,
+	},
+	{
+		test:    "not an event",
+		input:   `<message/>`,
+		wantErr: true,
+	},
+}
+
+func Test_Parse(t *testing.T) {
+	for _, tt := range Test_ParseTests {
+		t.Run(tt.test, func(t *testing.T) {
+			_, err := Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v; wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_Params(t *testing.T) {
+	e, err := Parse([]byte(testEvent))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Callsign() != "ALPHA-1" || !e.Time.Equal(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Parse() = %+v", e)
+	}
+
+	p, err := e.Params("cot", []string{"https://demo.com/attr/classification/value/unclassified"}, []byte("tdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.SrcType != "cot" || p.EntityKey.String != "ANDROID-1234" || !p.Ts.Time.Equal(e.Time) || string(p.TdfBlob) != "tdf" {
+		t.Errorf("Params() = %+v", p)
+	}
+
+	var search map[string]interface{}
+	if err := json.Unmarshal(p.Search, &search); err != nil {
+		t.Fatal(err)
+	}
+	if search["callsign"] != "ALPHA-1" || search["type"] != "a-f-G-U-C" {
+		t.Errorf("Params() search = %s", p.Search)
+	}
+	if c, _ := search["attrClassification"].([]interface{}); len(c) != 1 {
+		t.Errorf("Params() search attrClassification = %v", search["attrClassification"])
+	}
+
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(p.Metadata, &metadata); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := metadata["hae"]; ok || metadata["speed"] != 4.2 || metadata["stale"] != "2024-06-01T12:05:00Z" {
+		t.Errorf("Params() metadata = %s", p.Metadata)
+	}
+
+	if got := string(e.DetailXML()); got != `<detail><contact callsign="ALPHA-1"/><track course="90" speed="4.2"/><__group name="Cyan"/></detail>` {
+		t.Errorf("DetailXML() = %s", got)
+	}
+}
+
+func Test_ListenerUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := make(chan Event, 1)
+	l := &Listener{Handle: func(_ context.Context, e Event) { events <- e }}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go l.ServeUDP(ctx, conn)
+
+	sender, err := net.Dial("udp", conn.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sender.Close()
+	for _, msg := range []string{"\xbf\x01\xbfprotobuf", testEvent} {
+		if _, err := sender.Write([]byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	select {
+	case e := <-events:
+		if e.UID != "ANDROID-1234" {
+			t.Errorf("received event uid = %s; want ANDROID-1234", e.UID)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+	}
+}
+
+func Test_ListenerTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := make(chan Event, 2)
+	l := &Listener{Handle: func(_ context.Context, e Event) { events <- e }}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go l.ServeTCP(ctx, ln)
+
+	sender, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sender.Close()
+	second := `<event uid="BRAVO" type="a-h-G" time="2024-06-01T12:01:00Z"><point lat="1" lon="2"/></event>`
+	if _, err := sender.Write([]byte(testEvent + second)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"ANDROID-1234", "BRAVO"} {
+		select {
+		case e := <-events:
+			if e.UID != want {
+				t.Errorf("received event uid = %s; want %s", e.UID, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("event %s not received", want)
+		}
+	}
+}
+
+func Test_NewEvent(t *testing.T) {
+	ts := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	b, err := NewEvent("COP-ship-123", "a-u-G", "EVER GIVEN", "ship <ais>", 30.5, 32.3, ts, time.Minute).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, err := Parse(b)
+	if err != nil {
+		t.Fatalf("Parse(%s) error = %v", b, err)
+	}
+	if e.UID != "COP-ship-123" || e.Callsign() != "EVER GIVEN" || e.Point.Lat != 30.5 || e.Point.Lon != 32.3 {
+		t.Errorf("Parse() = %+v", e)
+	}
+	if !e.Stale.Equal(ts.Add(time.Minute)) {
+		t.Errorf("Parse() stale = %v; want %v", e.Stale, ts.Add(time.Minute))
+	}
+	if got := string(e.Detail.Inner); !strings.Contains(got, "<remarks>ship &lt;ais&gt;</remarks>") {
+		t.Errorf("Parse() detail = %s", got)
+	}
+}
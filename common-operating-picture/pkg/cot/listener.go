@@ -0,0 +1,194 @@
+package cot
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"github.com/virtru-corp/dsp-cop/pkg/tdf"
+)
+
+// maxDatagramSize is the largest UDP datagram read by the listener
+const maxDatagramSize = 65535
+
+// Listener receives CoT XML events, one event per UDP datagram or a stream of events per TCP connection, and calls
+// Handle for every valid event. Invalid events are logged and dropped.
+type Listener struct {
+	Handle func(ctx context.Context, e Event)
+}
+
+// ListenAndServe listens on the UDP and TCP addresses, an empty address is not listened on, until the context is done
+func (l *Listener) ListenAndServe(ctx context.Context, udpAddr, tcpAddr string) error {
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+
+	if udpAddr != "" {
+		conn, err := net.ListenPacket("udp", udpAddr)
+		if err != nil {
+			return fmt.Errorf("failed to listen for CoT on udp %s: %w", udpAddr, err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- l.ServeUDP(ctx, conn)
+		}()
+	}
+	if tcpAddr != "" {
+		ln, err := net.Listen("tcp", tcpAddr)
+		if err != nil {
+			return fmt.Errorf("failed to listen for CoT on tcp %s: %w", tcpAddr, err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- l.ServeTCP(ctx, ln)
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+	var err error
+	for e := range errs {
+		err = errors.Join(err, e)
+	}
+	return err
+}
+
+// ServeUDP reads an event from every datagram of the connection until the context is done
+func (l *Listener) ServeUDP(ctx context.Context, conn net.PacketConn) error {
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to read CoT datagram: %w", err)
+		}
+
+		data := bytes.TrimSpace(buf[:n])
+		if !bytes.HasPrefix(data, []byte("<")) {
+			// TAK protocol version 1 sends protobuf, which is not supported
+			slog.DebugContext(ctx, "dropping non-XML CoT datagram", slog.String("from", addr.String()))
+			continue
+		}
+		e, err := Parse(data)
+		if err != nil {
+			slog.WarnContext(ctx, "dropping CoT datagram", slog.String("from", addr.String()), slog.String("error", err.Error()))
+			continue
+		}
+		l.Handle(ctx, e)
+	}
+}
+
+// ServeTCP reads the stream of events of every connection accepted until the context is done
+func (l *Listener) ServeTCP(ctx context.Context, ln net.Listener) error {
+	stop := context.AfterFunc(ctx, func() { ln.Close() })
+	defer stop()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to accept CoT connection: %w", err)
+		}
+		go l.serveConn(ctx, conn)
+	}
+}
+
+func (l *Listener) serveConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	from := conn.RemoteAddr().String()
+	dec := xml.NewDecoder(conn)
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+				slog.WarnContext(ctx, "closing CoT connection", slog.String("from", from), slog.String("error", err.Error()))
+			}
+			return
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "event" {
+			continue
+		}
+
+		var e Event
+		if err := dec.DecodeElement(&e, &start); err != nil {
+			slog.WarnContext(ctx, "closing CoT connection", slog.String("from", from), slog.String("error", err.Error()))
+			return
+		}
+		if err := e.validate(); err != nil {
+			slog.WarnContext(ctx, "dropping CoT event", slog.String("from", from), slog.String("error", err.Error()))
+			continue
+		}
+		l.Handle(ctx, e)
+	}
+}
+
+// Run listens for CoT events on the configured addresses until the context is done, storing every event as a
+// tdf_object of the configured src_type with its detail wrapped in a TDF of the configured attributes.
+func Run(ctx context.Context, q *db.Queries, cfg *config.Config, enc tdf.Encrypter) {
+	srcType := strings.ToLower(cfg.CoT.SrcType)
+	tdfType := tdf.ZTDF
+	if cfg.CoT.NanoTDF {
+		tdfType = tdf.NanoTDF
+	}
+
+	l := &Listener{
+		Handle: func(ctx context.Context, e Event) {
+			if err := store(ctx, q, enc, e, srcType, cfg.CoT.Attributes, tdfType); err != nil {
+				slog.ErrorContext(ctx, "failed to store CoT event", slog.String("uid", e.UID), slog.String("error", err.Error()))
+			}
+		},
+	}
+
+	slog.InfoContext(ctx, "starting CoT listener",
+		slog.String("udp_addr", cfg.CoT.UDPAddr),
+		slog.String("tcp_addr", cfg.CoT.TCPAddr),
+		slog.String("src_type", srcType),
+	)
+	if err := l.ListenAndServe(ctx, cfg.CoT.UDPAddr, cfg.CoT.TCPAddr); err != nil {
+		slog.ErrorContext(ctx, "CoT listener error", slog.String("error", err.Error()))
+	}
+}
+
+func store(ctx context.Context, q *db.Queries, enc tdf.Encrypter, e Event, srcType string, attrValues []string, tdfType int) error {
+	blob, err := enc.EncryptBytes(e.DetailXML(), attrValues, tdfType)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt detail: %w", err)
+	}
+
+	params, err := e.Params(srcType, attrValues, blob.Bytes())
+	if err != nil {
+		return err
+	}
+
+	var insertErr error
+	q.CreateTdfObjects(ctx, []db.CreateTdfObjectsParams{params}).QueryRow(func(_ int, id uuid.UUID, err error) {
+		if err != nil {
+			insertErr = err
+			return
+		}
+		slog.DebugContext(ctx, "stored CoT event", slog.String("uid", e.UID), slog.String("id", id.String()))
+	})
+	return insertErr
+}
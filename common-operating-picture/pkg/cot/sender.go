@@ -0,0 +1,102 @@
+package cot
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// dialTimeout is the timeout of connecting to a destination
+const dialTimeout = 10 * time.Second
+
+// writeTimeout is the timeout of sending an event, so a stalled destination does not hold up the sends behind it
+const writeTimeout = 10 * time.Second
+
+// NewEvent returns a CoT event of a position, stale after the stale duration from ts. The remarks are added to the
+// detail along with the callsign.
+func NewEvent(uid, cotType, callsign, remarks string, lat, lon float64, ts time.Time, stale time.Duration) Event {
+	e := Event{
+		Version: "2.0",
+		UID:     uid,
+		Type:    cotType,
+		How:     "m-g",
+		Time:    ts.UTC(),
+		Start:   ts.UTC(),
+		Stale:   ts.UTC().Add(stale),
+		Point:   Point{Lat: lat, Lon: lon, Hae: unknownHae, Ce: unknownHae, Le: unknownHae},
+		Detail:  &Detail{},
+	}
+	if remarks != "" {
+		var b bytes.Buffer
+		b.WriteString("<remarks>")
+		xml.EscapeText(&b, []byte(remarks))
+		b.WriteString("</remarks>")
+		e.Detail.Inner = b.Bytes()
+	}
+	if callsign != "" {
+		e.Detail.Contact = &Contact{Callsign: callsign}
+	}
+	return e
+}
+
+// Marshal returns the XML of the event
+func (e Event) Marshal() ([]byte, error) {
+	b, err := xml.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+// Sender sends events to a TCP or UDP destination, connecting on the first send and again after a failed send
+type Sender struct {
+	Network string
+	Addr    string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// Send sends an event, one event per datagram over UDP
+func (s *Sender) Send(e Event) error {
+	b, err := e.Marshal()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		conn, err := net.DialTimeout(s.Network, s.Addr, dialTimeout)
+		if err != nil {
+			return fmt.Errorf("failed to connect to %s %s: %w", s.Network, s.Addr, err)
+		}
+		s.conn = conn
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		s.conn.Close()
+		s.conn = nil
+		return fmt.Errorf("failed to set write deadline of %s %s: %w", s.Network, s.Addr, err)
+	}
+	if _, err := s.conn.Write(b); err != nil {
+		s.conn.Close()
+		s.conn = nil
+		return fmt.Errorf("failed to send to %s %s: %w", s.Network, s.Addr, err)
+	}
+	return nil
+}
+
+// Close closes the connection to the destination
+func (s *Sender) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
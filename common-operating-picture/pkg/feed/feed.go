@@ -0,0 +1,105 @@
+package feed
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// maxDatagramSize is the largest UDP datagram read
+	maxDatagramSize = 65535
+	// reconnectDelay is the delay before connecting to a TCP feed again after the connection is lost
+	reconnectDelay = 5 * time.Second
+)
+
+// Handler handles a line of a feed
+type Handler func(ctx context.Context, line string)
+
+// Serve reads the lines of a source until the context is done or a file ends. "tcp://host:port" connects to a feed,
+// connecting again when the connection is lost, "udp://host:port" listens for datagrams of lines and anything else
+// is a file, "-" being stdin.
+func Serve(ctx context.Context, source string, handle Handler) error {
+	if addr, ok := strings.CutPrefix(source, "tcp://"); ok {
+		return serveTCP(ctx, addr, handle)
+	}
+	if addr, ok := strings.CutPrefix(source, "udp://"); ok {
+		conn, err := net.ListenPacket("udp", addr)
+		if err != nil {
+			return fmt.Errorf("failed to listen on udp %s: %w", addr, err)
+		}
+		return ServeUDP(ctx, conn, handle)
+	}
+
+	if source == "-" {
+		return Read(ctx, os.Stdin, handle)
+	}
+	f, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return Read(ctx, f, handle)
+}
+
+// Read handles every line of a reader until it ends or the context is done
+func Read(ctx context.Context, r io.Reader, handle Handler) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return nil
+		}
+		handle(ctx, scanner.Text())
+	}
+	return scanner.Err()
+}
+
+// ServeUDP handles the lines of every datagram of the connection until the context is done
+func ServeUDP(ctx context.Context, conn net.PacketConn, handle Handler) error {
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to read datagram: %w", err)
+		}
+		for _, line := range strings.Split(string(buf[:n]), "\n") {
+			handle(ctx, line)
+		}
+	}
+}
+
+func serveTCP(ctx context.Context, addr string, handle Handler) error {
+	var dialer net.Dialer
+	for {
+		conn, err := dialer.DialContext(ctx, "tcp", addr)
+		if err == nil {
+			slog.InfoContext(ctx, "connected to feed", slog.String("addr", addr))
+			stop := context.AfterFunc(ctx, func() { conn.Close() })
+			err = Read(ctx, conn, handle)
+			stop()
+			conn.Close()
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		slog.WarnContext(ctx, "lost feed, reconnecting", slog.String("addr", addr), slog.Any("error", err))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Feature is a GeoJSON Feature
+type Feature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id,omitempty"`
+	Geometry   json.RawMessage        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// FeatureCollection is a GeoJSON FeatureCollection
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+// NewFeature returns a Feature of a GeoJSON geometry, an empty geometry is encoded as null
+func NewFeature(id string, geometry string, properties map[string]interface{}) Feature {
+	geom := json.RawMessage("null")
+	if geometry != "" {
+		geom = json.RawMessage(geometry)
+	}
+	if properties == nil {
+		properties = map[string]interface{}{}
+	}
+	return Feature{
+		Type:       "Feature",
+		ID:         id,
+		Geometry:   geom,
+		Properties: properties,
+	}
+}
+
+// NewFeatureCollection returns a FeatureCollection of the features, never encoding the features as null
+func NewFeatureCollection(features []Feature) FeatureCollection {
+	if features == nil {
+		features = []Feature{}
+	}
+	return FeatureCollection{
+		Type:     "FeatureCollection",
+		Features: features,
+	}
+}
+
+// SetJSONProperties copies the top level keys of a JSON object into the properties. Properties already set are
+// kept, anything but a JSON object is ignored.
+func SetJSONProperties(properties map[string]interface{}, doc []byte) {
+	if len(bytes.TrimSpace(doc)) == 0 {
+		return
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(doc, &fields); err != nil {
+		return
+	}
+	for k, v := range fields {
+		if _, ok := properties[k]; !ok {
+			properties[k] = v
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package kafka
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+)
+
+// ClientOptions returns the options of a client of the configured brokers
+func ClientOptions(cfg *config.Config) ([]kgo.Opt, error) {
+	if len(cfg.Kafka.Brokers) == 0 {
+		return nil, errors.New("no Kafka brokers configured")
+	}
+
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(cfg.Kafka.Brokers...),
+		kgo.ClientID(cfg.Kafka.ClientID),
+	}
+	if cfg.Kafka.TLS {
+		opts = append(opts, kgo.DialTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12}))
+	}
+
+	switch cfg.Kafka.SASLMechanism {
+	case "":
+	case "PLAIN":
+		opts = append(opts, kgo.SASL(plain.Auth{User: cfg.Kafka.Username, Pass: cfg.Kafka.Password}.AsMechanism()))
+	case "SCRAM-SHA-256":
+		opts = append(opts, kgo.SASL(scram.Auth{User: cfg.Kafka.Username, Pass: cfg.Kafka.Password}.AsSha256Mechanism()))
+	case "SCRAM-SHA-512":
+		opts = append(opts, kgo.SASL(scram.Auth{User: cfg.Kafka.Username, Pass: cfg.Kafka.Password}.AsSha512Mechanism()))
+	default:
+		return nil, fmt.Errorf("unsupported SASL mechanism %s", cfg.Kafka.SASLMechanism)
+	}
+	return opts, nil
+}
@@ -0,0 +1,183 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/twmb/franz-go/pkg/kgo"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_ClientOptions(t *testing.T) {
+	cfg := &config.Config{}
+	if _, err := ClientOptions(cfg); err == nil {
+		t.Error("ClientOptions() without brokers succeeded; want error")
+	}
+
+	cfg.Kafka.Brokers = []string{"localhost:9092"}
+	for _, mechanism := range []string{"", "PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512"} {
+		cfg.Kafka.SASLMechanism = mechanism
+		if _, err := ClientOptions(cfg); err != nil {
+			t.Errorf("ClientOptions() with SASL mechanism %q failed: %v", mechanism, err)
+		}
+	}
+
+	cfg.Kafka.SASLMechanism = "GSSAPI"
+	if _, err := ClientOptions(cfg); err == nil {
+		t.Error("ClientOptions() with SASL mechanism GSSAPI succeeded; want error")
+	}
+}
+
+func Test_SinkRecord(t *testing.T) {
+	s := &Sink{TopicPrefix: "dsp-cop.tdf_objects."}
+	obj := &tdf_objectv1.TdfObject{Id: uuid.NewString(), SrcType: "vehicles", EntityKey: "a1"}
+
+	r, err := s.Record(EventUpdated, obj)
+	if err != nil {
+		t.Fatalf("Record() failed: %v", err)
+	}
+	if r.Topic != "dsp-cop.tdf_objects.vehicles" {
+		t.Errorf("Record().Topic = %s; want dsp-cop.tdf_objects.vehicles", r.Topic)
+	}
+	if string(r.Key) != obj.Id {
+		t.Errorf("Record().Key = %s; want %s", r.Key, obj.Id)
+	}
+	if len(r.Headers) != 1 || r.Headers[0].Key != EventHeader || string(r.Headers[0].Value) != EventUpdated {
+		t.Errorf("Record().Headers = %v; want %s: %s", r.Headers, EventHeader, EventUpdated)
+	}
+
+	got := &tdf_objectv1.TdfObject{}
+	if err := proto.Unmarshal(r.Value, got); err != nil {
+		t.Fatalf("Record().Value is not a TdfObject: %v", err)
+	}
+	if !proto.Equal(got, obj) {
+		t.Errorf("Record().Value = %v; want %v", got, obj)
+	}
+}
+
+func Test_SourceStore(t *testing.T) {
+	valid, _ := proto.Marshal(&tdf_objectv1.CreateTdfObjectRequest{SrcType: "vehicles"})
+	noSrcType, _ := proto.Marshal(&tdf_objectv1.CreateTdfObjectRequest{Search: `{"a":1}`})
+	unavailable, _ := proto.Marshal(&tdf_objectv1.CreateTdfObjectRequest{SrcType: "unavailable"})
+
+	var inserted []db.CreateTdfObjectsParams
+	s := &Source{
+		Params: func(_ context.Context, req *tdf_objectv1.CreateTdfObjectRequest) (db.CreateTdfObjectsParams, error) {
+			if req.SrcType == "unavailable" {
+				return db.CreateTdfObjectsParams{}, errors.New("database unavailable")
+			}
+			return db.CreateTdfObjectsParams{SrcType: req.SrcType}, nil
+		},
+		Insert: func(_ context.Context, params []db.CreateTdfObjectsParams) []error {
+			inserted = append(inserted, params...)
+			return make([]error, len(params))
+		},
+	}
+
+	pending := s.store(context.Background(), []*kgo.Record{
+		{Value: valid},
+		{Value: []byte("not a protobuf message")},
+		{Value: noSrcType},
+		{Value: unavailable},
+	})
+	if len(inserted) != 1 || inserted[0].SrcType != "vehicles" {
+		t.Errorf("store() inserted %v; want the vehicles message", inserted)
+	}
+	if len(pending) != 1 || string(pending[0].Value) != string(unavailable) {
+		t.Errorf("store() = %v; want the unavailable message pending", pending)
+	}
+}
+
+// Test_SinkSource runs against the brokers of DSP_COP_TEST_KAFKA_BROKERS, e.g. localhost:9092 of the kafka profile
+// of docker-compose.dev.yaml
+func Test_SinkSource(t *testing.T) {
+	brokers := os.Getenv("DSP_COP_TEST_KAFKA_BROKERS")
+	if brokers == "" {
+		t.Skip("DSP_COP_TEST_KAFKA_BROKERS is not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cfg := &config.Config{}
+	cfg.Kafka.Brokers = strings.Split(brokers, ",")
+	cfg.Kafka.ClientID = "dsp-cop-test"
+	cfg.Kafka.Sink.TopicPrefix = "dsp-cop-test." + uuid.NewString() + "."
+	cfg.Kafka.Source.Topics = []string{cfg.Kafka.Sink.TopicPrefix + "requests"}
+	cfg.Kafka.Source.Group = "dsp-cop-test-" + uuid.NewString()
+	cfg.Kafka.Source.BatchSize = 10
+
+	sink, err := NewSink(cfg)
+	if err != nil {
+		t.Fatalf("NewSink() failed: %v", err)
+	}
+	defer sink.Close(ctx)
+
+	obj := &tdf_objectv1.TdfObject{Id: uuid.NewString(), SrcType: "vehicles"}
+	r, err := sink.Record(EventCreated, obj)
+	if err != nil {
+		t.Fatalf("Record() failed: %v", err)
+	}
+	req, _ := proto.Marshal(&tdf_objectv1.CreateTdfObjectRequest{SrcType: "vehicles"})
+	results := sink.Client.ProduceSync(ctx, r, &kgo.Record{Topic: cfg.Kafka.Source.Topics[0], Value: req})
+	if err := results.FirstErr(); err != nil {
+		t.Fatalf("ProduceSync() failed: %v", err)
+	}
+
+	source, err := NewSource(cfg)
+	if err != nil {
+		t.Fatalf("NewSource() failed: %v", err)
+	}
+
+	var mu sync.Mutex
+	var inserted []db.CreateTdfObjectsParams
+	source.Params = func(_ context.Context, req *tdf_objectv1.CreateTdfObjectRequest) (db.CreateTdfObjectsParams, error) {
+		return db.CreateTdfObjectsParams{SrcType: req.SrcType}, nil
+	}
+	source.Insert = func(_ context.Context, params []db.CreateTdfObjectsParams) []error {
+		mu.Lock()
+		defer mu.Unlock()
+		inserted = append(inserted, params...)
+		return make([]error, len(params))
+	}
+
+	runCtx, stop := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		source.Run(runCtx)
+		close(done)
+	}()
+	defer func() {
+		stop()
+		<-done
+	}()
+
+	for {
+		mu.Lock()
+		n := len(inserted)
+		mu.Unlock()
+		if n > 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal("no message consumed")
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if inserted[0].SrcType != "vehicles" {
+		t.Errorf("inserted src_type %s; want vehicles", inserted[0].SrcType)
+	}
+}
@@ -0,0 +1,88 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"google.golang.org/protobuf/proto"
+)
+
+// EventHeader is the header of the event of a message published by the sink
+const EventHeader = "event"
+
+// Events of the messages published by the sink
+const (
+	EventCreated = "created"
+	EventUpdated = "updated"
+	EventDeleted = "deleted"
+)
+
+// Sink publishes the changes of tdf_objects as protobuf TdfObject messages keyed by their id, to the topic of their
+// src_type. The EventHeader of a message is EventCreated, EventUpdated or EventDeleted, and the TdfObject of a
+// deleted tdf_object only has its id, ts, src_type and entity_key.
+//
+// Messages of the same tdf_object go to the same partition, in the order they are published. Publishing is
+// asynchronous and failures are logged, so consumers needing every change should reconcile with the API.
+type Sink struct {
+	Client      *kgo.Client
+	TopicPrefix string
+}
+
+// NewSink returns a sink of the configured brokers
+func NewSink(cfg *config.Config) (*Sink, error) {
+	opts, err := ClientOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// per src_type topics are created by the brokers allowing it
+	client, err := kgo.NewClient(append(opts, kgo.AllowAutoTopicCreation())...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka client: %w", err)
+	}
+	return &Sink{Client: client, TopicPrefix: cfg.Kafka.Sink.TopicPrefix}, nil
+}
+
+// Record returns the message of an event of a tdf_object
+func (s *Sink) Record(event string, obj *tdf_objectv1.TdfObject) (*kgo.Record, error) {
+	value, err := proto.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tdf_object: %w", err)
+	}
+	return &kgo.Record{
+		Topic:   s.TopicPrefix + obj.SrcType,
+		Key:     []byte(obj.Id),
+		Value:   value,
+		Headers: []kgo.RecordHeader{{Key: EventHeader, Value: []byte(event)}},
+	}, nil
+}
+
+// Publish publishes an event of a tdf_object without waiting for the brokers
+func (s *Sink) Publish(ctx context.Context, event string, obj *tdf_objectv1.TdfObject) {
+	r, err := s.Record(event, obj)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to publish tdf_object to Kafka", slog.String("id", obj.Id), slog.String("error", err.Error()))
+		return
+	}
+	s.Client.Produce(context.WithoutCancel(ctx), r, func(r *kgo.Record, err error) {
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to publish tdf_object to Kafka",
+				slog.String("id", obj.Id),
+				slog.String("topic", r.Topic),
+				slog.String("event", event),
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// Close flushes the pending messages and closes the client
+func (s *Sink) Close(ctx context.Context) {
+	if err := s.Client.Flush(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to flush Kafka sink", slog.String("error", err.Error()))
+	}
+	s.Client.Close()
+}
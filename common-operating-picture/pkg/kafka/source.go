@@ -0,0 +1,160 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/twmb/franz-go/pkg/kgo"
+	tdf_objectv1 "github.com/virtru-corp/dsp-cop/api/proto/tdf_object/v1"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"google.golang.org/protobuf/proto"
+)
+
+// retryInterval is the time between attempts to store a batch failing with a transient error
+const retryInterval = 5 * time.Second
+
+// ErrPermanent wraps the errors of messages which are dropped rather than retried
+var ErrPermanent = errors.New("permanent error")
+
+// Source stores the protobuf CreateTdfObjectRequest messages of topics as tdf_objects, with at-least-once semantics:
+// the offsets of a batch are committed once its messages are stored, or dropped when invalid, so the messages of a
+// server stopping before storing them are consumed again by the consumer group. Messages failing to store with a
+// transient error, such as the database being unavailable, are retried until stored.
+type Source struct {
+	Client *kgo.Client
+	// Maximum number of messages of a batch
+	BatchSize int
+	// Params returns the CreateTdfObjects parameters of a request, errors wrapping ErrPermanent are not retried
+	Params func(ctx context.Context, req *tdf_objectv1.CreateTdfObjectRequest) (db.CreateTdfObjectsParams, error)
+	// Insert inserts tdf_objects, returning the error of every tdf_object
+	Insert func(ctx context.Context, params []db.CreateTdfObjectsParams) []error
+}
+
+// NewSource returns a source consuming the configured topics in the configured consumer group
+func NewSource(cfg *config.Config) (*Source, error) {
+	if len(cfg.Kafka.Source.Topics) == 0 {
+		return nil, errors.New("no Kafka source topics configured")
+	}
+	opts, err := ClientOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	client, err := kgo.NewClient(append(opts,
+		kgo.ConsumerGroup(cfg.Kafka.Source.Group),
+		kgo.ConsumeTopics(cfg.Kafka.Source.Topics...),
+		kgo.DisableAutoCommit(),
+		// the partitions of a batch are not revoked before its offsets are committed
+		kgo.BlockRebalanceOnPoll(),
+	)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka client: %w", err)
+	}
+	return &Source{Client: client, BatchSize: cfg.Kafka.Source.BatchSize}, nil
+}
+
+// Run stores the messages of the topics in batches until the context is done, then closes the client
+func (s *Source) Run(ctx context.Context) {
+	defer s.Client.Close()
+
+	for {
+		fetches := s.Client.PollRecords(ctx, s.BatchSize)
+		if fetches.IsClientClosed() || ctx.Err() != nil {
+			return
+		}
+		fetches.EachError(func(topic string, partition int32, err error) {
+			slog.ErrorContext(ctx, "failed to fetch Kafka messages",
+				slog.String("topic", topic),
+				slog.Int("partition", int(partition)),
+				slog.String("error", err.Error()),
+			)
+		})
+
+		batch := fetches.Records()
+		for pending := s.store(ctx, batch); len(pending) > 0; pending = s.store(ctx, pending) {
+			slog.WarnContext(ctx, "retrying Kafka messages", slog.Int("count", len(pending)))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryInterval):
+			}
+		}
+
+		// an uncommitted batch is consumed again, the tdf_objects are stored at least once
+		if len(batch) > 0 {
+			if err := s.Client.CommitRecords(ctx, batch...); err != nil {
+				slog.ErrorContext(ctx, "failed to commit Kafka offsets", slog.String("error", err.Error()))
+			}
+		}
+		s.Client.AllowRebalance()
+	}
+}
+
+// store stores a batch of messages, returning the messages failing with a transient error
+func (s *Source) store(ctx context.Context, batch []*kgo.Record) []*kgo.Record {
+	var pending []*kgo.Record
+	messages := make([]*kgo.Record, 0, len(batch))
+	params := make([]db.CreateTdfObjectsParams, 0, len(batch))
+	for _, r := range batch {
+		p, err := s.params(ctx, r)
+		switch {
+		case errors.Is(err, ErrPermanent):
+			slog.WarnContext(ctx, "dropping Kafka message", recordAttrs(r, err)...)
+		case err != nil:
+			slog.ErrorContext(ctx, "failed to store Kafka message", recordAttrs(r, err)...)
+			pending = append(pending, r)
+		default:
+			messages = append(messages, r)
+			params = append(params, p)
+		}
+	}
+	if len(params) == 0 {
+		return pending
+	}
+
+	errs := s.Insert(ctx, params)
+	if errors.Join(errs...) == nil {
+		slog.DebugContext(ctx, "stored Kafka messages", slog.Int("count", len(messages)))
+		return pending
+	}
+
+	// a failing tdf_object aborts the whole batch, insert every tdf_object on its own to find the failing ones
+	for i, r := range messages {
+		err := errors.Join(s.Insert(ctx, params[i:i+1])...)
+		var pgErr *pgconn.PgError
+		switch {
+		case err == nil:
+		case errors.As(err, &pgErr):
+			slog.WarnContext(ctx, "dropping Kafka message", recordAttrs(r, err)...)
+		default:
+			slog.ErrorContext(ctx, "failed to store Kafka message", recordAttrs(r, err)...)
+			pending = append(pending, r)
+		}
+	}
+	return pending
+}
+
+// params returns the CreateTdfObjects parameters of a message
+func (s *Source) params(ctx context.Context, r *kgo.Record) (db.CreateTdfObjectsParams, error) {
+	req := &tdf_objectv1.CreateTdfObjectRequest{}
+	if err := proto.Unmarshal(r.Value, req); err != nil {
+		return db.CreateTdfObjectsParams{}, fmt.Errorf("%w: invalid CreateTdfObjectRequest: %w", ErrPermanent, err)
+	}
+	if req.SrcType == "" {
+		return db.CreateTdfObjectsParams{}, fmt.Errorf("%w: src_type is required", ErrPermanent)
+	}
+	return s.Params(ctx, req)
+}
+
+func recordAttrs(r *kgo.Record, err error) []any {
+	return []any{
+		slog.String("topic", r.Topic),
+		slog.Int("partition", int(r.Partition)),
+		slog.Int64("offset", r.Offset),
+		slog.String("error", err.Error()),
+	}
+}
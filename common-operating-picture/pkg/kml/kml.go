@@ -0,0 +1,254 @@
+package kml
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Namespace is the KML 2.2 namespace
+const Namespace = "http://www.opengis.net/kml/2.2"
+
+// KML is the root element of a KML file, holding a Document or a NetworkLink
+type KML struct {
+	XMLName     xml.Name     `xml:"kml"`
+	Namespace   string       `xml:"xmlns,attr"`
+	Document    *Document    `xml:"Document,omitempty"`
+	NetworkLink *NetworkLink `xml:"NetworkLink,omitempty"`
+}
+
+type Document struct {
+	Name       string      `xml:"name,omitempty"`
+	Styles     []Style     `xml:"Style"`
+	Placemarks []Placemark `xml:"Placemark"`
+}
+
+// Style is a shared icon style, referenced by the StyleURL "#<id>" of a Placemark
+type Style struct {
+	ID        string    `xml:"id,attr"`
+	IconStyle IconStyle `xml:"IconStyle"`
+}
+
+type IconStyle struct {
+	// aabbggrr color, see Color
+	Color string `xml:"color,omitempty"`
+	Icon  *Icon  `xml:"Icon,omitempty"`
+}
+
+type Icon struct {
+	Href string `xml:"href"`
+}
+
+type Placemark struct {
+	ID          string     `xml:"id,attr,omitempty"`
+	Name        string     `xml:"name,omitempty"`
+	Description string     `xml:"description,omitempty"`
+	TimeStamp   *TimeStamp `xml:"TimeStamp,omitempty"`
+	StyleURL    string     `xml:"styleUrl,omitempty"`
+	Geometry
+}
+
+type TimeStamp struct {
+	When string `xml:"when"`
+}
+
+// Geometry is a KML geometry, only one of its fields is set
+type Geometry struct {
+	Point         *Point         `xml:"Point,omitempty"`
+	LineString    *LineString    `xml:"LineString,omitempty"`
+	Polygon       *Polygon       `xml:"Polygon,omitempty"`
+	MultiGeometry *MultiGeometry `xml:"MultiGeometry,omitempty"`
+}
+
+type Point struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+type LineString struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+type Polygon struct {
+	OuterBoundary LinearRing   `xml:"outerBoundaryIs>LinearRing"`
+	InnerBoundary []LinearRing `xml:"innerBoundaryIs>LinearRing,omitempty"`
+}
+
+type LinearRing struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+type MultiGeometry struct {
+	Points      []Point      `xml:"Point"`
+	LineStrings []LineString `xml:"LineString"`
+	Polygons    []Polygon    `xml:"Polygon"`
+}
+
+// NetworkLink is a link a viewer refreshes on an interval
+type NetworkLink struct {
+	Name string `xml:"name,omitempty"`
+	Link Link   `xml:"Link"`
+}
+
+type Link struct {
+	Href            string `xml:"href"`
+	RefreshMode     string `xml:"refreshMode,omitempty"`
+	RefreshInterval int    `xml:"refreshInterval,omitempty"`
+}
+
+// NewTimeStamp returns the TimeStamp of a time
+func NewTimeStamp(t time.Time) *TimeStamp {
+	return &TimeStamp{When: t.UTC().Format(time.RFC3339)}
+}
+
+// NewNetworkLink returns a KML file of a NetworkLink refreshing href every interval seconds
+func NewNetworkLink(name, href string, interval int) KML {
+	return KML{
+		Namespace: Namespace,
+		NetworkLink: &NetworkLink{
+			Name: name,
+			Link: Link{Href: href, RefreshMode: "onInterval", RefreshInterval: interval},
+		},
+	}
+}
+
+// NewDocument returns a KML file of a Document
+func NewDocument(doc Document) KML {
+	return KML{Namespace: Namespace, Document: &doc}
+}
+
+// Write writes a KML file
+func Write(w io.Writer, k KML) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(k); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// WriteKMZ writes a KMZ file, a zip archive of the KML file as doc.kml
+func WriteKMZ(w io.Writer, k KML) error {
+	zw := zip.NewWriter(w)
+	f, err := zw.Create("doc.kml")
+	if err != nil {
+		return err
+	}
+	if err := Write(f, k); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// GeometryFromGeoJSON returns the KML geometry of a GeoJSON geometry
+func GeometryFromGeoJSON(geojson string) (Geometry, error) {
+	var g struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	}
+	if err := json.Unmarshal([]byte(geojson), &g); err != nil {
+		return Geometry{}, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+
+	switch g.Type {
+	case "Point":
+		var c []float64
+		if err := json.Unmarshal(g.Coordinates, &c); err != nil {
+			return Geometry{}, fmt.Errorf("invalid Point: %w", err)
+		}
+		return Geometry{Point: &Point{Coordinates: coordinates([][]float64{c})}}, nil
+	case "LineString":
+		var c [][]float64
+		if err := json.Unmarshal(g.Coordinates, &c); err != nil {
+			return Geometry{}, fmt.Errorf("invalid LineString: %w", err)
+		}
+		return Geometry{LineString: &LineString{Coordinates: coordinates(c)}}, nil
+	case "Polygon":
+		var c [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &c); err != nil {
+			return Geometry{}, fmt.Errorf("invalid Polygon: %w", err)
+		}
+		return Geometry{Polygon: polygon(c)}, nil
+	case "MultiPoint":
+		var c [][]float64
+		if err := json.Unmarshal(g.Coordinates, &c); err != nil {
+			return Geometry{}, fmt.Errorf("invalid MultiPoint: %w", err)
+		}
+		m := &MultiGeometry{}
+		for _, p := range c {
+			m.Points = append(m.Points, Point{Coordinates: coordinates([][]float64{p})})
+		}
+		return Geometry{MultiGeometry: m}, nil
+	case "MultiLineString":
+		var c [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &c); err != nil {
+			return Geometry{}, fmt.Errorf("invalid MultiLineString: %w", err)
+		}
+		m := &MultiGeometry{}
+		for _, l := range c {
+			m.LineStrings = append(m.LineStrings, LineString{Coordinates: coordinates(l)})
+		}
+		return Geometry{MultiGeometry: m}, nil
+	case "MultiPolygon":
+		var c [][][][]float64
+		if err := json.Unmarshal(g.Coordinates, &c); err != nil {
+			return Geometry{}, fmt.Errorf("invalid MultiPolygon: %w", err)
+		}
+		m := &MultiGeometry{}
+		for _, p := range c {
+			m.Polygons = append(m.Polygons, *polygon(p))
+		}
+		return Geometry{MultiGeometry: m}, nil
+	default:
+		return Geometry{}, fmt.Errorf("unsupported GeoJSON type %q", g.Type)
+	}
+}
+
+func polygon(rings [][][]float64) *Polygon {
+	p := &Polygon{}
+	for i, r := range rings {
+		if i == 0 {
+			p.OuterBoundary = LinearRing{Coordinates: coordinates(r)}
+			continue
+		}
+		p.InnerBoundary = append(p.InnerBoundary, LinearRing{Coordinates: coordinates(r)})
+	}
+	return p
+}
+
+// coordinates returns KML coordinates, lon,lat[,alt] tuples separated by spaces
+func coordinates(positions [][]float64) string {
+	tuples := make([]string, 0, len(positions))
+	for _, p := range positions {
+		values := make([]string, 0, len(p))
+		for _, v := range p {
+			values = append(values, strconv.FormatFloat(v, 'f', -1, 64))
+		}
+		tuples = append(tuples, strings.Join(values, ","))
+	}
+	return strings.Join(tuples, " ")
+}
+
+// Color returns the KML aabbggrr color of a CSS #rrggbb or #rgb color
+func Color(css string) (string, bool) {
+	hex := strings.TrimPrefix(strings.TrimSpace(css), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return "", false
+	}
+	if _, err := strconv.ParseUint(hex, 16, 32); err != nil {
+		return "", false
+	}
+	hex = strings.ToLower(hex)
+	return "ff" + hex[4:6] + hex[2:4] + hex[0:2], true
+}
@@ -0,0 +1,68 @@
+package kml
+
+import (
+	"strings"
+	"testing"
+)
+
+var Test_GeometryFromGeoJSONTests = []struct {
+	test string
+
+	geojson string
+	want    string
+	wantErr bool
+}{
+	{
+		test:    "point",
+		geojson: `{"type": "Point", "coordinates": [-77.03, 38.89]}`,
+		want:    "<Point><coordinates>-77.03,38.89</coordinates></Point>",
+	},
+	{
+		test:    "polygon with hole",
+		geojson: `{"type": "Polygon", "coordinates": [[[0, 0], [4, 0], [4, 4], [0, 0]], [[1, 1], [2, 1], [2, 2], [1, 1]]]}`,
+		want: "<Polygon><outerBoundaryIs><LinearRing><coordinates>0,0 4,0 4,4 0,0</coordinates></LinearRing></outerBoundaryIs>" +
+			"<innerBoundaryIs><LinearRing><coordinates>1,1 2,1 2,2 1,1</coordinates></LinearRing></innerBoundaryIs></Polygon>",
+	},
+	{
+		test:    "multipoint",
+		geojson: `{"type": "MultiPoint", "coordinates": [[1, 2, 3], [4, 5]]}`,
+		want:    "<MultiGeometry><Point><coordinates>1,2,3</coordinates></Point><Point><coordinates>4,5</coordinates></Point></MultiGeometry>",
+	},
+	{
+		test:    "unsupported",
+		geojson: `{"type": "GeometryCollection", "geometries": []}`,
+		wantErr: true,
+	},
+}
+
+func Test_GeometryFromGeoJSON(t *testing.T) {
+	for _, tt := range Test_GeometryFromGeoJSONTests {
+		t.Run(tt.test, func(t *testing.T) {
+			g, err := GeometryFromGeoJSON(tt.geojson)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GeometryFromGeoJSON() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			var b strings.Builder
+			if err := Write(&b, NewDocument(Document{Placemarks: []Placemark{{Geometry: g}}})); err != nil {
+				t.Fatal(err)
+			}
+			got := strings.Join(strings.Fields(b.String()), "")
+			if !strings.Contains(got, strings.ReplaceAll(tt.want, " ", "")) {
+				t.Errorf("GeometryFromGeoJSON() = %s; want %s", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func Test_Color(t *testing.T) {
+	for css, want := range map[string]string{"#2a81cb": "ffcb812a", "#F00": "ff0000ff", "red": "", "#Ccb2b3e": ""} {
+		got, ok := Color(css)
+		if got != want || ok != (want != "") {
+			t.Errorf("Color(%q) = %q, %v; want %q", css, got, ok, want)
+		}
+	}
+}
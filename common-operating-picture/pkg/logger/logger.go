@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"fmt"
 	"log/slog"
 	"os"
 	"strings"
@@ -19,19 +20,9 @@ func init() {
 }
 
 func SetLevel(level string) {
-	var lvl slog.Level
-	switch strings.ToUpper(level) {
-	case "DEBUG":
-		lvl = slog.LevelDebug
-	case "INFO":
-		lvl = slog.LevelInfo
-	case "WARN":
-		lvl = slog.LevelWarn
-	case "ERROR":
-		lvl = slog.LevelError
-	default:
+	lvl, err := ParseLevel(level)
+	if err != nil {
 		slog.Warn("Unknown log level", slog.String("level", level))
-		lvl = slog.LevelInfo
 	}
 
 	logger = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
@@ -40,3 +31,18 @@ func SetLevel(level string) {
 
 	slog.SetDefault(logger)
 }
+
+// ParseLevel returns the level of a name of the config, INFO with an error for an unknown name
+func ParseLevel(level string) (slog.Level, error) {
+	switch strings.ToUpper(level) {
+	case "DEBUG":
+		return slog.LevelDebug, nil
+	case "INFO":
+		return slog.LevelInfo, nil
+	case "WARN":
+		return slog.LevelWarn, nil
+	case "ERROR":
+		return slog.LevelError, nil
+	}
+	return slog.LevelInfo, fmt.Errorf("unknown log level %s", level)
+}
@@ -0,0 +1,383 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"github.com/virtru-corp/dsp-cop/pkg/records"
+	"github.com/virtru-corp/dsp-cop/pkg/tdf"
+)
+
+const (
+	// retryInterval is the time between attempts to store a batch failing with a transient error
+	retryInterval = 5 * time.Second
+	// fieldsTTL is how long the fields of a src_type are cached
+	fieldsTTL = time.Minute
+)
+
+// errPermanent wraps the errors of messages which are dropped rather than retried
+var errPermanent = errors.New("permanent error")
+
+// Bridge stores the JSON payloads of MQTT messages as tdf_objects of the src_type of the first topic matching their
+// topic. The payload is indexed by the fields of the src_type metadata, see records.Payload, and wrapped in a TDF of
+// the attribute values of its attr fields, or of the topic's attributes when it has none.
+//
+// Messages are acknowledged once stored, or once dropped when invalid, so messages of QoS 1 and 2 are redelivered by
+// the broker when the bridge stops before storing them. Payloads failing to store with a transient error, such as
+// the database or KAS being unavailable, are retried until stored.
+type Bridge struct {
+	Topics    []config.MQTTTopic
+	Encrypter tdf.Encrypter
+	TDFType   int
+	// Maximum number of payloads of a batch and time a payload waits for its batch to fill
+	BatchSize int
+	BatchWait time.Duration
+	// Fields returns the fields of a src_type
+	Fields func(ctx context.Context, srcType string) (records.SrcTypeFields, error)
+	// Insert inserts tdf_objects, returning the error of every tdf_object
+	Insert func(ctx context.Context, params []db.CreateTdfObjectsParams) []error
+
+	messages chan paho.Message
+	once     sync.Once
+}
+
+// Match reports whether a topic matches a topic filter with + (single level) and # (multi level) wildcards. The
+// $share/<group>/ prefix of a shared subscription is ignored.
+func Match(filter, topic string) bool {
+	if strings.HasPrefix(filter, "$share/") {
+		parts := strings.SplitN(filter, "/", 3)
+		if len(parts) < 3 {
+			return false
+		}
+		filter = parts[2]
+	}
+	// wildcards at the first level do not match topics starting with $, such as $SYS
+	if strings.HasPrefix(topic, "$") && (strings.HasPrefix(filter, "+") || strings.HasPrefix(filter, "#")) {
+		return false
+	}
+
+	f := strings.Split(filter, "/")
+	t := strings.Split(topic, "/")
+	for i, level := range f {
+		if level == "#" {
+			return i == len(f)-1
+		}
+		if i >= len(t) || (level != "+" && level != t[i]) {
+			return false
+		}
+	}
+	return len(f) == len(t)
+}
+
+// topic returns the first topic of the bridge matching the topic of a message
+func (b *Bridge) topic(name string) (config.MQTTTopic, bool) {
+	for _, t := range b.Topics {
+		if Match(t.Filter, name) {
+			return t, true
+		}
+	}
+	return config.MQTTTopic{}, false
+}
+
+func (b *Bridge) init() {
+	b.once.Do(func() {
+		if b.BatchSize <= 0 {
+			b.BatchSize = 1
+		}
+		b.messages = make(chan paho.Message, b.BatchSize)
+	})
+}
+
+// Subscriptions returns the topic filters of the bridge and their QoS, as subscribed by Serve
+func (b *Bridge) Subscriptions(qos byte) map[string]byte {
+	filters := make(map[string]byte, len(b.Topics))
+	for _, t := range b.Topics {
+		filters[t.Filter] = qos
+	}
+	return filters
+}
+
+// Serve connects to the broker of the client options, retrying until connected, subscribes to the topics of the
+// bridge at every connection and stores the payloads of the messages until the context is done
+func (b *Bridge) Serve(ctx context.Context, opts *paho.ClientOptions, qos byte) {
+	b.init()
+
+	opts.SetAutoAckDisabled(true).
+		SetOrderMatters(false).
+		SetDefaultPublishHandler(func(_ paho.Client, m paho.Message) {
+			select {
+			case b.messages <- m:
+			case <-ctx.Done():
+			}
+		}).
+		SetOnConnectHandler(func(c paho.Client) {
+			slog.InfoContext(ctx, "connected to MQTT broker")
+			// subscriptions are not resumed by a clean session, subscribe again at every connection. Messages are
+			// routed by the default handler so that a message matching several filters is stored once.
+			t := c.SubscribeMultiple(b.Subscriptions(qos), nil)
+			t.Wait()
+			if err := t.Error(); err != nil {
+				slog.ErrorContext(ctx, "failed to subscribe to MQTT topics", slog.String("error", err.Error()))
+			}
+		}).
+		SetConnectionLostHandler(func(_ paho.Client, err error) {
+			slog.WarnContext(ctx, "lost connection to MQTT broker", slog.String("error", err.Error()))
+		}).
+		SetReconnectingHandler(func(_ paho.Client, _ *paho.ClientOptions) {
+			slog.DebugContext(ctx, "reconnecting to MQTT broker")
+		})
+
+	c := paho.NewClient(opts)
+	t := c.Connect()
+	go func() {
+		// with ConnectRetry the token completes once connected
+		t.Wait()
+		if err := t.Error(); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to connect to MQTT broker", slog.String("error", err.Error()))
+		}
+	}()
+	defer c.Disconnect(250)
+
+	b.run(ctx)
+}
+
+// run stores the messages in batches until the context is done
+func (b *Bridge) run(ctx context.Context) {
+	batch := make([]paho.Message, 0, b.BatchSize)
+	timer := time.NewTimer(b.BatchWait)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case m := <-b.messages:
+			if len(batch) == 0 {
+				timer.Reset(b.BatchWait)
+			}
+			batch = append(batch, m)
+			if len(batch) < b.BatchSize {
+				continue
+			}
+			timer.Stop()
+		case <-timer.C:
+		}
+
+		for pending := b.store(ctx, batch); len(pending) > 0; pending = b.store(ctx, pending) {
+			slog.WarnContext(ctx, "retrying MQTT messages", slog.Int("count", len(pending)))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryInterval):
+			}
+		}
+		batch = batch[:0]
+	}
+}
+
+// store stores a batch of messages, acknowledging the messages stored or dropped and returning the messages failing
+// with a transient error
+func (b *Bridge) store(ctx context.Context, batch []paho.Message) []paho.Message {
+	var pending []paho.Message
+	messages := make([]paho.Message, 0, len(batch))
+	params := make([]db.CreateTdfObjectsParams, 0, len(batch))
+	for _, m := range batch {
+		p, err := b.params(ctx, m)
+		switch {
+		case errors.Is(err, errPermanent):
+			slog.WarnContext(ctx, "dropping MQTT message", slog.String("topic", m.Topic()), slog.String("error", err.Error()))
+			m.Ack()
+		case err != nil:
+			slog.ErrorContext(ctx, "failed to store MQTT message", slog.String("topic", m.Topic()), slog.String("error", err.Error()))
+			pending = append(pending, m)
+		default:
+			messages = append(messages, m)
+			params = append(params, p)
+		}
+	}
+	if len(params) == 0 {
+		return pending
+	}
+
+	errs := b.Insert(ctx, params)
+	if errors.Join(errs...) == nil {
+		for _, m := range messages {
+			m.Ack()
+		}
+		slog.DebugContext(ctx, "stored MQTT messages", slog.Int("count", len(messages)))
+		return pending
+	}
+
+	// a failing tdf_object aborts the whole batch, insert every tdf_object on its own to find the failing ones
+	for i, m := range messages {
+		err := errors.Join(b.Insert(ctx, params[i:i+1])...)
+		var pgErr *pgconn.PgError
+		switch {
+		case err == nil:
+			m.Ack()
+		case errors.As(err, &pgErr) && permanentPgError(pgErr):
+			slog.WarnContext(ctx, "dropping MQTT message", slog.String("topic", m.Topic()), slog.String("error", err.Error()))
+			m.Ack()
+		default:
+			slog.ErrorContext(ctx, "failed to store MQTT message", slog.String("topic", m.Topic()), slog.String("error", err.Error()))
+			pending = append(pending, m)
+		}
+	}
+	return pending
+}
+
+// permanentPgError reports whether a database error is caused by the tdf_object, invalid data or a constraint
+// violation, and fails again when retried. Serialization failures, deadlocks, connection and resource errors are not.
+func permanentPgError(err *pgconn.PgError) bool {
+	return pgerrcode.IsDataException(err.Code) || pgerrcode.IsIntegrityConstraintViolation(err.Code)
+}
+
+// withSearchAttributes returns search with the search attributes of the attribute values
+func withSearchAttributes(search json.RawMessage, attrValues []string) (json.RawMessage, error) {
+	var fields map[string]interface{}
+	if len(search) > 0 {
+		if err := json.Unmarshal(search, &fields); err != nil {
+			return nil, fmt.Errorf("invalid search: %w", err)
+		}
+	}
+	if fields == nil {
+		fields = make(map[string]interface{})
+	}
+	for k, attrs := range tdf.SearchAttributes(attrValues) {
+		fields[k] = attrs
+	}
+	return json.Marshal(fields)
+}
+
+// params returns the CreateTdfObjects parameters of a message, errors wrapping errPermanent are not retried
+func (b *Bridge) params(ctx context.Context, m paho.Message) (db.CreateTdfObjectsParams, error) {
+	t, ok := b.topic(m.Topic())
+	if !ok {
+		return db.CreateTdfObjectsParams{}, fmt.Errorf("%w: no topic matches %s", errPermanent, m.Topic())
+	}
+	srcType := strings.ToLower(t.SrcType)
+
+	fields, err := b.Fields(ctx, srcType)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.CreateTdfObjectsParams{}, fmt.Errorf("%w: src_type %s not found", errPermanent, srcType)
+	} else if err != nil {
+		return db.CreateTdfObjectsParams{}, fmt.Errorf("failed to get src_type %s: %w", srcType, err)
+	}
+
+	r, attrValues, err := records.Payload(srcType, fields, m.Payload())
+	if err != nil {
+		return db.CreateTdfObjectsParams{}, fmt.Errorf("%w: %w", errPermanent, err)
+	}
+	if len(attrValues) == 0 && len(t.Attributes) > 0 {
+		// the topic attributes are not in the payload, index them as the search attributes entitlements are checked
+		// against
+		attrValues = t.Attributes
+		if r.Search, err = withSearchAttributes(r.Search, attrValues); err != nil {
+			return db.CreateTdfObjectsParams{}, fmt.Errorf("%w: %w", errPermanent, err)
+		}
+	}
+
+	blob, err := b.Encrypter.EncryptBytes(m.Payload(), attrValues, b.TDFType)
+	if err != nil {
+		return db.CreateTdfObjectsParams{}, fmt.Errorf("failed to encrypt payload: %w", err)
+	}
+	r.TdfBlob = blob.Bytes()
+
+	params, err := r.CreateParams("")
+	if err != nil {
+		return db.CreateTdfObjectsParams{}, fmt.Errorf("%w: %w", errPermanent, err)
+	}
+	return params, nil
+}
+
+// ClientOptions returns the options of a client of the configured broker, reconnecting when the connection is lost
+func ClientOptions(cfg *config.Config) *paho.ClientOptions {
+	return paho.NewClientOptions().
+		AddBroker(cfg.MQTT.Broker).
+		SetClientID(cfg.MQTT.ClientID).
+		SetUsername(cfg.MQTT.Username).
+		SetPassword(cfg.MQTT.Password).
+		SetCleanSession(cfg.MQTT.CleanSession).
+		SetAutoReconnect(true).
+		SetMaxReconnectInterval(time.Minute).
+		SetConnectRetry(true).
+		SetConnectRetryInterval(retryInterval)
+}
+
+// Run bridges the configured topics to tdf_objects until the context is done, looking up the fields of the src_types
+// and inserting the tdf_objects in the database
+func Run(ctx context.Context, q *db.Queries, cfg *config.Config, enc tdf.Encrypter) {
+	if len(cfg.MQTT.Topics) == 0 {
+		slog.WarnContext(ctx, "MQTT bridge enabled without topics")
+		return
+	}
+	tdfType := tdf.ZTDF
+	if cfg.MQTT.NanoTDF {
+		tdfType = tdf.NanoTDF
+	}
+
+	b := &Bridge{
+		Topics:    cfg.MQTT.Topics,
+		Encrypter: enc,
+		TDFType:   tdfType,
+		BatchSize: cfg.MQTT.BatchSize,
+		BatchWait: cfg.MQTT.BatchWait,
+		Fields:    srcTypeFields(q),
+		Insert: func(ctx context.Context, params []db.CreateTdfObjectsParams) []error {
+			errs := make([]error, len(params))
+			q.CreateTdfObjects(ctx, params).QueryRow(func(i int, _ uuid.UUID, err error) {
+				errs[i] = err
+			})
+			return errs
+		},
+	}
+
+	slog.InfoContext(ctx, "starting MQTT bridge",
+		slog.String("broker", cfg.MQTT.Broker),
+		slog.String("client_id", cfg.MQTT.ClientID),
+		slog.Int("topics", len(cfg.MQTT.Topics)),
+	)
+	b.Serve(ctx, ClientOptions(cfg), byte(cfg.MQTT.QoS))
+}
+
+// srcTypeFields returns a lookup of the fields of src_types caching them for fieldsTTL
+func srcTypeFields(q *db.Queries) func(ctx context.Context, srcType string) (records.SrcTypeFields, error) {
+	type cached struct {
+		fields  records.SrcTypeFields
+		expires time.Time
+	}
+	var mu sync.Mutex
+	cache := make(map[string]cached)
+
+	return func(ctx context.Context, srcType string) (records.SrcTypeFields, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if c, ok := cache[srcType]; ok && time.Now().Before(c.expires) {
+			return c.fields, nil
+		}
+
+		st, err := q.GetSrcType(ctx, srcType)
+		if err != nil {
+			return records.SrcTypeFields{}, err
+		}
+		fields, err := records.ParseSrcTypeFields(st.Metadata)
+		if err != nil {
+			return records.SrcTypeFields{}, fmt.Errorf("%w: %w", errPermanent, err)
+		}
+		cache[srcType] = cached{fields: fields, expires: time.Now().Add(fieldsTTL)}
+		return fields, nil
+	}
+}
@@ -0,0 +1,154 @@
+package mqtt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+	"github.com/virtru-corp/dsp-cop/pkg/records"
+)
+
+var Test_MatchTests = []struct {
+	filter string
+	topic  string
+	want   bool
+}{
+	{"sensors/a/position", "sensors/a/position", true},
+	{"sensors/a/position", "sensors/b/position", false},
+	{"sensors/+/position", "sensors/b/position", true},
+	{"sensors/+/position", "sensors/b/c/position", false},
+	{"sensors/+", "sensors", false},
+	{"sensors/#", "sensors", true},
+	{"sensors/#", "sensors/b/c/position", true},
+	{"#", "sensors/b", true},
+	{"#", "$SYS/broker/uptime", false},
+	{"+/b", "/b", true},
+	{"$share/cop/sensors/+", "sensors/b", true},
+	{"sensors/#/position", "sensors/b/position", false},
+}
+
+func Test_Match(t *testing.T) {
+	for _, tt := range Test_MatchTests {
+		if got := Match(tt.filter, tt.topic); got != tt.want {
+			t.Errorf("Match(%q, %q) = %v; want %v", tt.filter, tt.topic, got, tt.want)
+		}
+	}
+}
+
+var Test_permanentPgErrorTests = []struct {
+	code string
+	want bool
+}{
+	{pgerrcode.NotNullViolation, true},
+	{pgerrcode.InvalidTextRepresentation, true},
+	{pgerrcode.SerializationFailure, false},
+	{pgerrcode.DeadlockDetected, false},
+	{pgerrcode.AdminShutdown, false},
+	{pgerrcode.TooManyConnections, false},
+}
+
+func Test_permanentPgError(t *testing.T) {
+	for _, tt := range Test_permanentPgErrorTests {
+		if got := permanentPgError(&pgconn.PgError{Code: tt.code}); got != tt.want {
+			t.Errorf("permanentPgError(%s) = %v; want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func Test_withSearchAttributes(t *testing.T) {
+	attrValues := []string{"https://demo.com/attr/classification/value/secret"}
+	for _, search := range []string{`{"serial":"S-1"}`, `null`, ``} {
+		got, err := withSearchAttributes(json.RawMessage(search), attrValues)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(got, &fields); err != nil {
+			t.Fatal(err)
+		}
+		if c, _ := fields["attrClassification"].([]interface{}); len(c) != 1 {
+			t.Errorf("withSearchAttributes(%q) = %s", search, got)
+		}
+		if search == `{"serial":"S-1"}` && fields["serial"] != "S-1" {
+			t.Errorf("withSearchAttributes(%q) = %s", search, got)
+		}
+	}
+}
+
+type testEncrypter struct{}
+
+func (testEncrypter) EncryptBytes(b []byte, _ []string, _ int) (*bytes.Buffer, error) {
+	return bytes.NewBuffer(b), nil
+}
+
+// Test_Bridge runs against the broker of DSP_COP_TEST_MQTT_BROKER, e.g. tcp://localhost:1883 of the mqtt profile
+// of docker-compose.dev.yaml
+func Test_Bridge(t *testing.T) {
+	broker := os.Getenv("DSP_COP_TEST_MQTT_BROKER")
+	if broker == "" {
+		t.Skip("DSP_COP_TEST_MQTT_BROKER is not set")
+	}
+
+	var mu sync.Mutex
+	var inserted []db.CreateTdfObjectsParams
+	b := &Bridge{
+		Topics:    []config.MQTTTopic{{Filter: "dsp-cop-test/+/position", SrcType: "Sensors"}},
+		Encrypter: testEncrypter{},
+		BatchSize: 10,
+		BatchWait: 100 * time.Millisecond,
+		Fields: func(_ context.Context, srcType string) (records.SrcTypeFields, error) {
+			return records.SrcTypeFields{EntityField: "serial", SearchFields: []string{"serial"}}, nil
+		},
+		Insert: func(_ context.Context, params []db.CreateTdfObjectsParams) []error {
+			mu.Lock()
+			defer mu.Unlock()
+			inserted = append(inserted, params...)
+			return make([]error, len(params))
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go b.Serve(ctx, paho.NewClientOptions().AddBroker(broker).SetClientID("dsp-cop-test-bridge").SetCleanSession(true), 1)
+
+	pub := paho.NewClient(paho.NewClientOptions().AddBroker(broker).SetClientID("dsp-cop-test-publisher"))
+	if tok := pub.Connect(); tok.WaitTimeout(5*time.Second) && tok.Error() != nil {
+		t.Fatal(tok.Error())
+	}
+	defer pub.Disconnect(250)
+
+	// publish until the bridge has subscribed and stored a payload
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		pub.Publish("dsp-cop-test/other/status", 1, false, `{"serial": "S-0"}`).Wait()
+		pub.Publish("dsp-cop-test/s1/position", 1, false, `{"serial": "S-1"}`).Wait()
+		time.Sleep(200 * time.Millisecond)
+
+		mu.Lock()
+		n := len(inserted)
+		mu.Unlock()
+		if n > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("no payload stored")
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, p := range inserted {
+		if p.SrcType != "sensors" || p.EntityKey.String != "S-1" || string(p.Search) != `{"serial":"S-1"}` || string(p.TdfBlob) != `{"serial": "S-1"}` {
+			t.Errorf("inserted %+v", p)
+		}
+	}
+}
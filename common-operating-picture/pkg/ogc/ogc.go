@@ -0,0 +1,308 @@
+package ogc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/virtru-corp/dsp-cop/pkg/geo"
+)
+
+// CRS84 is the only CRS of the collections, longitude and latitude on WGS 84
+const CRS84 = "http://www.opengis.net/def/crs/OGC/1.3/CRS84"
+
+// ConformsTo are the conformance classes of OGC API - Features implemented
+var ConformsTo = []string{
+	"http://www.opengis.net/spec/ogcapi-features-1/1.0/conf/core",
+	"http://www.opengis.net/spec/ogcapi-features-1/1.0/conf/geojson",
+	"http://www.opengis.net/spec/ogcapi-features-3/1.0/conf/queryables",
+	"http://www.opengis.net/spec/ogcapi-features-3/1.0/conf/queryables-query-parameters",
+}
+
+// Media types of the responses
+const (
+	MediaTypeJSON    = "application/json"
+	MediaTypeGeoJSON = "application/geo+json"
+	MediaTypeSchema  = "application/schema+json"
+)
+
+// Link is a link of a resource
+type Link struct {
+	Href  string `json:"href"`
+	Rel   string `json:"rel"`
+	Type  string `json:"type,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
+// LandingPage is the root resource of the API
+type LandingPage struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Links       []Link `json:"links"`
+}
+
+// Conformance lists the conformance classes of the API
+type Conformance struct {
+	ConformsTo []string `json:"conformsTo"`
+}
+
+// Collection is a collection of features, one per src_type
+type Collection struct {
+	ID          string   `json:"id"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	ItemType    string   `json:"itemType"`
+	CRS         []string `json:"crs"`
+	Links       []Link   `json:"links"`
+}
+
+// Collections lists the collections of the API
+type Collections struct {
+	Links       []Link       `json:"links"`
+	Collections []Collection `json:"collections"`
+}
+
+// FeatureCollection is a page of the items of a collection. NumberMatched is optional, and left out when zero.
+type FeatureCollection struct {
+	Type           string    `json:"type"`
+	Features       []Feature `json:"features"`
+	TimeStamp      string    `json:"timeStamp"`
+	NumberMatched  int       `json:"numberMatched,omitempty"`
+	NumberReturned int       `json:"numberReturned"`
+	Links          []Link    `json:"links"`
+}
+
+// Feature is an item of a collection
+type Feature struct {
+	geo.Feature
+	Links []Link `json:"links"`
+}
+
+// BBox is a bounding box of CRS84 coordinates
+type BBox struct {
+	MinLon, MinLat, MaxLon, MaxLat float64
+}
+
+// ParseBBox parses a bbox parameter of 4 (2D) or 6 (3D) comma separated numbers, the heights of a 3D box are
+// ignored. Boxes crossing the antimeridian are not supported.
+func ParseBBox(s string) (BBox, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 && len(parts) != 6 {
+		return BBox{}, errors.New("invalid bbox: 4 or 6 numbers are required")
+	}
+	values := make([]float64, len(parts))
+	for i, p := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil {
+			return BBox{}, fmt.Errorf("invalid bbox: %w", err)
+		}
+		values[i] = v
+	}
+	if len(values) == 6 {
+		values = []float64{values[0], values[1], values[3], values[4]}
+	}
+
+	b := BBox{MinLon: values[0], MinLat: values[1], MaxLon: values[2], MaxLat: values[3]}
+	switch {
+	case b.MinLat < -90 || b.MaxLat > 90 || b.MinLon < -180 || b.MaxLon > 180:
+		return BBox{}, errors.New("invalid bbox: coordinates out of range")
+	case b.MinLat > b.MaxLat:
+		return BBox{}, errors.New("invalid bbox: the minimum latitude is greater than the maximum")
+	case b.MinLon > b.MaxLon:
+		return BBox{}, errors.New("invalid bbox: boxes crossing the antimeridian are not supported")
+	}
+	return b, nil
+}
+
+// GeoJSON returns the GeoJSON Polygon of the box
+func (b BBox) GeoJSON() string {
+	return fmt.Sprintf(`{"type":"Polygon","coordinates":[[[%[1]g,%[2]g],[%[3]g,%[2]g],[%[3]g,%[4]g],[%[1]g,%[4]g],[%[1]g,%[2]g]]]}`,
+		b.MinLon, b.MinLat, b.MaxLon, b.MaxLat)
+}
+
+// ParseDatetime parses a datetime parameter, an RFC 3339 instant or an interval of two instants separated by a
+// slash, where an empty or ".." end is open. An open end is returned as nil.
+func ParseDatetime(s string) (start *time.Time, end *time.Time, err error) {
+	parse := func(v string) (*time.Time, error) {
+		if v == "" || v == ".." {
+			return nil, nil
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid datetime: %w", err)
+		}
+		return &t, nil
+	}
+
+	from, to, interval := strings.Cut(s, "/")
+	if start, err = parse(from); err != nil {
+		return nil, nil, err
+	}
+	if !interval {
+		if start == nil {
+			return nil, nil, errors.New("invalid datetime: an instant or interval is required")
+		}
+		return start, start, nil
+	}
+	if end, err = parse(to); err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case start == nil && end == nil:
+		return nil, nil, errors.New("invalid datetime: an interval with both ends open is not supported")
+	case start != nil && end != nil && start.After(*end):
+		return nil, nil, errors.New("invalid datetime: the start is after the end")
+	}
+	return start, end, nil
+}
+
+// queryableKeys are the JSON Schema keywords of a form_schema property kept in its queryable
+var queryableKeys = []string{"title", "description", "type", "format", "minimum", "maximum"}
+
+// Queryables returns the JSON Schema of the queryables of a collection: its primary geometry and instant, and the
+// search fields of its src_type described by their form_schema properties. The other fields of the form are only in
+// the encrypted payload and can not be queried.
+func Queryables(id, title string, formSchema map[string]interface{}, searchFields []string) map[string]interface{} {
+	properties := map[string]interface{}{
+		"geometry": map[string]interface{}{
+			"title":      "Geometry",
+			"format":     "geometry-any",
+			"x-ogc-role": "primary-geometry",
+		},
+		"ts": map[string]interface{}{
+			"title":      "Timestamp",
+			"type":       "string",
+			"format":     "date-time",
+			"x-ogc-role": "primary-instant",
+		},
+	}
+	for _, field := range searchFields {
+		properties[field] = queryable(formSchema, field)
+	}
+
+	return map[string]interface{}{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"$id":                  id,
+		"type":                 "object",
+		"title":                title,
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+// queryable returns the schema of a dot separated field of a form_schema, resolving local references
+func queryable(formSchema map[string]interface{}, field string) map[string]interface{} {
+	schema := formSchema
+	for _, key := range strings.Split(field, ".") {
+		properties, _ := schema["properties"].(map[string]interface{})
+		property, ok := properties[key].(map[string]interface{})
+		if !ok {
+			return map[string]interface{}{"title": field}
+		}
+		schema = resolveRef(formSchema, property)
+	}
+
+	q := make(map[string]interface{})
+	for _, k := range queryableKeys {
+		if v, ok := schema[k]; ok {
+			q[k] = v
+		}
+	}
+	// enums of objects, such as the countries of a location, are not queryable values
+	if enum, ok := schema["enum"].([]interface{}); ok && scalars(enum) {
+		q["enum"] = enum
+	}
+	if _, ok := q["title"]; !ok {
+		q["title"] = field
+	}
+	return q
+}
+
+// resolveRef merges the definition referenced by the $ref of a property into a copy of it, the keywords of the
+// property taking precedence
+func resolveRef(formSchema map[string]interface{}, property map[string]interface{}) map[string]interface{} {
+	ref, _ := property["$ref"].(string)
+	path, ok := strings.CutPrefix(ref, "#/")
+	if !ok {
+		return property
+	}
+	var target interface{} = formSchema
+	for _, key := range strings.Split(path, "/") {
+		m, ok := target.(map[string]interface{})
+		if !ok {
+			return property
+		}
+		target = m[key]
+	}
+	definition, ok := target.(map[string]interface{})
+	if !ok {
+		return property
+	}
+
+	resolved := make(map[string]interface{}, len(definition)+len(property))
+	for k, v := range definition {
+		resolved[k] = v
+	}
+	for k, v := range property {
+		resolved[k] = v
+	}
+	return resolved
+}
+
+func scalars(values []interface{}) bool {
+	for _, v := range values {
+		switch v.(type) {
+		case string, float64, bool:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// PropertyFilter returns the JSON search document of the queryable query parameters of a request, whose values are
+// converted to the type of their queryable. Dot separated fields are nested. An empty document is returned without
+// any queryable parameter.
+func PropertyFilter(queryables map[string]interface{}, params map[string][]string) ([]byte, error) {
+	properties, _ := queryables["properties"].(map[string]interface{})
+	doc := make(map[string]interface{})
+	for name, values := range params {
+		q, ok := properties[name].(map[string]interface{})
+		if !ok || name == "geometry" || name == "ts" || len(values) == 0 {
+			continue
+		}
+		v, err := queryableValue(q, values[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", name, err)
+		}
+
+		m := doc
+		keys := strings.Split(name, ".")
+		for _, key := range keys[:len(keys)-1] {
+			next, ok := m[key].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				m[key] = next
+			}
+			m = next
+		}
+		m[keys[len(keys)-1]] = v
+	}
+	if len(doc) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(doc)
+}
+
+func queryableValue(q map[string]interface{}, s string) (interface{}, error) {
+	switch q["type"] {
+	case "number", "integer":
+		return strconv.ParseFloat(s, 64)
+	case "boolean":
+		return strconv.ParseBool(s)
+	}
+	return s, nil
+}
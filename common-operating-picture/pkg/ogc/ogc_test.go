@@ -0,0 +1,223 @@
+package ogc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var Test_ParseBBoxTests = []struct {
+	test string
+
+	bbox    string
+	want    BBox
+	wantErr bool
+}{
+	{
+		test: "2D",
+		bbox: "-10,40.5,10,60",
+		want: BBox{MinLon: -10, MinLat: 40.5, MaxLon: 10, MaxLat: 60},
+	},
+	{
+		test: "3D",
+		bbox: "-10,40,0,10,60,100",
+		want: BBox{MinLon: -10, MinLat: 40, MaxLon: 10, MaxLat: 60},
+	},
+	{
+		test:    "too few numbers",
+		bbox:    "-10,40,10",
+		wantErr: true,
+	},
+	{
+		test:    "not a number",
+		bbox:    "-10,north,10,60",
+		wantErr: true,
+	},
+	{
+		test:    "out of range",
+		bbox:    "-10,40,10,95",
+		wantErr: true,
+	},
+	{
+		test:    "crossing the antimeridian",
+		bbox:    "170,40,-170,60",
+		wantErr: true,
+	},
+}
+
+func Test_ParseBBox(t *testing.T) {
+	for _, tt := range Test_ParseBBoxTests {
+		t.Run(tt.test, func(t *testing.T) {
+			got, err := ParseBBox(tt.bbox)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseBBox() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseBBox() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_BBoxGeoJSON(t *testing.T) {
+	got := BBox{MinLon: -10, MinLat: 40.5, MaxLon: 10, MaxLat: 60}.GeoJSON()
+	want := `{"type":"Polygon","coordinates":[[[-10,40.5],[10,40.5],[10,60],[-10,60],[-10,40.5]]]}`
+	if got != want {
+		t.Errorf("GeoJSON() = %s; want %s", got, want)
+	}
+}
+
+var Test_ParseDatetimeTests = []struct {
+	test string
+
+	datetime  string
+	wantStart string
+	wantEnd   string
+	wantErr   bool
+}{
+	{
+		test:      "instant",
+		datetime:  "2024-06-01T12:00:00Z",
+		wantStart: "2024-06-01T12:00:00Z",
+		wantEnd:   "2024-06-01T12:00:00Z",
+	},
+	{
+		test:      "interval",
+		datetime:  "2024-06-01T00:00:00Z/2024-06-02T00:00:00Z",
+		wantStart: "2024-06-01T00:00:00Z",
+		wantEnd:   "2024-06-02T00:00:00Z",
+	},
+	{
+		test:      "open end",
+		datetime:  "2024-06-01T00:00:00Z/..",
+		wantStart: "2024-06-01T00:00:00Z",
+	},
+	{
+		test:     "open start",
+		datetime: "/2024-06-02T00:00:00Z",
+		wantEnd:  "2024-06-02T00:00:00Z",
+	},
+	{
+		test:     "both ends open",
+		datetime: "../..",
+		wantErr:  true,
+	},
+	{
+		test:     "start after end",
+		datetime: "2024-06-02T00:00:00Z/2024-06-01T00:00:00Z",
+		wantErr:  true,
+	},
+	{
+		test:     "not RFC 3339",
+		datetime: "2024-06-01",
+		wantErr:  true,
+	},
+}
+
+func Test_ParseDatetime(t *testing.T) {
+	format := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+	for _, tt := range Test_ParseDatetimeTests {
+		t.Run(tt.test, func(t *testing.T) {
+			start, end, err := ParseDatetime(tt.datetime)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDatetime() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if got := format(start); got != tt.wantStart {
+				t.Errorf("ParseDatetime() start = %s; want %s", got, tt.wantStart)
+			}
+			if got := format(end); got != tt.wantEnd {
+				t.Errorf("ParseDatetime() end = %s; want %s", got, tt.wantEnd)
+			}
+		})
+	}
+}
+
+const testFormSchema = `{
+	"type": "object",
+	"definitions": {
+		"status": {"type": "string", "enum": ["active", "inactive"]},
+		"countries": {"enum": [{"country": "Albania", "latitude": 41, "longitude": 20}]}
+	},
+	"properties": {
+		"name": {"title": "Name", "type": "string", "placeholder": "Enter a name"},
+		"status": {"title": "Status", "$ref": "#/definitions/status"},
+		"placeOfBirth": {"title": "Place of Birth", "$ref": "#/definitions/countries"},
+		"vehicle": {"type": "object", "properties": {"speed": {"type": "number", "minimum": 0}}}
+	}
+}`
+
+func Test_Queryables(t *testing.T) {
+	var formSchema map[string]interface{}
+	if err := json.Unmarshal([]byte(testFormSchema), &formSchema); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Queryables("https://cop/ogc/collections/employee/queryables", "Employee", formSchema,
+		[]string{"name", "status", "placeOfBirth", "vehicle.speed", "missing"})
+	got, err := json.Marshal(q["properties"])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{` +
+		`"geometry":{"format":"geometry-any","title":"Geometry","x-ogc-role":"primary-geometry"},` +
+		`"missing":{"title":"missing"},` +
+		`"name":{"title":"Name","type":"string"},` +
+		`"placeOfBirth":{"title":"Place of Birth"},` +
+		`"status":{"enum":["active","inactive"],"title":"Status","type":"string"},` +
+		`"ts":{"format":"date-time","title":"Timestamp","type":"string","x-ogc-role":"primary-instant"},` +
+		`"vehicle.speed":{"minimum":0,"title":"vehicle.speed","type":"number"}` +
+		`}`
+	if string(got) != want {
+		t.Errorf("Queryables() properties = %s; want %s", got, want)
+	}
+}
+
+var Test_PropertyFilterTests = []struct {
+	test string
+
+	params  map[string][]string
+	want    string
+	wantErr bool
+}{
+	{
+		test:   "no queryables",
+		params: map[string][]string{"limit": {"10"}, "ts": {"2024-06-01T00:00:00Z"}},
+		want:   "",
+	},
+	{
+		test:   "typed and nested values",
+		params: map[string][]string{"name": {"alpha"}, "vehicle.speed": {"12.5"}, "bbox": {"0,0,1,1"}},
+		want:   `{"name":"alpha","vehicle":{"speed":12.5}}`,
+	},
+	{
+		test:    "invalid number",
+		params:  map[string][]string{"vehicle.speed": {"fast"}},
+		wantErr: true,
+	},
+}
+
+func Test_PropertyFilter(t *testing.T) {
+	var formSchema map[string]interface{}
+	if err := json.Unmarshal([]byte(testFormSchema), &formSchema); err != nil {
+		t.Fatal(err)
+	}
+	q := Queryables("", "", formSchema, []string{"name", "vehicle.speed"})
+
+	for _, tt := range Test_PropertyFilterTests {
+		t.Run(tt.test, func(t *testing.T) {
+			got, err := PropertyFilter(q, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PropertyFilter() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("PropertyFilter() = %s; want %s", got, tt.want)
+			}
+		})
+	}
+}
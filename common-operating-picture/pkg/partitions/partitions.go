@@ -0,0 +1,49 @@
+package partitions
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+)
+
+// Maintain creates the missing monthly tdf_objects partitions through premake months after the current month and
+// returns the created partitions. Partitions are dropped by the retention purge.
+func Maintain(ctx context.Context, q *db.Queries, premake int) ([]string, error) {
+	through := time.Now().UTC().AddDate(0, premake, 0)
+	created, err := q.CreateTdfObjectPartitions(ctx, pgtype.Timestamp{Time: through, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tdf_objects partitions: %w", err)
+	}
+	return created, nil
+}
+
+// Run maintains the tdf_objects partitions every configured interval until the context is done
+func Run(ctx context.Context, q *db.Queries, cfg *config.Config) {
+	slog.InfoContext(ctx, "starting partition maintenance job",
+		slog.Int("interval", cfg.Partitions.Interval),
+		slog.Int("premake", cfg.Partitions.Premake),
+	)
+	ticker := time.NewTicker(time.Duration(cfg.Partitions.Interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		created, err := Maintain(ctx, q, cfg.Partitions.Premake)
+		if err != nil {
+			slog.ErrorContext(ctx, "partition maintenance failed", slog.String("error", err.Error()))
+		}
+		for _, p := range created {
+			slog.InfoContext(ctx, "created tdf_objects partition", slog.String("partition", p))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package records
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/virtru-corp/dsp-cop/db"
+)
+
+// exportPageSize is the number of tdf_objects read per query while exporting
+const exportPageSize = 500
+
+// Exported is a tdf_object and its notes, one per line of an export. Blobs are exported as is, base64 encoded, so
+// an export holds no more plaintext than the search and metadata indexes.
+type Exported struct {
+	ID      uuid.UUID `json:"id"`
+	Ts      time.Time `json:"ts"`
+	SrcType string    `json:"src_type"`
+	// GeoJSON geometry and its SRID, which GeoJSON does not carry
+	Geo       json.RawMessage `json:"geo,omitempty"`
+	SRID      int             `json:"srid,omitempty"`
+	Search    json.RawMessage `json:"search,omitempty"`
+	Metadata  json.RawMessage `json:"metadata,omitempty"`
+	TdfBlob   []byte          `json:"tdf_blob,omitempty"`
+	TdfUri    string          `json:"tdf_uri,omitempty"`
+	EntityKey string          `json:"entity_key,omitempty"`
+	CreatedAt *time.Time      `json:"_created_at,omitempty"`
+	CreatedBy string          `json:"_created_by,omitempty"`
+	Notes     []ExportedNote  `json:"notes,omitempty"`
+}
+
+// ExportedNote is a tdf_note nested under its parent in an export
+type ExportedNote struct {
+	ID        uuid.UUID       `json:"id"`
+	Ts        *time.Time      `json:"ts,omitempty"`
+	Search    json.RawMessage `json:"search,omitempty"`
+	TdfBlob   []byte          `json:"tdf_blob,omitempty"`
+	TdfUri    string          `json:"tdf_uri,omitempty"`
+	CreatedAt *time.Time      `json:"_created_at,omitempty"`
+	CreatedBy string          `json:"_created_by,omitempty"`
+}
+
+// Filter selects the tdf_objects to export, as the `db list` command does
+type Filter struct {
+	SrcTypes []string
+	Start    time.Time
+	End      time.Time
+	// EWKT geometry the tdf_objects are within
+	Geometry string
+	// JSON the search index contains
+	Search []byte
+}
+
+// ImportResult counts the records of an import. Records whose id already exists are skipped.
+type ImportResult struct {
+	Imported int
+	Skipped  int
+	Notes    int
+}
+
+// Export writes the tdf_objects matching the filter as NDJSON ordered by ts, calling progress after every page
+func Export(ctx context.Context, q *db.Queries, filter Filter, w io.Writer, progress func(exported int)) (int, error) {
+	params := db.ExportTdfObjectsParams{
+		SourceTypes: filter.SrcTypes,
+		AfterTs:     pgtype.Timestamp{Time: filter.Start, Valid: true},
+		EndTime:     pgtype.Timestamp{Time: filter.End, Valid: true},
+		Limit:       exportPageSize,
+	}
+	if len(filter.Search) > 0 {
+		params.Search = filter.Search
+	}
+	if filter.Geometry != "" {
+		params.Geometry = filter.Geometry
+	}
+
+	enc := json.NewEncoder(w)
+	exported := 0
+	for {
+		objects, err := q.ExportTdfObjects(ctx, params)
+		if err != nil {
+			return exported, fmt.Errorf("failed to query tdf_objects: %w", err)
+		}
+		if len(objects) == 0 {
+			return exported, nil
+		}
+
+		ids := make([]uuid.UUID, len(objects))
+		for i, o := range objects {
+			ids[i] = o.ID
+		}
+		notes, err := q.ListNotesByParents(ctx, ids)
+		if err != nil {
+			return exported, fmt.Errorf("failed to query tdf_notes: %w", err)
+		}
+		notesByParent := make(map[uuid.UUID][]ExportedNote)
+		for _, n := range notes {
+			notesByParent[n.ParentID] = append(notesByParent[n.ParentID], exportNote(n))
+		}
+
+		for _, o := range objects {
+			e := exportObject(o)
+			e.Notes = notesByParent[o.ID]
+			if err := enc.Encode(e); err != nil {
+				return exported, err
+			}
+			exported++
+		}
+		if progress != nil {
+			progress(exported)
+		}
+
+		last := objects[len(objects)-1]
+		params.AfterTs, params.AfterID = last.Ts, last.ID
+	}
+}
+
+func exportObject(o db.TdfObject) Exported {
+	e := Exported{
+		ID:        o.ID,
+		Ts:        o.Ts.Time,
+		SrcType:   o.SrcType,
+		Search:    o.Search,
+		Metadata:  o.Metadata,
+		TdfBlob:   o.TdfBlob,
+		TdfUri:    o.TdfUri.String,
+		EntityKey: o.EntityKey.String,
+		CreatedAt: timePtr(o.CreatedAt),
+		CreatedBy: o.CreatedBy.String,
+	}
+	if o.Geo != nil {
+		e.Geo = json.RawMessage(o.Geo.ToGeoJSON(0))
+		e.SRID = o.Geo.SRID()
+	}
+	return e
+}
+
+func exportNote(n db.TdfNote) ExportedNote {
+	return ExportedNote{
+		ID:        n.ID,
+		Ts:        timePtr(n.Ts),
+		Search:    n.Search,
+		TdfBlob:   n.TdfBlob,
+		TdfUri:    n.TdfUri.String,
+		CreatedAt: timePtr(n.CreatedAt),
+		CreatedBy: n.CreatedBy.String,
+	}
+}
+
+// Import inserts the tdf_objects and notes of an export in batches, calling progress after every batch. Records
+// whose id already exists are skipped, so an export can be imported again.
+func Import(ctx context.Context, q *db.Queries, r io.Reader, batchSize int, progress func(ImportResult)) (ImportResult, error) {
+	var result ImportResult
+	dec := json.NewDecoder(r)
+	batch := make([]Exported, 0, batchSize)
+	line := 0
+	for {
+		var e Exported
+		err := dec.Decode(&e)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return result, fmt.Errorf("invalid record %d: %w", line+1, err)
+		}
+		if err == nil {
+			line++
+			batch = append(batch, e)
+		}
+
+		if len(batch) > 0 && (len(batch) == batchSize || err != nil) {
+			if err := importBatch(ctx, q, batch, &result); err != nil {
+				return result, err
+			}
+			if progress != nil {
+				progress(result)
+			}
+			batch = batch[:0]
+		}
+		if err != nil {
+			return result, nil
+		}
+	}
+}
+
+func importBatch(ctx context.Context, q *db.Queries, batch []Exported, result *ImportResult) error {
+	objects := make([]db.ImportTdfObjectsParams, 0, len(batch))
+	var notes []db.ImportTdfNotesParams
+	for _, e := range batch {
+		if e.ID == uuid.Nil || e.SrcType == "" {
+			return fmt.Errorf("record %s: id and src_type are required", e.ID)
+		}
+		geo, err := Geometry(e.Geo)
+		if err != nil {
+			return fmt.Errorf("record %s: %w", e.ID, err)
+		}
+		if geo != nil && e.SRID != 0 {
+			geo = geo.SetSRID(e.SRID)
+		}
+		objects = append(objects, db.ImportTdfObjectsParams{
+			ID:        e.ID,
+			Ts:        pgtype.Timestamp{Time: e.Ts.UTC(), Valid: true},
+			SrcType:   e.SrcType,
+			Geo:       geo,
+			Search:    rawOrNil(e.Search),
+			Metadata:  rawOrNil(e.Metadata),
+			TdfBlob:   e.TdfBlob,
+			TdfUri:    pgtype.Text{String: e.TdfUri, Valid: e.TdfUri != ""},
+			CreatedAt: timestamp(e.CreatedAt),
+			CreatedBy: pgtype.Text{String: e.CreatedBy, Valid: e.CreatedBy != ""},
+			EntityKey: pgtype.Text{String: e.EntityKey, Valid: e.EntityKey != ""},
+		})
+		for _, n := range e.Notes {
+			notes = append(notes, db.ImportTdfNotesParams{
+				ID:        n.ID,
+				Ts:        timestamp(n.Ts),
+				ParentID:  e.ID,
+				Search:    rawOrNil(n.Search),
+				TdfBlob:   n.TdfBlob,
+				TdfUri:    pgtype.Text{String: n.TdfUri, Valid: n.TdfUri != ""},
+				CreatedAt: timestamp(n.CreatedAt),
+				CreatedBy: pgtype.Text{String: n.CreatedBy, Valid: n.CreatedBy != ""},
+			})
+		}
+	}
+
+	var batchErr error
+	q.ImportTdfObjects(ctx, objects).QueryRow(func(i int, _ uuid.UUID, err error) {
+		switch {
+		case err == nil:
+			result.Imported++
+		case errors.Is(err, pgx.ErrNoRows):
+			result.Skipped++
+		default:
+			batchErr = errors.Join(batchErr, fmt.Errorf("record %s: %w", objects[i].ID, err))
+		}
+	})
+	if batchErr != nil {
+		return fmt.Errorf("failed to import tdf_objects: %w", batchErr)
+	}
+
+	q.ImportTdfNotes(ctx, notes).QueryRow(func(i int, _ uuid.UUID, err error) {
+		switch {
+		case err == nil:
+			result.Notes++
+		case errors.Is(err, pgx.ErrNoRows):
+		default:
+			batchErr = errors.Join(batchErr, fmt.Errorf("note %s: %w", notes[i].ID, err))
+		}
+	})
+	if batchErr != nil {
+		return fmt.Errorf("failed to import tdf_notes: %w", batchErr)
+	}
+	return nil
+}
+
+func rawOrNil(raw json.RawMessage) []byte {
+	if isNull(raw) {
+		return nil
+	}
+	return raw
+}
+
+func timePtr(ts pgtype.Timestamp) *time.Time {
+	if !ts.Valid {
+		return nil
+	}
+	return &ts.Time
+}
+
+func timestamp(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+	return pgtype.Timestamp{Time: t.UTC(), Valid: true}
+}
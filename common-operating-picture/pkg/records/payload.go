@@ -0,0 +1,182 @@
+package records
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SrcTypeFields are the fields of the src_types metadata indexing the JSON payload of a tdf_object
+type SrcTypeFields struct {
+	GeoField     string   `json:"geoField"`
+	TsField      string   `json:"tsField"`
+	EntityField  string   `json:"entityField"`
+	SearchFields []string `json:"searchFields"`
+	AttrFields   []string `json:"attrFields"`
+}
+
+// ParseSrcTypeFields returns the fields of the metadata of a src_type
+func ParseSrcTypeFields(metadata []byte) (SrcTypeFields, error) {
+	var f SrcTypeFields
+	if err := json.Unmarshal(metadata, &f); err != nil {
+		return f, fmt.Errorf("invalid src_type metadata: %w", err)
+	}
+	return f, nil
+}
+
+// Payload returns the record of a JSON object payload of a src_type, indexed as the create form of the web interface
+// does: search holds the search fields present in the payload, geo is the geo field and ts the ts field. The entity
+// key is the entity field, and the attribute values of the attribute fields are returned for the TDF wrapping the
+// payload. Fields are dot separated paths (e.g. "location.latitude").
+//
+// The geo field is a GeoJSON geometry, an object with a latitude and longitude (or lat and lon) or a [lon, lat]
+// array, and the ts field is an RFC 3339 string or unix time in seconds or milliseconds.
+func Payload(srcType string, f SrcTypeFields, payload []byte) (Record, []string, error) {
+	r := Record{SrcType: srcType}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(payload, &doc); err != nil {
+		return r, nil, fmt.Errorf("invalid payload, a JSON object is required: %w", err)
+	}
+
+	search := make(map[string]interface{})
+	for _, field := range f.SearchFields {
+		if v, ok := fieldValue(doc, field); ok && !isEmpty(v) {
+			search[field] = v
+		}
+	}
+	if len(search) > 0 {
+		b, err := json.Marshal(search)
+		if err != nil {
+			return r, nil, err
+		}
+		r.Search = b
+	}
+
+	var attrValues []string
+	for _, field := range f.AttrFields {
+		v, _ := fieldValue(doc, field)
+		switch t := v.(type) {
+		case string:
+			if t != "" {
+				attrValues = append(attrValues, t)
+			}
+		case []interface{}:
+			for _, a := range t {
+				if s, ok := a.(string); ok && s != "" {
+					attrValues = append(attrValues, s)
+				}
+			}
+		}
+	}
+
+	if f.GeoField != "" {
+		if v, ok := fieldValue(doc, f.GeoField); ok && v != nil {
+			geo, err := geoJSON(v)
+			if err != nil {
+				return r, nil, fmt.Errorf("invalid geo field %s: %w", f.GeoField, err)
+			}
+			r.Geo = geo
+		}
+	}
+
+	if f.TsField != "" {
+		if v, ok := fieldValue(doc, f.TsField); ok && v != nil {
+			ts, err := timestampValue(v)
+			if err != nil {
+				return r, nil, fmt.Errorf("invalid ts field %s: %w", f.TsField, err)
+			}
+			r.Ts = &ts
+		}
+	}
+
+	if f.EntityField != "" {
+		switch v, _ := fieldValue(doc, f.EntityField); t := v.(type) {
+		case string:
+			r.EntityKey = t
+		case float64:
+			r.EntityKey = strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%f", t), "0"), ".")
+		}
+	}
+
+	return r, attrValues, nil
+}
+
+func fieldValue(doc map[string]interface{}, field string) (interface{}, bool) {
+	var v interface{} = doc
+	for _, key := range strings.Split(field, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+func isEmpty(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case string:
+		return t == ""
+	case []interface{}:
+		return len(t) == 0
+	}
+	return false
+}
+
+// geoJSON returns the GeoJSON geometry of a geo field value
+func geoJSON(v interface{}) (json.RawMessage, error) {
+	point := func(lon, lat float64) (json.RawMessage, error) {
+		if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+			return nil, fmt.Errorf("position %f,%f out of range", lat, lon)
+		}
+		return json.Marshal(map[string]interface{}{"type": "Point", "coordinates": []float64{lon, lat}})
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if _, ok := t["type"]; ok {
+			return json.Marshal(t)
+		}
+		for _, keys := range [][2]string{{"longitude", "latitude"}, {"lon", "lat"}, {"lng", "lat"}} {
+			lon, lonOk := t[keys[0]].(float64)
+			lat, latOk := t[keys[1]].(float64)
+			if lonOk && latOk {
+				return point(lon, lat)
+			}
+		}
+	case []interface{}:
+		if len(t) >= 2 {
+			lon, lonOk := t[0].(float64)
+			lat, latOk := t[1].(float64)
+			if lonOk && latOk {
+				return point(lon, lat)
+			}
+		}
+	}
+	return nil, errors.New("a GeoJSON geometry, a latitude and longitude or a [lon, lat] array is required")
+}
+
+// timestampValue returns the time of a ts field value
+func timestampValue(v interface{}) (time.Time, error) {
+	switch t := v.(type) {
+	case string:
+		ts, err := time.Parse(time.RFC3339, t)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return ts.UTC(), nil
+	case float64:
+		if t > 1e12 {
+			return time.UnixMilli(int64(t)).UTC(), nil
+		}
+		return time.Unix(int64(t), 0).UTC(), nil
+	}
+	return time.Time{}, errors.New("an RFC 3339 string or unix time is required")
+}
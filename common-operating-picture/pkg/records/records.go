@@ -0,0 +1,145 @@
+package records
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	geos "github.com/twpayne/go-geos"
+	"github.com/virtru-corp/dsp-cop/db"
+)
+
+// Record is a tdf_object read from a JSON or NDJSON file, e.g.
+//
+//	{"src_type": "vehicle", "ts": "2024-06-01T12:00:00Z", "geo": {"type": "Point", "coordinates": [-77.03, 38.89]},
+//	 "search": {"callsign": "alpha"}, "tdf_blob_file": "alpha.tdf"}
+type Record struct {
+	SrcType string     `json:"src_type"`
+	Ts      *time.Time `json:"ts,omitempty"`
+	// GeoJSON geometry
+	Geo      json.RawMessage `json:"geo,omitempty"`
+	Search   json.RawMessage `json:"search,omitempty"`
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+	// base64 encoded tdf
+	TdfBlob []byte `json:"tdf_blob,omitempty"`
+	// path of a tdf file, relative to the records file
+	TdfBlobFile string `json:"tdf_blob_file,omitempty"`
+	TdfUri      string `json:"tdf_uri,omitempty"`
+	EntityKey   string `json:"entity_key,omitempty"`
+}
+
+// Read reads the records of a JSON array, a JSON object or NDJSON (one JSON object per line)
+func Read(r io.Reader) ([]Record, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("[")) {
+		var records []Record
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, fmt.Errorf("invalid JSON array: %w", err)
+		}
+		return records, nil
+	}
+
+	var records []Record
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var record Record
+		if err := dec.Decode(&record); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid record %d: %w", len(records)+1, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// CreateParams returns the CreateTdfObjects parameters of the record. A relative TdfBlobFile is read from dir.
+func (r Record) CreateParams(dir string) (db.CreateTdfObjectsParams, error) {
+	if r.SrcType == "" {
+		return db.CreateTdfObjectsParams{}, errors.New("src_type is required")
+	}
+
+	ts := time.Now().UTC()
+	if r.Ts != nil {
+		ts = r.Ts.UTC()
+	}
+
+	geo, err := Geometry(r.Geo)
+	if err != nil {
+		return db.CreateTdfObjectsParams{}, err
+	}
+
+	blob, err := r.blob(dir)
+	if err != nil {
+		return db.CreateTdfObjectsParams{}, err
+	}
+
+	// match the defaults of the CreateTdfObject rpc
+	search := []byte(r.Search)
+	if isNull(r.Search) {
+		search = []byte("null")
+	}
+	metadata := []byte(r.Metadata)
+	if isNull(r.Metadata) {
+		metadata = []byte("{}")
+	}
+
+	return db.CreateTdfObjectsParams{
+		Ts:        pgtype.Timestamp{Time: ts, Valid: true},
+		SrcType:   strings.ToLower(r.SrcType),
+		Geo:       geo,
+		Search:    search,
+		Metadata:  metadata,
+		TdfBlob:   blob,
+		TdfUri:    pgtype.Text{String: r.TdfUri, Valid: r.TdfUri != ""},
+		EntityKey: pgtype.Text{String: r.EntityKey, Valid: r.EntityKey != ""},
+	}, nil
+}
+
+func (r Record) blob(dir string) ([]byte, error) {
+	if r.TdfBlobFile == "" {
+		return r.TdfBlob, nil
+	}
+	if len(r.TdfBlob) > 0 {
+		return nil, errors.New("only one of tdf_blob and tdf_blob_file may be set")
+	}
+
+	path := r.TdfBlobFile
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tdf_blob_file: %w", err)
+	}
+	return b, nil
+}
+
+// Geometry returns the geometry of GeoJSON, or nil for empty or null GeoJSON
+func Geometry(geojson []byte) (*geos.Geom, error) {
+	if isNull(geojson) {
+		return nil, nil
+	}
+	geo, err := geos.NewGeomFromGeoJSON(string(geojson))
+	if err != nil {
+		return nil, fmt.Errorf("error creating geometry from GeoJSON: %w", err)
+	}
+	return geo, nil
+}
+
+func isNull(raw []byte) bool {
+	raw = bytes.TrimSpace(raw)
+	return len(raw) == 0 || bytes.Equal(raw, []byte("null"))
+}
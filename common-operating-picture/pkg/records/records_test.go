@@ -0,0 +1,205 @@
+package records
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/virtru-corp/dsp-cop/db"
+)
+
+var Test_ReadTests = []struct {
+	test string
+
+	input    string
+	srcTypes []string
+	wantErr  bool
+}{
+	{
+		test:     "json object",
+		input:    `{"src_type": "a"}`,
+		srcTypes: []string{"a"},
+	},
+	{
+		test:     "json array",
+		input:    ` [{"src_type": "a"}, {"src_type": "b"}]`,
+		srcTypes: []string{"a", "b"},
+	},
+	{
+		test:     "ndjson",
+		input:    "{\"src_type\": \"a\"}\n{\"src_type\": \"b\"}\n\n{\"src_type\": \"c\"}\n",
+		srcTypes: []string{"a", "b", "c"},
+	},
+	{
+		test:    "invalid ndjson",
+		input:   "{\"src_type\": \"a\"}\n{\"src_type\": \n",
+		wantErr: true,
+	},
+	{
+		test: "empty",
+	},
+}
+
+func Test_Read(t *testing.T) {
+	for _, tt := range Test_ReadTests {
+		t.Run(tt.test, func(t *testing.T) {
+			records, err := Read(strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Read() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if len(records) != len(tt.srcTypes) {
+				t.Fatalf("Read() returned %d records; want %d", len(records), len(tt.srcTypes))
+			}
+			for i, r := range records {
+				if r.SrcType != tt.srcTypes[i] {
+					t.Errorf("records[%d].SrcType = %s; want %s", i, r.SrcType, tt.srcTypes[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_CreateParams(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "a.tdf"), []byte("tdf"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := Read(strings.NewReader(`
+		{"src_type": "Vehicle", "ts": "2024-06-01T12:00:00Z", "tdf_blob_file": "a.tdf", "entity_key": "alpha"}
+		{"src_type": "vehicle", "tdf_blob": "dGRm", "search": {"callsign": "bravo"}}
+		{"src_type": "vehicle", "tdf_blob": "dGRm", "tdf_blob_file": "a.tdf"}
+		{"ts": "2024-06-01T12:00:00Z"}
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := records[0].CreateParams(dir)
+	if err != nil {
+		t.Fatalf("CreateParams() error = %v", err)
+	}
+	if p.SrcType != "vehicle" || string(p.TdfBlob) != "tdf" || p.EntityKey.String != "alpha" || p.Ts.Time.Year() != 2024 {
+		t.Errorf("CreateParams() = %+v", p)
+	}
+	if string(p.Search) != "null" || string(p.Metadata) != "{}" {
+		t.Errorf("CreateParams() search = %s, metadata = %s; want null, {}", p.Search, p.Metadata)
+	}
+
+	p, err = records[1].CreateParams(dir)
+	if err != nil {
+		t.Fatalf("CreateParams() error = %v", err)
+	}
+	if string(p.TdfBlob) != "tdf" || string(p.Search) != `{"callsign": "bravo"}` || p.EntityKey.Valid {
+		t.Errorf("CreateParams() = %+v", p)
+	}
+
+	if _, err := records[2].CreateParams(dir); err == nil {
+		t.Error("CreateParams() with tdf_blob and tdf_blob_file error = nil")
+	}
+	if _, err := records[3].CreateParams(dir); err == nil {
+		t.Error("CreateParams() without src_type error = nil")
+	}
+}
+
+func Test_ExportObject(t *testing.T) {
+	e := exportObject(db.TdfObject{
+		ID:      uuid.New(),
+		Ts:      pgtype.Timestamp{Time: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC), Valid: true},
+		SrcType: "vehicle",
+		TdfBlob: []byte("tdf"),
+	})
+	if e.Geo != nil || e.CreatedAt != nil || e.TdfUri != "" {
+		t.Errorf("exportObject() = %+v", e)
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var imported Exported
+	if err := json.Unmarshal(b, &imported); err != nil {
+		t.Fatal(err)
+	}
+	if imported.ID != e.ID || !imported.Ts.Equal(e.Ts) || string(imported.TdfBlob) != "tdf" {
+		t.Errorf("round trip = %+v; want %+v", imported, e)
+	}
+}
+
+func Test_Payload(t *testing.T) {
+	fields := SrcTypeFields{
+		GeoField:     "position",
+		TsField:      "reported.at",
+		EntityField:  "serial",
+		SearchFields: []string{"serial", "name", "attrClassification"},
+		AttrFields:   []string{"attrClassification", "attrRelTo"},
+	}
+
+	tests := []struct {
+		test    string
+		payload string
+
+		search     string
+		entityKey  string
+		attrValues []string
+		wantErr    bool
+	}{
+		{
+			test:       "indexed fields",
+			payload:    `{"serial": "S-1", "name": "", "position": {"lat": 51.47, "lon": -0.45}, "reported": {"at": "2024-06-01T12:00:00Z"}, "attrClassification": "https://demo.com/attr/classification/value/unclassified", "attrRelTo": ["https://demo.com/attr/relto/value/usa"]}`,
+			search:     `{"attrClassification":"https://demo.com/attr/classification/value/unclassified","serial":"S-1"}`,
+			entityKey:  "S-1",
+			attrValues: []string{"https://demo.com/attr/classification/value/unclassified", "https://demo.com/attr/relto/value/usa"},
+		},
+		{
+			test:      "numeric entity",
+			payload:   `{"serial": 1234, "position": [-0.45, 51.47], "reported": {"at": 1717243200}}`,
+			search:    `{"serial":1234}`,
+			entityKey: "1234",
+		},
+		{
+			test:    "no fields",
+			payload: `{"other": true}`,
+		},
+		{
+			test:    "invalid position",
+			payload: `{"position": {"lat": 95, "lon": -0.45}}`,
+			wantErr: true,
+		},
+		{
+			test:    "invalid ts",
+			payload: `{"reported": {"at": "yesterday"}}`,
+			wantErr: true,
+		},
+		{
+			test:    "not an object",
+			payload: `[1, 2]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.test, func(t *testing.T) {
+			r, attrValues, err := Payload("sensors", fields, []byte(tt.payload))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Payload() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if string(r.Search) != tt.search || r.EntityKey != tt.entityKey || r.SrcType != "sensors" {
+				t.Errorf("Payload() = %+v", r)
+			}
+			if strings.Join(attrValues, ",") != strings.Join(tt.attrValues, ",") {
+				t.Errorf("Payload() attrValues = %v; want %v", attrValues, tt.attrValues)
+			}
+			if tt.search != "" && (r.Ts == nil || !r.Ts.Equal(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))) {
+				t.Errorf("Payload() ts = %v", r.Ts)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/virtru-corp/dsp-cop/db"
+	"github.com/virtru-corp/dsp-cop/pkg/blobstore"
+	"github.com/virtru-corp/dsp-cop/pkg/config"
+)
+
+// deletedBlobsPageSize is the number of queued tdf_uris read per query
+const deletedBlobsPageSize = 100
+
+// purgeOperation is the dsp_cop.operation of the transactions of the purge. The tdf_objects delete trigger then
+// removes the revisions of the tdf_objects deleted, rather than recording their delete as other deletes do.
+const purgeOperation = "purge"
+
+// purgeUser is the dsp_cop.changed_by of the transactions of the purge
+const purgeUser = "retention"
+
+// Rule is the retention rule of a source type, a zero MaxAge or MaxRows is not enforced
+type Rule struct {
+	SrcType string
+	MaxAge  time.Duration
+	MaxRows int64
+}
+
+// Result is the number of tdf_objects of a source type purged, or that would be purged in a dry run.
+// Notes and revisions of purged tdf_objects are removed by the tdf_objects delete triggers, and their payloads by
+// DeleteBlobs.
+type Result struct {
+	Rule
+	// tdf_objects older than MaxAge
+	Expired int64
+	// tdf_objects over MaxRows, after removing the expired tdf_objects
+	Excess int64
+}
+
+// srcTypeMetadata is the retention rule in the src_types metadata, e.g. {"retention": {"maxAge": "720h", "maxRows": 1000}}
+type srcTypeMetadata struct {
+	Retention *struct {
+		MaxAge  string `json:"maxAge,omitempty"`
+		MaxRows int64  `json:"maxRows,omitempty"`
+	} `json:"retention,omitempty"`
+}
+
+// Rules returns the retention rule of every source type with tdf_objects
+func Rules(ctx context.Context, q *db.Queries, cfg *config.Config) ([]Rule, error) {
+	srcTypes, err := q.ListTdfObjectSrcTypes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list source types: %w", err)
+	}
+
+	rules := make([]Rule, 0, len(srcTypes))
+	for _, srcType := range srcTypes {
+		var metadata []byte
+		s, err := q.GetSrcType(ctx, srcType)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to get source type %s: %w", srcType, err)
+		}
+		if err == nil {
+			metadata = s.Metadata
+		}
+
+		rule, err := resolveRule(srcType, cfg, metadata)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// resolveRule returns the retention rule of a source type from its metadata, the configured source type rule or the
+// configured default rule, in that order of precedence.
+func resolveRule(srcType string, cfg *config.Config, metadata []byte) (Rule, error) {
+	if len(metadata) > 0 {
+		var m srcTypeMetadata
+		if err := json.Unmarshal(metadata, &m); err != nil {
+			return Rule{}, fmt.Errorf("failed to parse source type %s metadata: %w", srcType, err)
+		}
+		if m.Retention != nil {
+			rule := Rule{SrcType: srcType, MaxRows: m.Retention.MaxRows}
+			if m.Retention.MaxAge != "" {
+				maxAge, err := time.ParseDuration(m.Retention.MaxAge)
+				if err != nil {
+					return Rule{}, fmt.Errorf("invalid source type %s retention maxAge: %w", srcType, err)
+				}
+				rule.MaxAge = maxAge
+			}
+			return rule, nil
+		}
+	}
+
+	if r, ok := cfg.Retention.SrcTypes[srcType]; ok {
+		return Rule{SrcType: srcType, MaxAge: r.MaxAge, MaxRows: r.MaxRows}, nil
+	}
+
+	return Rule{SrcType: srcType, MaxAge: cfg.Retention.Default.MaxAge, MaxRows: cfg.Retention.Default.MaxRows}, nil
+}
+
+// DropPartitions drops the monthly tdf_objects partitions that only hold tdf_objects older than the MaxAge of every
+// rule, which is faster than deleting their rows. No partitions are dropped while a rule has no MaxAge.
+// With dryRun nothing is dropped and the partitions that would be dropped are returned.
+func DropPartitions(ctx context.Context, q *db.Queries, rules []Rule, dryRun bool) ([]string, error) {
+	before, ok := partitionCutoff(time.Now().UTC(), rules)
+	if !ok {
+		return nil, nil
+	}
+
+	partitions, err := q.DropTdfObjectPartitions(ctx, db.DropTdfObjectPartitionsParams{
+		Before: pgtype.Timestamp{Time: before, Valid: true},
+		DryRun: dryRun,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to drop tdf_objects partitions: %w", err)
+	}
+	return partitions, nil
+}
+
+// partitionCutoff returns the time before which the tdf_objects of every rule are expired
+func partitionCutoff(now time.Time, rules []Rule) (time.Time, bool) {
+	var maxAge time.Duration
+	for _, rule := range rules {
+		if rule.MaxAge <= 0 {
+			return time.Time{}, false
+		}
+		maxAge = max(maxAge, rule.MaxAge)
+	}
+	if maxAge == 0 {
+		return time.Time{}, false
+	}
+	return now.Add(-maxAge), true
+}
+
+// Purge removes the tdf_objects older than the MaxAge, then the oldest tdf_objects over the MaxRows of each rule,
+// with their revisions. With dryRun nothing is removed and the results report what would be removed.
+func Purge(ctx context.Context, pool *pgxpool.Pool, rules []Rule, dryRun bool) ([]Result, error) {
+	q := db.New(pool)
+	now := time.Now().UTC()
+	results := make([]Result, 0, len(rules))
+	for _, rule := range rules {
+		if rule.MaxAge <= 0 && rule.MaxRows <= 0 {
+			continue
+		}
+
+		// a zero timestamp matches no tdf_objects
+		before := pgtype.Timestamp{Valid: true}
+		if rule.MaxAge > 0 {
+			before.Time = now.Add(-rule.MaxAge)
+		}
+
+		result := Result{Rule: rule}
+		if dryRun {
+			count, err := q.CountTdfObjectsForRetention(ctx, db.CountTdfObjectsForRetentionParams{
+				Before:     before,
+				SourceType: rule.SrcType,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to count %s tdf_objects: %w", rule.SrcType, err)
+			}
+			result.Expired = count.Expired
+			result.Excess = excess(count.Total-count.Expired, rule.MaxRows)
+		} else {
+			if rule.MaxAge > 0 {
+				err := purge(ctx, pool, func(q *db.Queries) (err error) {
+					result.Expired, err = q.DeleteExpiredTdfObjects(ctx, db.DeleteExpiredTdfObjectsParams{
+						SourceType: rule.SrcType,
+						Before:     before,
+					})
+					return err
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to purge expired %s tdf_objects: %w", rule.SrcType, err)
+				}
+			}
+			if rule.MaxRows > 0 {
+				err := purge(ctx, pool, func(q *db.Queries) (err error) {
+					result.Excess, err = q.DeleteExcessTdfObjects(ctx, db.DeleteExcessTdfObjectsParams{
+						SourceType: rule.SrcType,
+						MaxRows:    rule.MaxRows,
+					})
+					return err
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to purge excess %s tdf_objects: %w", rule.SrcType, err)
+				}
+			}
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// purge runs fn in a transaction of the purge operation
+func purge(ctx context.Context, pool *pgxpool.Pool, fn func(q *db.Queries) error) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	q := db.New(tx)
+	err = q.SetTdfObjectChange(ctx, db.SetTdfObjectChangeParams{ChangedBy: purgeUser, Operation: purgeOperation})
+	if err != nil {
+		return err
+	}
+	if err := fn(q); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// RevisionResult is the number of revisions of tdf_objects purged, or that would be purged in a dry run
+type RevisionResult struct {
+	// revisions older than the max age
+	Expired int64
+	// revisions over the max count of their tdf_object, after removing the expired revisions
+	Excess int64
+}
+
+// PurgeRevisions removes the revisions older than the configured max age, then the oldest revisions of each tdf_object
+// over the max count. The latest revision of a tdf_object is kept, so its revision numbers are not reused, unless it
+// records the delete of the tdf_object, which expires with the rest of its history. The tdf_object_revisions delete
+// trigger removes the tdf_blobs no revision has left and queues the payloads for
+// DeleteBlobs. With dryRun nothing is removed and the result reports what would be removed.
+func PurgeRevisions(ctx context.Context, q *db.Queries, cfg *config.Config, dryRun bool) (RevisionResult, error) {
+	var result RevisionResult
+	maxAge, maxCount := cfg.Retention.Revisions.MaxAge, cfg.Retention.Revisions.MaxCount
+
+	// a zero timestamp matches no revisions
+	before := pgtype.Timestamp{Valid: true}
+	if maxAge > 0 {
+		before.Time = time.Now().UTC().Add(-maxAge)
+	}
+
+	if dryRun {
+		count, err := q.CountTdfObjectRevisionsForRetention(ctx, db.CountTdfObjectRevisionsForRetentionParams{
+			Before:   before,
+			MaxCount: maxCount,
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to count revisions: %w", err)
+		}
+		return RevisionResult{Expired: count.Expired, Excess: count.Excess}, nil
+	}
+
+	var err error
+	if maxAge > 0 {
+		result.Expired, err = q.DeleteExpiredTdfObjectRevisions(ctx, before)
+		if err != nil {
+			return result, fmt.Errorf("failed to purge expired revisions: %w", err)
+		}
+	}
+	if maxCount > 0 {
+		result.Excess, err = q.DeleteExcessTdfObjectRevisions(ctx, maxCount)
+		if err != nil {
+			return result, fmt.Errorf("failed to purge excess revisions: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// excess returns the number of rows over maxRows, a zero maxRows allows any number of rows
+func excess(rows int64, maxRows int64) int64 {
+	if maxRows <= 0 || rows <= maxRows {
+		return 0
+	}
+	return rows - maxRows
+}
+
+// DeleteBlobs deletes the payloads of the tdf_uris queued by the deletes of tdf_objects, tdf_notes and tdf_attachments
+// from the blob store, returning the number deleted. tdf_uris of other stores are dropped from the queue, which is
+// kept as it is without a blob store. Payloads failing to delete stay queued for the next run.
+func DeleteBlobs(ctx context.Context, q *db.Queries, blobs *blobstore.Blobs) (int, error) {
+	if blobs == nil {
+		return 0, nil
+	}
+
+	deleted := 0
+	after := ""
+	for {
+		uris, err := q.ListDeletedBlobs(ctx, db.ListDeletedBlobsParams{After: after, Limit: deletedBlobsPageSize})
+		if err != nil {
+			return deleted, fmt.Errorf("failed to list deleted blobs: %w", err)
+		}
+		for _, uri := range uris {
+			if blobs.Owns(uri) {
+				if err := blobs.Delete(ctx, uri); err != nil {
+					slog.ErrorContext(ctx, "failed to delete blob", slog.String("tdf_uri", uri), slog.String("error", err.Error()))
+					continue
+				}
+				deleted++
+			}
+			if err := q.DeleteDeletedBlob(ctx, uri); err != nil {
+				return deleted, fmt.Errorf("failed to dequeue deleted blob: %w", err)
+			}
+		}
+		if len(uris) < deletedBlobsPageSize {
+			return deleted, nil
+		}
+		after = uris[len(uris)-1]
+	}
+}
+
+// Run purges tdf_objects and their revisions and deletes their payloads from the blob store every configured interval until the context
+// is done
+func Run(ctx context.Context, pool *pgxpool.Pool, cfg *config.Config, blobs *blobstore.Blobs) {
+	if cfg.Retention.Interval <= 0 {
+		slog.InfoContext(ctx, "retention purge job disabled")
+		return
+	}
+
+	slog.InfoContext(ctx, "starting retention purge job", slog.Int("interval", cfg.Retention.Interval))
+	ticker := time.NewTicker(time.Duration(cfg.Retention.Interval) * time.Second)
+	defer ticker.Stop()
+
+	q := db.New(pool)
+	for {
+		rules, err := Rules(ctx, q, cfg)
+		if err == nil {
+			var partitions []string
+			partitions, err = DropPartitions(ctx, q, rules, false)
+			for _, p := range partitions {
+				slog.InfoContext(ctx, "dropped tdf_objects partition", slog.String("partition", p))
+			}
+		}
+		if err == nil {
+			var results []Result
+			results, err = Purge(ctx, pool, rules, false)
+			for _, r := range results {
+				if r.Expired > 0 || r.Excess > 0 {
+					slog.InfoContext(ctx, "purged tdf_objects",
+						slog.String("src_type", r.SrcType),
+						slog.Int64("expired", r.Expired),
+						slog.Int64("excess", r.Excess),
+					)
+				}
+			}
+		}
+		if err == nil {
+			var revisions RevisionResult
+			revisions, err = PurgeRevisions(ctx, q, cfg, false)
+			if revisions.Expired > 0 || revisions.Excess > 0 {
+				slog.InfoContext(ctx, "purged tdf_object revisions",
+					slog.Int64("expired", revisions.Expired),
+					slog.Int64("excess", revisions.Excess),
+				)
+			}
+		}
+		// payloads of the tdf_objects and revisions deleted since the last run, purged or not
+		if err == nil {
+			var deleted int
+			deleted, err = DeleteBlobs(ctx, q, blobs)
+			if deleted > 0 {
+				slog.InfoContext(ctx, "deleted blobs", slog.Int("blobs", deleted))
+			}
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "retention purge failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
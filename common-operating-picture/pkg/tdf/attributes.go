@@ -0,0 +1,37 @@
+package tdf
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Encrypter wraps bytes in a TDF, see Handler
+type Encrypter interface {
+	EncryptBytes(b []byte, attrValues []string, TDFType int) (*bytes.Buffer, error)
+}
+
+// SearchAttributes returns the attrClassification, attrNeedToKnow and attrRelTo search attributes of attribute value
+// FQNs (https://<namespace>/attr/<name>/value/<value>), which entitlements are checked against when querying.
+func SearchAttributes(attrValues []string) map[string][]string {
+	attrs := map[string][]string{
+		"attrClassification": {},
+		"attrNeedToKnow":     {},
+		"attrRelTo":          {},
+	}
+	for _, fqn := range attrValues {
+		_, rest, ok := strings.Cut(fqn, "/attr/")
+		if !ok {
+			continue
+		}
+		name, _, _ := strings.Cut(rest, "/")
+		switch strings.ToLower(name) {
+		case "classification":
+			attrs["attrClassification"] = append(attrs["attrClassification"], fqn)
+		case "needtoknow":
+			attrs["attrNeedToKnow"] = append(attrs["attrNeedToKnow"], fqn)
+		case "relto":
+			attrs["attrRelTo"] = append(attrs["attrRelTo"], fqn)
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,310 @@
+package uploads
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalid is the error of the State of a new upload with an invalid size or sha256
+	ErrInvalid = errors.New("invalid upload")
+
+	// ErrNotFound is the error of an upload id with no partial upload, unknown or expired
+	ErrNotFound = errors.New("upload not found")
+
+	// ErrBusy is the error of an upload written to by another call
+	ErrBusy = errors.New("upload in progress in another call")
+
+	// ErrOffset is the error of a chunk that does not start at the offset of the upload
+	ErrOffset = errors.New("chunk offset is not the offset of the upload")
+
+	// ErrSize is the error of a chunk going past the size of the upload
+	ErrSize = errors.New("chunk past the size of the upload")
+
+	// ErrChecksum is the error of a complete upload whose payload does not have its sha256
+	ErrChecksum = errors.New("sha256 of the upload does not match")
+)
+
+// State is the state of an upload kept next to its payload
+type State struct {
+	// Size of the payload in bytes
+	Size int64 `json:"size"`
+
+	// Hex encoded SHA-256 of the payload
+	SHA256 string `json:"sha256"`
+
+	// Target is the tdf_object the payload is attached to when complete, as encoded by the caller
+	Target json.RawMessage `json:"target"`
+}
+
+// Dir keeps the partial uploads of a directory, each as a <id>.data payload and a <id>.json State. An upload is
+// written by a single call at a time.
+type Dir struct {
+	dir     string
+	maxSize int64
+
+	mu   sync.Mutex
+	busy map[string]bool
+}
+
+// New returns the uploads of a directory, created when missing, of payloads of at most maxSize bytes
+func New(dir string, maxSize int64) (*Dir, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create uploads directory: %w", err)
+	}
+	return &Dir{dir: dir, maxSize: maxSize, busy: map[string]bool{}}, nil
+}
+
+// Create starts a new upload, held by the caller until closed
+func (d *Dir) Create(state State) (*Upload, error) {
+	if state.Size <= 0 || state.Size > d.maxSize {
+		return nil, fmt.Errorf("%w: size must be between 1 and %d bytes", ErrInvalid, d.maxSize)
+	}
+	state.SHA256 = strings.ToLower(state.SHA256)
+	if sum, err := hex.DecodeString(state.SHA256); err != nil || len(sum) != sha256.Size {
+		return nil, fmt.Errorf("%w: sha256 must be a hex encoded SHA-256", ErrInvalid)
+	}
+
+	id := uuid.NewString()
+	d.acquire(id)
+	u := &Upload{ID: id, State: state, d: d}
+	if err := u.init(); err != nil {
+		d.release(id)
+		os.Remove(d.path(id, ".data"))
+		os.Remove(d.path(id, ".json"))
+		return nil, err
+	}
+	return u, nil
+}
+
+// Open resumes an upload, held by the caller until closed
+func (d *Dir) Open(id string) (*Upload, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrNotFound
+	}
+	if !d.acquire(id) {
+		return nil, ErrBusy
+	}
+
+	u, err := d.open(id)
+	if err != nil {
+		d.release(id)
+		return nil, err
+	}
+	return u, nil
+}
+
+// Remove deletes an upload, complete or abandoned
+func (d *Dir) Remove(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return ErrNotFound
+	}
+	for _, ext := range []string{".data", ".json"} {
+		if err := os.Remove(d.path(id, ext)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove upload: %w", err)
+		}
+	}
+	return nil
+}
+
+// Expire removes the uploads not written to since olderThan and not held, returning their number
+func (d *Dir) Expire(olderThan time.Duration) (int, error) {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list uploads: %w", err)
+	}
+
+	removed := 0
+	for _, e := range entries {
+		id, ok := strings.CutSuffix(e.Name(), ".json")
+		if !ok {
+			continue
+		}
+		info, err := os.Stat(d.path(id, ".data"))
+		if err == nil && time.Since(info.ModTime()) < olderThan {
+			continue
+		}
+		if !d.acquire(id) {
+			continue
+		}
+		err = d.Remove(id)
+		d.release(id)
+		if err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// Run removes the expired uploads every hour until the context is done
+func (d *Dir) Run(ctx context.Context, expiry time.Duration) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		removed, err := d.Expire(expiry)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to expire uploads", slog.String("error", err.Error()))
+		}
+		if removed > 0 {
+			slog.InfoContext(ctx, "removed expired uploads", slog.Int("count", removed))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dir) open(id string) (*Upload, error) {
+	data, err := os.ReadFile(d.path(id, ".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	u := &Upload{ID: id, d: d}
+	if err := json.Unmarshal(data, &u.State); err != nil {
+		return nil, fmt.Errorf("invalid upload state: %w", err)
+	}
+
+	u.f, err = os.OpenFile(d.path(id, ".data"), os.O_WRONLY|os.O_APPEND, 0)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open upload: %w", err)
+	}
+	info, err := u.f.Stat()
+	if err != nil {
+		u.f.Close()
+		return nil, fmt.Errorf("failed to open upload: %w", err)
+	}
+	u.offset = info.Size()
+	if u.offset > u.Size {
+		u.f.Close()
+		return nil, fmt.Errorf("upload of %d bytes has %d bytes", u.Size, u.offset)
+	}
+	return u, nil
+}
+
+func (d *Dir) path(id, ext string) string {
+	return filepath.Join(d.dir, id+ext)
+}
+
+func (d *Dir) acquire(id string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.busy[id] {
+		return false
+	}
+	d.busy[id] = true
+	return true
+}
+
+func (d *Dir) release(id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.busy, id)
+}
+
+// Upload is a partial upload held by a call
+type Upload struct {
+	ID string
+	State
+
+	d      *Dir
+	f      *os.File
+	offset int64
+}
+
+// Offset returns the number of bytes received, where the next chunk starts
+func (u *Upload) Offset() int64 {
+	return u.offset
+}
+
+// Complete reports whether every byte of the payload was received
+func (u *Upload) Complete() bool {
+	return u.offset == u.Size
+}
+
+// Write appends a chunk starting at offset
+func (u *Upload) Write(offset int64, data []byte) error {
+	if offset != u.offset {
+		return fmt.Errorf("%w: got %d, want %d", ErrOffset, offset, u.offset)
+	}
+	if offset+int64(len(data)) > u.Size {
+		return fmt.Errorf("%w: %d bytes past %d", ErrSize, offset+int64(len(data))-u.Size, u.Size)
+	}
+	n, err := u.f.Write(data)
+	u.offset += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write upload: %w", err)
+	}
+	return nil
+}
+
+// Verify checks the sha256 of a complete upload
+func (u *Upload) Verify() error {
+	r, err := u.Reader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return fmt.Errorf("failed to read upload: %w", err)
+	}
+	if hex.EncodeToString(h.Sum(nil)) != u.SHA256 {
+		return ErrChecksum
+	}
+	return nil
+}
+
+// Reader returns a reader of the payload received
+func (u *Upload) Reader() (io.ReadCloser, error) {
+	f, err := os.Open(u.d.path(u.ID, ".data"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	return f, nil
+}
+
+// Close releases the upload for other calls
+func (u *Upload) Close() error {
+	defer u.d.release(u.ID)
+	return u.f.Close()
+}
+
+func (u *Upload) init() error {
+	var err error
+	u.f, err = os.OpenFile(u.d.path(u.ID, ".data"), os.O_WRONLY|os.O_APPEND|os.O_CREATE|os.O_EXCL, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to create upload: %w", err)
+	}
+	state, err := json.Marshal(u.State)
+	if err != nil {
+		u.f.Close()
+		return fmt.Errorf("failed to encode upload state: %w", err)
+	}
+	if err := os.WriteFile(u.d.path(u.ID, ".json"), state, 0o640); err != nil {
+		u.f.Close()
+		return fmt.Errorf("failed to write upload state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package uploads
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func sum(data string) string {
+	h := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(h[:])
+}
+
+var Test_CreateTests = []struct {
+	name    string
+	state   State
+	wantErr bool
+}{
+	{"valid", State{Size: 7, SHA256: sum("payload")}, false},
+	{"upper case sha256", State{Size: 7, SHA256: "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855"}, false},
+	{"empty", State{Size: 0, SHA256: sum("")}, true},
+	{"too large", State{Size: 1025, SHA256: sum("payload")}, true},
+	{"invalid sha256", State{Size: 7, SHA256: "payload"}, true},
+	{"short sha256", State{Size: 7, SHA256: "e3b0c442"}, true},
+}
+
+func Test_Create(t *testing.T) {
+	d, err := New(t.TempDir(), 1024)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	for _, tt := range Test_CreateTests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := d.Create(tt.state)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				u.Close()
+			}
+		})
+	}
+}
+
+func Test_Resume(t *testing.T) {
+	d, err := New(t.TempDir(), 1024)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	target := json.RawMessage(`{"id":"a1"}`)
+	u, err := d.Create(State{Size: 7, SHA256: sum("payload"), Target: target})
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	if err := u.Write(0, []byte("pay")); err != nil {
+		t.Fatalf("Write() failed: %v", err)
+	}
+	if _, err := d.Open(u.ID); !errors.Is(err, ErrBusy) {
+		t.Errorf("Open() of a held upload error = %v; want ErrBusy", err)
+	}
+	u.Close()
+
+	u, err = d.Open(u.ID)
+	if err != nil {
+		t.Fatalf("Open() failed: %v", err)
+	}
+	defer u.Close()
+	if u.Offset() != 3 || u.Complete() || string(u.Target) != string(target) {
+		t.Errorf("Open() = offset %d, target %s; want offset 3, target %s", u.Offset(), u.Target, target)
+	}
+	if err := u.Write(0, []byte("pay")); !errors.Is(err, ErrOffset) {
+		t.Errorf("Write() of a received chunk error = %v; want ErrOffset", err)
+	}
+	if err := u.Write(3, []byte("loads")); !errors.Is(err, ErrSize) {
+		t.Errorf("Write() past the size error = %v; want ErrSize", err)
+	}
+	if err := u.Write(3, []byte("load")); err != nil {
+		t.Fatalf("Write() failed: %v", err)
+	}
+	if !u.Complete() {
+		t.Fatalf("Complete() = false at offset %d; want true", u.Offset())
+	}
+	if err := u.Verify(); err != nil {
+		t.Errorf("Verify() failed: %v", err)
+	}
+
+	r, err := u.Reader()
+	if err != nil {
+		t.Fatalf("Reader() failed: %v", err)
+	}
+	data, _ := io.ReadAll(r)
+	r.Close()
+	if string(data) != "payload" {
+		t.Errorf("Reader() = %s; want payload", data)
+	}
+
+	if err := d.Remove(u.ID); err != nil {
+		t.Fatalf("Remove() failed: %v", err)
+	}
+	if _, err := d.Open(u.ID); !errors.Is(err, ErrBusy) {
+		t.Errorf("Open() of a held upload error = %v; want ErrBusy", err)
+	}
+	u.Close()
+	if _, err := d.Open(u.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Open() of a removed upload error = %v; want ErrNotFound", err)
+	}
+}
+
+func Test_Verify(t *testing.T) {
+	d, err := New(t.TempDir(), 1024)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	u, err := d.Create(State{Size: 7, SHA256: sum("payload")})
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	defer u.Close()
+	if err := u.Write(0, []byte("paylode")); err != nil {
+		t.Fatalf("Write() failed: %v", err)
+	}
+	if err := u.Verify(); !errors.Is(err, ErrChecksum) {
+		t.Errorf("Verify() of another payload error = %v; want ErrChecksum", err)
+	}
+}
+
+func Test_Expire(t *testing.T) {
+	dir := t.TempDir()
+	d, err := New(dir, 1024)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	stale, err := d.Create(State{Size: 7, SHA256: sum("payload")})
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	stale.Close()
+	old := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, stale.ID+".data"), old, old); err != nil {
+		t.Fatal(err)
+	}
+	fresh, err := d.Create(State{Size: 7, SHA256: sum("payload")})
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	fresh.Close()
+
+	if _, err := d.Open("not-an-id"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Open() of an invalid id error = %v; want ErrNotFound", err)
+	}
+	if removed, err := d.Expire(time.Hour); err != nil || removed != 1 {
+		t.Fatalf("Expire() = %d, %v; want 1", removed, err)
+	}
+	if _, err := d.Open(stale.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Open() of an expired upload error = %v; want ErrNotFound", err)
+	}
+	u, err := d.Open(fresh.ID)
+	if err != nil {
+		t.Fatalf("Open() of a fresh upload failed: %v", err)
+	}
+	u.Close()
+}
@@ -13,7 +13,7 @@ export function Nifi() {
   useEffect(() => {
     const getData = async () => {
       const results = await queryTdfObjects({
-        srcType: 'unit',
+        srcTypes: ['unit'],
         tsRange: new TimestampSelector({
           greaterOrEqualTo: Timestamp.fromDate(new Date('2023-01-01')),
         }),
@@ -156,7 +156,7 @@ export function SearchFilter({ map }: Props) { //onSearch removed
       geoLocation = JSON.stringify(bboxPolygon);
     }
     return queryTdfObjects({
-      srcType: srcTypeId,
+      srcTypes: [srcTypeId],
       tsRange,
       search: JSON.stringify(searchJson),
       geoLocation,
@@ -40,6 +40,16 @@ export const mapColors: Record<string, string> = {
   'unclassified': '#007a33',
   'employee': '#2aad27',
   'sitrep': '#Ccb2b3e',
+  // AIS ship categories
+  'cargo': '#2e8b57',
+  'tanker': '#b22222',
+  'passenger': '#1e90ff',
+  'fishing': '#ff8c00',
+  'tug': '#daa520',
+  'military': '#2f4f4f',
+  'pleasure': '#9370db',
+  'high speed': '#00ced1',
+  'law enforcement': '#00008b',
   'red':'red',
   'orange':'orange',
   'yellow':'yellow',
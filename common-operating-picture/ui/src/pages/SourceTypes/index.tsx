@@ -134,7 +134,7 @@ export function SourceTypes() {
       tsRange.greaterOrEqualTo = Timestamp.fromDate(dayjsStart.toDate());
 
       const response = await queryTdfObjectsLight({
-        srcType: id,
+        srcTypes: [id],
         tsRange: tsRange,
       });
 
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateTdfObjectRequest, CreateTdfObjectResponse, GetEntitlementsRequest, GetEntitlementsResponse, GetSrcTypeRequest, GetSrcTypeResponse, GetTdfObjectRequest, GetTdfObjectResponse, ListSrcTypesRequest, ListSrcTypesResponse, QueryTdfObjectsRequest, QueryTdfObjectsResponse, StreamTdfObjectsRequest, StreamTdfObjectsResponse, UpdateTdfObjectRequest, UpdateTdfObjectResponse } from "./tdf_object_pb";
+import { CreateGeofenceRequest, CreateGeofenceResponse, CreateTdfAttachmentRequest, CreateTdfAttachmentResponse, CreateTdfObjectRequest, CreateTdfObjectResponse, CreateWebhookRequest, CreateWebhookResponse, DeleteGeofenceRequest, DeleteGeofenceResponse, DeleteWebhookRequest, DeleteWebhookResponse, DownloadTdfBlobRequest, DownloadTdfBlobResponse, GetEntitlementsRequest, GetEntitlementsResponse, GetGeofenceRequest, GetGeofenceResponse, GetLatestPositionsRequest, GetLatestPositionsResponse, GetSrcTypeRequest, GetSrcTypeResponse, GetTdfAttachmentRequest, GetTdfAttachmentResponse, GetTdfObjectRequest, GetTdfObjectResponse, GetTrackRequest, GetTrackResponse, GetWebhookRequest, GetWebhookResponse, ListGeofencesRequest, ListGeofencesResponse, ListSrcTypesRequest, ListSrcTypesResponse, ListTdfAttachmentsRequest, ListTdfAttachmentsResponse, ListTdfObjectRevisionsRequest, ListTdfObjectRevisionsResponse, ListWebhookDeadLettersRequest, ListWebhookDeadLettersResponse, ListWebhooksRequest, ListWebhooksResponse, QueryTdfObjectsRequest, QueryTdfObjectsResponse, RevertTdfObjectRequest, RevertTdfObjectResponse, StreamGeofenceEventsRequest, StreamGeofenceEventsResponse, StreamTdfObjectsRequest, StreamTdfObjectsResponse, UpdateGeofenceRequest, UpdateGeofenceResponse, UpdateTdfObjectRequest, UpdateTdfObjectResponse, UpdateWebhookRequest, UpdateWebhookResponse, UploadTdfBlobRequest, UploadTdfBlobResponse } from "./tdf_object_pb";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -48,6 +48,24 @@ export const TdfObjectService = {
       O: QueryTdfObjectsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.GetLatestPositions
+     */
+    getLatestPositions: {
+      name: "GetLatestPositions",
+      I: GetLatestPositionsRequest,
+      O: GetLatestPositionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.GetTrack
+     */
+    getTrack: {
+      name: "GetTrack",
+      I: GetTrackRequest,
+      O: GetTrackResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc tdf_object.v1.TdfObjectService.StreamTdfObjects
      */
@@ -84,6 +102,182 @@ export const TdfObjectService = {
       O: GetEntitlementsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.CreateGeofence
+     */
+    createGeofence: {
+      name: "CreateGeofence",
+      I: CreateGeofenceRequest,
+      O: CreateGeofenceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.UpdateGeofence
+     */
+    updateGeofence: {
+      name: "UpdateGeofence",
+      I: UpdateGeofenceRequest,
+      O: UpdateGeofenceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.GetGeofence
+     */
+    getGeofence: {
+      name: "GetGeofence",
+      I: GetGeofenceRequest,
+      O: GetGeofenceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.ListGeofences
+     */
+    listGeofences: {
+      name: "ListGeofences",
+      I: ListGeofencesRequest,
+      O: ListGeofencesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.DeleteGeofence
+     */
+    deleteGeofence: {
+      name: "DeleteGeofence",
+      I: DeleteGeofenceRequest,
+      O: DeleteGeofenceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.StreamGeofenceEvents
+     */
+    streamGeofenceEvents: {
+      name: "StreamGeofenceEvents",
+      I: StreamGeofenceEventsRequest,
+      O: StreamGeofenceEventsResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.CreateWebhook
+     */
+    createWebhook: {
+      name: "CreateWebhook",
+      I: CreateWebhookRequest,
+      O: CreateWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.UpdateWebhook
+     */
+    updateWebhook: {
+      name: "UpdateWebhook",
+      I: UpdateWebhookRequest,
+      O: UpdateWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.GetWebhook
+     */
+    getWebhook: {
+      name: "GetWebhook",
+      I: GetWebhookRequest,
+      O: GetWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.ListWebhooks
+     */
+    listWebhooks: {
+      name: "ListWebhooks",
+      I: ListWebhooksRequest,
+      O: ListWebhooksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.DeleteWebhook
+     */
+    deleteWebhook: {
+      name: "DeleteWebhook",
+      I: DeleteWebhookRequest,
+      O: DeleteWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.ListWebhookDeadLetters
+     */
+    listWebhookDeadLetters: {
+      name: "ListWebhookDeadLetters",
+      I: ListWebhookDeadLettersRequest,
+      O: ListWebhookDeadLettersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * uploads a TDF blob in chunks, resumable from the offset of the upload, and attaches it to a new or existing
+     * tdf_object once complete
+     *
+     * @generated from rpc tdf_object.v1.TdfObjectService.UploadTdfBlob
+     */
+    uploadTdfBlob: {
+      name: "UploadTdfBlob",
+      I: UploadTdfBlobRequest,
+      O: UploadTdfBlobResponse,
+      kind: MethodKind.ClientStreaming,
+    },
+    /**
+     * downloads the TDF blob of a tdf_object in chunks from an offset
+     *
+     * @generated from rpc tdf_object.v1.TdfObjectService.DownloadTdfBlob
+     */
+    downloadTdfBlob: {
+      name: "DownloadTdfBlob",
+      I: DownloadTdfBlobRequest,
+      O: DownloadTdfBlobResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.CreateTdfAttachment
+     */
+    createTdfAttachment: {
+      name: "CreateTdfAttachment",
+      I: CreateTdfAttachmentRequest,
+      O: CreateTdfAttachmentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.ListTdfAttachments
+     */
+    listTdfAttachments: {
+      name: "ListTdfAttachments",
+      I: ListTdfAttachmentsRequest,
+      O: ListTdfAttachmentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.GetTdfAttachment
+     */
+    getTdfAttachment: {
+      name: "GetTdfAttachment",
+      I: GetTdfAttachmentRequest,
+      O: GetTdfAttachmentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.ListTdfObjectRevisions
+     */
+    listTdfObjectRevisions: {
+      name: "ListTdfObjectRevisions",
+      I: ListTdfObjectRevisionsRequest,
+      O: ListTdfObjectRevisionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc tdf_object.v1.TdfObjectService.RevertTdfObject
+     */
+    revertTdfObject: {
+      name: "RevertTdfObject",
+      I: RevertTdfObjectRequest,
+      O: RevertTdfObjectResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { BytesValue, Message, proto3, StringValue, Struct, Timestamp } from "@bufbuild/protobuf";
+import { BytesValue, Int32Value, Message, proto3, protoInt64, StringValue, Struct, Timestamp, UInt32Value } from "@bufbuild/protobuf";
 
 /**
  * @generated from enum tdf_object.v1.StreamEventType
@@ -72,6 +72,28 @@ export enum StreamEventType {
    * @generated from enum value: STREAM_EVENT_TYPE_TDF_OBJECTS_NEW = 20;
    */
   TDF_OBJECTS_NEW = 20,
+
+  /**
+   * @generated from enum value: STREAM_EVENT_TYPE_TDF_OBJECTS_UPDATED = 21;
+   */
+  TDF_OBJECTS_UPDATED = 21,
+
+  /**
+   * geofence stream events
+   *
+   * @generated from enum value: STREAM_EVENT_TYPE_GEOFENCE_ENTER = 30;
+   */
+  GEOFENCE_ENTER = 30,
+
+  /**
+   * @generated from enum value: STREAM_EVENT_TYPE_GEOFENCE_EXIT = 31;
+   */
+  GEOFENCE_EXIT = 31,
+
+  /**
+   * @generated from enum value: STREAM_EVENT_TYPE_GEOFENCE_DWELL = 32;
+   */
+  GEOFENCE_DWELL = 32,
 }
 // Retrieve enum metadata with: proto3.getEnumType(StreamEventType)
 proto3.util.setEnumType(StreamEventType, "tdf_object.v1.StreamEventType", [
@@ -86,6 +108,10 @@ proto3.util.setEnumType(StreamEventType, "tdf_object.v1.StreamEventType", [
   { no: 11, name: "STREAM_EVENT_TYPE_SERVER_ERROR" },
   { no: 12, name: "STREAM_EVENT_TYPE_DATA_ERROR" },
   { no: 20, name: "STREAM_EVENT_TYPE_TDF_OBJECTS_NEW" },
+  { no: 21, name: "STREAM_EVENT_TYPE_TDF_OBJECTS_UPDATED" },
+  { no: 30, name: "STREAM_EVENT_TYPE_GEOFENCE_ENTER" },
+  { no: 31, name: "STREAM_EVENT_TYPE_GEOFENCE_EXIT" },
+  { no: 32, name: "STREAM_EVENT_TYPE_GEOFENCE_DWELL" },
 ]);
 
 /**
@@ -145,6 +171,20 @@ export class TdfObject extends Message<TdfObject> {
    */
   tdfUri = "";
 
+  /**
+   * identifies the entity (e.g. aircraft, vessel) the data is about
+   *
+   * @generated from field: string entity_key = 9;
+   */
+  entityKey = "";
+
+  /**
+   * incremented by every update, passed as expected_version of UpdateTdfObjectRequest
+   *
+   * @generated from field: int32 version = 10;
+   */
+  version = 0;
+
   constructor(data?: PartialMessage<TdfObject>) {
     super();
     proto3.util.initPartial(data, this);
@@ -161,6 +201,8 @@ export class TdfObject extends Message<TdfObject> {
     { no: 6, name: "metadata", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "tdf_blob", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
     { no: 8, name: "tdf_uri", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "entity_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TdfObject {
@@ -447,6 +489,13 @@ export class SrcTypeMetadata extends Message<SrcTypeMetadata> {
    */
   mapFields?: SrcTypeMetadataMapFields;
 
+  /**
+   * search or metadata field used as the entity_key when one is not provided
+   *
+   * @generated from field: string entity_field = 7;
+   */
+  entityField = "";
+
   constructor(data?: PartialMessage<SrcTypeMetadata>) {
     super();
     proto3.util.initPartial(data, this);
@@ -461,6 +510,7 @@ export class SrcTypeMetadata extends Message<SrcTypeMetadata> {
     { no: 4, name: "ts_field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "display_fields", kind: "message", T: SrcTypeMetadataDisplayFields },
     { no: 6, name: "map_fields", kind: "message", T: SrcTypeMetadataMapFields },
+    { no: 7, name: "entity_field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SrcTypeMetadata {
@@ -631,6 +681,13 @@ export class CreateTdfObjectRequest extends Message<CreateTdfObjectRequest> {
    */
   ts?: Timestamp;
 
+  /**
+   * identifies the entity (e.g. aircraft, vessel) the data is about
+   *
+   * @generated from field: string entity_key = 8;
+   */
+  entityKey = "";
+
   constructor(data?: PartialMessage<CreateTdfObjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -646,6 +703,7 @@ export class CreateTdfObjectRequest extends Message<CreateTdfObjectRequest> {
     { no: 5, name: "tdf_blob", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
     { no: 6, name: "tdf_uri", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "ts", kind: "message", T: Timestamp },
+    { no: 8, name: "entity_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTdfObjectRequest {
@@ -762,6 +820,20 @@ export class UpdateTdfObjectRequest extends Message<UpdateTdfObjectRequest> {
    */
   ts?: Timestamp;
 
+  /**
+   * identifies the entity (e.g. aircraft, vessel) the data is about
+   *
+   * @generated from field: google.protobuf.StringValue entity_key = 9;
+   */
+  entityKey?: string;
+
+  /**
+   * version the update is based on, the update fails with ABORTED when the tdf_object has another version
+   *
+   * @generated from field: google.protobuf.Int32Value expected_version = 10;
+   */
+  expectedVersion?: number;
+
   constructor(data?: PartialMessage<UpdateTdfObjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -778,6 +850,8 @@ export class UpdateTdfObjectRequest extends Message<UpdateTdfObjectRequest> {
     { no: 6, name: "tdf_blob", kind: "message", T: BytesValue },
     { no: 7, name: "tdf_uri", kind: "message", T: StringValue },
     { no: 8, name: "ts", kind: "message", T: Timestamp },
+    { no: 9, name: "entity_key", kind: "message", T: StringValue },
+    { no: 10, name: "expected_version", kind: "message", T: Int32Value },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTdfObjectRequest {
@@ -806,6 +880,20 @@ export class UpdateTdfObjectResponse extends Message<UpdateTdfObjectResponse> {
    */
   id = "";
 
+  /**
+   * revision recording the version the update replaced
+   *
+   * @generated from field: int32 revision = 2;
+   */
+  revision = 0;
+
+  /**
+   * version of the updated tdf_object
+   *
+   * @generated from field: int32 version = 3;
+   */
+  version = 0;
+
   constructor(data?: PartialMessage<UpdateTdfObjectResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -815,6 +903,8 @@ export class UpdateTdfObjectResponse extends Message<UpdateTdfObjectResponse> {
   static readonly typeName = "tdf_object.v1.UpdateTdfObjectResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTdfObjectResponse {
@@ -918,9 +1008,11 @@ export class QueryTdfObjectsRequest extends Message<QueryTdfObjectsRequest> {
   tsRange?: TimestampSelector;
 
   /**
-   * @generated from field: string src_type = 2;
+   * source types to query, an empty list queries all source types
+   *
+   * @generated from field: repeated string src_types = 2;
    */
-  srcType = "";
+  srcTypes: string[] = [];
 
   /**
    * @generated from field: string geo_location = 3;
@@ -946,7 +1038,7 @@ export class QueryTdfObjectsRequest extends Message<QueryTdfObjectsRequest> {
   static readonly typeName = "tdf_object.v1.QueryTdfObjectsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "ts_range", kind: "message", T: TimestampSelector },
-    { no: 2, name: "src_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "src_types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "geo_location", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "search", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "metadata", kind: "scalar", T: 9 /* ScalarType.STRING */ },
@@ -1006,6 +1098,194 @@ export class QueryTdfObjectsResponse extends Message<QueryTdfObjectsResponse> {
   }
 }
 
+/**
+ * @generated from message tdf_object.v1.GetLatestPositionsRequest
+ */
+export class GetLatestPositionsRequest extends Message<GetLatestPositionsRequest> {
+  /**
+   * @generated from field: tdf_object.v1.TimestampSelector ts_range = 1;
+   */
+  tsRange?: TimestampSelector;
+
+  /**
+   * source types to query, an empty list queries all source types
+   *
+   * @generated from field: repeated string src_types = 2;
+   */
+  srcTypes: string[] = [];
+
+  constructor(data?: PartialMessage<GetLatestPositionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.GetLatestPositionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ts_range", kind: "message", T: TimestampSelector },
+    { no: 2, name: "src_types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLatestPositionsRequest {
+    return new GetLatestPositionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLatestPositionsRequest {
+    return new GetLatestPositionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLatestPositionsRequest {
+    return new GetLatestPositionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetLatestPositionsRequest | PlainMessage<GetLatestPositionsRequest> | undefined, b: GetLatestPositionsRequest | PlainMessage<GetLatestPositionsRequest> | undefined): boolean {
+    return proto3.util.equals(GetLatestPositionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.GetLatestPositionsResponse
+ */
+export class GetLatestPositionsResponse extends Message<GetLatestPositionsResponse> {
+  /**
+   * most recent tdf_object of each entity
+   *
+   * @generated from field: repeated tdf_object.v1.TdfObject tdf_objects = 1;
+   */
+  tdfObjects: TdfObject[] = [];
+
+  constructor(data?: PartialMessage<GetLatestPositionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.GetLatestPositionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tdf_objects", kind: "message", T: TdfObject, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLatestPositionsResponse {
+    return new GetLatestPositionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLatestPositionsResponse {
+    return new GetLatestPositionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLatestPositionsResponse {
+    return new GetLatestPositionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetLatestPositionsResponse | PlainMessage<GetLatestPositionsResponse> | undefined, b: GetLatestPositionsResponse | PlainMessage<GetLatestPositionsResponse> | undefined): boolean {
+    return proto3.util.equals(GetLatestPositionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.GetTrackRequest
+ */
+export class GetTrackRequest extends Message<GetTrackRequest> {
+  /**
+   * @generated from field: tdf_object.v1.TimestampSelector ts_range = 1;
+   */
+  tsRange?: TimestampSelector;
+
+  /**
+   * @generated from field: string src_type = 2;
+   */
+  srcType = "";
+
+  /**
+   * @generated from field: string entity_key = 3;
+   */
+  entityKey = "";
+
+  constructor(data?: PartialMessage<GetTrackRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.GetTrackRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ts_range", kind: "message", T: TimestampSelector },
+    { no: 2, name: "src_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "entity_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTrackRequest {
+    return new GetTrackRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTrackRequest {
+    return new GetTrackRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTrackRequest {
+    return new GetTrackRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetTrackRequest | PlainMessage<GetTrackRequest> | undefined, b: GetTrackRequest | PlainMessage<GetTrackRequest> | undefined): boolean {
+    return proto3.util.equals(GetTrackRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.GetTrackResponse
+ */
+export class GetTrackResponse extends Message<GetTrackResponse> {
+  /**
+   * GeoJSON LineString of the entity positions ordered by time
+   *
+   * @generated from field: string geo = 1;
+   */
+  geo = "";
+
+  /**
+   * timestamp of each LineString coordinate
+   *
+   * @generated from field: repeated google.protobuf.Timestamp ts = 2;
+   */
+  ts: Timestamp[] = [];
+
+  /**
+   * tdf_object id of each LineString coordinate
+   *
+   * @generated from field: repeated string ids = 3;
+   */
+  ids: string[] = [];
+
+  constructor(data?: PartialMessage<GetTrackResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.GetTrackResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "geo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ts", kind: "message", T: Timestamp, repeated: true },
+    { no: 3, name: "ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTrackResponse {
+    return new GetTrackResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTrackResponse {
+    return new GetTrackResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTrackResponse {
+    return new GetTrackResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetTrackResponse | PlainMessage<GetTrackResponse> | undefined, b: GetTrackResponse | PlainMessage<GetTrackResponse> | undefined): boolean {
+    return proto3.util.equals(GetTrackResponse, a, b);
+  }
+}
+
 /**
  * todo: intentionally left empty until we decide if any filtering needs to be done
  *
@@ -1088,6 +1368,2301 @@ export class StreamTdfObjectsResponse extends Message<StreamTdfObjectsResponse>
   }
 }
 
+/**
+ * @generated from message tdf_object.v1.Geofence
+ */
+export class Geofence extends Message<Geofence> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * GeoJSON polygon of the geofence
+   *
+   * @generated from field: string geo = 3;
+   */
+  geo = "";
+
+  /**
+   * only tdf_objects of this source type are evaluated, empty evaluates all source types
+   *
+   * @generated from field: string src_type = 4;
+   */
+  srcType = "";
+
+  /**
+   * only tdf_objects whose search index contains this json are evaluated
+   *
+   * @generated from field: string search = 5;
+   */
+  search = "";
+
+  /**
+   * seconds an entity must stay inside before a dwell event is emitted, 0 disables dwell events
+   *
+   * @generated from field: uint32 dwell_seconds = 6;
+   */
+  dwellSeconds = 0;
+
+  constructor(data?: PartialMessage<Geofence>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.Geofence";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "geo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "src_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "search", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "dwell_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Geofence {
+    return new Geofence().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Geofence {
+    return new Geofence().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Geofence {
+    return new Geofence().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Geofence | PlainMessage<Geofence> | undefined, b: Geofence | PlainMessage<Geofence> | undefined): boolean {
+    return proto3.util.equals(Geofence, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.CreateGeofenceRequest
+ */
+export class CreateGeofenceRequest extends Message<CreateGeofenceRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * GeoJSON polygon of the geofence
+   *
+   * @generated from field: string geo = 2;
+   */
+  geo = "";
+
+  /**
+   * only tdf_objects of this source type are evaluated, empty evaluates all source types
+   *
+   * @generated from field: string src_type = 3;
+   */
+  srcType = "";
+
+  /**
+   * only tdf_objects whose search index contains this json are evaluated
+   *
+   * @generated from field: string search = 4;
+   */
+  search = "";
+
+  /**
+   * seconds an entity must stay inside before a dwell event is emitted, 0 disables dwell events
+   *
+   * @generated from field: uint32 dwell_seconds = 5;
+   */
+  dwellSeconds = 0;
+
+  constructor(data?: PartialMessage<CreateGeofenceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.CreateGeofenceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "geo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "src_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "search", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "dwell_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateGeofenceRequest {
+    return new CreateGeofenceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateGeofenceRequest {
+    return new CreateGeofenceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateGeofenceRequest {
+    return new CreateGeofenceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateGeofenceRequest | PlainMessage<CreateGeofenceRequest> | undefined, b: CreateGeofenceRequest | PlainMessage<CreateGeofenceRequest> | undefined): boolean {
+    return proto3.util.equals(CreateGeofenceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.CreateGeofenceResponse
+ */
+export class CreateGeofenceResponse extends Message<CreateGeofenceResponse> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<CreateGeofenceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.CreateGeofenceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateGeofenceResponse {
+    return new CreateGeofenceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateGeofenceResponse {
+    return new CreateGeofenceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateGeofenceResponse {
+    return new CreateGeofenceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateGeofenceResponse | PlainMessage<CreateGeofenceResponse> | undefined, b: CreateGeofenceResponse | PlainMessage<CreateGeofenceResponse> | undefined): boolean {
+    return proto3.util.equals(CreateGeofenceResponse, a, b);
+  }
+}
+
+/**
+ * Uses wrappers to enable optional fields
+ *
+ * @generated from message tdf_object.v1.UpdateGeofenceRequest
+ */
+export class UpdateGeofenceRequest extends Message<UpdateGeofenceRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: google.protobuf.StringValue name = 2;
+   */
+  name?: string;
+
+  /**
+   * GeoJSON polygon of the geofence
+   *
+   * @generated from field: google.protobuf.StringValue geo = 3;
+   */
+  geo?: string;
+
+  /**
+   * an empty string evaluates all source types
+   *
+   * @generated from field: google.protobuf.StringValue src_type = 4;
+   */
+  srcType?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue search = 5;
+   */
+  search?: string;
+
+  /**
+   * @generated from field: google.protobuf.UInt32Value dwell_seconds = 6;
+   */
+  dwellSeconds?: number;
+
+  constructor(data?: PartialMessage<UpdateGeofenceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.UpdateGeofenceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "message", T: StringValue },
+    { no: 3, name: "geo", kind: "message", T: StringValue },
+    { no: 4, name: "src_type", kind: "message", T: StringValue },
+    { no: 5, name: "search", kind: "message", T: StringValue },
+    { no: 6, name: "dwell_seconds", kind: "message", T: UInt32Value },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateGeofenceRequest {
+    return new UpdateGeofenceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateGeofenceRequest {
+    return new UpdateGeofenceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateGeofenceRequest {
+    return new UpdateGeofenceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateGeofenceRequest | PlainMessage<UpdateGeofenceRequest> | undefined, b: UpdateGeofenceRequest | PlainMessage<UpdateGeofenceRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateGeofenceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.UpdateGeofenceResponse
+ */
+export class UpdateGeofenceResponse extends Message<UpdateGeofenceResponse> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<UpdateGeofenceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.UpdateGeofenceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateGeofenceResponse {
+    return new UpdateGeofenceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateGeofenceResponse {
+    return new UpdateGeofenceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateGeofenceResponse {
+    return new UpdateGeofenceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateGeofenceResponse | PlainMessage<UpdateGeofenceResponse> | undefined, b: UpdateGeofenceResponse | PlainMessage<UpdateGeofenceResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateGeofenceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.GetGeofenceRequest
+ */
+export class GetGeofenceRequest extends Message<GetGeofenceRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetGeofenceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.GetGeofenceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGeofenceRequest {
+    return new GetGeofenceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGeofenceRequest {
+    return new GetGeofenceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGeofenceRequest {
+    return new GetGeofenceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetGeofenceRequest | PlainMessage<GetGeofenceRequest> | undefined, b: GetGeofenceRequest | PlainMessage<GetGeofenceRequest> | undefined): boolean {
+    return proto3.util.equals(GetGeofenceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.GetGeofenceResponse
+ */
+export class GetGeofenceResponse extends Message<GetGeofenceResponse> {
+  /**
+   * @generated from field: tdf_object.v1.Geofence geofence = 1;
+   */
+  geofence?: Geofence;
+
+  constructor(data?: PartialMessage<GetGeofenceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.GetGeofenceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "geofence", kind: "message", T: Geofence },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGeofenceResponse {
+    return new GetGeofenceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGeofenceResponse {
+    return new GetGeofenceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGeofenceResponse {
+    return new GetGeofenceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetGeofenceResponse | PlainMessage<GetGeofenceResponse> | undefined, b: GetGeofenceResponse | PlainMessage<GetGeofenceResponse> | undefined): boolean {
+    return proto3.util.equals(GetGeofenceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.ListGeofencesRequest
+ */
+export class ListGeofencesRequest extends Message<ListGeofencesRequest> {
+  constructor(data?: PartialMessage<ListGeofencesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.ListGeofencesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListGeofencesRequest {
+    return new ListGeofencesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListGeofencesRequest {
+    return new ListGeofencesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListGeofencesRequest {
+    return new ListGeofencesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListGeofencesRequest | PlainMessage<ListGeofencesRequest> | undefined, b: ListGeofencesRequest | PlainMessage<ListGeofencesRequest> | undefined): boolean {
+    return proto3.util.equals(ListGeofencesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.ListGeofencesResponse
+ */
+export class ListGeofencesResponse extends Message<ListGeofencesResponse> {
+  /**
+   * @generated from field: repeated tdf_object.v1.Geofence geofences = 1;
+   */
+  geofences: Geofence[] = [];
+
+  constructor(data?: PartialMessage<ListGeofencesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.ListGeofencesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "geofences", kind: "message", T: Geofence, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListGeofencesResponse {
+    return new ListGeofencesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListGeofencesResponse {
+    return new ListGeofencesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListGeofencesResponse {
+    return new ListGeofencesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListGeofencesResponse | PlainMessage<ListGeofencesResponse> | undefined, b: ListGeofencesResponse | PlainMessage<ListGeofencesResponse> | undefined): boolean {
+    return proto3.util.equals(ListGeofencesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.DeleteGeofenceRequest
+ */
+export class DeleteGeofenceRequest extends Message<DeleteGeofenceRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteGeofenceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.DeleteGeofenceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteGeofenceRequest {
+    return new DeleteGeofenceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteGeofenceRequest {
+    return new DeleteGeofenceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteGeofenceRequest {
+    return new DeleteGeofenceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteGeofenceRequest | PlainMessage<DeleteGeofenceRequest> | undefined, b: DeleteGeofenceRequest | PlainMessage<DeleteGeofenceRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteGeofenceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.DeleteGeofenceResponse
+ */
+export class DeleteGeofenceResponse extends Message<DeleteGeofenceResponse> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteGeofenceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.DeleteGeofenceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteGeofenceResponse {
+    return new DeleteGeofenceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteGeofenceResponse {
+    return new DeleteGeofenceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteGeofenceResponse {
+    return new DeleteGeofenceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteGeofenceResponse | PlainMessage<DeleteGeofenceResponse> | undefined, b: DeleteGeofenceResponse | PlainMessage<DeleteGeofenceResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteGeofenceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.GeofenceEvent
+ */
+export class GeofenceEvent extends Message<GeofenceEvent> {
+  /**
+   * @generated from field: string geofence_id = 1;
+   */
+  geofenceId = "";
+
+  /**
+   * @generated from field: string geofence_name = 2;
+   */
+  geofenceName = "";
+
+  /**
+   * entity_key of the tdf_object, or its id when it has no entity_key
+   *
+   * @generated from field: string entity_key = 3;
+   */
+  entityKey = "";
+
+  /**
+   * timestamp of the first tdf_object inside the geofence
+   *
+   * @generated from field: google.protobuf.Timestamp entered_at = 4;
+   */
+  enteredAt?: Timestamp;
+
+  /**
+   * tdf_object that triggered the event
+   *
+   * @generated from field: tdf_object.v1.TdfObject tdf_object = 5;
+   */
+  tdfObject?: TdfObject;
+
+  constructor(data?: PartialMessage<GeofenceEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.GeofenceEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "geofence_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "geofence_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "entity_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "entered_at", kind: "message", T: Timestamp },
+    { no: 5, name: "tdf_object", kind: "message", T: TdfObject },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GeofenceEvent {
+    return new GeofenceEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GeofenceEvent {
+    return new GeofenceEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GeofenceEvent {
+    return new GeofenceEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GeofenceEvent | PlainMessage<GeofenceEvent> | undefined, b: GeofenceEvent | PlainMessage<GeofenceEvent> | undefined): boolean {
+    return proto3.util.equals(GeofenceEvent, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.StreamGeofenceEventsRequest
+ */
+export class StreamGeofenceEventsRequest extends Message<StreamGeofenceEventsRequest> {
+  /**
+   * geofences to subscribe to, an empty list subscribes to all geofences
+   *
+   * @generated from field: repeated string geofence_ids = 1;
+   */
+  geofenceIds: string[] = [];
+
+  constructor(data?: PartialMessage<StreamGeofenceEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.StreamGeofenceEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "geofence_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamGeofenceEventsRequest {
+    return new StreamGeofenceEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamGeofenceEventsRequest {
+    return new StreamGeofenceEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamGeofenceEventsRequest {
+    return new StreamGeofenceEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StreamGeofenceEventsRequest | PlainMessage<StreamGeofenceEventsRequest> | undefined, b: StreamGeofenceEventsRequest | PlainMessage<StreamGeofenceEventsRequest> | undefined): boolean {
+    return proto3.util.equals(StreamGeofenceEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.StreamGeofenceEventsResponse
+ */
+export class StreamGeofenceEventsResponse extends Message<StreamGeofenceEventsResponse> {
+  /**
+   * @generated from field: tdf_object.v1.StreamEventType event_type = 1;
+   */
+  eventType = StreamEventType.UNSPECIFIED;
+
+  /**
+   * @generated from field: string event_detail = 2;
+   */
+  eventDetail = "";
+
+  /**
+   * @generated from field: tdf_object.v1.GeofenceEvent geofence_event = 3;
+   */
+  geofenceEvent?: GeofenceEvent;
+
+  constructor(data?: PartialMessage<StreamGeofenceEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.StreamGeofenceEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event_type", kind: "enum", T: proto3.getEnumType(StreamEventType) },
+    { no: 2, name: "event_detail", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "geofence_event", kind: "message", T: GeofenceEvent },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamGeofenceEventsResponse {
+    return new StreamGeofenceEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamGeofenceEventsResponse {
+    return new StreamGeofenceEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamGeofenceEventsResponse {
+    return new StreamGeofenceEventsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StreamGeofenceEventsResponse | PlainMessage<StreamGeofenceEventsResponse> | undefined, b: StreamGeofenceEventsResponse | PlainMessage<StreamGeofenceEventsResponse> | undefined): boolean {
+    return proto3.util.equals(StreamGeofenceEventsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.Webhook
+ */
+export class Webhook extends Message<Webhook> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * URL new tdf_objects are POSTed to
+   *
+   * @generated from field: string url = 3;
+   */
+  url = "";
+
+  /**
+   * only tdf_objects of this source type are delivered, empty delivers all source types
+   *
+   * @generated from field: string src_type = 4;
+   */
+  srcType = "";
+
+  /**
+   * only tdf_objects intersecting this GeoJSON geometry are delivered, empty delivers tdf_objects anywhere
+   *
+   * @generated from field: string geo = 5;
+   */
+  geo = "";
+
+  /**
+   * only tdf_objects whose search index contains this json are delivered
+   *
+   * @generated from field: string search = 6;
+   */
+  search = "";
+
+  /**
+   * OIDC client of the service identity whose entitlements limit the tdf_objects delivered
+   *
+   * @generated from field: string client_id = 7;
+   */
+  clientId = "";
+
+  constructor(data?: PartialMessage<Webhook>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.Webhook";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "src_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "geo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "search", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "client_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Webhook {
+    return new Webhook().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Webhook {
+    return new Webhook().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Webhook {
+    return new Webhook().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Webhook | PlainMessage<Webhook> | undefined, b: Webhook | PlainMessage<Webhook> | undefined): boolean {
+    return proto3.util.equals(Webhook, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.CreateWebhookRequest
+ */
+export class CreateWebhookRequest extends Message<CreateWebhookRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * URL new tdf_objects are POSTed to
+   *
+   * @generated from field: string url = 2;
+   */
+  url = "";
+
+  /**
+   * only tdf_objects of this source type are delivered, empty delivers all source types
+   *
+   * @generated from field: string src_type = 3;
+   */
+  srcType = "";
+
+  /**
+   * only tdf_objects intersecting this GeoJSON geometry are delivered, empty delivers tdf_objects anywhere
+   *
+   * @generated from field: string geo = 4;
+   */
+  geo = "";
+
+  /**
+   * only tdf_objects whose search index contains this json are delivered
+   *
+   * @generated from field: string search = 5;
+   */
+  search = "";
+
+  /**
+   * HMAC-SHA256 secret signing the deliveries, at least 32 characters. It is never returned
+   *
+   * @generated from field: string secret = 6;
+   */
+  secret = "";
+
+  /**
+   * OIDC client credentials of the service identity whose entitlements limit the tdf_objects delivered
+   *
+   * @generated from field: string client_id = 7;
+   */
+  clientId = "";
+
+  /**
+   * @generated from field: string client_secret = 8;
+   */
+  clientSecret = "";
+
+  constructor(data?: PartialMessage<CreateWebhookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.CreateWebhookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "src_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "geo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "search", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "client_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "client_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWebhookRequest {
+    return new CreateWebhookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWebhookRequest {
+    return new CreateWebhookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWebhookRequest {
+    return new CreateWebhookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWebhookRequest | PlainMessage<CreateWebhookRequest> | undefined, b: CreateWebhookRequest | PlainMessage<CreateWebhookRequest> | undefined): boolean {
+    return proto3.util.equals(CreateWebhookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.CreateWebhookResponse
+ */
+export class CreateWebhookResponse extends Message<CreateWebhookResponse> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<CreateWebhookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.CreateWebhookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWebhookResponse {
+    return new CreateWebhookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWebhookResponse {
+    return new CreateWebhookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWebhookResponse {
+    return new CreateWebhookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWebhookResponse | PlainMessage<CreateWebhookResponse> | undefined, b: CreateWebhookResponse | PlainMessage<CreateWebhookResponse> | undefined): boolean {
+    return proto3.util.equals(CreateWebhookResponse, a, b);
+  }
+}
+
+/**
+ * Uses wrappers to enable optional fields
+ *
+ * @generated from message tdf_object.v1.UpdateWebhookRequest
+ */
+export class UpdateWebhookRequest extends Message<UpdateWebhookRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: google.protobuf.StringValue name = 2;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue url = 3;
+   */
+  url?: string;
+
+  /**
+   * an empty string delivers all source types
+   *
+   * @generated from field: google.protobuf.StringValue src_type = 4;
+   */
+  srcType?: string;
+
+  /**
+   * an empty string delivers tdf_objects anywhere
+   *
+   * @generated from field: google.protobuf.StringValue geo = 5;
+   */
+  geo?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue search = 6;
+   */
+  search?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue secret = 7;
+   */
+  secret?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue client_id = 8;
+   */
+  clientId?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue client_secret = 9;
+   */
+  clientSecret?: string;
+
+  constructor(data?: PartialMessage<UpdateWebhookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.UpdateWebhookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "message", T: StringValue },
+    { no: 3, name: "url", kind: "message", T: StringValue },
+    { no: 4, name: "src_type", kind: "message", T: StringValue },
+    { no: 5, name: "geo", kind: "message", T: StringValue },
+    { no: 6, name: "search", kind: "message", T: StringValue },
+    { no: 7, name: "secret", kind: "message", T: StringValue },
+    { no: 8, name: "client_id", kind: "message", T: StringValue },
+    { no: 9, name: "client_secret", kind: "message", T: StringValue },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWebhookRequest {
+    return new UpdateWebhookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWebhookRequest {
+    return new UpdateWebhookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWebhookRequest {
+    return new UpdateWebhookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateWebhookRequest | PlainMessage<UpdateWebhookRequest> | undefined, b: UpdateWebhookRequest | PlainMessage<UpdateWebhookRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateWebhookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.UpdateWebhookResponse
+ */
+export class UpdateWebhookResponse extends Message<UpdateWebhookResponse> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<UpdateWebhookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.UpdateWebhookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWebhookResponse {
+    return new UpdateWebhookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWebhookResponse {
+    return new UpdateWebhookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWebhookResponse {
+    return new UpdateWebhookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateWebhookResponse | PlainMessage<UpdateWebhookResponse> | undefined, b: UpdateWebhookResponse | PlainMessage<UpdateWebhookResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateWebhookResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.GetWebhookRequest
+ */
+export class GetWebhookRequest extends Message<GetWebhookRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetWebhookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.GetWebhookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWebhookRequest {
+    return new GetWebhookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWebhookRequest {
+    return new GetWebhookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWebhookRequest {
+    return new GetWebhookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWebhookRequest | PlainMessage<GetWebhookRequest> | undefined, b: GetWebhookRequest | PlainMessage<GetWebhookRequest> | undefined): boolean {
+    return proto3.util.equals(GetWebhookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.GetWebhookResponse
+ */
+export class GetWebhookResponse extends Message<GetWebhookResponse> {
+  /**
+   * @generated from field: tdf_object.v1.Webhook webhook = 1;
+   */
+  webhook?: Webhook;
+
+  constructor(data?: PartialMessage<GetWebhookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.GetWebhookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook", kind: "message", T: Webhook },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWebhookResponse {
+    return new GetWebhookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWebhookResponse {
+    return new GetWebhookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWebhookResponse {
+    return new GetWebhookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWebhookResponse | PlainMessage<GetWebhookResponse> | undefined, b: GetWebhookResponse | PlainMessage<GetWebhookResponse> | undefined): boolean {
+    return proto3.util.equals(GetWebhookResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.ListWebhooksRequest
+ */
+export class ListWebhooksRequest extends Message<ListWebhooksRequest> {
+  constructor(data?: PartialMessage<ListWebhooksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.ListWebhooksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhooksRequest {
+    return new ListWebhooksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhooksRequest {
+    return new ListWebhooksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhooksRequest {
+    return new ListWebhooksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWebhooksRequest | PlainMessage<ListWebhooksRequest> | undefined, b: ListWebhooksRequest | PlainMessage<ListWebhooksRequest> | undefined): boolean {
+    return proto3.util.equals(ListWebhooksRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.ListWebhooksResponse
+ */
+export class ListWebhooksResponse extends Message<ListWebhooksResponse> {
+  /**
+   * @generated from field: repeated tdf_object.v1.Webhook webhooks = 1;
+   */
+  webhooks: Webhook[] = [];
+
+  constructor(data?: PartialMessage<ListWebhooksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.ListWebhooksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhooks", kind: "message", T: Webhook, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhooksResponse {
+    return new ListWebhooksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhooksResponse {
+    return new ListWebhooksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhooksResponse {
+    return new ListWebhooksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWebhooksResponse | PlainMessage<ListWebhooksResponse> | undefined, b: ListWebhooksResponse | PlainMessage<ListWebhooksResponse> | undefined): boolean {
+    return proto3.util.equals(ListWebhooksResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.DeleteWebhookRequest
+ */
+export class DeleteWebhookRequest extends Message<DeleteWebhookRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteWebhookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.DeleteWebhookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWebhookRequest {
+    return new DeleteWebhookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWebhookRequest {
+    return new DeleteWebhookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWebhookRequest {
+    return new DeleteWebhookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWebhookRequest | PlainMessage<DeleteWebhookRequest> | undefined, b: DeleteWebhookRequest | PlainMessage<DeleteWebhookRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteWebhookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.DeleteWebhookResponse
+ */
+export class DeleteWebhookResponse extends Message<DeleteWebhookResponse> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteWebhookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.DeleteWebhookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWebhookResponse {
+    return new DeleteWebhookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWebhookResponse {
+    return new DeleteWebhookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWebhookResponse {
+    return new DeleteWebhookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWebhookResponse | PlainMessage<DeleteWebhookResponse> | undefined, b: DeleteWebhookResponse | PlainMessage<DeleteWebhookResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteWebhookResponse, a, b);
+  }
+}
+
+/**
+ * delivery of a webhook that failed after all its attempts
+ *
+ * @generated from message tdf_object.v1.WebhookDeadLetter
+ */
+export class WebhookDeadLetter extends Message<WebhookDeadLetter> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string webhook_id = 2;
+   */
+  webhookId = "";
+
+  /**
+   * @generated from field: string tdf_object_id = 3;
+   */
+  tdfObjectId = "";
+
+  /**
+   * JSON body of the delivery
+   *
+   * @generated from field: string payload = 4;
+   */
+  payload = "";
+
+  /**
+   * @generated from field: uint32 attempts = 5;
+   */
+  attempts = 0;
+
+  /**
+   * error of the last attempt
+   *
+   * @generated from field: string error = 6;
+   */
+  error = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<WebhookDeadLetter>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.WebhookDeadLetter";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "webhook_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "tdf_object_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "payload", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "attempts", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookDeadLetter {
+    return new WebhookDeadLetter().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookDeadLetter {
+    return new WebhookDeadLetter().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookDeadLetter {
+    return new WebhookDeadLetter().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookDeadLetter | PlainMessage<WebhookDeadLetter> | undefined, b: WebhookDeadLetter | PlainMessage<WebhookDeadLetter> | undefined): boolean {
+    return proto3.util.equals(WebhookDeadLetter, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.ListWebhookDeadLettersRequest
+ */
+export class ListWebhookDeadLettersRequest extends Message<ListWebhookDeadLettersRequest> {
+  /**
+   * @generated from field: string webhook_id = 1;
+   */
+  webhookId = "";
+
+  constructor(data?: PartialMessage<ListWebhookDeadLettersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.ListWebhookDeadLettersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhookDeadLettersRequest {
+    return new ListWebhookDeadLettersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhookDeadLettersRequest {
+    return new ListWebhookDeadLettersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhookDeadLettersRequest {
+    return new ListWebhookDeadLettersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWebhookDeadLettersRequest | PlainMessage<ListWebhookDeadLettersRequest> | undefined, b: ListWebhookDeadLettersRequest | PlainMessage<ListWebhookDeadLettersRequest> | undefined): boolean {
+    return proto3.util.equals(ListWebhookDeadLettersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.ListWebhookDeadLettersResponse
+ */
+export class ListWebhookDeadLettersResponse extends Message<ListWebhookDeadLettersResponse> {
+  /**
+   * @generated from field: repeated tdf_object.v1.WebhookDeadLetter dead_letters = 1;
+   */
+  deadLetters: WebhookDeadLetter[] = [];
+
+  constructor(data?: PartialMessage<ListWebhookDeadLettersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.ListWebhookDeadLettersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dead_letters", kind: "message", T: WebhookDeadLetter, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhookDeadLettersResponse {
+    return new ListWebhookDeadLettersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhookDeadLettersResponse {
+    return new ListWebhookDeadLettersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhookDeadLettersResponse {
+    return new ListWebhookDeadLettersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWebhookDeadLettersResponse | PlainMessage<ListWebhookDeadLettersResponse> | undefined, b: ListWebhookDeadLettersResponse | PlainMessage<ListWebhookDeadLettersResponse> | undefined): boolean {
+    return proto3.util.equals(ListWebhookDeadLettersResponse, a, b);
+  }
+}
+
+/**
+ * first message of an upload, starting or resuming it
+ *
+ * @generated from message tdf_object.v1.UploadTdfBlobStart
+ */
+export class UploadTdfBlobStart extends Message<UploadTdfBlobStart> {
+  /**
+   * upload to resume, empty starts a new upload
+   *
+   * @generated from field: string upload_id = 1;
+   */
+  uploadId = "";
+
+  /**
+   * size in bytes of the blob
+   *
+   * @generated from field: int64 size = 2;
+   */
+  size = protoInt64.zero;
+
+  /**
+   * hex SHA-256 of the blob, checked once the upload is complete
+   *
+   * @generated from field: string sha256 = 3;
+   */
+  sha256 = "";
+
+  /**
+   * tdf_object whose TDF the blob replaces
+   *
+   * @generated from field: string tdf_object_id = 4;
+   */
+  tdfObjectId = "";
+
+  /**
+   * tdf_object created with the blob when tdf_object_id is empty, its tdf_blob and tdf_uri are ignored
+   *
+   * @generated from field: tdf_object.v1.CreateTdfObjectRequest tdf_object = 5;
+   */
+  tdfObject?: CreateTdfObjectRequest;
+
+  constructor(data?: PartialMessage<UploadTdfBlobStart>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.UploadTdfBlobStart";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "upload_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "sha256", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "tdf_object_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "tdf_object", kind: "message", T: CreateTdfObjectRequest },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadTdfBlobStart {
+    return new UploadTdfBlobStart().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadTdfBlobStart {
+    return new UploadTdfBlobStart().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadTdfBlobStart {
+    return new UploadTdfBlobStart().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadTdfBlobStart | PlainMessage<UploadTdfBlobStart> | undefined, b: UploadTdfBlobStart | PlainMessage<UploadTdfBlobStart> | undefined): boolean {
+    return proto3.util.equals(UploadTdfBlobStart, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.UploadTdfBlobRequest
+ */
+export class UploadTdfBlobRequest extends Message<UploadTdfBlobRequest> {
+  /**
+   * set in the first message of the stream only
+   *
+   * @generated from field: tdf_object.v1.UploadTdfBlobStart start = 1;
+   */
+  start?: UploadTdfBlobStart;
+
+  /**
+   * offset of the chunk in the blob, which must be the number of bytes already received
+   *
+   * @generated from field: int64 offset = 2;
+   */
+  offset = protoInt64.zero;
+
+  /**
+   * @generated from field: bytes data = 3;
+   */
+  data = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<UploadTdfBlobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.UploadTdfBlobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start", kind: "message", T: UploadTdfBlobStart },
+    { no: 2, name: "offset", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadTdfBlobRequest {
+    return new UploadTdfBlobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadTdfBlobRequest {
+    return new UploadTdfBlobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadTdfBlobRequest {
+    return new UploadTdfBlobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadTdfBlobRequest | PlainMessage<UploadTdfBlobRequest> | undefined, b: UploadTdfBlobRequest | PlainMessage<UploadTdfBlobRequest> | undefined): boolean {
+    return proto3.util.equals(UploadTdfBlobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.UploadTdfBlobResponse
+ */
+export class UploadTdfBlobResponse extends Message<UploadTdfBlobResponse> {
+  /**
+   * @generated from field: string upload_id = 1;
+   */
+  uploadId = "";
+
+  /**
+   * number of bytes received, a resumed upload continues from this offset
+   *
+   * @generated from field: int64 offset = 2;
+   */
+  offset = protoInt64.zero;
+
+  /**
+   * tdf_object the blob is attached to, set once the upload is complete
+   *
+   * @generated from field: string tdf_object_id = 3;
+   */
+  tdfObjectId = "";
+
+  constructor(data?: PartialMessage<UploadTdfBlobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.UploadTdfBlobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "upload_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "offset", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "tdf_object_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadTdfBlobResponse {
+    return new UploadTdfBlobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadTdfBlobResponse {
+    return new UploadTdfBlobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadTdfBlobResponse {
+    return new UploadTdfBlobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadTdfBlobResponse | PlainMessage<UploadTdfBlobResponse> | undefined, b: UploadTdfBlobResponse | PlainMessage<UploadTdfBlobResponse> | undefined): boolean {
+    return proto3.util.equals(UploadTdfBlobResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.DownloadTdfBlobRequest
+ */
+export class DownloadTdfBlobRequest extends Message<DownloadTdfBlobRequest> {
+  /**
+   * tdf_object of the blob
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * offset the download starts from, to resume a download
+   *
+   * @generated from field: int64 offset = 2;
+   */
+  offset = protoInt64.zero;
+
+  /**
+   * maximum bytes per chunk, 0 is 1 MiB
+   *
+   * @generated from field: int32 chunk_size = 3;
+   */
+  chunkSize = 0;
+
+  constructor(data?: PartialMessage<DownloadTdfBlobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.DownloadTdfBlobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "offset", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "chunk_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DownloadTdfBlobRequest {
+    return new DownloadTdfBlobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DownloadTdfBlobRequest {
+    return new DownloadTdfBlobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DownloadTdfBlobRequest {
+    return new DownloadTdfBlobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DownloadTdfBlobRequest | PlainMessage<DownloadTdfBlobRequest> | undefined, b: DownloadTdfBlobRequest | PlainMessage<DownloadTdfBlobRequest> | undefined): boolean {
+    return proto3.util.equals(DownloadTdfBlobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.DownloadTdfBlobResponse
+ */
+export class DownloadTdfBlobResponse extends Message<DownloadTdfBlobResponse> {
+  /**
+   * offset of the chunk in the blob
+   *
+   * @generated from field: int64 offset = 1;
+   */
+  offset = protoInt64.zero;
+
+  /**
+   * @generated from field: bytes data = 2;
+   */
+  data = new Uint8Array(0);
+
+  /**
+   * size in bytes of the blob, set in the first message
+   *
+   * @generated from field: int64 size = 3;
+   */
+  size = protoInt64.zero;
+
+  /**
+   * hex SHA-256 of the whole blob, set in the last message
+   *
+   * @generated from field: string sha256 = 4;
+   */
+  sha256 = "";
+
+  constructor(data?: PartialMessage<DownloadTdfBlobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.DownloadTdfBlobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "offset", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "sha256", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DownloadTdfBlobResponse {
+    return new DownloadTdfBlobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DownloadTdfBlobResponse {
+    return new DownloadTdfBlobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DownloadTdfBlobResponse {
+    return new DownloadTdfBlobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DownloadTdfBlobResponse | PlainMessage<DownloadTdfBlobResponse> | undefined, b: DownloadTdfBlobResponse | PlainMessage<DownloadTdfBlobResponse> | undefined): boolean {
+    return proto3.util.equals(DownloadTdfBlobResponse, a, b);
+  }
+}
+
+/**
+ * file attached to a tdf_object or tdf_note, with its own classification
+ *
+ * @generated from message tdf_object.v1.TdfAttachment
+ */
+export class TdfAttachment extends Message<TdfAttachment> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp ts = 2;
+   */
+  ts?: Timestamp;
+
+  /**
+   * tdf_object or tdf_note the file is attached to
+   *
+   * @generated from field: string parent_id = 3;
+   */
+  parentId = "";
+
+  /**
+   * "tdf_object" or "tdf_note"
+   *
+   * @generated from field: string parent_type = 4;
+   */
+  parentType = "";
+
+  /**
+   * file name, e.g. "photo.jpg"
+   *
+   * @generated from field: string name = 5;
+   */
+  name = "";
+
+  /**
+   * MIME type of the attached file, e.g. "image/jpeg"
+   *
+   * @generated from field: string mime_type = 6;
+   */
+  mimeType = "";
+
+  /**
+   * size in bytes of the tdf
+   *
+   * @generated from field: int64 size = 7;
+   */
+  size = protoInt64.zero;
+
+  /**
+   * plaintext json search index
+   *
+   * @generated from field: string search = 8;
+   */
+  search = "";
+
+  /**
+   * tdf bytes, only returned by GetTdfAttachment
+   *
+   * @generated from field: bytes tdf_blob = 9;
+   */
+  tdfBlob = new Uint8Array(0);
+
+  /**
+   * tdf data uri, only returned by GetTdfAttachment
+   *
+   * @generated from field: string tdf_uri = 10;
+   */
+  tdfUri = "";
+
+  constructor(data?: PartialMessage<TdfAttachment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.TdfAttachment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ts", kind: "message", T: Timestamp },
+    { no: 3, name: "parent_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "parent_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "search", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "tdf_blob", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 10, name: "tdf_uri", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TdfAttachment {
+    return new TdfAttachment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TdfAttachment {
+    return new TdfAttachment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TdfAttachment {
+    return new TdfAttachment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TdfAttachment | PlainMessage<TdfAttachment> | undefined, b: TdfAttachment | PlainMessage<TdfAttachment> | undefined): boolean {
+    return proto3.util.equals(TdfAttachment, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.CreateTdfAttachmentRequest
+ */
+export class CreateTdfAttachmentRequest extends Message<CreateTdfAttachmentRequest> {
+  /**
+   * @generated from field: string parent_id = 1;
+   */
+  parentId = "";
+
+  /**
+   * "tdf_object" or "tdf_note"
+   *
+   * @generated from field: string parent_type = 2;
+   */
+  parentType = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string mime_type = 4;
+   */
+  mimeType = "";
+
+  /**
+   * plaintext json search index, with the classification of the attachment
+   *
+   * @generated from field: string search = 5;
+   */
+  search = "";
+
+  /**
+   * @generated from field: bytes tdf_blob = 6;
+   */
+  tdfBlob = new Uint8Array(0);
+
+  /**
+   * tdf data uri, instead of tdf_blob
+   *
+   * @generated from field: string tdf_uri = 7;
+   */
+  tdfUri = "";
+
+  /**
+   * size in bytes of the tdf at tdf_uri, the size of tdf_blob is used when it is set
+   *
+   * @generated from field: int64 size = 8;
+   */
+  size = protoInt64.zero;
+
+  constructor(data?: PartialMessage<CreateTdfAttachmentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.CreateTdfAttachmentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "parent_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "parent_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "search", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "tdf_blob", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 7, name: "tdf_uri", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTdfAttachmentRequest {
+    return new CreateTdfAttachmentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTdfAttachmentRequest {
+    return new CreateTdfAttachmentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTdfAttachmentRequest {
+    return new CreateTdfAttachmentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateTdfAttachmentRequest | PlainMessage<CreateTdfAttachmentRequest> | undefined, b: CreateTdfAttachmentRequest | PlainMessage<CreateTdfAttachmentRequest> | undefined): boolean {
+    return proto3.util.equals(CreateTdfAttachmentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.CreateTdfAttachmentResponse
+ */
+export class CreateTdfAttachmentResponse extends Message<CreateTdfAttachmentResponse> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<CreateTdfAttachmentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.CreateTdfAttachmentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTdfAttachmentResponse {
+    return new CreateTdfAttachmentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTdfAttachmentResponse {
+    return new CreateTdfAttachmentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTdfAttachmentResponse {
+    return new CreateTdfAttachmentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateTdfAttachmentResponse | PlainMessage<CreateTdfAttachmentResponse> | undefined, b: CreateTdfAttachmentResponse | PlainMessage<CreateTdfAttachmentResponse> | undefined): boolean {
+    return proto3.util.equals(CreateTdfAttachmentResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.ListTdfAttachmentsRequest
+ */
+export class ListTdfAttachmentsRequest extends Message<ListTdfAttachmentsRequest> {
+  /**
+   * tdf_object or tdf_note of the attachments
+   *
+   * @generated from field: string parent_id = 1;
+   */
+  parentId = "";
+
+  constructor(data?: PartialMessage<ListTdfAttachmentsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.ListTdfAttachmentsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "parent_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTdfAttachmentsRequest {
+    return new ListTdfAttachmentsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTdfAttachmentsRequest {
+    return new ListTdfAttachmentsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTdfAttachmentsRequest {
+    return new ListTdfAttachmentsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTdfAttachmentsRequest | PlainMessage<ListTdfAttachmentsRequest> | undefined, b: ListTdfAttachmentsRequest | PlainMessage<ListTdfAttachmentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListTdfAttachmentsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.ListTdfAttachmentsResponse
+ */
+export class ListTdfAttachmentsResponse extends Message<ListTdfAttachmentsResponse> {
+  /**
+   * attachments without their tdf_blob and tdf_uri
+   *
+   * @generated from field: repeated tdf_object.v1.TdfAttachment tdf_attachments = 1;
+   */
+  tdfAttachments: TdfAttachment[] = [];
+
+  constructor(data?: PartialMessage<ListTdfAttachmentsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.ListTdfAttachmentsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tdf_attachments", kind: "message", T: TdfAttachment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTdfAttachmentsResponse {
+    return new ListTdfAttachmentsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTdfAttachmentsResponse {
+    return new ListTdfAttachmentsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTdfAttachmentsResponse {
+    return new ListTdfAttachmentsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTdfAttachmentsResponse | PlainMessage<ListTdfAttachmentsResponse> | undefined, b: ListTdfAttachmentsResponse | PlainMessage<ListTdfAttachmentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListTdfAttachmentsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.GetTdfAttachmentRequest
+ */
+export class GetTdfAttachmentRequest extends Message<GetTdfAttachmentRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetTdfAttachmentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.GetTdfAttachmentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTdfAttachmentRequest {
+    return new GetTdfAttachmentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTdfAttachmentRequest {
+    return new GetTdfAttachmentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTdfAttachmentRequest {
+    return new GetTdfAttachmentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetTdfAttachmentRequest | PlainMessage<GetTdfAttachmentRequest> | undefined, b: GetTdfAttachmentRequest | PlainMessage<GetTdfAttachmentRequest> | undefined): boolean {
+    return proto3.util.equals(GetTdfAttachmentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.GetTdfAttachmentResponse
+ */
+export class GetTdfAttachmentResponse extends Message<GetTdfAttachmentResponse> {
+  /**
+   * @generated from field: tdf_object.v1.TdfAttachment tdf_attachment = 1;
+   */
+  tdfAttachment?: TdfAttachment;
+
+  constructor(data?: PartialMessage<GetTdfAttachmentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.GetTdfAttachmentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tdf_attachment", kind: "message", T: TdfAttachment },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTdfAttachmentResponse {
+    return new GetTdfAttachmentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTdfAttachmentResponse {
+    return new GetTdfAttachmentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTdfAttachmentResponse {
+    return new GetTdfAttachmentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetTdfAttachmentResponse | PlainMessage<GetTdfAttachmentResponse> | undefined, b: GetTdfAttachmentResponse | PlainMessage<GetTdfAttachmentResponse> | undefined): boolean {
+    return proto3.util.equals(GetTdfAttachmentResponse, a, b);
+  }
+}
+
+/**
+ * version of a tdf_object before a change, with who changed it and when
+ *
+ * @generated from message tdf_object.v1.TdfObjectRevision
+ */
+export class TdfObjectRevision extends Message<TdfObjectRevision> {
+  /**
+   * number of the change, counting from 1 per tdf_object
+   *
+   * @generated from field: int32 revision = 1;
+   */
+  revision = 0;
+
+  /**
+   * the tdf_object as it was before the change
+   *
+   * @generated from field: tdf_object.v1.TdfObject tdf_object = 2;
+   */
+  tdfObject?: TdfObject;
+
+  /**
+   * columns the change modified, e.g. "search", "geo"
+   *
+   * @generated from field: repeated string changed_fields = 3;
+   */
+  changedFields: string[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp changed_at = 4;
+   */
+  changedAt?: Timestamp;
+
+  /**
+   * user of the token of the change, "anonymous" without one
+   *
+   * @generated from field: string changed_by = 5;
+   */
+  changedBy = "";
+
+  /**
+   * "update" or "revert"
+   *
+   * @generated from field: string operation = 6;
+   */
+  operation = "";
+
+  constructor(data?: PartialMessage<TdfObjectRevision>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.TdfObjectRevision";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "tdf_object", kind: "message", T: TdfObject },
+    { no: 3, name: "changed_fields", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "changed_at", kind: "message", T: Timestamp },
+    { no: 5, name: "changed_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "operation", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TdfObjectRevision {
+    return new TdfObjectRevision().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TdfObjectRevision {
+    return new TdfObjectRevision().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TdfObjectRevision {
+    return new TdfObjectRevision().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TdfObjectRevision | PlainMessage<TdfObjectRevision> | undefined, b: TdfObjectRevision | PlainMessage<TdfObjectRevision> | undefined): boolean {
+    return proto3.util.equals(TdfObjectRevision, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.ListTdfObjectRevisionsRequest
+ */
+export class ListTdfObjectRevisionsRequest extends Message<ListTdfObjectRevisionsRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<ListTdfObjectRevisionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.ListTdfObjectRevisionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTdfObjectRevisionsRequest {
+    return new ListTdfObjectRevisionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTdfObjectRevisionsRequest {
+    return new ListTdfObjectRevisionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTdfObjectRevisionsRequest {
+    return new ListTdfObjectRevisionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTdfObjectRevisionsRequest | PlainMessage<ListTdfObjectRevisionsRequest> | undefined, b: ListTdfObjectRevisionsRequest | PlainMessage<ListTdfObjectRevisionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListTdfObjectRevisionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.ListTdfObjectRevisionsResponse
+ */
+export class ListTdfObjectRevisionsResponse extends Message<ListTdfObjectRevisionsResponse> {
+  /**
+   * revisions visible to the caller, latest first
+   *
+   * @generated from field: repeated tdf_object.v1.TdfObjectRevision revisions = 1;
+   */
+  revisions: TdfObjectRevision[] = [];
+
+  constructor(data?: PartialMessage<ListTdfObjectRevisionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.ListTdfObjectRevisionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revisions", kind: "message", T: TdfObjectRevision, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTdfObjectRevisionsResponse {
+    return new ListTdfObjectRevisionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTdfObjectRevisionsResponse {
+    return new ListTdfObjectRevisionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTdfObjectRevisionsResponse {
+    return new ListTdfObjectRevisionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTdfObjectRevisionsResponse | PlainMessage<ListTdfObjectRevisionsResponse> | undefined, b: ListTdfObjectRevisionsResponse | PlainMessage<ListTdfObjectRevisionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListTdfObjectRevisionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.RevertTdfObjectRequest
+ */
+export class RevertTdfObjectRequest extends Message<RevertTdfObjectRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * revision whose version is restored
+   *
+   * @generated from field: int32 revision = 2;
+   */
+  revision = 0;
+
+  constructor(data?: PartialMessage<RevertTdfObjectRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.RevertTdfObjectRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevertTdfObjectRequest {
+    return new RevertTdfObjectRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevertTdfObjectRequest {
+    return new RevertTdfObjectRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevertTdfObjectRequest {
+    return new RevertTdfObjectRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevertTdfObjectRequest | PlainMessage<RevertTdfObjectRequest> | undefined, b: RevertTdfObjectRequest | PlainMessage<RevertTdfObjectRequest> | undefined): boolean {
+    return proto3.util.equals(RevertTdfObjectRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message tdf_object.v1.RevertTdfObjectResponse
+ */
+export class RevertTdfObjectResponse extends Message<RevertTdfObjectResponse> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * revision recording the version the revert replaced
+   *
+   * @generated from field: int32 revision = 2;
+   */
+  revision = 0;
+
+  constructor(data?: PartialMessage<RevertTdfObjectResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "tdf_object.v1.RevertTdfObjectResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevertTdfObjectResponse {
+    return new RevertTdfObjectResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevertTdfObjectResponse {
+    return new RevertTdfObjectResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevertTdfObjectResponse {
+    return new RevertTdfObjectResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevertTdfObjectResponse | PlainMessage<RevertTdfObjectResponse> | undefined, b: RevertTdfObjectResponse | PlainMessage<RevertTdfObjectResponse> | undefined): boolean {
+    return proto3.util.equals(RevertTdfObjectResponse, a, b);
+  }
+}
+
 /**
  * @generated from message tdf_object.v1.ListSrcTypesRequest
  */